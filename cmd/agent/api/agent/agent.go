@@ -24,6 +24,7 @@ import (
 	"github.com/DataDog/datadog-agent/cmd/agent/common/signals"
 	"github.com/DataDog/datadog-agent/cmd/agent/gui"
 	"github.com/DataDog/datadog-agent/pkg/autodiscovery"
+	"github.com/DataDog/datadog-agent/pkg/collector/runner/expvars"
 	"github.com/DataDog/datadog-agent/pkg/config"
 	settingshttp "github.com/DataDog/datadog-agent/pkg/config/settings/http"
 	"github.com/DataDog/datadog-agent/pkg/flare"
@@ -62,6 +63,7 @@ func SetupHandlers(r *mux.Router) *mux.Router {
 	r.HandleFunc("/{component}/configs", componentConfigHandler).Methods("GET")
 	r.HandleFunc("/gui/csrf-token", getCSRFToken).Methods("GET")
 	r.HandleFunc("/config-check", getConfigCheck).Methods("GET")
+	r.HandleFunc("/config-check/explain", getConfigCheckExplain).Methods("GET")
 	r.HandleFunc("/config", settingshttp.Server.GetFull("")).Methods("GET")
 	r.HandleFunc("/config/list-runtime", settingshttp.Server.ListConfigurable).Methods("GET")
 	r.HandleFunc("/config/{setting}", settingshttp.Server.GetValue).Methods("GET")
@@ -70,6 +72,7 @@ func SetupHandlers(r *mux.Router) *mux.Router {
 	r.HandleFunc("/workload-list/short", getShortWorkloadList).Methods("GET")
 	r.HandleFunc("/workload-list/verbose", getVerboseWorkloadList).Methods("GET")
 	r.HandleFunc("/secrets", secretInfo).Methods("GET")
+	r.HandleFunc("/check-history/{check}", getCheckHistory).Methods("GET")
 
 	return r
 }
@@ -371,6 +374,39 @@ func getConfigCheck(w http.ResponseWriter, r *http.Request) {
 	w.Write(jsonConfig)
 }
 
+func getConfigCheckExplain(w http.ResponseWriter, r *http.Request) {
+	if common.AC == nil {
+		log.Errorf("Trying to use /config-check/explain before the agent has been initialized.")
+		body, _ := json.Marshal(map[string]string{"error": "agent not initialized"})
+		http.Error(w, string(body), 503)
+		return
+	}
+
+	query := r.URL.Query().Get("service")
+	if query == "" {
+		body, _ := json.Marshal(map[string]string{"error": "a container or service must be specified"})
+		http.Error(w, string(body), 400)
+		return
+	}
+
+	explanations := common.AC.Explain(r.Context(), query)
+	if len(explanations) == 0 {
+		body, _ := json.Marshal(map[string]string{"error": fmt.Sprintf("no service matching %q found", query)})
+		http.Error(w, string(body), 404)
+		return
+	}
+
+	jsonExplanations, err := json.Marshal(explanations)
+	if err != nil {
+		log.Errorf("Unable to marshal config check explanations: %s", err)
+		body, _ := json.Marshal(map[string]string{"error": err.Error()})
+		http.Error(w, string(body), 500)
+		return
+	}
+
+	w.Write(jsonExplanations)
+}
+
 func getTaggerList(w http.ResponseWriter, r *http.Request) {
 	// query at the highest cardinality between checks and dogstatsd cardinalities
 	cardinality := collectors.TagCardinality(max(int(tagger.ChecksCardinality), int(tagger.DogstatsdCardinality)))
@@ -425,6 +461,25 @@ func secretInfo(w http.ResponseWriter, r *http.Request) {
 	w.Write(jsonInfo)
 }
 
+func getCheckHistory(w http.ResponseWriter, r *http.Request) {
+	name := mux.Vars(r)["check"]
+	history := expvars.GetCheckHistory(name)
+	if len(history) == 0 {
+		body, _ := json.Marshal(map[string]string{"error": fmt.Sprintf("no check named or with ID %q is running", name)})
+		http.Error(w, string(body), 404)
+		return
+	}
+
+	jsonHistory, err := json.Marshal(history)
+	if err != nil {
+		log.Errorf("Unable to marshal check history response: %s", err)
+		body, _ := json.Marshal(map[string]string{"error": err.Error()})
+		http.Error(w, string(body), 500)
+		return
+	}
+	w.Write(jsonHistory)
+}
+
 // max returns the maximum value between a and b.
 func max(a, b int) int {
 	if a > b {
@@ -0,0 +1,171 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/DataDog/datadog-agent/cmd/agent/common"
+	"github.com/DataDog/datadog-agent/pkg/api/util"
+	"github.com/DataDog/datadog-agent/pkg/collector/check"
+	"github.com/DataDog/datadog-agent/pkg/config"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+var (
+	checkHistoryJSON       bool
+	checkHistoryPrettyJSON bool
+	checkHistoryLast       int
+)
+
+func init() {
+	AgentCmd.AddCommand(checkHistoryCommand)
+	checkHistoryCommand.Flags().BoolVarP(&checkHistoryJSON, "json", "j", false, "print out raw json")
+	checkHistoryCommand.Flags().BoolVarP(&checkHistoryPrettyJSON, "pretty-json", "p", false, "pretty print JSON")
+	checkHistoryCommand.Flags().IntVarP(&checkHistoryLast, "last", "n", 0, "only print the last N runs of each instance (0 prints all the recorded runs)")
+}
+
+var checkHistoryCommand = &cobra.Command{
+	Use:   "check-history <check_name|check_id>",
+	Short: "Print the results of the most recent runs of a check in a running agent",
+	Long: `Print the results of the most recent runs of the instances of a check in a running agent.
+The number of runs kept per instance is set by check_history.size, the samples submitted
+during each run are only recorded when check_history.include_samples is enabled.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			return fmt.Errorf("a check name or check ID must be specified")
+		}
+
+		if flagNoColor {
+			color.NoColor = true
+		}
+
+		err := common.SetupConfigWithoutSecrets(confFilePath, "")
+		if err != nil {
+			return fmt.Errorf("unable to set up global agent configuration: %v", err)
+		}
+
+		err = config.SetupLogger(loggerName, config.GetEnvDefault("DD_LOG_LEVEL", "off"), "", "", false, true, false)
+		if err != nil {
+			fmt.Printf("Cannot setup logger, exiting: %v\n", err)
+			return err
+		}
+
+		c := util.GetClient(false) // FIX: get certificates right then make this true
+
+		// Set session token
+		err = util.SetAuthToken()
+		if err != nil {
+			return err
+		}
+		ipcAddress, err := config.GetIPCAddress()
+		if err != nil {
+			return err
+		}
+
+		urlstr := fmt.Sprintf("https://%v:%v/agent/check-history/%s", ipcAddress, config.Datadog.GetInt("cmd_port"), url.PathEscape(args[0]))
+		r, err := util.DoGet(c, urlstr)
+		if err != nil {
+			var errMap = make(map[string]string)
+			json.Unmarshal(r, &errMap) //nolint:errcheck
+			if e, found := errMap["error"]; found {
+				return fmt.Errorf("the agent ran into an error while getting the check history: %s", e)
+			}
+			return fmt.Errorf("could not reach agent: %v\nMake sure the agent is running before requesting the check history and contact support if you continue having issues", err)
+		}
+
+		history := make(map[check.ID][]check.RunResult)
+		if err = json.Unmarshal(r, &history); err != nil {
+			return err
+		}
+		if checkHistoryLast > 0 {
+			for id, results := range history {
+				if len(results) > checkHistoryLast {
+					history[id] = results[len(results)-checkHistoryLast:]
+				}
+			}
+		}
+
+		if checkHistoryJSON || checkHistoryPrettyJSON {
+			out, err := json.Marshal(history)
+			if err != nil {
+				return err
+			}
+			if checkHistoryPrettyJSON {
+				var prettyJSON bytes.Buffer
+				json.Indent(&prettyJSON, out, "", "  ") //nolint:errcheck
+				out = prettyJSON.Bytes()
+			}
+			fmt.Println(string(out))
+			return nil
+		}
+
+		printCheckHistory(color.Output, history)
+		return nil
+	},
+}
+
+func printCheckHistory(w io.Writer, history map[check.ID][]check.RunResult) {
+	ids := make([]string, 0, len(history))
+	for id := range history {
+		ids = append(ids, string(id))
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		results := history[check.ID(id)]
+		fmt.Fprintf(w, "=== %s ===\n", color.HiCyanString(id))
+		if len(results) == 0 {
+			fmt.Fprintln(w, "  No run recorded yet")
+		}
+		for _, r := range results {
+			status := color.GreenString("[OK]")
+			if r.TimedOut {
+				status = color.RedString("[TIMEOUT]")
+			} else if r.Error != "" {
+				status = color.RedString("[ERROR]")
+			} else if len(r.Warnings) > 0 {
+				status = color.YellowString("[WARNING]")
+			}
+			fmt.Fprintf(w, "%s %s duration: %dms, metric samples: %d, histogram buckets: %d, events: %d, service checks: %d\n",
+				status, r.Start().UTC().Format("2006-01-02 15:04:05 MST"), r.Duration, r.MetricSamples, r.HistogramBuckets, r.Events, r.ServiceChecks)
+			if r.Error != "" {
+				fmt.Fprintf(w, "  Error: %s\n", r.Error)
+			}
+			for _, warning := range r.Warnings {
+				fmt.Fprintf(w, "  Warning: %s\n", warning)
+			}
+			if len(r.Samples) > 0 {
+				fmt.Fprintln(w, "  Samples:")
+			}
+			for _, s := range r.Samples {
+				fmt.Fprintf(w, "    %s %s %s %v", s.Kind, s.Name, s.Type, s.Value)
+				if s.Hostname != "" {
+					fmt.Fprintf(w, " host:%s", s.Hostname)
+				}
+				if len(s.Tags) > 0 {
+					fmt.Fprintf(w, " tags:[%s]", strings.Join(s.Tags, ","))
+				}
+				if s.Message != "" {
+					fmt.Fprintf(w, " message:%q", s.Message)
+				}
+				fmt.Fprintln(w)
+			}
+			if r.SamplesDropped > 0 {
+				fmt.Fprintf(w, "    (%d more samples not recorded)\n", r.SamplesDropped)
+			}
+		}
+		fmt.Fprintln(w)
+	}
+}
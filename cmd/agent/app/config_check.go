@@ -18,12 +18,16 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var withDebug bool
+var (
+	withDebug      bool
+	explainService string
+)
 
 func init() {
 	AgentCmd.AddCommand(configCheckCommand)
 
 	configCheckCommand.Flags().BoolVarP(&withDebug, "verbose", "v", false, "print additional debug info")
+	configCheckCommand.Flags().StringVar(&explainService, "explain", "", "explain how the templates match the services whose entity contains the given container ID or service name")
 }
 
 var configCheckCommand = &cobra.Command{
@@ -48,7 +52,11 @@ var configCheckCommand = &cobra.Command{
 		}
 		var b bytes.Buffer
 		color.Output = &b
-		err = flare.GetConfigCheck(color.Output, withDebug)
+		if explainService != "" {
+			err = flare.GetConfigCheckExplain(color.Output, explainService)
+		} else {
+			err = flare.GetConfigCheck(color.Output, withDebug)
+		}
 		if err != nil {
 			return fmt.Errorf("unable to get config: %v", err)
 		}
@@ -0,0 +1,13 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// +build wasmchecks,go1.18
+
+package app
+
+import (
+	// register the WebAssembly check loader, whose runtime requires Go 1.18
+	_ "github.com/DataDog/datadog-agent/pkg/collector/wasm"
+)
@@ -0,0 +1,188 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package baseline implements the baselines of the `check` command: the series,
+// service checks and events emitted by a check are saved to a file with `--record`,
+// and the output of later runs is compared against it with `--compare`.
+package baseline
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/DataDog/datadog-agent/pkg/metrics"
+)
+
+// Metric is a metric context emitted by a check, with the last value it was reported with
+type Metric struct {
+	Name  string   `json:"metric"`
+	Type  string   `json:"type"`
+	Host  string   `json:"host,omitempty"`
+	Tags  []string `json:"tags,omitempty"`
+	Value *float64 `json:"value,omitempty"` // not set for distributions
+}
+
+// ServiceCheck is a service check emitted by a check
+type ServiceCheck struct {
+	Name   string   `json:"check"`
+	Host   string   `json:"host,omitempty"`
+	Tags   []string `json:"tags,omitempty"`
+	Status string   `json:"status"`
+}
+
+// Event is an event emitted by a check
+type Event struct {
+	Title          string   `json:"title"`
+	AlertType      string   `json:"alert_type,omitempty"`
+	SourceTypeName string   `json:"source_type_name,omitempty"`
+	Host           string   `json:"host,omitempty"`
+	Tags           []string `json:"tags,omitempty"`
+}
+
+// Baseline holds the output of the runs of a check
+type Baseline struct {
+	Check         string         `json:"check"`
+	Metrics       []Metric       `json:"metrics"`
+	ServiceChecks []ServiceCheck `json:"service_checks"`
+	Events        []Event        `json:"events"`
+
+	// hostname of the agent, which isn't recorded so that baselines can be compared
+	// across hosts
+	hostname string
+}
+
+// New returns an empty baseline for a check, run by an agent with the given hostname
+func New(checkName, hostname string) *Baseline {
+	return &Baseline{
+		Check:         checkName,
+		Metrics:       []Metric{},
+		ServiceChecks: []ServiceCheck{},
+		Events:        []Event{},
+		hostname:      hostname,
+	}
+}
+
+// Load reads a baseline from a file
+func Load(path string) (*Baseline, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read baseline: %v", err)
+	}
+	b := &Baseline{}
+	if err := json.Unmarshal(content, b); err != nil {
+		return nil, fmt.Errorf("unable to parse baseline %s: %v", path, err)
+	}
+	return b, nil
+}
+
+// Save writes the baseline to a file
+func (b *Baseline) Save(path string) error {
+	b.sort()
+	content, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, append(content, '\n'), 0644)
+}
+
+// Add adds the output of a check run to the baseline
+func (b *Baseline) Add(series metrics.Series, sketches metrics.SketchSeriesList, serviceChecks metrics.ServiceChecks, events metrics.Events) {
+	for _, s := range series {
+		if len(s.Points) == 0 {
+			continue
+		}
+		value := s.Points[len(s.Points)-1].Value
+		b.Metrics = append(b.Metrics, Metric{
+			Name:  s.Name,
+			Type:  s.MType.String(),
+			Host:  b.host(s.Host),
+			Tags:  sortedTags(s.Tags),
+			Value: &value,
+		})
+	}
+	for _, s := range sketches {
+		b.Metrics = append(b.Metrics, Metric{
+			Name: s.Name,
+			Type: "distribution",
+			Host: b.host(s.Host),
+			Tags: sortedTags(s.Tags),
+		})
+	}
+	for _, sc := range serviceChecks {
+		b.ServiceChecks = append(b.ServiceChecks, ServiceCheck{
+			Name:   sc.CheckName,
+			Host:   b.host(sc.Host),
+			Tags:   sortedTags(sc.Tags),
+			Status: sc.Status.String(),
+		})
+	}
+	for _, e := range events {
+		b.Events = append(b.Events, Event{
+			Title:          e.Title,
+			AlertType:      string(e.AlertType),
+			SourceTypeName: e.SourceTypeName,
+			Host:           b.host(e.Host),
+			Tags:           sortedTags(e.Tags),
+		})
+	}
+}
+
+// host returns the host to record, empty for the hostname of the agent
+func (b *Baseline) host(host string) string {
+	if host == b.hostname {
+		return ""
+	}
+	return host
+}
+
+func (b *Baseline) sort() {
+	sort.SliceStable(b.Metrics, func(i, j int) bool { return b.Metrics[i].context() < b.Metrics[j].context() })
+	sort.SliceStable(b.ServiceChecks, func(i, j int) bool { return b.ServiceChecks[i].context() < b.ServiceChecks[j].context() })
+	sort.SliceStable(b.Events, func(i, j int) bool { return b.Events[i].context() < b.Events[j].context() })
+}
+
+func sortedTags(tags []string) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+	sorted := append([]string{}, tags...)
+	sort.Strings(sorted)
+	return sorted
+}
+
+func formatContext(name, host string, tags []string) string {
+	var sb strings.Builder
+	sb.WriteString(name)
+	sb.WriteString("{")
+	sb.WriteString(strings.Join(tags, ","))
+	sb.WriteString("}")
+	if host != "" {
+		sb.WriteString(" host:")
+		sb.WriteString(host)
+	}
+	return sb.String()
+}
+
+func (m Metric) context() string {
+	return formatContext(m.Name, m.Host, m.Tags)
+}
+
+func (sc ServiceCheck) context() string {
+	return formatContext(sc.Name, sc.Host, sc.Tags)
+}
+
+func (e Event) context() string {
+	name := e.Title
+	if e.AlertType != "" {
+		name += " [" + e.AlertType + "]"
+	}
+	if e.SourceTypeName != "" {
+		name += " source:" + e.SourceTypeName
+	}
+	return formatContext(name, e.Host, e.Tags)
+}
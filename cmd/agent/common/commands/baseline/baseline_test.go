@@ -0,0 +1,125 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package baseline
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DataDog/datadog-agent/pkg/metrics"
+)
+
+func newTestBaseline(gaugeValue float64, status metrics.ServiceCheckStatus, events ...string) *Baseline {
+	b := New("test", "agent-host")
+	series := metrics.Series{
+		{Name: "test.gauge", Points: []metrics.Point{{Ts: 1, Value: 0}, {Ts: 2, Value: gaugeValue}}, Tags: []string{"b:2", "a:1"}, Host: "agent-host", MType: metrics.APIGaugeType},
+		{Name: "test.count", Points: []metrics.Point{{Ts: 1, Value: 3}}, Host: "other-host", MType: metrics.APICountType},
+		{Name: "test.no_points", MType: metrics.APIGaugeType},
+	}
+	sketches := metrics.SketchSeriesList{{Name: "test.distribution", Host: "agent-host"}}
+	serviceChecks := metrics.ServiceChecks{{CheckName: "test.can_connect", Status: status, Host: "agent-host", Tags: []string{"port:80"}}}
+	var evs metrics.Events
+	for _, title := range events {
+		evs = append(evs, &metrics.Event{Title: title, AlertType: metrics.EventAlertTypeInfo, Host: "agent-host"})
+	}
+	b.Add(series, sketches, serviceChecks, evs)
+	return b
+}
+
+func TestAdd(t *testing.T) {
+	b := newTestBaseline(42, metrics.ServiceCheckOK, "restarted")
+
+	value, count := 42.0, 3.0
+	assert.ElementsMatch(t, []Metric{
+		{Name: "test.gauge", Type: "gauge", Tags: []string{"a:1", "b:2"}, Value: &value},
+		{Name: "test.count", Type: "count", Host: "other-host", Value: &count},
+		{Name: "test.distribution", Type: "distribution"},
+	}, b.Metrics)
+	assert.Equal(t, []ServiceCheck{{Name: "test.can_connect", Tags: []string{"port:80"}, Status: "OK"}}, b.ServiceChecks)
+	assert.Equal(t, []Event{{Title: "restarted", AlertType: "info"}}, b.Events)
+}
+
+func TestSaveLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "check-baseline")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "baseline.json")
+
+	b := newTestBaseline(42, metrics.ServiceCheckOK, "restarted")
+	require.NoError(t, b.Save(path))
+
+	loaded, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, "test", loaded.Check)
+	assert.Equal(t, b.Metrics, loaded.Metrics)
+	assert.Equal(t, b.ServiceChecks, loaded.ServiceChecks)
+	assert.Equal(t, b.Events, loaded.Events)
+	assert.True(t, Compare(b, loaded, 0).IsEmpty())
+
+	_, err = Load(filepath.Join(dir, "missing.json"))
+	assert.Error(t, err)
+}
+
+func TestCompare(t *testing.T) {
+	baseline := newTestBaseline(42, metrics.ServiceCheckOK, "restarted")
+	assert.True(t, Compare(baseline, newTestBaseline(42, metrics.ServiceCheckOK, "restarted"), 0).IsEmpty())
+
+	current := newTestBaseline(44, metrics.ServiceCheckCritical, "restarted", "restarted", "failover")
+	value := 1.0
+	for i, m := range current.Metrics {
+		switch m.Name {
+		case "test.gauge":
+			current.Metrics[i] = Metric{Name: "test.gauge", Type: "gauge", Tags: []string{"a:1"}, Value: &value}
+		case "test.count":
+			current.Metrics[i] = Metric{Name: "test.count", Type: "rate", Host: "other-host", Value: &value}
+		}
+	}
+
+	diff := Compare(baseline, current, IgnoreValues)
+	assert.False(t, diff.IsEmpty())
+	assert.Equal(t, []string{"test.gauge{a:1}"}, diff.AddedMetrics)
+	assert.Equal(t, []string{"test.gauge{a:1,b:2}"}, diff.RemovedMetrics)
+	assert.Equal(t, []Change{{Context: "test.count{} host:other-host", Baseline: "count 3", Current: "rate 1"}}, diff.ChangedMetrics)
+	assert.Empty(t, diff.AddedServiceChecks)
+	assert.Empty(t, diff.RemovedServiceChecks)
+	assert.Equal(t, []Change{{Context: "test.can_connect{port:80}", Baseline: "OK", Current: "CRITICAL"}}, diff.ChangedServiceChecks)
+	assert.Equal(t, []string{"failover [info]{}", "restarted [info]{}"}, diff.AddedEvents)
+	assert.Empty(t, diff.RemovedEvents)
+
+	diff = Compare(current, baseline, IgnoreValues)
+	assert.Equal(t, []string{"failover [info]{}", "restarted [info]{}"}, diff.RemovedEvents)
+}
+
+func TestCompareDuplicateContexts(t *testing.T) {
+	baseline := newTestBaseline(42, metrics.ServiceCheckOK)
+	current := newTestBaseline(42, metrics.ServiceCheckOK)
+	value := 42.0
+	current.Metrics = append(current.Metrics, Metric{Name: "test.gauge", Type: "gauge", Tags: []string{"a:1", "b:2"}, Value: &value})
+	current.ServiceChecks = append(current.ServiceChecks, ServiceCheck{Name: "test.can_connect", Tags: []string{"port:80"}, Status: "OK"})
+
+	diff := Compare(baseline, current, IgnoreValues)
+	assert.Equal(t, []Change{{Context: "test.gauge{a:1,b:2}", Baseline: "submitted once", Current: "submitted 2 times"}}, diff.ChangedMetrics)
+	assert.Equal(t, []Change{{Context: "test.can_connect{port:80}", Baseline: "submitted once", Current: "submitted 2 times"}}, diff.ChangedServiceChecks)
+
+	assert.True(t, Compare(current, current, 0).IsEmpty())
+}
+
+func TestCompareTolerance(t *testing.T) {
+	baseline := newTestBaseline(100, metrics.ServiceCheckOK)
+	current := newTestBaseline(105, metrics.ServiceCheckOK)
+
+	assert.True(t, Compare(baseline, current, IgnoreValues).IsEmpty())
+
+	diff := Compare(baseline, current, 0)
+	assert.Equal(t, []Change{{Context: "test.gauge{a:1,b:2}", Baseline: "gauge 100", Current: "gauge 105"}}, diff.ChangedMetrics)
+	assert.False(t, Compare(baseline, current, 0.01).IsEmpty())
+	assert.True(t, Compare(baseline, current, 0.1).IsEmpty())
+}
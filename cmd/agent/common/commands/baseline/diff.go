@@ -0,0 +1,218 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package baseline
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/fatih/color"
+)
+
+// Change is a context found both in the baseline and in the current output of the check,
+// with a different type, value or status
+type Change struct {
+	Context  string `json:"context"`
+	Baseline string `json:"baseline"`
+	Current  string `json:"current"`
+}
+
+// Diff lists the differences between a baseline and the current output of a check
+type Diff struct {
+	AddedMetrics         []string `json:"added_metrics,omitempty"`
+	RemovedMetrics       []string `json:"removed_metrics,omitempty"`
+	ChangedMetrics       []Change `json:"changed_metrics,omitempty"`
+	AddedServiceChecks   []string `json:"added_service_checks,omitempty"`
+	RemovedServiceChecks []string `json:"removed_service_checks,omitempty"`
+	ChangedServiceChecks []Change `json:"changed_service_checks,omitempty"`
+	AddedEvents          []string `json:"added_events,omitempty"`
+	RemovedEvents        []string `json:"removed_events,omitempty"`
+}
+
+// IgnoreValues is the tolerance with which Compare only compares the contexts of the metrics,
+// not their values
+const IgnoreValues = -1
+
+// Compare returns the differences between a baseline and the current output of a check.
+// Contexts submitted a different number of times are reported as changed. Metric values are
+// considered changed when their relative difference exceeds tolerance, a negative tolerance
+// such as IgnoreValues disables the comparison of values.
+func Compare(baseline, current *Baseline, tolerance float64) Diff {
+	var d Diff
+
+	baselineMetrics := make(map[string][]Metric, len(baseline.Metrics))
+	for _, m := range baseline.Metrics {
+		baselineMetrics[m.context()] = append(baselineMetrics[m.context()], m)
+	}
+	currentMetrics := make(map[string][]Metric, len(current.Metrics))
+	for _, m := range current.Metrics {
+		currentMetrics[m.context()] = append(currentMetrics[m.context()], m)
+	}
+	for ctx, ms := range currentMetrics {
+		olds, found := baselineMetrics[ctx]
+		switch {
+		case !found:
+			d.AddedMetrics = appendN(d.AddedMetrics, ctx, len(ms))
+		case len(olds) != len(ms):
+			d.ChangedMetrics = append(d.ChangedMetrics, Change{Context: ctx, Baseline: submissions(len(olds)), Current: submissions(len(ms))})
+		default:
+			sortMetrics(olds)
+			sortMetrics(ms)
+			for i, m := range ms {
+				if olds[i].Type != m.Type || (tolerance >= 0 && valueChanged(olds[i].Value, m.Value, tolerance)) {
+					d.ChangedMetrics = append(d.ChangedMetrics, Change{Context: ctx, Baseline: olds[i].describe(), Current: m.describe()})
+					break
+				}
+			}
+		}
+	}
+	for ctx, olds := range baselineMetrics {
+		if _, found := currentMetrics[ctx]; !found {
+			d.RemovedMetrics = appendN(d.RemovedMetrics, ctx, len(olds))
+		}
+	}
+
+	baselineServiceChecks := make(map[string][]ServiceCheck, len(baseline.ServiceChecks))
+	for _, sc := range baseline.ServiceChecks {
+		baselineServiceChecks[sc.context()] = append(baselineServiceChecks[sc.context()], sc)
+	}
+	currentServiceChecks := make(map[string][]ServiceCheck, len(current.ServiceChecks))
+	for _, sc := range current.ServiceChecks {
+		currentServiceChecks[sc.context()] = append(currentServiceChecks[sc.context()], sc)
+	}
+	for ctx, scs := range currentServiceChecks {
+		olds, found := baselineServiceChecks[ctx]
+		switch {
+		case !found:
+			d.AddedServiceChecks = appendN(d.AddedServiceChecks, ctx, len(scs))
+		case len(olds) != len(scs):
+			d.ChangedServiceChecks = append(d.ChangedServiceChecks, Change{Context: ctx, Baseline: submissions(len(olds)), Current: submissions(len(scs))})
+		default:
+			oldStatuses, statuses := serviceCheckStatuses(olds), serviceCheckStatuses(scs)
+			if oldStatuses != statuses {
+				d.ChangedServiceChecks = append(d.ChangedServiceChecks, Change{Context: ctx, Baseline: oldStatuses, Current: statuses})
+			}
+		}
+	}
+	for ctx, olds := range baselineServiceChecks {
+		if _, found := currentServiceChecks[ctx]; !found {
+			d.RemovedServiceChecks = appendN(d.RemovedServiceChecks, ctx, len(olds))
+		}
+	}
+
+	// events aren't deduplicated, the same event can be emitted several times
+	eventCounts := make(map[string]int)
+	for _, e := range current.Events {
+		eventCounts[e.context()]++
+	}
+	for _, e := range baseline.Events {
+		eventCounts[e.context()]--
+	}
+	for ctx, count := range eventCounts {
+		if count > 0 {
+			d.AddedEvents = appendN(d.AddedEvents, ctx, count)
+		} else if count < 0 {
+			d.RemovedEvents = appendN(d.RemovedEvents, ctx, -count)
+		}
+	}
+
+	d.sort()
+	return d
+}
+
+// appendN appends n times ctx to contexts
+func appendN(contexts []string, ctx string, n int) []string {
+	for i := 0; i < n; i++ {
+		contexts = append(contexts, ctx)
+	}
+	return contexts
+}
+
+func submissions(n int) string {
+	if n == 1 {
+		return "submitted once"
+	}
+	return fmt.Sprintf("submitted %d times", n)
+}
+
+// sortMetrics sorts metrics sharing the same context, so that they can be compared one by one
+func sortMetrics(ms []Metric) {
+	sort.SliceStable(ms, func(i, j int) bool { return ms[i].describe() < ms[j].describe() })
+}
+
+// serviceCheckStatuses returns the sorted statuses of service checks sharing the same context
+func serviceCheckStatuses(scs []ServiceCheck) string {
+	statuses := make([]string, 0, len(scs))
+	for _, sc := range scs {
+		statuses = append(statuses, sc.Status)
+	}
+	sort.Strings(statuses)
+	return strings.Join(statuses, ",")
+}
+
+// IsEmpty returns whether the current output of the check matches the baseline
+func (d Diff) IsEmpty() bool {
+	return len(d.AddedMetrics) == 0 && len(d.RemovedMetrics) == 0 && len(d.ChangedMetrics) == 0 &&
+		len(d.AddedServiceChecks) == 0 && len(d.RemovedServiceChecks) == 0 && len(d.ChangedServiceChecks) == 0 &&
+		len(d.AddedEvents) == 0 && len(d.RemovedEvents) == 0
+}
+
+// Write writes a human-readable version of the diff
+func (d Diff) Write(w io.Writer) {
+	if d.IsEmpty() {
+		fmt.Fprintln(w, color.GreenString("The output of the check matches the baseline"))
+		return
+	}
+	writeSection(w, "Metrics", d.AddedMetrics, d.RemovedMetrics, d.ChangedMetrics)
+	writeSection(w, "Service Checks", d.AddedServiceChecks, d.RemovedServiceChecks, d.ChangedServiceChecks)
+	writeSection(w, "Events", d.AddedEvents, d.RemovedEvents, nil)
+}
+
+func writeSection(w io.Writer, title string, added, removed []string, changed []Change) {
+	if len(added) == 0 && len(removed) == 0 && len(changed) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "=== %s ===\n", color.BlueString(title))
+	for _, ctx := range added {
+		fmt.Fprintln(w, color.GreenString("+ %s", ctx))
+	}
+	for _, ctx := range removed {
+		fmt.Fprintln(w, color.RedString("- %s", ctx))
+	}
+	for _, c := range changed {
+		fmt.Fprintf(w, "%s: %s -> %s\n", color.YellowString("~ %s", c.Context), c.Baseline, c.Current)
+	}
+	fmt.Fprintln(w)
+}
+
+func (d *Diff) sort() {
+	sort.Strings(d.AddedMetrics)
+	sort.Strings(d.RemovedMetrics)
+	sort.Slice(d.ChangedMetrics, func(i, j int) bool { return d.ChangedMetrics[i].Context < d.ChangedMetrics[j].Context })
+	sort.Strings(d.AddedServiceChecks)
+	sort.Strings(d.RemovedServiceChecks)
+	sort.Slice(d.ChangedServiceChecks, func(i, j int) bool { return d.ChangedServiceChecks[i].Context < d.ChangedServiceChecks[j].Context })
+	sort.Strings(d.AddedEvents)
+	sort.Strings(d.RemovedEvents)
+}
+
+func (m Metric) describe() string {
+	if m.Value == nil {
+		return m.Type
+	}
+	return fmt.Sprintf("%s %v", m.Type, *m.Value)
+}
+
+func valueChanged(old, current *float64, tolerance float64) bool {
+	if old == nil || current == nil {
+		return old != current
+	}
+	diff := math.Abs(*current - *old)
+	return diff > tolerance*math.Max(math.Abs(*old), math.Abs(*current))
+}
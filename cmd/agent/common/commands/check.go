@@ -26,6 +26,7 @@ import (
 
 	"github.com/DataDog/datadog-agent/cmd/agent/app/standalone"
 	"github.com/DataDog/datadog-agent/cmd/agent/common"
+	"github.com/DataDog/datadog-agent/cmd/agent/common/commands/baseline"
 	"github.com/DataDog/datadog-agent/pkg/aggregator"
 	"github.com/DataDog/datadog-agent/pkg/autodiscovery"
 	"github.com/DataDog/datadog-agent/pkg/autodiscovery/integration"
@@ -67,6 +68,9 @@ var (
 	profileMemoryVerbose   string
 	discoveryTimeout       uint
 	discoveryRetryInterval uint
+	recordBaseline         string
+	compareBaseline        string
+	compareTolerance       float64
 )
 
 func setupCmd(cmd *cobra.Command) {
@@ -83,6 +87,9 @@ func setupCmd(cmd *cobra.Command) {
 	cmd.Flags().BoolVarP(&saveFlare, "flare", "", false, "save check results to the log dir so it may be reported in a flare")
 	cmd.Flags().UintVarP(&discoveryTimeout, "discovery-timeout", "", 5, "max retry duration until Autodiscovery resolves the check template (in seconds)")
 	cmd.Flags().UintVarP(&discoveryRetryInterval, "discovery-retry-interval", "", 1, "duration between retries until Autodiscovery resolves the check template (in seconds)")
+	cmd.Flags().StringVar(&recordBaseline, "record", "", "save the series, service checks and events emitted by the check as a JSON baseline to this file")
+	cmd.Flags().StringVar(&compareBaseline, "compare", "", "compare the series, service checks and events emitted by the check against the JSON baseline in this file, and fail if they differ")
+	cmd.Flags().Float64Var(&compareTolerance, "tolerance", 0, "relative difference allowed between the metric values of the check and of the baseline with --compare, metric values are only compared when it is set")
 	config.Datadog.BindPFlag("cmd.check.fullsketches", cmd.Flags().Lookup("full-sketches")) //nolint:errcheck
 
 	// Power user flags - mark as hidden
@@ -129,6 +136,27 @@ func Check(loggerName config.LoggerName, confFilePath *string, flagNoColor *bool
 				return nil
 			}
 
+			if recordBaseline != "" && compareBaseline != "" {
+				return fmt.Errorf("the --record and --compare options can't be used together")
+			}
+			var expectedBaseline *baseline.Baseline
+			tolerance := float64(baseline.IgnoreValues)
+			if cmd.Flags().Changed("tolerance") {
+				if compareTolerance < 0 {
+					return fmt.Errorf("invalid --tolerance %v: must not be negative", compareTolerance)
+				}
+				tolerance = compareTolerance
+			}
+			if compareBaseline != "" {
+				expectedBaseline, err = baseline.Load(compareBaseline)
+				if err != nil {
+					return err
+				}
+				if expectedBaseline.Check != checkName {
+					color.Yellow("The baseline %s was recorded for the check %s", compareBaseline, expectedBaseline.Check)
+				}
+			}
+
 			hostname, err := util.GetHostname(context.TODO())
 			if err != nil {
 				fmt.Printf("Cannot get hostname, exiting: %v\n", err)
@@ -308,13 +336,25 @@ func Check(loggerName config.LoggerName, confFilePath *string, flagNoColor *bool
 
 			var checkFileOutput bytes.Buffer
 			var instancesData []interface{}
+			currentBaseline := baseline.New(checkName, hostname)
 			for _, c := range cs {
 				s := runCheck(c, agg)
 
 				// Sleep for a while to allow the aggregator to finish ingesting all the metrics/events/sc
 				time.Sleep(time.Duration(checkDelay) * time.Millisecond)
 
-				if formatJSON {
+				if recordBaseline != "" || compareBaseline != "" {
+					// the output of all the instances is merged in the baseline
+					series, sketches := agg.GetSeriesAndSketches(time.Now())
+					currentBaseline.Add(series, sketches, agg.GetServiceChecks(), agg.GetEvents())
+					checkStatus, _ := status.GetCheckStatus(c, s)
+					if formatJSON {
+						// keep stdout parseable, only the baseline comparison is written there
+						fmt.Fprintln(os.Stderr, string(checkStatus))
+					} else {
+						fmt.Println(string(checkStatus))
+					}
+				} else if formatJSON {
 					aggregatorData := getMetricsData(agg)
 					var collectorData map[string]interface{}
 
@@ -405,6 +445,30 @@ func Check(loggerName config.LoggerName, confFilePath *string, flagNoColor *bool
 				standalone.PrintWindowsUserWarning("check")
 			}
 
+			if recordBaseline != "" {
+				if err := currentBaseline.Save(recordBaseline); err != nil {
+					return fmt.Errorf("unable to write the baseline: %v", err)
+				}
+				fmt.Fprintln(color.Output, fmt.Sprintf("Baseline with %d metrics, %d service checks and %d events written to %s",
+					len(currentBaseline.Metrics), len(currentBaseline.ServiceChecks), len(currentBaseline.Events), recordBaseline))
+				return nil
+			}
+
+			if compareBaseline != "" {
+				diff := baseline.Compare(expectedBaseline, currentBaseline, tolerance)
+				if formatJSON {
+					j, _ := json.MarshalIndent(diff, "", "  ")
+					fmt.Println(string(j))
+				} else {
+					fmt.Fprintln(color.Output, fmt.Sprintf("=== %s ===", color.BlueString("Baseline comparison")))
+					diff.Write(color.Output)
+				}
+				if !diff.IsEmpty() {
+					return fmt.Errorf("the output of the check differs from the baseline %s", compareBaseline)
+				}
+				return nil
+			}
+
 			if formatJSON {
 				fmt.Fprintln(color.Output, fmt.Sprintf("=== %s ===", color.BlueString("JSON")))
 				checkFileOutput.WriteString("=== JSON ===\n")
@@ -38,6 +38,10 @@ var (
 		overrideRegoInput string
 		dumpRegoInput     string
 		dumpReports       string
+		reportFile        string
+		reportFormat      string
+		rootFS            string
+		exitCode          bool
 	}{}
 )
 
@@ -49,6 +53,10 @@ func setupCheckCmd(cmd *cobra.Command) {
 	cmd.Flags().StringVarP(&checkArgs.overrideRegoInput, "override-rego-input", "", "", "Rego input to use when running rego checks")
 	cmd.Flags().StringVarP(&checkArgs.dumpRegoInput, "dump-rego-input", "", "", "Path to file where to dump the Rego input JSON")
 	cmd.Flags().StringVarP(&checkArgs.dumpReports, "dump-reports", "", "", "Path to file where to dump reports")
+	cmd.Flags().StringVarP(&checkArgs.reportFile, "report-file", "", "", "Path to file where to write the results of the checks")
+	cmd.Flags().StringVarP(&checkArgs.reportFormat, "report-format", "", event.FormatJSON, "Format of the report file: json, sarif or junit")
+	cmd.Flags().StringVarP(&checkArgs.rootFS, "rootfs", "", "", "Root filesystem to run the checks against instead of the host: a directory, an OCI image layout or a tar archive")
+	cmd.Flags().BoolVarP(&checkArgs.exitCode, "exit-code", "", false, "Exit with a non-zero status when a check fails or can't be evaluated")
 }
 
 // CheckCmd returns a cobra command to run security agent checks
@@ -71,6 +79,12 @@ func runCheck(cmd *cobra.Command, confPathArray []string, args []string) error {
 		return err
 	}
 
+	switch checkArgs.reportFormat {
+	case event.FormatJSON, event.FormatSARIF, event.FormatJUnit:
+	default:
+		return fmt.Errorf("invalid report format `%s`, expecting json, sarif or junit", checkArgs.reportFormat)
+	}
+
 	// We need to set before calling `SetupConfig`
 	configName := "datadog"
 	if flavor.GetFlavor() == flavor.ClusterAgent {
@@ -84,7 +98,15 @@ func runCheck(cmd *cobra.Command, confPathArray []string, args []string) error {
 
 	options := []checks.BuilderOption{}
 
-	if flavor.GetFlavor() == flavor.ClusterAgent {
+	if checkArgs.rootFS != "" {
+		rootFS, err := checks.OpenRootFS(checkArgs.rootFS)
+		if err != nil {
+			return fmt.Errorf("failed to open root filesystem %s: %w", checkArgs.rootFS, err)
+		}
+		defer rootFS.Close()
+
+		options = append(options, checks.WithRootFS(rootFS))
+	} else if flavor.GetFlavor() == flavor.ClusterAgent {
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
 
@@ -167,6 +189,17 @@ func runCheck(cmd *cobra.Command, confPathArray []string, args []string) error {
 		return err
 	}
 
+	if err := reporter.writeReportFile(checkArgs.reportFile, checkArgs.reportFormat); err != nil {
+		log.Errorf("Failed to write report file %v", err)
+		return err
+	}
+
+	if checkArgs.exitCode {
+		if failed, errored := reporter.countFailures(); failed > 0 || errored > 0 {
+			return fmt.Errorf("%d checks failed and %d checks couldn't be evaluated", failed, errored)
+		}
+	}
+
 	return nil
 }
 
@@ -192,6 +225,7 @@ func configureLogger() error {
 type RunCheckReporter struct {
 	reporter        event.Reporter
 	events          map[string][]*event.Event
+	allEvents       []*event.Event
 	dumpReportsPath string
 }
 
@@ -221,6 +255,7 @@ func NewCheckReporter(stopper restart.Stopper, report bool, dumpReportsPath stri
 
 func (r *RunCheckReporter) Report(event *event.Event) {
 	r.events[event.AgentRuleID] = append(r.events[event.AgentRuleID], event)
+	r.allEvents = append(r.allEvents, event)
 
 	eventJSON, err := checks.PrettyPrintJSON(event, "  ")
 	if err != nil {
@@ -251,6 +286,37 @@ func (r *RunCheckReporter) dumpReports() error {
 	return nil
 }
 
+// countFailures returns the number of events of the checks that failed, and of the checks that couldn't be evaluated
+func (r *RunCheckReporter) countFailures() (failed int, errored int) {
+	for _, e := range r.allEvents {
+		switch e.Result {
+		case event.Failed:
+			failed++
+		case event.Error:
+			errored++
+		}
+	}
+	return failed, errored
+}
+
+func (r *RunCheckReporter) writeReportFile(path, format string) error {
+	if path == "" {
+		return nil
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := event.Export(f, format, r.allEvents); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
 func init() {
 	complianceCmd.AddCommand(CheckCmd(func() []string {
 		return confPathArray
@@ -10,6 +10,10 @@ package app
 import (
 	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -23,7 +27,9 @@ import (
 	"github.com/DataDog/datadog-agent/pkg/logs/pipeline"
 	"github.com/DataDog/datadog-agent/pkg/logs/restart"
 	secagent "github.com/DataDog/datadog-agent/pkg/security/agent"
+	"github.com/DataDog/datadog-agent/pkg/security/api"
 	secconfig "github.com/DataDog/datadog-agent/pkg/security/config"
+	"github.com/DataDog/datadog-agent/pkg/security/ebpf/kernel"
 	securityLogger "github.com/DataDog/datadog-agent/pkg/security/log"
 	sprobe "github.com/DataDog/datadog-agent/pkg/security/probe"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
@@ -47,13 +53,55 @@ var (
 	checkPoliciesCmd = &cobra.Command{
 		Use:   "check-policies",
 		Short: "Check policies and return a report",
-		RunE:  checkPolicies,
+		Long: `Check policies and return a report.
+
+The policy files are merged in the order of their names, a rule or a macro being either
+disabled by a later definition with 'disabled: true', or modified by a later definition
+with 'combine: override'. Rules and macros whose 'agent_version' or 'filters' don't match
+the running agent and kernel are ignored. The effective rules are listed before the report.`,
+		RunE: checkPolicies,
 	}
 
 	checkPoliciesArgs = struct {
 		dir string
 	}{}
 
+	policyCmd = &cobra.Command{
+		Use:   "policy",
+		Short: "Policy utility commands",
+	}
+
+	testPolicyCmd = &cobra.Command{
+		Use:   "test",
+		Short: "Lint policies and evaluate them against synthetic events",
+		Long: `Lint the policies for unreachable rules, rules without approver and macro cycles, then
+evaluate them against the synthetic events of the test files, without loading the security module.
+A test file lists events along with the IDs of the rules they should match, in YAML or JSON:
+
+tests:
+  - name: shadow file read
+    event:
+      type: open
+      fields:
+        open.file.path: /etc/shadow
+        open.flags: O_RDONLY
+        process.comm: cat
+    expect:
+      - shadow_read
+
+The agent version and kernel filters of the rules aren't applied unless --agent-version or
+--host-filters is set, so that the result doesn't depend on the host running the command.`,
+		RunE: testPolicies,
+	}
+
+	testPolicyArgs = struct {
+		dir          string
+		tests        []string
+		strict       bool
+		agentVersion string
+		hostFilters  bool
+	}{}
+
 	dumpCmd = &cobra.Command{
 		Use:   "dump",
 		Short: "Dump security module information",
@@ -65,6 +113,44 @@ var (
 		RunE:  dumpProcessCache,
 	}
 
+	activityDumpCmd = &cobra.Command{
+		Use:   "activity-dump",
+		Short: "Activity dump utility commands",
+	}
+
+	startActivityDumpCmd = &cobra.Command{
+		Use:   "start",
+		Short: "Start recording the activity of a container or an image",
+		Long: `Start recording the process tree, the file accesses and the syscalls of a container, or of all the
+containers of an image. When the dump is stopped, either by the stop command or once its timeout is
+reached, its profile is written in JSON along with a generated policy matching the activity outside
+of it. The profiles copied in the profiles directory are enforced, an anomaly_detection event being
+sent when a process or a file access falls outside of them.`,
+		RunE: startActivityDump,
+	}
+
+	startActivityDumpArgs = struct {
+		containerID string
+		image       string
+		timeout     time.Duration
+	}{}
+
+	listActivityDumpsCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List the running activity dumps",
+		RunE:  listActivityDumps,
+	}
+
+	stopActivityDumpCmd = &cobra.Command{
+		Use:   "stop",
+		Short: "Stop an activity dump and write its profile",
+		RunE:  stopActivityDump,
+	}
+
+	stopActivityDumpArgs = struct {
+		name string
+	}{}
+
 	selfTestCmd = &cobra.Command{
 		Use:   "self-test",
 		Short: "Run runtime self test",
@@ -80,6 +166,23 @@ func init() {
 	checkPoliciesCmd.Flags().StringVar(&checkPoliciesArgs.dir, "policies-dir", coreconfig.DefaultRuntimePoliciesDir, "Path to policies directory")
 
 	runtimeCmd.AddCommand(selfTestCmd)
+
+	startActivityDumpCmd.Flags().StringVar(&startActivityDumpArgs.containerID, "container-id", "", "ID of the container to record")
+	startActivityDumpCmd.Flags().StringVar(&startActivityDumpArgs.image, "image", "", "Name of the image whose containers are recorded")
+	startActivityDumpCmd.Flags().DurationVar(&startActivityDumpArgs.timeout, "timeout", 0, "Duration of the dump, the default of the configuration being used when not set")
+	activityDumpCmd.AddCommand(startActivityDumpCmd)
+	activityDumpCmd.AddCommand(listActivityDumpsCmd)
+	stopActivityDumpCmd.Flags().StringVar(&stopActivityDumpArgs.name, "name", "", "Name of the activity dump to stop")
+	activityDumpCmd.AddCommand(stopActivityDumpCmd)
+	runtimeCmd.AddCommand(activityDumpCmd)
+
+	policyCmd.AddCommand(testPolicyCmd)
+	testPolicyCmd.Flags().StringVar(&testPolicyArgs.dir, "policies-dir", coreconfig.DefaultRuntimePoliciesDir, "Path to policies directory")
+	testPolicyCmd.Flags().StringSliceVar(&testPolicyArgs.tests, "tests", nil, "Paths to test files")
+	testPolicyCmd.Flags().BoolVar(&testPolicyArgs.strict, "strict", false, "Fail on lint issues")
+	testPolicyCmd.Flags().StringVar(&testPolicyArgs.agentVersion, "agent-version", "", "Only load the rules and macros supporting this agent version")
+	testPolicyCmd.Flags().BoolVar(&testPolicyArgs.hostFilters, "host-filters", false, "Only load the rules and macros supporting the running agent and kernel")
+	runtimeCmd.AddCommand(policyCmd)
 }
 
 func dumpProcessCache(cmd *cobra.Command, args []string) error {
@@ -99,6 +202,86 @@ func dumpProcessCache(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func printActivityDump(dump *api.SecurityActivityDumpMessage) {
+	selector := "container_id:" + dump.ContainerID
+	if dump.ContainerID == "" {
+		selector = "image:" + dump.Image
+	}
+	fmt.Printf("%s: %s, started at %s for %s\n", dump.Name, selector, dump.Start, dump.Timeout)
+}
+
+func startActivityDump(cmd *cobra.Command, args []string) error {
+	client, err := secagent.NewRuntimeSecurityClient()
+	if err != nil {
+		return errors.Wrap(err, "unable to create a runtime security client instance")
+	}
+	defer client.Close()
+
+	var timeout string
+	if startActivityDumpArgs.timeout > 0 {
+		timeout = startActivityDumpArgs.timeout.String()
+	}
+
+	dump, err := client.DumpActivity(startActivityDumpArgs.containerID, startActivityDumpArgs.image, timeout)
+	if err != nil {
+		return errors.Wrap(err, "unable to start an activity dump")
+	}
+	if dump.Error != "" {
+		return fmt.Errorf("activity dump error: %s", dump.Error)
+	}
+
+	fmt.Printf("Activity dump started: ")
+	printActivityDump(dump)
+
+	return nil
+}
+
+func listActivityDumps(cmd *cobra.Command, args []string) error {
+	client, err := secagent.NewRuntimeSecurityClient()
+	if err != nil {
+		return errors.Wrap(err, "unable to create a runtime security client instance")
+	}
+	defer client.Close()
+
+	list, err := client.ListActivityDumps()
+	if err != nil {
+		return errors.Wrap(err, "unable to list the activity dumps")
+	}
+	if list.Error != "" {
+		return fmt.Errorf("activity dump list error: %s", list.Error)
+	}
+
+	if len(list.Dumps) == 0 {
+		fmt.Printf("No activity dump running\n")
+	}
+	for _, dump := range list.Dumps {
+		printActivityDump(dump)
+	}
+
+	return nil
+}
+
+func stopActivityDump(cmd *cobra.Command, args []string) error {
+	client, err := secagent.NewRuntimeSecurityClient()
+	if err != nil {
+		return errors.Wrap(err, "unable to create a runtime security client instance")
+	}
+	defer client.Close()
+
+	dump, err := client.StopActivityDump(stopActivityDumpArgs.name)
+	if err != nil {
+		return errors.Wrap(err, "unable to stop the activity dump")
+	}
+	if dump.Error != "" {
+		return fmt.Errorf("activity dump error: %s", dump.Error)
+	}
+
+	fmt.Printf("Profile written: %s\n", dump.OutputFilename)
+	fmt.Printf("Policy written: %s\n", dump.PolicyFilename)
+
+	return nil
+}
+
 func checkPolicies(cmd *cobra.Command, args []string) error {
 	cfg := &secconfig.Config{
 		PoliciesDir:         checkPoliciesArgs.dir,
@@ -112,13 +295,22 @@ func checkPolicies(cmd *cobra.Command, args []string) error {
 	enabled := map[eval.EventType]bool{"*": true}
 
 	opts := rules.NewOptsWithParams(model.SECLConstants, sprobe.SECLVariables, sprobe.SupportedDiscarders, enabled, sprobe.AllCustomRuleIDs(), model.SECLLegacyAttributes, &securityLogger.PatternLogger{})
+	opts.VariableScopes = sprobe.SECLVariableScopes
+	opts.Signals = model.SignalConstants
 	model := &model.Model{}
 	ruleSet := rules.NewRuleSet(model, model.NewEvent, opts)
 
-	if err := rules.LoadPolicies(cfg.PoliciesDir, ruleSet); err.ErrorOrNil() != nil {
+	ruleFilters, err := newRuleFilters()
+	if err != nil {
 		return err
 	}
 
+	if err := rules.LoadPolicies(cfg.PoliciesDir, ruleSet, ruleFilters...); err.ErrorOrNil() != nil {
+		return err
+	}
+
+	printEffectiveRules(ruleSet)
+
 	approvers, err := ruleSet.GetApprovers(sprobe.GetCapababilities())
 	if err != nil {
 		return err
@@ -137,6 +329,150 @@ func checkPolicies(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// newRuleFilters returns the filters of the rules and macros for the running agent and kernel
+func newRuleFilters() ([]rules.RuleFilter, error) {
+	kernelVersion, err := kernel.NewKernelVersion()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to detect the kernel version")
+	}
+
+	return sprobe.NewRuleFilters(kernelVersion)
+}
+
+// newPolicyTestRuleFilters returns the filters of the rules and macros requested by the flags
+// of the policy test command, none by default
+func newPolicyTestRuleFilters() ([]rules.RuleFilter, error) {
+	switch {
+	case testPolicyArgs.hostFilters && testPolicyArgs.agentVersion != "":
+		return nil, errors.New("the --agent-version and --host-filters options can't be used together")
+	case testPolicyArgs.hostFilters:
+		return newRuleFilters()
+	case testPolicyArgs.agentVersion != "":
+		versionFilter, err := rules.NewAgentVersionFilter(testPolicyArgs.agentVersion)
+		if err != nil {
+			return nil, err
+		}
+		return []rules.RuleFilter{versionFilter}, nil
+	default:
+		return nil, nil
+	}
+}
+
+// printEffectiveRules prints the rules of the ruleset once the policies are merged, along
+// with the policy their definition comes from
+func printEffectiveRules(ruleSet *rules.RuleSet) {
+	ids := ruleSet.ListRuleIDs()
+	sort.Strings(ids)
+
+	allRules := ruleSet.GetRules()
+	for _, id := range ids {
+		definition := allRules[id].Definition
+
+		policy := ""
+		if definition.Policy != nil {
+			policy = definition.Policy.Name
+		}
+
+		fmt.Printf("rule %s (%s): %s\n", id, policy, definition.Expression)
+	}
+}
+
+func testPolicies(cmd *cobra.Command, args []string) error {
+	logger := &securityLogger.PatternLogger{}
+
+	ruleFilters, err := newPolicyTestRuleFilters()
+	if err != nil {
+		return err
+	}
+
+	// look for macro cycles first as they prevent the macros from being compiled
+	policies, _ := rules.LoadPolicyDir(testPolicyArgs.dir, logger)
+
+	layers := rules.NewPolicyLayers(logger, ruleFilters...)
+	for _, policy := range policies {
+		layers.AddPolicy(policy)
+	}
+	issues := rules.LintMacroCycles(layers.GetMacros())
+
+	// enabled all the rules
+	enabled := map[eval.EventType]bool{"*": true}
+	opts := rules.NewOptsWithParams(model.SECLConstants, sprobe.SECLVariables, sprobe.SupportedDiscarders, enabled, sprobe.AllCustomRuleIDs(), model.SECLLegacyAttributes, logger)
+	opts.VariableScopes = policyTestVariableScopes
+	opts.Signals = model.SignalConstants
+
+	ruleSet := rules.NewRuleSet(&model.Model{}, (&model.Model{}).NewEvent, opts)
+	if err := rules.LoadPolicies(testPolicyArgs.dir, ruleSet, ruleFilters...); err.ErrorOrNil() != nil {
+		for _, issue := range issues {
+			fmt.Printf("lint: %s\n", issue)
+		}
+		return err
+	}
+
+	issues = append(issues, ruleSet.LintRules(sprobe.GetCapababilities())...)
+	for _, issue := range issues {
+		fmt.Printf("lint: %s\n", issue)
+	}
+
+	failures := 0
+	for _, path := range testPolicyArgs.tests {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+
+		suite, err := rules.LoadTestSuite(f, filepath.Base(path))
+		f.Close()
+		if err != nil {
+			return err
+		}
+
+		for _, result := range ruleSet.RunTestSuite(suite, newPolicyTestEvent) {
+			if result.Passed() {
+				fmt.Printf("PASS %s: %s\n", suite.Name, result.Case.Name)
+				continue
+			}
+
+			failures++
+			switch {
+			case result.Err != nil:
+				fmt.Printf("FAIL %s: %s: %s\n", suite.Name, result.Case.Name, result.Err)
+			default:
+				fmt.Printf("FAIL %s: %s: missing rules %v, unexpected rules %v\n", suite.Name, result.Case.Name, result.Missing, result.Unexpected)
+			}
+		}
+	}
+
+	if failures > 0 {
+		return fmt.Errorf("%d test(s) failed", failures)
+	}
+
+	if testPolicyArgs.strict && len(issues) > 0 {
+		return fmt.Errorf("%d lint issue(s) found", len(issues))
+	}
+
+	return nil
+}
+
+// policyTestVariableScopes resolves the scopes of the variables set by the rule actions on
+// synthetic events, which aren't backed by the resolvers of the probe
+var policyTestVariableScopes = map[rules.Scope]rules.VariableScope{
+	rules.ScopeProcess: func(ctx *eval.Context) string {
+		return sprobe.ProcessScopeKey((*model.Event)(ctx.Object).ProcessContext.Pid)
+	},
+	rules.ScopeContainer: func(ctx *eval.Context) string {
+		return (*model.Event)(ctx.Object).ContainerContext.ID
+	},
+}
+
+func newPolicyTestEvent(eventType eval.EventType) (eval.Event, error) {
+	kind := model.ParseEvalEventType(eventType)
+	if kind == model.UnknownEventType {
+		return nil, fmt.Errorf("unknown event type `%s`", eventType)
+	}
+
+	return &model.Event{Type: uint64(kind)}, nil
+}
+
 func runRuntimeSelfTest(cmd *cobra.Command, args []string) error {
 	client, err := secagent.NewRuntimeSecurityClient()
 	if err != nil {
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/DataDog/datadog-agent/pkg/collector/check"
+	"github.com/DataDog/datadog-agent/pkg/config"
 	"github.com/DataDog/datadog-agent/pkg/metrics"
 	"github.com/DataDog/datadog-agent/pkg/serializer"
 	"github.com/DataDog/datadog-agent/pkg/util/log"
@@ -67,6 +68,8 @@ type checkSender struct {
 	eventPlatformOut        chan<- senderEventPlatformEvent
 	checkTags               []string
 	service                 string
+	// maximum number of samples recorded per run for the check history, 0 if disabled
+	maxRecordedSamples int
 }
 
 type senderMetricSample struct {
@@ -218,6 +221,19 @@ func (s *checkSender) cyclemetricStats() {
 	s.metricStats = check.NewSenderStats()
 }
 
+// recordSample records a sample of the current run for the check history, if enabled.
+// statsLock must be held.
+func (s *checkSender) recordSample(sample check.SenderSample) {
+	if s.maxRecordedSamples <= 0 {
+		return
+	}
+	if len(s.metricStats.Samples) >= s.maxRecordedSamples {
+		s.metricStats.SamplesDropped++
+		return
+	}
+	s.metricStats.Samples = append(s.metricStats.Samples, sample)
+}
+
 // SendRawMetricSample sends the raw sample
 // Useful for testing - submitting precomputed samples.
 func (s *checkSender) SendRawMetricSample(sample *metrics.MetricSample) {
@@ -248,6 +264,14 @@ func (s *checkSender) sendMetricSample(metric string, value float64, hostname st
 
 	s.statsLock.Lock()
 	s.metricStats.MetricSamples++
+	s.recordSample(check.SenderSample{
+		Kind:     check.SampleKindMetric,
+		Name:     metric,
+		Type:     mType.String(),
+		Value:    value,
+		Hostname: metricSample.Host,
+		Tags:     tags,
+	})
 	s.statsLock.Unlock()
 }
 
@@ -325,6 +349,14 @@ func (s *checkSender) HistogramBucket(metric string, value int64, lowerBound, up
 
 	s.statsLock.Lock()
 	s.metricStats.HistogramBuckets++
+	s.recordSample(check.SenderSample{
+		Kind:     check.SampleKindHistogramBucket,
+		Name:     metric,
+		Type:     fmt.Sprintf("[%v,%v]", lowerBound, upperBound),
+		Value:    float64(value),
+		Hostname: histogramBucket.Host,
+		Tags:     tags,
+	})
 	s.statsLock.Unlock()
 }
 
@@ -360,6 +392,15 @@ func (s *checkSender) ServiceCheck(checkName string, status metrics.ServiceCheck
 
 	s.statsLock.Lock()
 	s.metricStats.ServiceChecks++
+	s.recordSample(check.SenderSample{
+		Kind:     check.SampleKindServiceCheck,
+		Name:     checkName,
+		Type:     status.String(),
+		Value:    float64(status),
+		Hostname: serviceCheck.Host,
+		Tags:     serviceCheck.Tags,
+		Message:  message,
+	})
 	s.statsLock.Unlock()
 }
 
@@ -377,6 +418,14 @@ func (s *checkSender) Event(e metrics.Event) {
 
 	s.statsLock.Lock()
 	s.metricStats.Events++
+	s.recordSample(check.SenderSample{
+		Kind:     check.SampleKindEvent,
+		Name:     e.Title,
+		Type:     string(e.AlertType),
+		Hostname: e.Host,
+		Tags:     e.Tags,
+		Message:  e.Text,
+	})
 	s.statsLock.Unlock()
 }
 
@@ -431,6 +480,9 @@ func (sp *checkSenderPool) mkSender(id check.ID) (Sender, error) {
 
 	err := aggregatorInstance.registerSender(id)
 	sender := newCheckSender(id, aggregatorInstance.hostname, aggregatorInstance.checkMetricIn, aggregatorInstance.serviceCheckIn, aggregatorInstance.eventIn, aggregatorInstance.checkHistogramBucketIn, aggregatorInstance.orchestratorMetadataIn, aggregatorInstance.eventPlatformIn)
+	if config.Datadog.GetBool("check_history.include_samples") {
+		sender.maxRecordedSamples = config.Datadog.GetInt("check_history.max_samples")
+	}
 	sp.senders[id] = sender
 	return sender, err
 }
@@ -477,6 +477,36 @@ func TestCheckSenderInterface(t *testing.T) {
 	assert.Equal(t, "dbm-sample", eventPlatformEvent.eventType)
 }
 
+func TestCheckSenderRecordSamples(t *testing.T) {
+	s := initSender(checkID1, "default-hostname")
+	s.sender.SetCheckCustomTags([]string{"custom:tag"})
+	s.sender.Gauge("my.metric", 1.0, "", []string{"foo"})
+	s.sender.Commit()
+	assert.Empty(t, s.sender.GetSenderStats().Samples)
+
+	s.sender.maxRecordedSamples = 3
+	s.sender.Gauge("my.metric", 1.0, "", []string{"foo"})
+	s.sender.HistogramBucket("my.histogram_bucket", 42, 1.0, 2.0, true, "my-hostname", nil, false)
+	s.sender.ServiceCheck("my_service.can_connect", metrics.ServiceCheckCritical, "my-hostname", nil, "message")
+	s.sender.Event(metrics.Event{Title: "title", Text: "text", AlertType: metrics.EventAlertTypeError})
+	s.sender.Rate("my.rate_metric", 2.0, "", nil)
+	s.sender.Commit()
+
+	stats := s.sender.GetSenderStats()
+	assert.Equal(t, int64(2), stats.SamplesDropped)
+	assert.Equal(t, []check.SenderSample{
+		{Kind: check.SampleKindMetric, Name: "my.metric", Type: "Gauge", Value: 1.0, Hostname: "default-hostname", Tags: []string{"foo", "custom:tag"}},
+		{Kind: check.SampleKindHistogramBucket, Name: "my.histogram_bucket", Type: "[1,2]", Value: 42, Hostname: "my-hostname", Tags: []string{"custom:tag"}},
+		{Kind: check.SampleKindServiceCheck, Name: "my_service.can_connect", Type: "CRITICAL", Value: 2, Hostname: "my-hostname", Tags: []string{"custom:tag"}, Message: "message"},
+	}, stats.Samples)
+
+	// samples are reset at every commit
+	s.sender.Commit()
+	stats = s.sender.GetSenderStats()
+	assert.Empty(t, stats.Samples)
+	assert.Zero(t, stats.SamplesDropped)
+}
+
 func TestCheckSenderHostname(t *testing.T) {
 	defaultHostname := "default-host"
 
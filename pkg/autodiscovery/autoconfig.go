@@ -64,6 +64,7 @@ type AutoConfig struct {
 	listenerRetryStop  chan struct{}
 	scheduler          *scheduler.MetaScheduler
 	listenerStop       chan struct{}
+	forwardStop        chan struct{} // closed to stop forwarding the services of the listeners
 	healthListening    *health.Handle
 	newService         chan listeners.Service
 	delService         chan listeners.Service
@@ -80,6 +81,7 @@ func NewAutoConfig(scheduler *scheduler.MetaScheduler) *AutoConfig {
 		listenerCandidates: make(map[string]listeners.ServiceListenerFactory),
 		listenerRetryStop:  nil, // We'll open it if needed
 		listenerStop:       make(chan struct{}),
+		forwardStop:        make(chan struct{}),
 		healthListening:    health.RegisterLiveness("ad-servicelistening"),
 		newService:         make(chan listeners.Service),
 		delService:         make(chan listeners.Service),
@@ -152,8 +154,9 @@ func (ac *AutoConfig) Stop() {
 		pd.stop()
 	}
 
-	// stop the service listener
+	// stop the service listener and the forwarding of the services of the listeners
 	ac.listenerStop <- struct{}{}
+	close(ac.forwardStop)
 
 	// stop the meta scheduler
 	ac.scheduler.Stop()
@@ -364,7 +367,7 @@ func (ac *AutoConfig) initListenerCandidates() bool {
 			// Init successful, let's start listening
 			log.Infof("%s listener successfully started", name)
 			ac.listeners = append(ac.listeners, listener)
-			listener.Listen(ac.newService, ac.delService)
+			ac.listen(name, listener)
 			delete(ac.listenerCandidates, name)
 		case retry.IsErrWillRetry(err):
 			// Log an info and keep in candidates
@@ -379,6 +382,37 @@ func (ac *AutoConfig) initListenerCandidates() bool {
 	return len(ac.listenerCandidates) > 0
 }
 
+// listen starts a listener, forwarding its services to the service channels
+// of the AutoConfig after recording the name of the listener of each service,
+// until the AutoConfig is stopped
+func (ac *AutoConfig) listen(name string, listener listeners.ServiceListener) {
+	newService := make(chan listeners.Service)
+	delService := make(chan listeners.Service)
+	listener.Listen(newService, delService)
+
+	go func() {
+		for {
+			select {
+			case <-ac.forwardStop:
+				return
+			case svc := <-newService:
+				ac.store.setListenerForEntity(svc.GetEntity(), name)
+				select {
+				case ac.newService <- svc:
+				case <-ac.forwardStop:
+					return
+				}
+			case svc := <-delService:
+				select {
+				case ac.delService <- svc:
+				case <-ac.forwardStop:
+					return
+				}
+			}
+		}
+	}()
+}
+
 func (ac *AutoConfig) retryListenerCandidates() {
 	retryTicker := time.NewTicker(listenerCandidateIntl)
 	defer func() {
@@ -194,6 +194,11 @@ func (suite *AutoConfigTestSuite) TestStop() {
 	ac.Stop()
 
 	assert.True(suite.T(), ml.stopReceived)
+	select {
+	case <-ac.forwardStop:
+	default:
+		assert.Fail(suite.T(), "the services of the listeners are still forwarded")
+	}
 }
 
 func (suite *AutoConfigTestSuite) TestListenerRetry() {
@@ -30,6 +30,14 @@ var templateVariables = map[string]variableGetter{
 	"hostname": getHostname,
 	"extra":    getAdditionalTplVariables,
 	"kube":     getAdditionalTplVariables,
+
+	// template variables pulled from workloadmeta
+	"label":        getWorkloadVariable("label"),
+	"podlabel":     getWorkloadVariable("podlabel"),
+	"annotation":   getWorkloadVariable("annotation"),
+	"image":        getWorkloadVariable("image"),
+	"containerenv": getWorkloadVariable("containerenv"),
+	"ecs":          getWorkloadVariable("ecs"),
 }
 
 // SubstituteTemplateEnvVars replaces %%ENV_VARIABLE%% from environment
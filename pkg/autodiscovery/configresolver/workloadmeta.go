@@ -0,0 +1,239 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package configresolver
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/DataDog/datadog-agent/pkg/autodiscovery/listeners"
+)
+
+// workloadLookup returns the value of a key in the metadata of the workload of a service,
+// and whether it was found
+type workloadLookup func(key string, svc listeners.WorkloadService) (string, bool)
+
+// workloadLookups holds the sources of the template variables pulled from workloadmeta
+var workloadLookups = map[string]workloadLookup{
+	"label":        getWorkloadLabel,
+	"podlabel":     getPodLabel,
+	"annotation":   getPodAnnotation,
+	"image":        getContainerImage,
+	"containerenv": getContainerEnv,
+	"ecs":          getECSTaskAttribute,
+}
+
+// workloadFilters holds the filters that can be applied to the values of the template
+// variables pulled from workloadmeta, with the number of arguments they take
+var workloadFilters = map[string]int{
+	"default":    1,
+	"lower":      0,
+	"upper":      0,
+	"trimprefix": 1,
+	"trimsuffix": 1,
+	"replace":    2,
+	"regex":      1,
+}
+
+// getWorkloadVariable returns the variable getter of a workloadmeta source.
+//
+// Workload template variables are pipelines of lookups and filters separated by `|`:
+// lookups are tried in order until one of them resolves, the next lookups being fallbacks,
+// and filters transform the value resolved so far, e.g.
+// `%%label_app.kubernetes.io/version|annotation_version|default:latest|lower%%`.
+// Filter arguments are separated by `:`.
+func getWorkloadVariable(source string) variableGetter {
+	return func(_ context.Context, key []byte, svc listeners.Service) ([]byte, error) {
+		expr := source + "_" + string(key)
+		wsvc, ok := svc.(listeners.WorkloadService)
+		if !ok {
+			return nil, fmt.Errorf("template variable %%%%%s%%%% is not supported for service %s", expr, svc.GetEntity())
+		}
+		value, err := resolveWorkloadExpression(expr, wsvc)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve template variable %%%%%s%%%% for service %s, skipping config - %s", expr, svc.GetEntity(), err)
+		}
+		return []byte(value), nil
+	}
+}
+
+// resolveWorkloadExpression resolves a pipeline of lookups and filters
+func resolveWorkloadExpression(expr string, svc listeners.WorkloadService) (string, error) {
+	var value string
+	var found bool
+
+	for _, step := range strings.Split(expr, "|") {
+		name := strings.SplitN(step, ":", 2)[0]
+		if nArgs, isFilter := workloadFilters[name]; isFilter {
+			// the last argument can contain colons, e.g. in regexes
+			args := strings.SplitN(step, ":", nArgs+1)[1:]
+			if len(args) != nArgs || (nArgs == 0 && name != step) {
+				return "", fmt.Errorf("filter %q takes %d argument(s)", name, nArgs)
+			}
+			var err error
+			value, found, err = applyWorkloadFilter(name, args, value, found)
+			if err != nil {
+				return "", err
+			}
+			continue
+		}
+
+		split := strings.SplitN(step, "_", 2)
+		lookup, isLookup := workloadLookups[split[0]]
+		if !isLookup || len(split) != 2 || split[1] == "" {
+			return "", fmt.Errorf("invalid lookup or filter %q", step)
+		}
+		if !found {
+			value, found = lookup(split[1], svc)
+		}
+	}
+
+	if !found {
+		return "", fmt.Errorf("no value found")
+	}
+	return value, nil
+}
+
+func applyWorkloadFilter(name string, args []string, value string, found bool) (string, bool, error) {
+	if name == "default" {
+		if !found {
+			return args[0], true, nil
+		}
+		return value, found, nil
+	}
+
+	// filters other than default only apply to resolved values
+	if !found {
+		return value, found, nil
+	}
+
+	switch name {
+	case "lower":
+		value = strings.ToLower(value)
+	case "upper":
+		value = strings.ToUpper(value)
+	case "trimprefix":
+		value = strings.TrimPrefix(value, args[0])
+	case "trimsuffix":
+		value = strings.TrimSuffix(value, args[0])
+	case "replace":
+		value = strings.ReplaceAll(value, args[0], args[1])
+	case "regex":
+		re, err := regexp.Compile(args[0])
+		if err != nil {
+			return "", false, fmt.Errorf("invalid regex %q: %s", args[0], err)
+		}
+		// returns the first capture group if any, the whole match otherwise
+		match := re.FindStringSubmatch(value)
+		switch {
+		case match == nil:
+			return "", false, nil
+		case len(match) > 1:
+			value = match[1]
+		default:
+			value = match[0]
+		}
+	}
+
+	return value, true, nil
+}
+
+// getWorkloadLabel returns a label of the container of the service, or of the pod for
+// pod services
+func getWorkloadLabel(key string, svc listeners.WorkloadService) (string, bool) {
+	if container := svc.GetContainer(); container != nil {
+		value, found := container.Labels[key]
+		return value, found
+	}
+	if pod := svc.GetKubernetesPod(); pod != nil {
+		value, found := pod.Labels[key]
+		return value, found
+	}
+	return "", false
+}
+
+// getPodLabel returns a label of the pod of the service
+func getPodLabel(key string, svc listeners.WorkloadService) (string, bool) {
+	if pod := svc.GetKubernetesPod(); pod != nil {
+		value, found := pod.Labels[key]
+		return value, found
+	}
+	return "", false
+}
+
+// getPodAnnotation returns an annotation of the pod of the service
+func getPodAnnotation(key string, svc listeners.WorkloadService) (string, bool) {
+	if pod := svc.GetKubernetesPod(); pod != nil {
+		value, found := pod.Annotations[key]
+		return value, found
+	}
+	return "", false
+}
+
+// getContainerImage returns an attribute of the image of the container of the service
+func getContainerImage(key string, svc listeners.WorkloadService) (string, bool) {
+	container := svc.GetContainer()
+	if container == nil {
+		return "", false
+	}
+
+	var value string
+	switch key {
+	case "name":
+		value = container.Image.Name
+	case "short_name":
+		value = container.Image.ShortName
+	case "tag":
+		value = container.Image.Tag
+	case "id":
+		value = container.Image.ID
+	}
+	return value, value != ""
+}
+
+// getContainerEnv returns an environment variable of the container of the service. Only
+// the environment variables collected by workloadmeta are available.
+func getContainerEnv(key string, svc listeners.WorkloadService) (string, bool) {
+	if container := svc.GetContainer(); container != nil {
+		value, found := container.EnvVars[key]
+		return value, found
+	}
+	return "", false
+}
+
+// getECSTaskAttribute returns an attribute or a tag of the ECS task of the service
+func getECSTaskAttribute(key string, svc listeners.WorkloadService) (string, bool) {
+	task := svc.GetECSTask()
+	if task == nil {
+		return "", false
+	}
+
+	if tag := strings.TrimPrefix(key, "tag_"); tag != key {
+		value, found := task.Tags[tag]
+		return value, found
+	}
+
+	var value string
+	switch key {
+	case "task_arn":
+		value = task.ID
+	case "family":
+		value = task.Family
+	case "version":
+		value = task.Version
+	case "cluster_name":
+		value = task.ClusterName
+	case "region":
+		value = task.Region
+	case "availability_zone":
+		value = task.AvailabilityZone
+	case "launch_type":
+		value = string(task.LaunchType)
+	}
+	return value, value != ""
+}
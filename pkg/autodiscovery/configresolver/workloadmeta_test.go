@@ -0,0 +1,191 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package configresolver
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-agent/pkg/autodiscovery/integration"
+	"github.com/DataDog/datadog-agent/pkg/autodiscovery/listeners"
+	"github.com/DataDog/datadog-agent/pkg/workloadmeta"
+)
+
+type dummyWorkloadService struct {
+	dummyService
+	Container *workloadmeta.Container
+	Pod       *workloadmeta.KubernetesPod
+	ECSTask   *workloadmeta.ECSTask
+}
+
+// GetContainer returns the dummy container
+func (s *dummyWorkloadService) GetContainer() *workloadmeta.Container {
+	return s.Container
+}
+
+// GetKubernetesPod returns the dummy pod
+func (s *dummyWorkloadService) GetKubernetesPod() *workloadmeta.KubernetesPod {
+	return s.Pod
+}
+
+// GetECSTask returns the dummy ECS task
+func (s *dummyWorkloadService) GetECSTask() *workloadmeta.ECSTask {
+	return s.ECSTask
+}
+
+func newDummyWorkloadService() *dummyWorkloadService {
+	return &dummyWorkloadService{
+		dummyService: dummyService{
+			ID:            "a5901276aed1",
+			ADIdentifiers: []string{"redis"},
+		},
+		Container: &workloadmeta.Container{
+			EntityMeta: workloadmeta.EntityMeta{
+				Labels: map[string]string{"app.kubernetes.io/version": "V1.2.3", "empty": ""},
+			},
+			EnvVars: map[string]string{"REDIS_PORT": "6380"},
+			Image: workloadmeta.ContainerImage{
+				Name:      "docker.io/library/redis",
+				ShortName: "redis",
+				Tag:       "6.2",
+			},
+		},
+		Pod: &workloadmeta.KubernetesPod{
+			EntityMeta: workloadmeta.EntityMeta{
+				Labels:      map[string]string{"team": "storage"},
+				Annotations: map[string]string{"redis/db": "db-3"},
+			},
+		},
+		ECSTask: &workloadmeta.ECSTask{
+			EntityID:    workloadmeta.EntityID{Kind: workloadmeta.KindECSTask, ID: "arn:aws:ecs:us-east-1:123456789012:task/cluster/abc"},
+			Family:      "redis-task",
+			ClusterName: "cluster",
+			LaunchType:  workloadmeta.ECSLaunchTypeFargate,
+			Tags:        map[string]string{"owner": "storage"},
+		},
+	}
+}
+
+func TestResolveWorkloadExpression(t *testing.T) {
+	svc := newDummyWorkloadService()
+
+	testCases := []struct {
+		expr        string
+		value       string
+		errorString string
+	}{
+		{expr: "label_app.kubernetes.io/version", value: "V1.2.3"},
+		{expr: "label_empty", value: ""},
+		{expr: "podlabel_team", value: "storage"},
+		{expr: "annotation_redis/db", value: "db-3"},
+		{expr: "image_short_name", value: "redis"},
+		{expr: "image_tag", value: "6.2"},
+		{expr: "containerenv_REDIS_PORT", value: "6380"},
+		{expr: "ecs_family", value: "redis-task"},
+		{expr: "ecs_launch_type", value: "fargate"},
+		{expr: "ecs_task_arn", value: "arn:aws:ecs:us-east-1:123456789012:task/cluster/abc"},
+		{expr: "ecs_tag_owner", value: "storage"},
+		{expr: "label_missing", errorString: "no value found"},
+		{expr: "ecs_unknown", errorString: "no value found"},
+		// fallbacks and defaults
+		{expr: "label_missing|annotation_redis/db", value: "db-3"},
+		{expr: "podlabel_team|annotation_redis/db", value: "storage"},
+		{expr: "label_missing|annotation_missing|default:none", value: "none"},
+		{expr: "label_empty|default:none", value: ""},
+		{expr: "default:none|label_app.kubernetes.io/version", value: "none"},
+		// filters
+		{expr: "label_app.kubernetes.io/version|lower|trimprefix:v", value: "1.2.3"},
+		{expr: "label_app.kubernetes.io/version|upper", value: "V1.2.3"},
+		{expr: "annotation_redis/db|trimprefix:db-", value: "3"},
+		{expr: "image_name|trimsuffix:/redis|replace:/:.", value: "docker.io.library"},
+		{expr: "label_app.kubernetes.io/version|regex:^V(\\d+)\\.", value: "1"},
+		{expr: "label_app.kubernetes.io/version|regex:(?:\\d+\\.){2}\\d+", value: "1.2.3"},
+		{expr: "label_app.kubernetes.io/version|regex:^nomatch|default:0", value: "0"},
+		{expr: "label_missing|lower|default:NONE", value: "NONE"},
+		// invalid expressions
+		{expr: "label_foo|unknown_bar", errorString: `invalid lookup or filter "unknown_bar"`},
+		{expr: "label_", errorString: `invalid lookup or filter "label_"`},
+		{expr: "label_foo|default", errorString: `filter "default" takes 1 argument(s)`},
+		{expr: "label_foo|lower:x", errorString: `filter "lower" takes 0 argument(s)`},
+		{expr: "label_foo|replace:a", errorString: `filter "replace" takes 2 argument(s)`},
+		{expr: "label_empty|regex:(", errorString: "invalid regex \"(\": error parsing regexp: missing closing ): `(`"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expr, func(t *testing.T) {
+			value, err := resolveWorkloadExpression(tc.expr, svc)
+			if tc.errorString != "" {
+				assert.EqualError(t, err, tc.errorString)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.value, value)
+			}
+		})
+	}
+}
+
+func TestResolveWorkloadExpressionPodService(t *testing.T) {
+	svc := newDummyWorkloadService()
+	svc.Container = nil
+	svc.ECSTask = nil
+
+	value, err := resolveWorkloadExpression("label_team", svc)
+	assert.NoError(t, err)
+	assert.Equal(t, "storage", value)
+
+	_, err = resolveWorkloadExpression("image_tag", svc)
+	assert.EqualError(t, err, "no value found")
+	_, err = resolveWorkloadExpression("ecs_family", svc)
+	assert.EqualError(t, err, "no value found")
+}
+
+func TestResolveWorkloadVariables(t *testing.T) {
+	testCases := []struct {
+		testName    string
+		svc         listeners.Service
+		instance    string
+		out         string
+		errorString string
+	}{
+		{
+			testName: "workload variables",
+			svc:      newDummyWorkloadService(),
+			instance: "port: %%containerenv_REDIS_PORT|default:6379%%\nversion: %%label_app.kubernetes.io/version|lower%%\ndb: %%annotation_redis/db|trimprefix:db-%%",
+			out:      "db: 3\nport: 6380\ntags:\n- foo:bar\nversion: v1.2.3\n",
+		},
+		{
+			testName:    "unresolved workload variable",
+			svc:         newDummyWorkloadService(),
+			instance:    "version: %%label_version%%",
+			errorString: "failed to resolve template variable %%label_version%% for service a5901276aed1, skipping config - no value found",
+		},
+		{
+			testName:    "service not backed by workloadmeta",
+			svc:         &dummyService{ID: "a5901276aed1", ADIdentifiers: []string{"redis"}},
+			instance:    "version: %%label_version|default:latest%%",
+			errorString: "template variable %%label_version|default:latest%% is not supported for service a5901276aed1",
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("case %d: %s", i, tc.testName), func(t *testing.T) {
+			tpl := integration.Config{
+				Name:          "redis",
+				ADIdentifiers: []string{"redis"},
+				Instances:     []integration.Data{integration.Data(tc.instance)},
+			}
+			cfg, _, err := Resolve(tpl, tc.svc)
+			if tc.errorString != "" {
+				assert.EqualError(t, err, tc.errorString)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.out, string(cfg.Instances[0]))
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package autodiscovery
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/DataDog/datadog-agent/pkg/autodiscovery/configresolver"
+	"github.com/DataDog/datadog-agent/pkg/autodiscovery/integration"
+	"github.com/DataDog/datadog-agent/pkg/autodiscovery/listeners"
+)
+
+// ServiceExplanation explains how the templates known to the AutoConfig match a service
+type ServiceExplanation struct {
+	Entity             string                `json:"entity"`
+	TaggerEntity       string                `json:"tagger_entity"`
+	Listener           string                `json:"listener"`
+	ADIdentifiers      []string              `json:"ad_identifiers"`
+	ADIdentifiersError string                `json:"ad_identifiers_error,omitempty"`
+	Templates          []TemplateExplanation `json:"templates"`
+	ScheduledConfigs   []integration.Config  `json:"scheduled_configs"`
+}
+
+// TemplateExplanation is the result of the resolution of a template against a service
+type TemplateExplanation struct {
+	Template integration.Config `json:"template"`
+	// MatchedADIdentifiers holds the AD identifiers of the template shared with the service,
+	// the template is only resolved against the service when there is at least one
+	MatchedADIdentifiers []string            `json:"matched_ad_identifiers"`
+	Resolved             *integration.Config `json:"resolved,omitempty"`
+	Error                string              `json:"error,omitempty"`
+}
+
+// Matched returns whether the template shares an AD identifier with the service
+func (t TemplateExplanation) Matched() bool {
+	return len(t.MatchedADIdentifiers) > 0
+}
+
+// Explain resolves every template against the services whose entity contains `query`,
+// e.g. a container ID or `kube_service://<namespace>/<name>`. The resolution is a dry run:
+// secrets aren't decrypted and nothing is scheduled.
+func (ac *AutoConfig) Explain(ctx context.Context, query string) []ServiceExplanation {
+	templates := ac.store.templateCache.GetAll()
+	sort.Slice(templates, func(i, j int) bool {
+		if templates[i].Name != templates[j].Name {
+			return templates[i].Name < templates[j].Name
+		}
+		return templates[i].Source < templates[j].Source
+	})
+
+	var explanations []ServiceExplanation
+	for _, svc := range ac.store.getServices() {
+		if !strings.Contains(svc.GetEntity(), query) {
+			continue
+		}
+		explanations = append(explanations, ac.explainService(ctx, svc, templates))
+	}
+
+	sort.Slice(explanations, func(i, j int) bool {
+		return explanations[i].Entity < explanations[j].Entity
+	})
+	return explanations
+}
+
+func (ac *AutoConfig) explainService(ctx context.Context, svc listeners.Service, templates []integration.Config) ServiceExplanation {
+	explanation := ServiceExplanation{
+		Entity:           svc.GetEntity(),
+		TaggerEntity:     svc.GetTaggerEntity(),
+		Listener:         ac.store.getListenerForEntity(svc.GetEntity()),
+		ScheduledConfigs: ac.store.getConfigsForService(svc.GetEntity()),
+	}
+
+	adIdentifiers, err := svc.GetADIdentifiers(ctx)
+	if err != nil {
+		explanation.ADIdentifiersError = err.Error()
+	}
+	explanation.ADIdentifiers = adIdentifiers
+
+	serviceIDs := make(map[string]struct{}, len(adIdentifiers))
+	for _, id := range adIdentifiers {
+		serviceIDs[id] = struct{}{}
+	}
+
+	for _, tpl := range templates {
+		te := TemplateExplanation{Template: tpl}
+		for _, id := range tpl.ADIdentifiers {
+			if _, found := serviceIDs[id]; found {
+				te.MatchedADIdentifiers = append(te.MatchedADIdentifiers, id)
+			}
+		}
+		if te.Matched() {
+			resolved, _, err := configresolver.Resolve(tpl, svc)
+			if err != nil {
+				te.Error = err.Error()
+			} else {
+				te.Resolved = &resolved
+			}
+		}
+		explanation.Templates = append(explanation.Templates, te)
+	}
+
+	return explanation
+}
@@ -0,0 +1,112 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package autodiscovery
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DataDog/datadog-agent/pkg/autodiscovery/integration"
+	"github.com/DataDog/datadog-agent/pkg/autodiscovery/listeners"
+	"github.com/DataDog/datadog-agent/pkg/autodiscovery/scheduler"
+)
+
+// sendingListener sends a service as soon as it starts listening
+type sendingListener struct {
+	svc listeners.Service
+}
+
+func (l *sendingListener) Listen(newSvc, delSvc chan<- listeners.Service) {
+	go func() { newSvc <- l.svc }()
+}
+
+func (l *sendingListener) Stop() {}
+
+func TestExplain(t *testing.T) {
+	ctx := context.Background()
+	ac := NewAutoConfig(scheduler.NewMetaScheduler())
+
+	redis := &dummyService{
+		ID:            "container_id://a5901276aed16ae9ea11660a41fecd674da47e8f5d8d5bce0080a611feed2be9",
+		ADIdentifiers: []string{"redis", "docker.io/redis"},
+		Hosts:         map[string]string{"bridge": "172.17.0.2"},
+	}
+	ac.processNewService(ctx, redis)
+	ac.store.setListenerForEntity(redis.ID, "docker")
+	ac.processNewService(ctx, &dummyService{
+		ID:            "container_id://0b4c2d5e6f",
+		ADIdentifiers: []string{"nginx"},
+	})
+
+	ac.processNewConfig(integration.Config{
+		Name:          "redisdb",
+		ADIdentifiers: []string{"redis"},
+		Instances:     []integration.Data{integration.Data("host: '%%host%%'")},
+		Provider:      "file",
+		Source:        "file:/etc/datadog-agent/conf.d/redisdb.d/auto_conf.yaml",
+	})
+	ac.processNewConfig(integration.Config{
+		Name:          "tcp_check",
+		ADIdentifiers: []string{"docker.io/redis"},
+		Instances:     []integration.Data{integration.Data("port: '%%port%%'")},
+		Provider:      "kubernetes",
+	})
+	ac.processNewConfig(integration.Config{
+		Name:          "nginx",
+		ADIdentifiers: []string{"nginx"},
+		Instances:     []integration.Data{integration.Data("{}")},
+		Provider:      "file",
+	})
+
+	assert.Empty(t, ac.Explain(ctx, "unknown"))
+	assert.Len(t, ac.Explain(ctx, "container_id://"), 2)
+
+	explanations := ac.Explain(ctx, "a5901276aed1")
+	require.Len(t, explanations, 1)
+	e := explanations[0]
+	assert.Equal(t, redis.ID, e.Entity)
+	assert.Equal(t, "docker", e.Listener)
+	assert.Equal(t, []string{"redis", "docker.io/redis"}, e.ADIdentifiers)
+	require.Len(t, e.ScheduledConfigs, 1)
+	assert.Equal(t, "redisdb", e.ScheduledConfigs[0].Name)
+
+	require.Len(t, e.Templates, 3)
+
+	assert.Equal(t, "nginx", e.Templates[0].Template.Name)
+	assert.False(t, e.Templates[0].Matched())
+	assert.Nil(t, e.Templates[0].Resolved)
+
+	assert.Equal(t, "redisdb", e.Templates[1].Template.Name)
+	assert.Equal(t, []string{"redis"}, e.Templates[1].MatchedADIdentifiers)
+	assert.Empty(t, e.Templates[1].Error)
+	require.NotNil(t, e.Templates[1].Resolved)
+	assert.Equal(t, integration.Data("host: '172.17.0.2'"), e.Templates[1].Resolved.Instances[0])
+
+	// the service has no ports
+	assert.Equal(t, "tcp_check", e.Templates[2].Template.Name)
+	assert.Equal(t, []string{"docker.io/redis"}, e.Templates[2].MatchedADIdentifiers)
+	assert.NotEmpty(t, e.Templates[2].Error)
+	assert.Nil(t, e.Templates[2].Resolved)
+}
+
+func TestListenRecordsListener(t *testing.T) {
+	ac := NewAutoConfig(scheduler.NewMetaScheduler())
+	svc := &dummyService{ID: "container_id://a5901276aed1"}
+
+	ac.listen("docker", &sendingListener{svc: svc})
+
+	assert.Eventually(t, func() bool {
+		return ac.store.getServiceForEntity(svc.ID) != nil
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, "docker", ac.store.getListenerForEntity(svc.ID))
+
+	ac.processDelService(svc)
+	assert.Empty(t, ac.store.getListenerForEntity(svc.ID))
+}
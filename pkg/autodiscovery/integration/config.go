@@ -67,6 +67,9 @@ type CommonInstanceConfig struct {
 	Service               string   `yaml:"service"`
 	Name                  string   `yaml:"name"`
 	Namespace             string   `yaml:"namespace"`
+	CheckTimeout          float64  `yaml:"check_timeout,omitempty"`
+	StartJitter           float64  `yaml:"start_jitter,omitempty"`
+	Schedule              string   `yaml:"schedule,omitempty"`
 }
 
 // CommonGlobalConfig holds the reserved fields for the yaml init_config data
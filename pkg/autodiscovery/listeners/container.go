@@ -20,6 +20,9 @@ import (
 	"github.com/DataDog/datadog-agent/pkg/workloadmeta"
 )
 
+// ecsTaskARNLabel is the label set by the ECS agent on the containers of a task
+const ecsTaskARNLabel = "com.amazonaws.ecs.task-arn"
+
 func init() {
 	Register("container", NewContainerListener)
 }
@@ -104,6 +107,7 @@ func (l *ContainerListener) createContainerService(
 		if err == nil {
 			svc.hosts = map[string]string{"pod": pod.IP}
 			svc.ready = pod.Ready
+			svc.pod = pod
 		} else {
 			log.Debugf("container %q belongs to a pod but was not found: %s", container.ID, err)
 		}
@@ -146,6 +150,14 @@ func (l *ContainerListener) createContainerService(
 		)
 	}
 
+	if taskARN, found := container.Labels[ecsTaskARNLabel]; found {
+		if task, err := l.Store().GetECSTask(taskARN); err == nil {
+			svc.ecsTask = task
+		} else {
+			log.Debugf("container %q belongs to ECS task %q but it was not found: %s", container.ID, taskARN, err)
+		}
+	}
+
 	svcID := buildSvcID(container.GetID())
 	l.AddService(svcID, svc, "")
 }
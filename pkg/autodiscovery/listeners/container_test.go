@@ -11,6 +11,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+
 	"github.com/DataDog/datadog-agent/pkg/autodiscovery/integration"
 	"github.com/DataDog/datadog-agent/pkg/workloadmeta"
 )
@@ -142,6 +144,46 @@ func TestCreateContainerService(t *testing.T) {
 	}
 }
 
+func TestCreateContainerServiceECSTask(t *testing.T) {
+	task := &workloadmeta.ECSTask{
+		EntityID: workloadmeta.EntityID{
+			Kind: workloadmeta.KindECSTask,
+			ID:   "arn:aws:ecs:us-east-1:123456789012:task/foobar",
+		},
+		Family: "foobar",
+	}
+	container := &workloadmeta.Container{
+		EntityID: workloadmeta.EntityID{
+			Kind: workloadmeta.KindContainer,
+			ID:   containerID,
+		},
+		EntityMeta: workloadmeta.EntityMeta{
+			Name:   containerName,
+			Labels: map[string]string{ecsTaskARNLabel: task.ID},
+		},
+		Image: workloadmeta.ContainerImage{
+			RawName:   "foobar",
+			ShortName: "foobar",
+		},
+		State: workloadmeta.ContainerState{
+			Running: true,
+		},
+		Runtime: workloadmeta.ContainerRuntimeDocker,
+	}
+
+	listener, wlm := newContainerListener(t)
+	wlm.store.Set(task)
+
+	listener.createContainerService(container, integration.After)
+
+	svc, ok := wlm.services["container://foobarquux"].service.(*service)
+	if assert.True(t, ok) {
+		assert.Equal(t, container, svc.GetContainer())
+		assert.Equal(t, task, svc.GetECSTask())
+		assert.Nil(t, svc.GetKubernetesPod())
+	}
+}
+
 func newContainerListener(t *testing.T) (*ContainerListener, *testWorkloadmetaListener) {
 	wlm := newTestWorkloadmetaListener(t)
 
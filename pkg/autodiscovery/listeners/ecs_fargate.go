@@ -85,7 +85,8 @@ func (l *ECSFargateListener) createContainerService(
 	}
 
 	svc := &service{
-		entity: container,
+		entity:  container,
+		ecsTask: task,
 		adIdentifiers: ComputeContainerServiceIDs(
 			containers.BuildEntityName(string(container.Runtime), container.ID),
 			containerImg.RawName,
@@ -69,7 +69,8 @@ func TestECSFargateCreateContainerService(t *testing.T) {
 				"container://foobarquux": {
 					parent: "ecs_task://foobar",
 					service: &service{
-						entity: container,
+						entity:  container,
+						ecsTask: task,
 						adIdentifiers: []string{
 							"docker://foobarquux",
 							"gcr.io/foobar",
@@ -155,6 +155,7 @@ func (l *KubeletListener) createContainerService(
 	entity := containers.BuildEntityName(string(container.Runtime), container.ID)
 	svc := &service{
 		entity:       container,
+		pod:          pod,
 		creationTime: creationTime,
 		ready:        pod.Ready,
 		ports:        ports,
@@ -213,6 +213,7 @@ func TestKubeletCreateContainerService(t *testing.T) {
 					parent: "kubernetes_pod://foobar",
 					service: &service{
 						entity: basicContainer,
+						pod:    pod,
 						adIdentifiers: []string{
 							"docker://foobarquux",
 							"gcr.io/foobar:latest",
@@ -241,6 +242,7 @@ func TestKubeletCreateContainerService(t *testing.T) {
 					parent: "kubernetes_pod://foobar",
 					service: &service{
 						entity: recentlyStoppedContainer,
+						pod:    pod,
 						adIdentifiers: []string{
 							"docker://foobarquux",
 							"foobar",
@@ -283,6 +285,7 @@ func TestKubeletCreateContainerService(t *testing.T) {
 					parent: "kubernetes_pod://foobar",
 					service: &service{
 						entity: multiplePortsContainer,
+						pod:    pod,
 						adIdentifiers: []string{
 							"docker://foobarquux",
 							"foobar",
@@ -319,6 +322,7 @@ func TestKubeletCreateContainerService(t *testing.T) {
 					parent: "kubernetes_pod://foobar",
 					service: &service{
 						entity: customIDsContainer,
+						pod:    podWithAnnotations,
 						adIdentifiers: []string{
 							"customid",
 							"docker://foobarquux",
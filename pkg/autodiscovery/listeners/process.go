@@ -0,0 +1,101 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// +build !serverless
+
+package listeners
+
+import (
+	"net"
+	"sort"
+
+	"github.com/DataDog/datadog-agent/pkg/autodiscovery/integration"
+	"github.com/DataDog/datadog-agent/pkg/workloadmeta"
+)
+
+// processADIdentifierPrefix prefixes the name of processes in their AD
+// identifier, e.g. `process:postgres`
+const processADIdentifierPrefix = "process:"
+
+func init() {
+	Register("process", NewProcessListener)
+}
+
+// ProcessListener listens to the processes collected by the workloadmeta
+// process collector through a subscription to the workloadmeta store.
+type ProcessListener struct {
+	workloadmetaListener
+}
+
+// NewProcessListener returns a new ProcessListener.
+func NewProcessListener() (ServiceListener, error) {
+	const name = "ad-processlistener"
+	l := &ProcessListener{}
+	f := workloadmeta.NewFilter(
+		[]workloadmeta.Kind{workloadmeta.KindProcess},
+		[]workloadmeta.Source{workloadmeta.SourceProcess},
+	)
+
+	var err error
+	l.workloadmetaListener, err = newWorkloadmetaListener(name, f, l.createProcessService)
+	if err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+func (l *ProcessListener) createProcessService(
+	entity workloadmeta.Entity,
+	creationTime integration.CreationTime,
+) {
+	process := entity.(*workloadmeta.Process)
+
+	// the same port can be listened on with several protocols
+	seen := make(map[int]struct{}, len(process.Ports))
+	ports := make([]ContainerPort, 0, len(process.Ports))
+	host := ""
+	for _, port := range process.Ports {
+		if host == "" && !isUnspecifiedAddress(port.Address) {
+			host = port.Address
+		}
+
+		if _, found := seen[port.Port]; found {
+			continue
+		}
+		seen[port.Port] = struct{}{}
+
+		ports = append(ports, ContainerPort{
+			Port: port.Port,
+		})
+	}
+
+	sort.Slice(ports, func(i, j int) bool {
+		return ports[i].Port < ports[j].Port
+	})
+
+	// processes listening on every address are reachable locally
+	if host == "" {
+		host = "127.0.0.1"
+	}
+
+	svc := &service{
+		entity:        process,
+		creationTime:  creationTime,
+		adIdentifiers: []string{processADIdentifierPrefix + process.Name},
+		hosts:         map[string]string{"process": host},
+		ports:         ports,
+		pid:           process.PID,
+		ready:         true,
+	}
+
+	svcID := buildSvcID(process.GetID())
+	l.AddService(svcID, svc, "")
+}
+
+func isUnspecifiedAddress(address string) bool {
+	ip := net.ParseIP(address)
+	return ip == nil || ip.IsUnspecified()
+}
@@ -0,0 +1,107 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// +build !serverless
+
+package listeners
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-agent/pkg/autodiscovery/integration"
+	"github.com/DataDog/datadog-agent/pkg/workloadmeta"
+)
+
+func TestCreateProcessService(t *testing.T) {
+	processEntityID := workloadmeta.EntityID{
+		Kind: workloadmeta.KindProcess,
+		ID:   "1234",
+	}
+
+	postgres := &workloadmeta.Process{
+		EntityID: processEntityID,
+		EntityMeta: workloadmeta.EntityMeta{
+			Name: "postgres",
+		},
+		PID:  1234,
+		Comm: "postgres",
+		Ports: []workloadmeta.ProcessPort{
+			{Address: "0.0.0.0", Port: 5432, Protocol: "tcp"},
+			{Address: "0.0.0.0", Port: 5432, Protocol: "udp"},
+			{Address: "10.0.0.12", Port: 5433, Protocol: "tcp"},
+			{Address: "0.0.0.0", Port: 22, Protocol: "tcp"},
+		},
+	}
+
+	noPorts := &workloadmeta.Process{
+		EntityID: processEntityID,
+		EntityMeta: workloadmeta.EntityMeta{
+			Name: "worker",
+		},
+		PID: 1234,
+	}
+
+	tests := []struct {
+		name             string
+		process          *workloadmeta.Process
+		expectedServices map[string]wlmListenerSvc
+	}{
+		{
+			name:    "process with ports",
+			process: postgres,
+			expectedServices: map[string]wlmListenerSvc{
+				"process://1234": {
+					service: &service{
+						entity:        postgres,
+						adIdentifiers: []string{"process:postgres"},
+						hosts:         map[string]string{"process": "10.0.0.12"},
+						ports: []ContainerPort{
+							{Port: 22},
+							{Port: 5432},
+							{Port: 5433},
+						},
+						pid:          1234,
+						creationTime: integration.Before,
+						ready:        true,
+					},
+				},
+			},
+		},
+		{
+			name:    "process without ports is reachable locally",
+			process: noPorts,
+			expectedServices: map[string]wlmListenerSvc{
+				"process://1234": {
+					service: &service{
+						entity:        noPorts,
+						adIdentifiers: []string{"process:worker"},
+						hosts:         map[string]string{"process": "127.0.0.1"},
+						ports:         []ContainerPort{},
+						pid:           1234,
+						creationTime:  integration.Before,
+						ready:         true,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wlm := newTestWorkloadmetaListener(t)
+			listener := &ProcessListener{workloadmetaListener: wlm}
+
+			listener.createProcessService(tt.process, integration.Before)
+
+			wlm.assertServices(tt.expectedServices)
+		})
+	}
+
+	svc := &service{entity: postgres}
+	assert.Equal(t, "process://1234", svc.GetEntity())
+	assert.Equal(t, "process://1234", svc.GetTaggerEntity())
+}
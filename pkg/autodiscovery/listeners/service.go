@@ -17,6 +17,8 @@ import (
 // workloadmeta.Store.
 type service struct {
 	entity          workloadmeta.Entity
+	pod             *workloadmeta.KubernetesPod // pod of the container, if any
+	ecsTask         *workloadmeta.ECSTask       // ECS task of the container, if any
 	adIdentifiers   []string
 	hosts           map[string]string
 	ports           []ContainerPort
@@ -30,7 +32,7 @@ type service struct {
 	logsExcluded    bool
 }
 
-var _ Service = &service{}
+var _ WorkloadService = &service{}
 
 // GetEntity returns the AD entity ID of the service.
 func (s *service) GetEntity() string {
@@ -39,6 +41,8 @@ func (s *service) GetEntity() string {
 		return containers.BuildEntityName(string(e.Runtime), e.ID)
 	case *workloadmeta.KubernetesPod:
 		return kubelet.PodUIDToEntityName(e.ID)
+	case *workloadmeta.Process:
+		return containers.BuildEntityName(string(workloadmeta.KindProcess), e.ID)
 	default:
 		entityID := s.entity.GetID()
 		log.Errorf("cannot build AD entity ID for kind %q, ID %q", entityID.Kind, entityID.ID)
@@ -53,6 +57,8 @@ func (s *service) GetTaggerEntity() string {
 		return containers.BuildTaggerEntityName(e.ID)
 	case *workloadmeta.KubernetesPod:
 		return kubelet.PodUIDToTaggerEntityName(e.ID)
+	case *workloadmeta.Process:
+		return containers.BuildEntityName(string(workloadmeta.KindProcess), e.ID)
 	default:
 		entityID := s.entity.GetID()
 		log.Errorf("cannot build AD entity ID for kind %q, ID %q", entityID.Kind, entityID.ID)
@@ -129,6 +135,25 @@ func (s *service) GetExtraConfig(key []byte) ([]byte, error) {
 	return []byte(result), nil
 }
 
+// GetContainer returns the container of the service, nil if the service isn't a container.
+func (s *service) GetContainer() *workloadmeta.Container {
+	container, _ := s.entity.(*workloadmeta.Container)
+	return container
+}
+
+// GetKubernetesPod returns the pod of the service, or the pod running its container.
+func (s *service) GetKubernetesPod() *workloadmeta.KubernetesPod {
+	if pod, ok := s.entity.(*workloadmeta.KubernetesPod); ok {
+		return pod
+	}
+	return s.pod
+}
+
+// GetECSTask returns the ECS task running the container of the service, if any.
+func (s *service) GetECSTask() *workloadmeta.ECSTask {
+	return s.ecsTask
+}
+
 // svcEqual checks that two Services are equal to each other by doing a deep
 // equality check on data returned by most of Service's methods. Methods not
 // checked are HasFilter and GetExtraConfig.
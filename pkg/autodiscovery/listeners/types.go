@@ -12,6 +12,7 @@ import (
 	"github.com/DataDog/datadog-agent/pkg/autodiscovery/integration"
 	"github.com/DataDog/datadog-agent/pkg/util/containers"
 	"github.com/DataDog/datadog-agent/pkg/util/log"
+	"github.com/DataDog/datadog-agent/pkg/workloadmeta"
 )
 
 // ContainerPort represents a network port in a Service.
@@ -39,6 +40,15 @@ type Service interface {
 	GetExtraConfig([]byte) ([]byte, error)               // Extra configuration values
 }
 
+// WorkloadService is implemented by services backed by workloadmeta entities. It
+// exposes the metadata of their workloads to template variables.
+type WorkloadService interface {
+	Service
+	GetContainer() *workloadmeta.Container         // container of the service, nil for pods
+	GetKubernetesPod() *workloadmeta.KubernetesPod // pod of the service or of its container, if any
+	GetECSTask() *workloadmeta.ECSTask             // ECS task of the service's container, if any
+}
+
 // ServiceListener monitors running services and triggers check (un)scheduling
 //
 // It holds a cache of running services, listens to new/killed services and
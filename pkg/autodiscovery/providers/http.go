@@ -0,0 +1,424 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package providers
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/DataDog/datadog-agent/pkg/autodiscovery/integration"
+	"github.com/DataDog/datadog-agent/pkg/autodiscovery/providers/names"
+	"github.com/DataDog/datadog-agent/pkg/config"
+	"github.com/DataDog/datadog-agent/pkg/persistentcache"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+const (
+	// httpSignatureHeader holds the base64-encoded ed25519 signature of the payload
+	httpSignatureHeader = "X-Signature"
+	httpRequestTimeout  = 10 * time.Second
+	httpCacheKeyPrefix  = "autodiscovery_http:"
+)
+
+// httpPayload is the format of the payload returned by the endpoint, in YAML or JSON.
+// Each config uses the format of the files of the conf.d directory, plus a `check_name`.
+type httpPayload struct {
+	Configs []map[string]interface{} `yaml:"configs"`
+}
+
+// httpCacheEntry is the last known good payload, stored on disk to keep the checks
+// running when the endpoint is unavailable
+type httpCacheEntry struct {
+	ETag      string `json:"etag"`
+	Signature string `json:"signature,omitempty"`
+	Payload   []byte `json:"payload"`
+}
+
+// HTTPConfigProvider implements the ConfigProvider interface for check configurations
+// served by an HTTP(S) endpoint. The endpoint is polled with If-None-Match requests,
+// the configurations being collected again only when the payload changes.
+type HTTPConfigProvider struct {
+	client    *http.Client
+	url       string
+	username  string
+	password  string
+	token     string
+	publicKey ed25519.PublicKey
+	cacheKey  string
+
+	// fetchLock serializes the requests to the endpoint and guards the fields below,
+	// it is held during the requests so that it doesn't block GetConfigErrors
+	fetchLock   sync.Mutex
+	etag        string
+	payload     []byte // last known good payload
+	payloadHash string // identifies the payload when the endpoint doesn't send an ETag
+	pending     []byte // payload fetched by IsUpToDate, not collected yet
+
+	configErrors map[string]ErrorMsgSet
+	sync.RWMutex // guards configErrors
+}
+
+// NewHTTPConfigProvider returns a new ConfigProvider polling the `template_url` endpoint.
+// Connectivity is not checked at this stage to allow for retries, Collect will do it.
+func NewHTTPConfigProvider(providerConfig config.ConfigurationProviders) (ConfigProvider, error) {
+	if providerConfig.TemplateURL == "" {
+		return nil, errors.New("the template_url of the http config provider must be set")
+	}
+
+	tlsConfig, err := buildHTTPProviderTLSConfig(providerConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &HTTPConfigProvider{
+		client: &http.Client{
+			Timeout:   httpRequestTimeout,
+			Transport: &http.Transport{TLSClientConfig: tlsConfig, Proxy: http.ProxyFromEnvironment},
+		},
+		url:          providerConfig.TemplateURL,
+		username:     providerConfig.Username,
+		password:     providerConfig.Password,
+		token:        providerConfig.Token,
+		cacheKey:     httpCacheKeyPrefix + httpHash([]byte(providerConfig.TemplateURL)),
+		configErrors: make(map[string]ErrorMsgSet),
+	}
+
+	if providerConfig.PublicKeyFile != "" {
+		p.publicKey, err = readEd25519PublicKey(providerConfig.PublicKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read the public key of the http config provider: %s", err)
+		}
+	}
+
+	return p, nil
+}
+
+// String returns a string representation of the HTTPConfigProvider
+func (p *HTTPConfigProvider) String() string {
+	return names.HTTP
+}
+
+// Collect retrieves the configurations served by the endpoint. When the endpoint is
+// unavailable, the last known good configurations are returned.
+func (p *HTTPConfigProvider) Collect(ctx context.Context) ([]integration.Config, error) {
+	p.fetchLock.Lock()
+	defer p.fetchLock.Unlock()
+
+	payload := p.pending
+	p.pending = nil
+
+	if payload == nil {
+		var err error
+		payload, err = p.fetch(ctx)
+		if err != nil {
+			log.Warnf("Cannot fetch the check configurations from %s, using the last known good configurations: %s", p.url, err)
+			if p.payload == nil {
+				p.payload, err = p.readCache()
+				if err != nil {
+					return nil, err
+				}
+				p.payloadHash = httpHash(p.payload)
+			}
+		}
+		if payload == nil {
+			// not modified, or fetch failed
+			payload = p.payload
+		}
+	}
+
+	configs, configErrors, err := parseHTTPPayload(payload, "http:"+p.url)
+	if err != nil {
+		return nil, err
+	}
+	p.Lock()
+	p.configErrors = configErrors
+	p.Unlock()
+
+	return configs, nil
+}
+
+// IsUpToDate sends a conditional request to the endpoint and returns false when the
+// configurations changed. The fetched payload is kept for the next Collect.
+func (p *HTTPConfigProvider) IsUpToDate(ctx context.Context) (bool, error) {
+	p.fetchLock.Lock()
+	defer p.fetchLock.Unlock()
+
+	if p.pending != nil {
+		return false, nil
+	}
+	if p.payload == nil {
+		// nothing successfully fetched yet
+		return false, nil
+	}
+
+	payload, err := p.fetch(ctx)
+	if err != nil {
+		// keep the current configurations
+		return true, err
+	}
+	if payload == nil {
+		return true, nil
+	}
+	p.pending = payload
+	return false, nil
+}
+
+// GetConfigErrors returns a map of configuration errors for each check of the payload
+func (p *HTTPConfigProvider) GetConfigErrors() map[string]ErrorMsgSet {
+	p.RLock()
+	defer p.RUnlock()
+	return p.configErrors
+}
+
+// fetch queries the endpoint with the ETag of the last known good payload. It returns
+// the new payload, nil if it didn't change, and stores it as the last known good payload
+// once verified. The endpoint may not support ETags, so the payloads are also compared
+// by hash. It must be called with fetchLock held.
+func (p *HTTPConfigProvider) fetch(ctx context.Context) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodGet, p.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Accept", "application/yaml, application/json")
+	if p.etag != "" && p.payload != nil {
+		req.Header.Set("If-None-Match", p.etag)
+	}
+	if p.token != "" {
+		req.Header.Set("Authorization", "Bearer "+p.token)
+	} else if p.username != "" {
+		req.SetBasicAuth(p.username, p.password)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified && p.payload != nil:
+		return nil, nil
+	case resp.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	hash := httpHash(body)
+	if p.payload != nil && hash == p.payloadHash {
+		p.etag = resp.Header.Get("ETag")
+		return nil, nil
+	}
+
+	signature := resp.Header.Get(httpSignatureHeader)
+	if err := p.verify(body, signature); err != nil {
+		return nil, err
+	}
+	// reject invalid payloads before they replace the last known good one
+	if err := yaml.Unmarshal(body, &httpPayload{}); err != nil {
+		return nil, fmt.Errorf("invalid payload: %s", err)
+	}
+
+	p.etag = resp.Header.Get("ETag")
+	p.payload = body
+	p.payloadHash = hash
+	p.writeCache(httpCacheEntry{ETag: p.etag, Signature: signature, Payload: body})
+
+	return body, nil
+}
+
+// verify checks the signature of a payload when a public key is configured
+func (p *HTTPConfigProvider) verify(payload []byte, signature string) error {
+	if p.publicKey == nil {
+		return nil
+	}
+	if signature == "" {
+		return fmt.Errorf("missing %s header", httpSignatureHeader)
+	}
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("invalid signature: %s", err)
+	}
+	if !ed25519.Verify(p.publicKey, payload, sig) {
+		return errors.New("the signature of the payload doesn't match")
+	}
+	return nil
+}
+
+func (p *HTTPConfigProvider) writeCache(entry httpCacheEntry) {
+	raw, err := json.Marshal(entry)
+	if err == nil {
+		err = persistentcache.Write(p.cacheKey, string(raw))
+	}
+	if err != nil {
+		log.Warnf("Cannot cache the check configurations from %s: %s", p.url, err)
+	}
+}
+
+// readCache returns the last known good payload stored on disk, verified again as the
+// public key may have changed since it was written
+func (p *HTTPConfigProvider) readCache() ([]byte, error) {
+	raw, err := persistentcache.Read(p.cacheKey)
+	if err != nil {
+		return nil, err
+	}
+	if raw == "" {
+		return nil, fmt.Errorf("no cached configurations for %s", p.url)
+	}
+
+	var entry httpCacheEntry
+	if err := json.Unmarshal([]byte(raw), &entry); err != nil {
+		return nil, fmt.Errorf("invalid cached configurations for %s: %s", p.url, err)
+	}
+	if err := p.verify(entry.Payload, entry.Signature); err != nil {
+		return nil, fmt.Errorf("invalid cached configurations for %s: %s", p.url, err)
+	}
+
+	log.Infof("Using the cached check configurations of %s", p.url)
+	return entry.Payload, nil
+}
+
+// parseHTTPPayload returns the check configurations of a payload, and the errors found
+// while parsing them, indexed by check name and position in the payload
+func parseHTTPPayload(payload []byte, source string) ([]integration.Config, map[string]ErrorMsgSet, error) {
+	var p httpPayload
+	if err := yaml.Unmarshal(payload, &p); err != nil {
+		return nil, nil, fmt.Errorf("invalid payload: %s", err)
+	}
+
+	var configs []integration.Config
+	configErrors := make(map[string]ErrorMsgSet)
+	for i, raw := range p.Configs {
+		name, _ := raw["check_name"].(string)
+		errKey := name + "#" + strconv.Itoa(i)
+		if name == "" {
+			configErrors[errKey] = ErrorMsgSet{"missing check_name": struct{}{}}
+			continue
+		}
+		delete(raw, "check_name")
+
+		// at this point the payload was already parsed, no need to check the error
+		content, _ := yaml.Marshal(raw)
+		conf, err := parseIntegrationConfig(name, content, source)
+		if err != nil {
+			log.Warnf("Cannot parse the configuration of check %s from %s: %s", name, source, err)
+			configErrors[errKey] = ErrorMsgSet{err.Error(): struct{}{}}
+			continue
+		}
+		configs = append(configs, conf)
+	}
+
+	return configs, configErrors, nil
+}
+
+func buildHTTPProviderTLSConfig(providerConfig config.ConfigurationProviders) (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if providerConfig.CAFile != "" || providerConfig.CAPath != "" {
+		pool := x509.NewCertPool()
+		if providerConfig.CAFile != "" {
+			caCert, err := ioutil.ReadFile(providerConfig.CAFile)
+			if err != nil {
+				return nil, fmt.Errorf("cannot read the CA file of the http config provider: %s", err)
+			}
+			if !pool.AppendCertsFromPEM(caCert) {
+				return nil, fmt.Errorf("no valid certificate found in %s", providerConfig.CAFile)
+			}
+		}
+		if providerConfig.CAPath != "" {
+			if err := appendCertsFromDir(pool, providerConfig.CAPath); err != nil {
+				return nil, fmt.Errorf("cannot read the CA path of the http config provider: %s", err)
+			}
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	// mutual TLS
+	if providerConfig.CertFile != "" || providerConfig.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(providerConfig.CertFile, providerConfig.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("cannot load the client certificate of the http config provider: %s", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// appendCertsFromDir adds the PEM-encoded certificates of the files of a directory to a pool
+func appendCertsFromDir(pool *x509.CertPool, dir string) error {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	found := false
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+		caCert, err := ioutil.ReadFile(filepath.Join(dir, f.Name()))
+		if err != nil {
+			return err
+		}
+		if pool.AppendCertsFromPEM(caCert) {
+			found = true
+		}
+	}
+	if !found {
+		return fmt.Errorf("no valid certificate found in %s", dir)
+	}
+	return nil
+}
+
+// readEd25519PublicKey reads a PEM-encoded PKIX ed25519 public key
+func readEd25519PublicKey(path string) (ed25519.PublicKey, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(raw)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found in %s", path)
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	publicKey, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("%s isn't an ed25519 public key", path)
+	}
+	return publicKey, nil
+}
+
+func httpHash(data []byte) string {
+	h := fnv.New64()
+	h.Write(data) //nolint:errcheck
+	return strconv.FormatUint(h.Sum64(), 16)
+}
+
+func init() {
+	RegisterProvider("http", NewHTTPConfigProvider)
+}
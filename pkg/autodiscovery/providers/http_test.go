@@ -0,0 +1,292 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package providers
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DataDog/datadog-agent/pkg/autodiscovery/integration"
+	"github.com/DataDog/datadog-agent/pkg/config"
+)
+
+const testHTTPPayload = `
+configs:
+  - check_name: redisdb
+    ad_identifiers:
+      - redis
+    init_config:
+    instances:
+      - host: "%%host%%"
+        port: 6379
+  - check_name: http_check
+    init_config:
+    instances:
+      - url: http://example.com
+  - check_name: broken
+    init_config:
+  - init_config:
+    instances:
+      - {}
+`
+
+// testConfigServer serves a payload with its ETag and signature, and counts the requests
+type testConfigServer struct {
+	sync.Mutex
+	payload     string
+	etag        string
+	signature   string
+	down        bool
+	requests    int
+	notModified int
+}
+
+func (s *testConfigServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	defer s.Unlock()
+	s.requests++
+	if s.down {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	if match := r.Header.Get("If-None-Match"); match != "" && match == s.etag {
+		s.notModified++
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	if s.etag != "" {
+		w.Header().Set("ETag", s.etag)
+	}
+	if s.signature != "" {
+		w.Header().Set(httpSignatureHeader, s.signature)
+	}
+	w.Write([]byte(s.payload)) //nolint:errcheck
+}
+
+func (s *testConfigServer) set(payload, etag, signature string) {
+	s.Lock()
+	defer s.Unlock()
+	s.payload, s.etag, s.signature = payload, etag, signature
+}
+
+func (s *testConfigServer) setDown() {
+	s.Lock()
+	defer s.Unlock()
+	s.down = true
+}
+
+func setupHTTPProviderTest(t *testing.T) {
+	runPath, err := ioutil.TempDir("", "http-provider")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(runPath) })
+	config.Mock().Set("run_path", runPath)
+}
+
+func TestParseHTTPPayload(t *testing.T) {
+	configs, configErrors, err := parseHTTPPayload([]byte(testHTTPPayload), "http:test")
+	require.NoError(t, err)
+
+	assert.Equal(t, []integration.Config{
+		{
+			Name:          "redisdb",
+			Instances:     []integration.Data{integration.Data("host: '%%host%%'\nport: 6379\n")},
+			ADIdentifiers: []string{"redis"},
+			Source:        "http:test",
+		},
+		{
+			Name:      "http_check",
+			Instances: []integration.Data{integration.Data("url: http://example.com\n")},
+			Source:    "http:test",
+		},
+	}, configs)
+	assert.Equal(t, map[string]ErrorMsgSet{
+		"broken#2": {"Configuration file contains no valid instances": struct{}{}},
+		"#3":       {"missing check_name": struct{}{}},
+	}, configErrors)
+
+	// JSON payloads are supported as well
+	configs, _, err = parseHTTPPayload([]byte(`{"configs": [{"check_name": "http_check", "instances": [{"url": "http://example.com"}]}]}`), "http:test")
+	require.NoError(t, err)
+	require.Len(t, configs, 1)
+	assert.Equal(t, "http_check", configs[0].Name)
+
+	_, _, err = parseHTTPPayload([]byte("configs: {"), "http:test")
+	assert.Error(t, err)
+}
+
+func TestHTTPConfigProviderETag(t *testing.T) {
+	setupHTTPProviderTest(t)
+	server := &testConfigServer{payload: testHTTPPayload, etag: `"v1"`}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	p, err := NewHTTPConfigProvider(config.ConfigurationProviders{TemplateURL: ts.URL})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	upToDate, err := p.IsUpToDate(ctx)
+	assert.NoError(t, err)
+	assert.False(t, upToDate)
+	configs, err := p.Collect(ctx)
+	require.NoError(t, err)
+	assert.Len(t, configs, 2)
+	assert.Len(t, p.GetConfigErrors(), 2)
+
+	// the payload didn't change
+	upToDate, err = p.IsUpToDate(ctx)
+	assert.NoError(t, err)
+	assert.True(t, upToDate)
+	assert.Equal(t, 1, server.notModified)
+
+	// the payload changed, it's collected without another request
+	server.set("configs:\n  - check_name: http_check\n    instances:\n      - url: http://example.com\n", `"v2"`, "")
+	upToDate, err = p.IsUpToDate(ctx)
+	assert.NoError(t, err)
+	assert.False(t, upToDate)
+	requests := server.requests
+	configs, err = p.Collect(ctx)
+	require.NoError(t, err)
+	assert.Len(t, configs, 1)
+	assert.Empty(t, p.GetConfigErrors())
+	assert.Equal(t, requests, server.requests)
+
+	// the endpoint is down, the current configurations are kept
+	server.setDown()
+	upToDate, err = p.IsUpToDate(ctx)
+	assert.Error(t, err)
+	assert.True(t, upToDate)
+	configs, err = p.Collect(ctx)
+	require.NoError(t, err)
+	assert.Len(t, configs, 1)
+}
+
+func TestHTTPConfigProviderNoETag(t *testing.T) {
+	setupHTTPProviderTest(t)
+	server := &testConfigServer{payload: testHTTPPayload}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	p, err := NewHTTPConfigProvider(config.ConfigurationProviders{TemplateURL: ts.URL})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	configs, err := p.Collect(ctx)
+	require.NoError(t, err)
+	assert.Len(t, configs, 2)
+
+	// the payload is compared by hash
+	upToDate, err := p.IsUpToDate(ctx)
+	assert.NoError(t, err)
+	assert.True(t, upToDate)
+	assert.Equal(t, 0, server.notModified)
+
+	server.set("configs:\n  - check_name: http_check\n    instances:\n      - url: http://example.com\n", "", "")
+	upToDate, err = p.IsUpToDate(ctx)
+	assert.NoError(t, err)
+	assert.False(t, upToDate)
+	configs, err = p.Collect(ctx)
+	require.NoError(t, err)
+	assert.Len(t, configs, 1)
+}
+
+func TestHTTPConfigProviderCAPath(t *testing.T) {
+	setupHTTPProviderTest(t)
+	ts := httptest.NewTLSServer(&testConfigServer{payload: testHTTPPayload, etag: `"v1"`})
+	defer ts.Close()
+	ctx := context.Background()
+
+	caPath, err := ioutil.TempDir("", "http-provider-ca")
+	require.NoError(t, err)
+	defer os.RemoveAll(caPath)
+
+	// the certificate of the server isn't trusted
+	p, err := NewHTTPConfigProvider(config.ConfigurationProviders{TemplateURL: ts.URL})
+	require.NoError(t, err)
+	_, err = p.Collect(ctx)
+	assert.Error(t, err)
+
+	_, err = NewHTTPConfigProvider(config.ConfigurationProviders{TemplateURL: ts.URL, CAPath: caPath})
+	assert.Error(t, err)
+
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+	require.NoError(t, ioutil.WriteFile(filepath.Join(caPath, "server.pem"), cert, 0644))
+	p, err = NewHTTPConfigProvider(config.ConfigurationProviders{TemplateURL: ts.URL, CAPath: caPath})
+	require.NoError(t, err)
+	configs, err := p.Collect(ctx)
+	require.NoError(t, err)
+	assert.Len(t, configs, 2)
+}
+
+func TestHTTPConfigProviderCache(t *testing.T) {
+	setupHTTPProviderTest(t)
+	server := &testConfigServer{payload: testHTTPPayload, etag: `"v1"`}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+	ctx := context.Background()
+
+	p, err := NewHTTPConfigProvider(config.ConfigurationProviders{TemplateURL: ts.URL})
+	require.NoError(t, err)
+	configs, err := p.Collect(ctx)
+	require.NoError(t, err)
+	assert.Len(t, configs, 2)
+
+	// a new provider, e.g. after a restart, uses the last known good payload
+	server.setDown()
+	p, err = NewHTTPConfigProvider(config.ConfigurationProviders{TemplateURL: ts.URL})
+	require.NoError(t, err)
+	configs, err = p.Collect(ctx)
+	require.NoError(t, err)
+	assert.Len(t, configs, 2)
+
+	// no cache for other endpoints
+	p, err = NewHTTPConfigProvider(config.ConfigurationProviders{TemplateURL: ts.URL + "/other"})
+	require.NoError(t, err)
+	_, err = p.Collect(ctx)
+	assert.Error(t, err)
+}
+
+func TestHTTPConfigProviderSignature(t *testing.T) {
+	setupHTTPProviderTest(t)
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	signature := base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, []byte(testHTTPPayload)))
+
+	server := &testConfigServer{payload: testHTTPPayload, etag: `"v1"`}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+	ctx := context.Background()
+
+	p, err := NewHTTPConfigProvider(config.ConfigurationProviders{TemplateURL: ts.URL})
+	require.NoError(t, err)
+	p.(*HTTPConfigProvider).publicKey = publicKey
+
+	// missing signature
+	_, err = p.Collect(ctx)
+	assert.Error(t, err)
+
+	// invalid signature
+	server.set(testHTTPPayload, `"v1"`, base64.StdEncoding.EncodeToString(make([]byte, ed25519.SignatureSize)))
+	_, err = p.Collect(ctx)
+	assert.Error(t, err)
+
+	server.set(testHTTPPayload, `"v1"`, signature)
+	configs, err := p.Collect(ctx)
+	require.NoError(t, err)
+	assert.Len(t, configs, 2)
+}
@@ -0,0 +1,207 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// +build kubeapiserver
+
+package providers
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	listersv1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/DataDog/datadog-agent/pkg/autodiscovery/integration"
+	"github.com/DataDog/datadog-agent/pkg/autodiscovery/providers/names"
+	"github.com/DataDog/datadog-agent/pkg/config"
+	"github.com/DataDog/datadog-agent/pkg/util/kubernetes/apiserver"
+	"github.com/DataDog/datadog-agent/pkg/util/kubernetes/apiserver/common"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+// KubeConfigMapsConfigProvider implements the ConfigProvider interface for the
+// check configurations stored in Kubernetes ConfigMaps.
+//
+// Each ConfigMap matching the label selector can hold several configurations, one per
+// data key named after the check, e.g. `redisdb.yaml`, using the format of the files of
+// the conf.d directory.
+type KubeConfigMapsConfigProvider struct {
+	lister       listersv1.ConfigMapLister
+	configErrors map[string]ErrorMsgSet
+	upToDate     bool
+	sync.RWMutex
+}
+
+// NewKubeConfigMapsConfigProvider returns a new ConfigProvider watching the ConfigMaps
+// of the apiserver. Connectivity is not checked at this stage to allow for retries,
+// Collect will do it.
+func NewKubeConfigMapsConfigProvider(providerConfig config.ConfigurationProviders) (ConfigProvider, error) {
+	// Using GetAPIClient() (no retry)
+	ac, err := apiserver.GetAPIClient()
+	if err != nil {
+		return nil, fmt.Errorf("cannot connect to apiserver: %s", err)
+	}
+
+	namespace := config.Datadog.GetString("kube_configmaps_provider.namespace")
+	if namespace == "" {
+		namespace = common.GetResourcesNamespace()
+	}
+	selector := config.Datadog.GetString("kube_configmaps_provider.label_selector")
+	if _, err := labels.Parse(selector); err != nil {
+		return nil, fmt.Errorf("invalid label selector %q: %s", selector, err)
+	}
+
+	resyncPeriod := time.Duration(config.Datadog.GetInt64("kubernetes_informers_resync_period")) * time.Second
+	informerFactory := informers.NewSharedInformerFactoryWithOptions(ac.Cl, resyncPeriod,
+		informers.WithNamespace(namespace),
+		informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+			opts.LabelSelector = selector
+		}),
+	)
+	configMapsInformer := informerFactory.Core().V1().ConfigMaps()
+
+	p := &KubeConfigMapsConfigProvider{
+		lister:       configMapsInformer.Lister(),
+		configErrors: make(map[string]ErrorMsgSet),
+	}
+
+	configMapsInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    p.invalidate,
+		UpdateFunc: p.invalidateIfChanged,
+		DeleteFunc: p.invalidate,
+	})
+
+	// The informer runs for the lifetime of the agent, like the provider
+	informerFactory.Start(make(chan struct{}))
+
+	log.Infof("Watching the ConfigMaps matching %q in namespace %s for check configurations", selector, namespace)
+
+	return p, nil
+}
+
+// String returns a string representation of the KubeConfigMapsConfigProvider
+func (k *KubeConfigMapsConfigProvider) String() string {
+	return names.KubeConfigMaps
+}
+
+// Collect retrieves the ConfigMaps from the apiserver and parses the check configurations they hold
+func (k *KubeConfigMapsConfigProvider) Collect(ctx context.Context) ([]integration.Config, error) {
+	configMaps, err := k.lister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	configs, configErrors := parseConfigMaps(configMaps)
+
+	k.Lock()
+	defer k.Unlock()
+	k.upToDate = true
+	k.configErrors = configErrors
+
+	return configs, nil
+}
+
+// IsUpToDate allows to cache configs as long as no changes are detected in the apiserver
+func (k *KubeConfigMapsConfigProvider) IsUpToDate(ctx context.Context) (bool, error) {
+	k.RLock()
+	defer k.RUnlock()
+	return k.upToDate, nil
+}
+
+// GetConfigErrors returns a map of configuration errors for each namespace/configmap/key
+func (k *KubeConfigMapsConfigProvider) GetConfigErrors() map[string]ErrorMsgSet {
+	k.RLock()
+	defer k.RUnlock()
+	return k.configErrors
+}
+
+func (k *KubeConfigMapsConfigProvider) invalidate(obj interface{}) {
+	if obj != nil {
+		log.Trace("Invalidating configs on new/deleted configmap")
+		k.Lock()
+		k.upToDate = false
+		k.Unlock()
+	}
+}
+
+func (k *KubeConfigMapsConfigProvider) invalidateIfChanged(old, obj interface{}) {
+	// Cast the updated object, don't invalidate on casting error.
+	// nil pointers are safely handled by the casting logic.
+	castedObj, ok := obj.(*v1.ConfigMap)
+	if !ok {
+		log.Errorf("Expected a ConfigMap type, got: %v", obj)
+		return
+	}
+	// Cast the old object, invalidate on casting error
+	castedOld, ok := old.(*v1.ConfigMap)
+	if !ok {
+		log.Errorf("Expected a ConfigMap type, got: %v", old)
+		k.invalidate(obj)
+		return
+	}
+	// Quick exit if resversion did not change
+	if castedObj.ResourceVersion == castedOld.ResourceVersion {
+		return
+	}
+	if !reflect.DeepEqual(castedObj.Data, castedOld.Data) {
+		log.Trace("Invalidating configs on configmap change")
+		k.invalidate(obj)
+	}
+}
+
+// parseConfigMaps returns the check configurations held by the ConfigMaps, and the errors
+// found while parsing them, indexed by namespace/configmap/key
+func parseConfigMaps(configMaps []*v1.ConfigMap) ([]integration.Config, map[string]ErrorMsgSet) {
+	var configs []integration.Config
+	configErrors := make(map[string]ErrorMsgSet)
+
+	for _, cm := range configMaps {
+		if cm == nil {
+			continue
+		}
+
+		// sort the keys to return the configs in a stable order
+		keys := make([]string, 0, len(cm.Data))
+		for key := range cm.Data {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			ext := filepath.Ext(key)
+			if ext != ".yaml" && ext != ".yml" {
+				log.Debugf("Ignoring key %s of configmap %s/%s: not a yaml file", key, cm.Namespace, cm.Name)
+				continue
+			}
+			checkName := strings.TrimSuffix(key, ext)
+			source := cm.Namespace + "/" + cm.Name + "/" + key
+
+			conf, err := parseIntegrationConfig(checkName, []byte(cm.Data[key]), "kube_configmaps:"+source)
+			if err != nil {
+				log.Warnf("Cannot parse the configuration of check %s in configmap %s/%s: %s", checkName, cm.Namespace, cm.Name, err)
+				configErrors[source] = ErrorMsgSet{err.Error(): struct{}{}}
+				continue
+			}
+			configs = append(configs, conf)
+		}
+	}
+
+	return configs, configErrors
+}
+
+func init() {
+	RegisterProvider("kube_configmaps", NewKubeConfigMapsConfigProvider)
+}
@@ -0,0 +1,93 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// +build kubeapiserver
+
+package providers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/DataDog/datadog-agent/pkg/autodiscovery/integration"
+)
+
+func TestParseConfigMaps(t *testing.T) {
+	configMaps := []*v1.ConfigMap{
+		nil,
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "checks"},
+			Data: map[string]string{
+				"redisdb.yaml":   "ad_identifiers:\n  - redis\ninit_config:\ninstances:\n  - host: \"%%host%%\"\n    port: 6379\n",
+				"http_check.yml": "init_config:\ninstances:\n  - name: My service\n    url: http://example.com\n",
+				"README.md":      "not a check configuration",
+				"broken.yaml":    "init_config:\n",
+			},
+		},
+	}
+
+	configs, configErrors := parseConfigMaps(configMaps)
+
+	require.Len(t, configs, 2)
+	assert.Equal(t, integration.Config{
+		Name:       "http_check",
+		Instances:  []integration.Data{integration.Data("name: My service\nurl: http://example.com\n")},
+		InitConfig: nil,
+		Source:     "kube_configmaps:default/checks/http_check.yml",
+	}, configs[0])
+	assert.Equal(t, integration.Config{
+		Name:          "redisdb",
+		Instances:     []integration.Data{integration.Data("host: '%%host%%'\nport: 6379\n")},
+		ADIdentifiers: []string{"redis"},
+		Source:        "kube_configmaps:default/checks/redisdb.yaml",
+	}, configs[1])
+
+	assert.Equal(t, map[string]ErrorMsgSet{
+		"default/checks/broken.yaml": {"Configuration file contains no valid instances": struct{}{}},
+	}, configErrors)
+}
+
+func TestKubeConfigMapsInvalidateIfChanged(t *testing.T) {
+	cm88 := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{ResourceVersion: "88"},
+		Data:       map[string]string{"redisdb.yaml": "instances:\n  - port: 6379\n"},
+	}
+	cm89 := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{ResourceVersion: "89"},
+		Data:       map[string]string{"redisdb.yaml": "instances:\n  - port: 6379\n"},
+	}
+	cm90 := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{ResourceVersion: "90"},
+		Data:       map[string]string{"redisdb.yaml": "instances:\n  - port: 6380\n"},
+	}
+
+	for _, tc := range []struct {
+		name       string
+		old        interface{}
+		obj        interface{}
+		invalidate bool
+	}{
+		{name: "invalid input", old: nil, obj: nil, invalidate: false},
+		{name: "missed create", old: nil, obj: cm88, invalidate: true},
+		{name: "invalid old object", old: &v1.Pod{}, obj: cm88, invalidate: true},
+		{name: "same resource version", old: cm88, obj: cm88, invalidate: false},
+		{name: "same data", old: cm88, obj: cm89, invalidate: false},
+		{name: "data change", old: cm89, obj: cm90, invalidate: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			provider := &KubeConfigMapsConfigProvider{upToDate: true}
+			provider.invalidateIfChanged(tc.old, tc.obj)
+
+			upToDate, err := provider.IsUpToDate(context.TODO())
+			assert.NoError(t, err)
+			assert.Equal(t, !tc.invalidate, upToDate)
+		})
+	}
+}
@@ -18,6 +18,8 @@ const (
 	Kubernetes         = "kubernetes"
 	KubeServices       = "kubernetes-services"
 	KubeEndpoints      = "kubernetes-endpoints"
+	KubeConfigMaps     = "kubernetes-configmaps"
+	HTTP               = "http"
 	PrometheusPods     = "prometheus-pods"
 	PrometheusServices = "prometheus-services"
 	SNMP               = "snmp"
@@ -21,6 +21,7 @@ type store struct {
 	nameToJMXMetrics  map[string]integration.Data
 	adIDToServices    map[string]map[string]bool
 	entityToService   map[string]listeners.Service
+	entityToListener  map[string]string
 	templateCache     *TemplateCache
 	m                 sync.RWMutex
 }
@@ -35,6 +36,7 @@ func newStore() *store {
 		nameToJMXMetrics:  make(map[string]integration.Data),
 		adIDToServices:    make(map[string]map[string]bool),
 		entityToService:   make(map[string]listeners.Service),
+		entityToListener:  make(map[string]string),
 		templateCache:     NewTemplateCache(),
 	}
 
@@ -51,6 +53,13 @@ func (s *store) removeConfigsForService(serviceEntity string) []integration.Conf
 	return removed
 }
 
+// getConfigsForService returns the configs resolved for a specified service
+func (s *store) getConfigsForService(serviceEntity string) []integration.Config {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	return append([]integration.Config{}, s.serviceToConfigs[serviceEntity]...)
+}
+
 // addConfigForService adds a config for a specified service
 func (s *store) addConfigForService(serviceEntity string, config integration.Config) {
 	s.m.Lock()
@@ -164,6 +173,20 @@ func (s *store) removeServiceForEntity(entity string) {
 	s.m.Lock()
 	defer s.m.Unlock()
 	delete(s.entityToService, entity)
+	delete(s.entityToListener, entity)
+}
+
+// setListenerForEntity stores the name of the listener of a service
+func (s *store) setListenerForEntity(entity string, listener string) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.entityToListener[entity] = listener
+}
+
+func (s *store) getListenerForEntity(entity string) string {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	return s.entityToListener[entity]
 }
 
 func (s *store) setADIDForServices(adID string, serviceEntity string) {
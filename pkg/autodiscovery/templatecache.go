@@ -85,6 +85,18 @@ func (cache *TemplateCache) GetUnresolvedTemplates() map[string][]integration.Co
 	return tpls
 }
 
+// GetAll returns all the templates in the cache
+func (cache *TemplateCache) GetAll() []integration.Config {
+	cache.m.RLock()
+	defer cache.m.RUnlock()
+
+	templates := make([]integration.Config, 0, len(cache.digestToTemplate))
+	for _, tpl := range cache.digestToTemplate {
+		templates = append(templates, tpl)
+	}
+	return templates
+}
+
 // Del removes a template from the cache
 func (cache *TemplateCache) Del(tpl integration.Config) error {
 	// compute the digest once
@@ -0,0 +1,197 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package check
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronMacros are the shorthands supported in place of the 5 fields of a cron schedule
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// cronField describes the range of values accepted by a field of a cron schedule
+type cronField struct {
+	name     string
+	min, max uint
+	names    []string // optional names of the values, starting at min
+}
+
+var (
+	cronMinute     = cronField{name: "minute", min: 0, max: 59}
+	cronHour       = cronField{name: "hour", min: 0, max: 23}
+	cronDayOfMonth = cronField{name: "day of month", min: 1, max: 31}
+	cronMonth      = cronField{name: "month", min: 1, max: 12, names: []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}}
+	// 7 is accepted as an alias of 0 (sunday), see parse
+	cronDayOfWeek = cronField{name: "day of week", min: 0, max: 7, names: []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}}
+)
+
+// maxCronSearch bounds the search of the next activation of a schedule, so that schedules
+// that never fire (e.g. on February 30th) don't loop forever.
+const maxCronSearch = 5 * 366 * 24 * time.Hour
+
+// CronSchedule is a cron-like schedule made of 5 space-separated fields: minute, hour, day
+// of month, month and day of week. Fields accept `*`, values, ranges (`1-5`), lists (`1,15`)
+// and steps (`*/10`, `0-30/5`); months and days of week also accept their 3-letter English
+// names. Like cron, when both the day of month and the day of week are restricted, the
+// schedule fires on days matching either of them.
+//
+// Schedules are evaluated in the local time zone of the agent.
+type CronSchedule struct {
+	expr                          string
+	minute, hour, dom, month, dow uint64 // bitsets of the allowed values
+	domRestricted, dowRestricted  bool
+}
+
+// ParseCronSchedule parses a cron-like schedule, see CronSchedule
+func ParseCronSchedule(expr string) (*CronSchedule, error) {
+	expr = strings.TrimSpace(expr)
+	fields := strings.Fields(expr)
+	if macro, ok := cronMacros[strings.ToLower(expr)]; ok {
+		fields = strings.Fields(macro)
+	}
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid cron schedule %q: expected 5 fields, found %d", expr, len(fields))
+	}
+
+	s := &CronSchedule{expr: expr}
+	var err error
+	if s.minute, err = cronMinute.parse(fields[0]); err != nil {
+		return nil, fmt.Errorf("invalid cron schedule %q: %s", expr, err)
+	}
+	if s.hour, err = cronHour.parse(fields[1]); err != nil {
+		return nil, fmt.Errorf("invalid cron schedule %q: %s", expr, err)
+	}
+	if s.dom, err = cronDayOfMonth.parse(fields[2]); err != nil {
+		return nil, fmt.Errorf("invalid cron schedule %q: %s", expr, err)
+	}
+	if s.month, err = cronMonth.parse(fields[3]); err != nil {
+		return nil, fmt.Errorf("invalid cron schedule %q: %s", expr, err)
+	}
+	if s.dow, err = cronDayOfWeek.parse(fields[4]); err != nil {
+		return nil, fmt.Errorf("invalid cron schedule %q: %s", expr, err)
+	}
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+	s.domRestricted = !strings.HasPrefix(fields[2], "*")
+	s.dowRestricted = !strings.HasPrefix(fields[4], "*")
+	return s, nil
+}
+
+// String returns the expression the schedule was parsed from
+func (s *CronSchedule) String() string {
+	return s.expr
+}
+
+// Next returns the first activation of the schedule strictly after t, or the zero time if
+// the schedule doesn't fire in the next 5 years.
+func (s *CronSchedule) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(maxCronSearch)
+
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !s.matchDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (s *CronSchedule) matchDay(t time.Time) bool {
+	dom := s.dom&(1<<uint(t.Day())) != 0
+	dow := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domRestricted && s.dowRestricted {
+		return dom || dow
+	}
+	return dom && dow
+}
+
+// parse parses a field of a cron schedule into a bitset of the allowed values
+func (f cronField) parse(field string) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		rng, step := part, uint(1)
+		if i := strings.Index(part, "/"); i >= 0 {
+			n, err := strconv.ParseUint(part[i+1:], 10, 8)
+			if err != nil || n == 0 {
+				return 0, fmt.Errorf("invalid step in %s field %q", f.name, part)
+			}
+			rng, step = part[:i], uint(n)
+		}
+
+		var low, high uint
+		switch {
+		case rng == "*":
+			low, high = f.min, f.max
+		case strings.Contains(rng, "-"):
+			i := strings.Index(rng, "-")
+			var err error
+			if low, err = f.value(rng[:i]); err != nil {
+				return 0, err
+			}
+			if high, err = f.value(rng[i+1:]); err != nil {
+				return 0, err
+			}
+			if low > high {
+				return 0, fmt.Errorf("invalid range in %s field %q", f.name, part)
+			}
+		default:
+			v, err := f.value(rng)
+			if err != nil {
+				return 0, err
+			}
+			low, high = v, v
+			if step > 1 {
+				// like cron, `n/step` means from n to the maximum value
+				high = f.max
+			}
+		}
+
+		for v := low; v <= high; v += step {
+			bits |= 1 << v
+		}
+	}
+	return bits, nil
+}
+
+// value parses a single value of the field, given as a number or a name
+func (f cronField) value(s string) (uint, error) {
+	for i, name := range f.names {
+		if strings.EqualFold(s, name) {
+			return f.min + uint(i), nil
+		}
+	}
+	n, err := strconv.ParseUint(s, 10, 8)
+	if err != nil || uint(n) < f.min || uint(n) > f.max {
+		return 0, fmt.Errorf("invalid %s %q: expected a value between %d and %d", f.name, s, f.min, f.max)
+	}
+	return uint(n), nil
+}
@@ -0,0 +1,68 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package check
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCronScheduleErrors(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"a * * * *",
+		"* * * foo *",
+		"@every 1h",
+	} {
+		_, err := ParseCronSchedule(expr)
+		assert.Error(t, err, expr)
+	}
+}
+
+func TestCronScheduleNext(t *testing.T) {
+	// a Friday
+	from := time.Date(2021, time.October, 15, 10, 42, 30, 0, time.UTC)
+
+	for _, tc := range []struct {
+		expr     string
+		expected time.Time
+	}{
+		{"* * * * *", time.Date(2021, time.October, 15, 10, 43, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2021, time.October, 15, 10, 45, 0, 0, time.UTC)},
+		{"0 2 * * *", time.Date(2021, time.October, 16, 2, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2021, time.October, 16, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2021, time.October, 15, 11, 0, 0, 0, time.UTC)},
+		{"@weekly", time.Date(2021, time.October, 17, 0, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2021, time.November, 1, 0, 0, 0, 0, time.UTC)},
+		{"@yearly", time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{"30 9 * * mon-fri", time.Date(2021, time.October, 18, 9, 30, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2021, time.October, 17, 0, 0, 0, 0, time.UTC)},
+		{"0 12 1,15 * *", time.Date(2021, time.October, 15, 12, 0, 0, 0, time.UTC)},
+		{"0 8-18/4 * * *", time.Date(2021, time.October, 15, 12, 0, 0, 0, time.UTC)},
+		{"0 0 29 feb *", time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		// day of month or day of week when both are restricted
+		{"0 0 1 * mon", time.Date(2021, time.October, 18, 0, 0, 0, 0, time.UTC)},
+		// never fires
+		{"0 0 30 2 *", time.Time{}},
+	} {
+		s, err := ParseCronSchedule(tc.expr)
+		require.NoError(t, err, tc.expr)
+		assert.Equal(t, tc.expr, s.String())
+		assert.Equal(t, tc.expected, s.Next(from), tc.expr)
+	}
+}
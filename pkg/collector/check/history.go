@@ -0,0 +1,91 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package check
+
+import (
+	"time"
+)
+
+// Kinds of the samples recorded in the execution history of checks
+const (
+	SampleKindMetric          = "metric"
+	SampleKindHistogramBucket = "histogram_bucket"
+	SampleKindServiceCheck    = "service_check"
+	SampleKindEvent           = "event"
+)
+
+// SenderSample is a sample submitted by a check during a run, recorded in its execution
+// history when `check_history.include_samples` is enabled
+type SenderSample struct {
+	Kind     string   `json:"kind"`
+	Name     string   `json:"name"`           // metric name, service check name or event title
+	Type     string   `json:"type,omitempty"` // metric type, bucket bounds, service check status or event alert type
+	Value    float64  `json:"value"`
+	Hostname string   `json:"host,omitempty"`
+	Tags     []string `json:"tags,omitempty"`
+	Message  string   `json:"message,omitempty"` // service check message or event text
+}
+
+// RunResult holds the results of a single run of a check instance
+type RunResult struct {
+	StartTime        int64          `json:"start_time"` // unix timestamp in milliseconds
+	Duration         int64          `json:"duration"`   // in milliseconds
+	MetricSamples    int64          `json:"metric_samples"`
+	HistogramBuckets int64          `json:"histogram_buckets"`
+	Events           int64          `json:"events"`
+	ServiceChecks    int64          `json:"service_checks"`
+	Error            string         `json:"error,omitempty"`
+	TimedOut         bool           `json:"timed_out,omitempty"`
+	Warnings         []string       `json:"warnings,omitempty"`
+	Samples          []SenderSample `json:"samples,omitempty"`
+	// SamplesDropped is the number of samples of the run that weren't recorded because
+	// of the `check_history.max_samples` limit
+	SamplesDropped int64 `json:"samples_dropped,omitempty"`
+}
+
+// Start returns the start time of the run
+func (r RunResult) Start() time.Time {
+	return time.Unix(0, r.StartTime*int64(time.Millisecond))
+}
+
+// History is a fixed-size ring buffer holding the results of the most recent runs of a
+// check instance. It isn't safe for concurrent use.
+type History struct {
+	results []RunResult
+	next    int  // index of the next result to write
+	full    bool // whether the buffer wrapped around
+}
+
+// NewHistory returns a history keeping the results of the last `size` runs. A history whose
+// size isn't positive keeps no results.
+func NewHistory(size int) *History {
+	if size < 0 {
+		size = 0
+	}
+	return &History{results: make([]RunResult, size)}
+}
+
+// Add adds the result of a run to the history, evicting the oldest result if the history is full
+func (h *History) Add(r RunResult) {
+	if len(h.results) == 0 {
+		return
+	}
+	h.results[h.next] = r
+	h.next = (h.next + 1) % len(h.results)
+	if h.next == 0 {
+		h.full = true
+	}
+}
+
+// Results returns a copy of the results in the history, from the oldest to the most recent
+func (h *History) Results() []RunResult {
+	if !h.full {
+		return append([]RunResult{}, h.results[:h.next]...)
+	}
+	results := make([]RunResult, 0, len(h.results))
+	results = append(results, h.results[h.next:]...)
+	return append(results, h.results[:h.next]...)
+}
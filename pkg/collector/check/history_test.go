@@ -0,0 +1,51 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package check
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func durations(results []RunResult) []int64 {
+	d := make([]int64, 0, len(results))
+	for _, r := range results {
+		d = append(d, r.Duration)
+	}
+	return d
+}
+
+func TestHistory(t *testing.T) {
+	h := NewHistory(3)
+	assert.Empty(t, h.Results())
+
+	h.Add(RunResult{Duration: 1})
+	h.Add(RunResult{Duration: 2})
+	assert.Equal(t, []int64{1, 2}, durations(h.Results()))
+
+	h.Add(RunResult{Duration: 3})
+	assert.Equal(t, []int64{1, 2, 3}, durations(h.Results()))
+
+	h.Add(RunResult{Duration: 4})
+	h.Add(RunResult{Duration: 5})
+	assert.Equal(t, []int64{3, 4, 5}, durations(h.Results()))
+
+	// the returned results are a copy
+	results := h.Results()
+	results[0].Duration = 42
+	assert.Equal(t, []int64{3, 4, 5}, durations(h.Results()))
+}
+
+func TestHistoryEmpty(t *testing.T) {
+	h := NewHistory(0)
+	h.Add(RunResult{Duration: 1})
+	assert.Empty(t, h.Results())
+
+	h = NewHistory(-1)
+	h.Add(RunResult{Duration: 1})
+	assert.Empty(t, h.Results())
+}
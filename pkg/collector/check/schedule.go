@@ -0,0 +1,72 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package check
+
+import (
+	"fmt"
+	"time"
+)
+
+// ScheduleOptions holds the scheduling options of a check instance, on top of its interval
+type ScheduleOptions struct {
+	// Timeout is the maximum duration of a run, after which the run is reported as failed
+	// and the worker running it is released. No timeout if 0.
+	Timeout time.Duration
+	// StartJitter is the maximum random delay applied before scheduling the check, so that
+	// agents started at the same time don't run their checks at the same time. When a cron
+	// schedule is set, the jitter is applied to every run.
+	StartJitter time.Duration
+	// Schedule is the cron-like schedule of the check. When set, the check runs according to
+	// it instead of at every interval.
+	Schedule *CronSchedule
+}
+
+// NewScheduleOptions returns the schedule options of a check instance, from the values of
+// the `check_timeout` and `start_jitter` (in seconds) and `schedule` instance fields.
+func NewScheduleOptions(timeout, startJitter float64, schedule string) (ScheduleOptions, error) {
+	var opts ScheduleOptions
+	if timeout < 0 {
+		return opts, fmt.Errorf("invalid check_timeout %v: must be positive", timeout)
+	}
+	if startJitter < 0 {
+		return opts, fmt.Errorf("invalid start_jitter %v: must be positive", startJitter)
+	}
+	opts.Timeout = time.Duration(timeout * float64(time.Second))
+	opts.StartJitter = time.Duration(startJitter * float64(time.Second))
+	if schedule != "" {
+		s, err := ParseCronSchedule(schedule)
+		if err != nil {
+			return opts, err
+		}
+		opts.Schedule = s
+	}
+	return opts, nil
+}
+
+// ScheduledCheck is implemented by checks supporting schedule options
+type ScheduledCheck interface {
+	// ScheduleOptions returns the schedule options of the check
+	ScheduleOptions() ScheduleOptions
+}
+
+// GetScheduleOptions returns the schedule options of the check, if it supports them
+func GetScheduleOptions(c Check) ScheduleOptions {
+	if sc, ok := c.(ScheduledCheck); ok {
+		return sc.ScheduleOptions()
+	}
+	return ScheduleOptions{}
+}
+
+// TimeoutError is the error reported for runs interrupted because they exceeded the
+// timeout of the check
+type TimeoutError struct {
+	Timeout time.Duration
+}
+
+// Error implements the error interface
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("check run timed out after %s", e.Timeout)
+}
@@ -6,6 +6,7 @@
 package check
 
 import (
+	"errors"
 	"sync"
 	"time"
 
@@ -51,6 +52,10 @@ type SenderStats struct {
 	HistogramBuckets int64
 	// EventPlatformEvents tracks the number of events submitted for each eventType
 	EventPlatformEvents map[string]int64
+	// Samples holds the samples submitted by the check, when they're recorded for its execution history
+	Samples []SenderSample
+	// SamplesDropped is the number of samples that weren't recorded because of the recording limit
+	SamplesDropped int64
 }
 
 // NewSenderStats creates a new SenderStats
@@ -67,6 +72,9 @@ func (s SenderStats) Copy() (result SenderStats) {
 	for k, v := range s.EventPlatformEvents {
 		result.EventPlatformEvents[k] = v
 	}
+	if s.Samples != nil {
+		result.Samples = append([]SenderSample(nil), s.Samples...)
+	}
 	return result
 }
 
@@ -79,6 +87,7 @@ type Stats struct {
 	TotalRuns                uint64
 	TotalErrors              uint64
 	TotalWarnings            uint64
+	TotalTimeouts            uint64
 	MetricSamples            int64
 	Events                   int64
 	ServiceChecks            int64
@@ -94,11 +103,14 @@ type Stats struct {
 	LastError                string    // error that occurred in the last run, if any
 	LastWarnings             []string  // warnings that occurred in the last run, if any
 	UpdateTimestamp          int64     // latest update to this instance, unix timestamp in seconds
+	Timeout                  int64     // maximum run duration in milliseconds, 0 if none
+	Schedule                 string    // cron schedule of the check, if any
 	m                        sync.Mutex
-	telemetry                bool // do we want telemetry on this Check
+	telemetry                bool     // do we want telemetry on this Check
+	history                  *History // results of the most recent runs
 }
 
-// NewStats returns a new check stats instance
+// NewStats returns a new check stats instance, which doesn't keep the history of the runs
 func NewStats(c Check) *Stats {
 	stats := Stats{
 		CheckID:                  c.ID(),
@@ -110,6 +122,12 @@ func NewStats(c Check) *Stats {
 		TotalEventPlatformEvents: make(map[string]int64),
 	}
 
+	opts := GetScheduleOptions(c)
+	stats.Timeout = opts.Timeout.Nanoseconds() / 1e6
+	if opts.Schedule != nil {
+		stats.Schedule = opts.Schedule.String()
+	}
+
 	// We are interested in a check's run state values even when they are 0 so we
 	// initialize them here explicitly
 	if stats.telemetry && telemetry_utils.IsEnabled() {
@@ -120,11 +138,21 @@ func NewStats(c Check) *Stats {
 	return &stats
 }
 
+// NewStatsWithHistory returns a new check stats instance keeping the results of the last
+// historySize runs
+func NewStatsWithHistory(c Check, historySize int) *Stats {
+	stats := NewStats(c)
+	stats.history = NewHistory(historySize)
+	return stats
+}
+
 // Add tracks a new execution time
 func (cs *Stats) Add(t time.Duration, err error, warnings []error, metricStats SenderStats) {
 	cs.m.Lock()
 	defer cs.m.Unlock()
 
+	cs.addHistory(t, err, warnings, metricStats)
+
 	// store execution times in Milliseconds
 	tms := t.Nanoseconds() / 1e6
 	cs.LastExecutionTime = tms
@@ -148,6 +176,10 @@ func (cs *Stats) Add(t time.Duration, err error, warnings []error, metricStats S
 			tlmRuns.Inc(cs.CheckName, runCheckFailureTag)
 		}
 		cs.LastError = err.Error()
+		var timeoutErr *TimeoutError
+		if errors.As(err, &timeoutErr) {
+			cs.TotalTimeouts++
+		}
 	} else {
 		if cs.telemetry {
 			tlmRuns.Inc(cs.CheckName, runCheckSuccessTag)
@@ -198,6 +230,45 @@ func (cs *Stats) Add(t time.Duration, err error, warnings []error, metricStats S
 	}
 }
 
+// addHistory records the results of a run in the history of the check
+func (cs *Stats) addHistory(t time.Duration, err error, warnings []error, metricStats SenderStats) {
+	if cs.history == nil {
+		return
+	}
+	now := time.Now()
+	result := RunResult{
+		StartTime:        now.Add(-t).UnixNano() / int64(time.Millisecond),
+		Duration:         t.Nanoseconds() / 1e6,
+		MetricSamples:    metricStats.MetricSamples,
+		HistogramBuckets: metricStats.HistogramBuckets,
+		Events:           metricStats.Events,
+		ServiceChecks:    metricStats.ServiceChecks,
+		Samples:          metricStats.Samples,
+		SamplesDropped:   metricStats.SamplesDropped,
+	}
+	if err != nil {
+		result.Error = err.Error()
+		var timeoutErr *TimeoutError
+		result.TimedOut = errors.As(err, &timeoutErr)
+	}
+	for _, w := range warnings {
+		result.Warnings = append(result.Warnings, w.Error())
+	}
+	cs.history.Add(result)
+}
+
+// History returns the results of the most recent runs of the check, from the oldest to the
+// most recent
+func (cs *Stats) History() []RunResult {
+	cs.m.Lock()
+	defer cs.m.Unlock()
+
+	if cs.history == nil {
+		return nil
+	}
+	return cs.history.Results()
+}
+
 type aggStats struct {
 	EventPlatformEvents       map[string]interface{}
 	EventPlatformEventsErrors map[string]interface{}
@@ -6,9 +6,12 @@
 package check
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -40,6 +43,14 @@ func newMockCheck() Check {
 	}
 }
 
+// Mock Check implementation with schedule options
+type mockScheduledCheck struct {
+	mockCheck
+	opts ScheduleOptions
+}
+
+func (mc *mockScheduledCheck) ScheduleOptions() ScheduleOptions { return mc.opts }
+
 func getTelemetryData() (string, error) {
 	req, err := http.NewRequest("GET", "/", nil)
 	if err != nil {
@@ -104,6 +115,58 @@ func TestNewStatsStateTelemetryInitializedWhenGloballyEnabled(t *testing.T) {
 	)
 }
 
+func TestStatsScheduleOptions(t *testing.T) {
+	opts, err := NewScheduleOptions(2.5, 0, "0 2 * * *")
+	assert.NoError(t, err)
+	c := &mockScheduledCheck{mockCheck: *newMockCheck().(*mockCheck), opts: opts}
+
+	stats := NewStats(c)
+	assert.Equal(t, int64(2500), stats.Timeout)
+	assert.Equal(t, "0 2 * * *", stats.Schedule)
+
+	stats.Add(time.Second, nil, nil, SenderStats{})
+	stats.Add(time.Second, errors.New("failure"), nil, SenderStats{})
+	stats.Add(opts.Timeout, fmt.Errorf("wrapped: %w", &TimeoutError{Timeout: opts.Timeout}), nil, SenderStats{})
+	assert.Equal(t, uint64(3), stats.TotalRuns)
+	assert.Equal(t, uint64(2), stats.TotalErrors)
+	assert.Equal(t, uint64(1), stats.TotalTimeouts)
+	assert.Equal(t, "wrapped: check run timed out after 2.5s", stats.LastError)
+
+	stats = NewStats(newMockCheck())
+	assert.Zero(t, stats.Timeout)
+	assert.Empty(t, stats.Schedule)
+}
+
+func TestStatsHistory(t *testing.T) {
+	stats := NewStats(newMockCheck())
+	stats.Add(time.Millisecond, nil, nil, SenderStats{})
+	assert.Empty(t, stats.History())
+
+	stats = NewStatsWithHistory(newMockCheck(), 10)
+	assert.Empty(t, stats.History())
+
+	samples := []SenderSample{{Kind: SampleKindMetric, Name: "foo", Type: "Gauge", Value: 1}}
+	stats.Add(10*time.Millisecond, nil, []error{errors.New("warning")}, SenderStats{MetricSamples: 1, Samples: samples, SamplesDropped: 3})
+	stats.Add(time.Second, &TimeoutError{Timeout: time.Second}, nil, SenderStats{})
+
+	history := stats.History()
+	assert.Len(t, history, 2)
+	assert.Equal(t, int64(10), history[0].Duration)
+	assert.Equal(t, int64(1), history[0].MetricSamples)
+	assert.Equal(t, []string{"warning"}, history[0].Warnings)
+	assert.Equal(t, samples, history[0].Samples)
+	assert.Equal(t, int64(3), history[0].SamplesDropped)
+	assert.Empty(t, history[0].Error)
+	assert.False(t, history[0].TimedOut)
+	assert.Equal(t, "check run timed out after 1s", history[1].Error)
+	assert.True(t, history[1].TimedOut)
+
+	for i := 0; i < 20; i++ {
+		stats.Add(time.Millisecond, nil, nil, SenderStats{})
+	}
+	assert.Len(t, stats.History(), 10)
+}
+
 func TestTranslateEventPlatformEventTypes(t *testing.T) {
 	original := map[string]interface{}{
 		"EventPlatformEvents": map[string]interface{}{
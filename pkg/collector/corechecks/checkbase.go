@@ -42,6 +42,7 @@ type CheckBase struct {
 	checkID        check.ID
 	latestWarnings []error
 	checkInterval  time.Duration
+	scheduleOpts   check.ScheduleOptions
 	source         string
 	telemetry      bool
 }
@@ -118,6 +119,13 @@ func (c *CheckBase) CommonConfigure(instance integration.Data, source string) er
 		c.checkInterval = time.Duration(commonOptions.MinCollectionInterval) * time.Second
 	}
 
+	// Set the timeout, start jitter and cron schedule of the check
+	c.scheduleOpts, err = check.NewScheduleOptions(commonOptions.CheckTimeout, commonOptions.StartJitter, commonOptions.Schedule)
+	if err != nil {
+		log.Errorf("invalid instance section for check %s: %s", string(c.ID()), err)
+		return err
+	}
+
 	// Disable default hostname if specified
 	if commonOptions.EmptyDefaultHostname {
 		s, err := aggregator.GetSender(c.checkID)
@@ -192,6 +200,11 @@ func (c *CheckBase) Interval() time.Duration {
 	return c.checkInterval
 }
 
+// ScheduleOptions returns the timeout, start jitter and cron schedule of the check.
+func (c *CheckBase) ScheduleOptions() check.ScheduleOptions {
+	return c.scheduleOpts
+}
+
 // String returns the name of the check, the same for every instance
 func (c *CheckBase) String() string {
 	return c.checkName
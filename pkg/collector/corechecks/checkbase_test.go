@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/DataDog/datadog-agent/pkg/aggregator/mocksender"
+	"github.com/DataDog/datadog-agent/pkg/collector/check"
 	"github.com/DataDog/datadog-agent/pkg/collector/check/defaults"
 )
 
@@ -68,3 +69,30 @@ func TestCommonConfigureCustomID(t *testing.T) {
 	assert.Equal(t, string(mycheck.ID()), "test:foobar:bd63a7031add5db9")
 	mockSender.AssertExpectations(t)
 }
+
+func TestCommonConfigureScheduleOptions(t *testing.T) {
+	mycheck := &dummyCheck{
+		CheckBase: NewCheckBase("test"),
+	}
+	mocksender.NewMockSender(mycheck.ID())
+
+	err := mycheck.CommonConfigure([]byte(defaultsInstance), "test")
+	assert.NoError(t, err)
+	assert.Equal(t, check.ScheduleOptions{}, mycheck.ScheduleOptions())
+
+	err = mycheck.CommonConfigure([]byte(`
+check_timeout: 1.5
+start_jitter: 30
+schedule: "0 2 * * *"
+`), "test")
+	assert.NoError(t, err)
+	opts := mycheck.ScheduleOptions()
+	assert.Equal(t, 1500*time.Millisecond, opts.Timeout)
+	assert.Equal(t, 30*time.Second, opts.StartJitter)
+	assert.Equal(t, "0 2 * * *", opts.Schedule.String())
+
+	err = mycheck.CommonConfigure([]byte(`schedule: "0 25 * * *"`), "test")
+	assert.Error(t, err)
+	err = mycheck.CommonConfigure([]byte(`check_timeout: -1`), "test")
+	assert.Error(t, err)
+}
@@ -43,6 +43,7 @@ type PythonCheck struct {
 	class        *C.rtloader_pyobject_t
 	ModuleName   string
 	interval     time.Duration
+	scheduleOpts check.ScheduleOptions
 	lastWarnings []error
 	source       string
 	telemetry    bool // whether or not the telemetry is enabled for this check
@@ -226,6 +227,14 @@ func (c *PythonCheck) Configure(data integration.Data, initConfig integration.Da
 		c.interval = time.Duration(commonOptions.MinCollectionInterval) * time.Second
 	}
 
+	// Set the timeout, start jitter and cron schedule of the check
+	scheduleOpts, err := check.NewScheduleOptions(commonOptions.CheckTimeout, commonOptions.StartJitter, commonOptions.Schedule)
+	if err != nil {
+		log.Errorf("invalid instance section for check %s: %s", string(c.id), err)
+		return err
+	}
+	c.scheduleOpts = scheduleOpts
+
 	// Disable default hostname if specified
 	if commonOptions.EmptyDefaultHostname {
 		s, err := aggregator.GetSender(c.id)
@@ -310,6 +319,11 @@ func (c *PythonCheck) Interval() time.Duration {
 	return c.interval
 }
 
+// ScheduleOptions returns the timeout, start jitter and cron schedule of the check
+func (c *PythonCheck) ScheduleOptions() check.ScheduleOptions {
+	return c.scheduleOpts
+}
+
 // ID returns the ID of the check
 func (c *PythonCheck) ID() check.ID {
 	return c.id
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/DataDog/datadog-agent/pkg/collector/check"
+	"github.com/DataDog/datadog-agent/pkg/config"
 	"github.com/DataDog/datadog-agent/pkg/util/log"
 )
 
@@ -25,6 +26,10 @@ const (
 	runsExpvarKey          = "Runs"
 	runningExpvarKey       = "Running"
 	warningsExpvarKey      = "Warnings"
+
+	// defaultCheckHistorySize is the number of runs kept per check instance when
+	// check_history.size is invalid
+	defaultCheckHistorySize = 10
 )
 
 var (
@@ -135,13 +140,23 @@ func AddCheckStats(
 
 	s, found = stats[c.ID()]
 	if !found {
-		s = check.NewStats(c)
+		s = check.NewStatsWithHistory(c, checkHistorySize())
 		stats[c.ID()] = s
 	}
 
 	s.Add(execTime, err, warnings, mStats)
 }
 
+// checkHistorySize returns the number of runs kept in the history of each check instance
+func checkHistorySize() int {
+	size := config.Datadog.GetInt("check_history.size")
+	if size < 0 {
+		log.Warnf("Invalid check_history.size %d, using %d instead", size, defaultCheckHistorySize)
+		return defaultCheckHistorySize
+	}
+	return size
+}
+
 // RemoveCheckStats removes a check from the check stats map
 func RemoveCheckStats(checkID check.ID) {
 	checkStats.statsLock.Lock()
@@ -184,6 +199,29 @@ func CheckStats(id check.ID) (*check.Stats, bool) {
 	return check, true
 }
 
+// GetCheckHistory returns the results of the most recent runs of the instances of a check,
+// given either the check name or the ID of one of its instances
+func GetCheckHistory(name string) map[check.ID][]check.RunResult {
+	checkStats.statsLock.RLock()
+	defer checkStats.statsLock.RUnlock()
+
+	history := make(map[check.ID][]check.RunResult)
+	if stats, found := checkStats.stats[name]; found {
+		for id, s := range stats {
+			history[id] = s.History()
+		}
+		return history
+	}
+
+	id := check.ID(name)
+	if stats, found := checkStats.stats[check.IDToCheckName(id)]; found {
+		if s, found := stats[id]; found {
+			history[id] = s.History()
+		}
+	}
+	return history
+}
+
 // Functions relating to running checks state map (`runningChecksStats`)
 
 // SetRunningStats sets the start time of a running check
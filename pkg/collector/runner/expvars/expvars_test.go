@@ -17,6 +17,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/DataDog/datadog-agent/pkg/collector/check"
+	"github.com/DataDog/datadog-agent/pkg/config"
 )
 
 // Helper methods
@@ -334,6 +335,43 @@ func TestExpvarsGetChecksStatsClone(t *testing.T) {
 	assert.Equal(t, numCheckInstances, len(getCheckStatsExpvarMap(t)["testcheck1"]))
 }
 
+func TestExpvarsCheckHistory(t *testing.T) {
+	setUp()
+
+	for _, id := range []string{"testcheck:1", "testcheck:2", "othercheck:1"} {
+		AddCheckStats(newTestCheck(id), 12345, nil, []error{}, check.SenderStats{MetricSamples: 2})
+	}
+	AddCheckStats(newTestCheck("testcheck:1"), 12345, fmt.Errorf("error"), []error{}, check.SenderStats{})
+
+	history := GetCheckHistory("testcheck")
+	require.Len(t, history, 2)
+	require.Len(t, history["testcheck:1"], 2)
+	assert.Equal(t, int64(2), history["testcheck:1"][0].MetricSamples)
+	assert.Equal(t, "", history["testcheck:1"][0].Error)
+	assert.Equal(t, "error", history["testcheck:1"][1].Error)
+	assert.Len(t, history["testcheck:2"], 1)
+
+	history = GetCheckHistory("testcheck:2")
+	require.Len(t, history, 1)
+	assert.Len(t, history["testcheck:2"], 1)
+
+	assert.Empty(t, GetCheckHistory("testcheck:3"))
+	assert.Empty(t, GetCheckHistory("unknown"))
+}
+
+func TestCheckHistorySize(t *testing.T) {
+	defer config.Datadog.Set("check_history.size", config.Datadog.GetInt("check_history.size"))
+
+	config.Datadog.Set("check_history.size", 3)
+	assert.Equal(t, 3, checkHistorySize())
+
+	config.Datadog.Set("check_history.size", 0)
+	assert.Equal(t, 0, checkHistorySize())
+
+	config.Datadog.Set("check_history.size", -1)
+	assert.Equal(t, defaultCheckHistorySize, checkHistorySize())
+}
+
 func TestExpvarsRunningStats(t *testing.T) {
 	loc, err := time.LoadLocation("America/New_York")
 	require.Nil(t, err)
@@ -0,0 +1,93 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package scheduler
+
+import (
+	"sync"
+	"time"
+
+	"github.com/DataDog/datadog-agent/pkg/collector/check"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+// cronJob posts a check to the execution pipeline according to its cron schedule.
+// Every cron job runs in its own goroutine.
+type cronJob struct {
+	check    check.Check
+	schedule *check.CronSchedule
+	jitter   time.Duration
+	done     chan struct{} // closed to stop the job
+	stopOnce sync.Once
+
+	mu      sync.RWMutex // to protect nextRun
+	nextRun time.Time
+}
+
+func newCronJob(c check.Check, schedule *check.CronSchedule, jitter time.Duration) *cronJob {
+	return &cronJob{
+		check:    c,
+		schedule: schedule,
+		jitter:   jitter,
+		done:     make(chan struct{}),
+	}
+}
+
+// run posts the check to the pipe at every activation of the schedule, delayed by a random
+// jitter, until the job is stopped.
+// Not blocking, runs in a new goroutine.
+func (j *cronJob) run(checksPipe chan<- check.Check) {
+	go func() {
+		for {
+			next := j.schedule.Next(time.Now())
+			if next.IsZero() {
+				log.Warnf("The cron schedule %q of check %v never fires, the check won't run", j.schedule, j.check)
+				return
+			}
+			next = next.Add(randomDuration(j.jitter))
+			j.setNextRun(next)
+
+			timer := time.NewTimer(time.Until(next))
+			select {
+			case <-timer.C:
+			case <-j.done:
+				timer.Stop()
+				return
+			}
+
+			log.Debugf("Cron schedule %q of check %v fired", j.schedule, j.check)
+			select {
+			// blocking, we'll be here as long as it takes
+			case checksPipe <- j.check:
+			case <-j.done:
+				return
+			}
+		}
+	}()
+}
+
+// stop stops the job. It doesn't block.
+func (j *cronJob) stop() {
+	j.stopOnce.Do(func() {
+		close(j.done)
+	})
+}
+
+func (j *cronJob) setNextRun(t time.Time) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.nextRun = t
+}
+
+func (j *cronJob) stats() map[string]interface{} {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+
+	return map[string]interface{}{
+		"CheckID":  j.check.ID(),
+		"Schedule": j.schedule.String(),
+		"NextRun":  j.nextRun.Unix(),
+	}
+}
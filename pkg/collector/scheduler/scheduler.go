@@ -8,6 +8,7 @@ package scheduler
 import (
 	"expvar"
 	"fmt"
+	"math/rand"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -48,8 +49,10 @@ type Scheduler struct {
 	tlmTrackedChecks map[check.ID]string         // Keep track of the checks that are tracked with telemetry
 	mu               sync.Mutex                  // To protect critical sections in struct's fields
 
-	checkToQueue map[check.ID]*jobQueue // Keep track of what is the queue for any Check
-	// To protect checkToQueue. Using mu would create a deadlock when stopping the Scheduler. 'jobQueue' is calling
+	checkToQueue  map[check.ID]*jobQueue   // Keep track of what is the queue for any Check
+	delayedChecks map[check.ID]*time.Timer // Checks waiting for their start jitter before entering their queue
+	cronJobs      map[check.ID]*cronJob    // Checks scheduled according to a cron schedule
+	// To protect checkToQueue, delayedChecks and cronJobs. Using mu would create a deadlock when stopping the Scheduler. 'jobQueue' is calling
 	// 'IsCheckScheduled' right when then 'Stop' function is called and mu is already lock. for this reason we have
 	// to lock: one for the Scheduler and a dedicated one for the 'IsCheckScheduled' method. This way 'jobQueue' and
 	// metadata provider can call 'IsCheckScheduled' without creating a deadlock.
@@ -68,6 +71,8 @@ func NewScheduler(checksPipe chan<- check.Check) *Scheduler {
 		started:          make(chan bool),
 		jobQueues:        make(map[time.Duration]*jobQueue),
 		checkToQueue:     make(map[check.ID]*jobQueue),
+		delayedChecks:    make(map[check.ID]*time.Timer),
+		cronJobs:         make(map[check.ID]*cronJob),
 		tlmTrackedChecks: make(map[check.ID]string),
 		running:          0,
 		cancelOneTime:    make(chan bool),
@@ -77,46 +82,125 @@ func NewScheduler(checksPipe chan<- check.Check) *Scheduler {
 
 // Enter schedules a `Check`s for execution accordingly to the `Check.Interval()` value.
 // If the interval is 0, the check is supposed to run only once.
-func (s *Scheduler) Enter(check check.Check) error {
+// Checks with a cron schedule run according to it instead, and checks with a start
+// jitter are entered in their queue after a random delay.
+func (s *Scheduler) Enter(c check.Check) error {
 	// enqueue immediately if this is a one-time schedule
-	if check.Interval() == 0 {
-		s.enqueueOnce(check)
+	if c.Interval() == 0 {
+		s.enqueueOnce(c)
 		return nil
 	}
 
-	if check.Interval() < minAllowedInterval {
-		return fmt.Errorf("Schedule interval must be greater than %v or 0", minAllowedInterval)
+	opts := check.GetScheduleOptions(c)
+	if opts.Schedule != nil {
+		s.enterCron(c, opts)
+		return nil
 	}
 
-	log.Infof("Scheduling check %v with an interval of %v", check, check.Interval())
+	if c.Interval() < minAllowedInterval {
+		return fmt.Errorf("Schedule interval must be greater than %v or 0", minAllowedInterval)
+	}
 
 	// sync when accessing `jobQueues` and `check2queue`
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	if _, ok := s.jobQueues[check.Interval()]; !ok {
-		s.jobQueues[check.Interval()] = newJobQueue(check.Interval())
-		s.startQueue(s.jobQueues[check.Interval()])
-		if check.IsTelemetryEnabled() {
+	if opts.StartJitter > 0 {
+		s.enterDelayed(c, randomDuration(opts.StartJitter))
+	} else {
+		log.Infof("Scheduling check %v with an interval of %v", c, c.Interval())
+		s.enterQueue(c)
+	}
+
+	s.trackCheck(c)
+	return nil
+}
+
+// enterQueue adds the check to the queue of its interval, creating it if needed.
+// s.mu must be held.
+func (s *Scheduler) enterQueue(c check.Check) {
+	if _, ok := s.jobQueues[c.Interval()]; !ok {
+		s.jobQueues[c.Interval()] = newJobQueue(c.Interval())
+		s.startQueue(s.jobQueues[c.Interval()])
+		if c.IsTelemetryEnabled() {
 			tlmQueuesCount.Inc()
 		}
 		schedulerQueuesCount.Add(1)
 	}
-	s.jobQueues[check.Interval()].addJob(check)
+	s.jobQueues[c.Interval()].addJob(c)
 
 	// map each check to the Job Queue it was assigned to
 	s.checkToQueueMutex.Lock()
-	s.checkToQueue[check.ID()] = s.jobQueues[check.Interval()]
+	s.checkToQueue[c.ID()] = s.jobQueues[c.Interval()]
+	s.checkToQueueMutex.Unlock()
+
+	schedulerExpvars.Set("Queues", expvar.Func(expQueues(s)))
+}
+
+// enterDelayed adds the check to the queue of its interval after the given delay.
+// s.mu must be held.
+func (s *Scheduler) enterDelayed(c check.Check, delay time.Duration) {
+	log.Infof("Scheduling check %v with an interval of %v, after a start jitter of %v", c, c.Interval(), delay)
+
+	s.checkToQueueMutex.Lock()
+	defer s.checkToQueueMutex.Unlock()
+
+	var timer *time.Timer
+	timer = time.AfterFunc(delay, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.checkToQueueMutex.Lock()
+		if s.delayedChecks[c.ID()] != timer {
+			// the check was unscheduled in the meantime
+			s.checkToQueueMutex.Unlock()
+			return
+		}
+		delete(s.delayedChecks, c.ID())
+		s.checkToQueueMutex.Unlock()
+
+		s.enterQueue(c)
+	})
+	s.delayedChecks[c.ID()] = timer
+}
+
+// enterCron schedules the check according to its cron schedule
+func (s *Scheduler) enterCron(c check.Check, opts check.ScheduleOptions) {
+	log.Infof("Scheduling check %v with the cron schedule %q", c, opts.Schedule)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	job := newCronJob(c, opts.Schedule, opts.StartJitter)
+	s.checkToQueueMutex.Lock()
+	if previous, ok := s.cronJobs[c.ID()]; ok {
+		previous.stop()
+	}
+	s.cronJobs[c.ID()] = job
 	s.checkToQueueMutex.Unlock()
+	job.run(s.checksPipe)
+
+	s.trackCheck(c)
+	schedulerExpvars.Set("CronJobs", expvar.Func(expCronJobs(s)))
+}
 
+// trackCheck updates the telemetry for a newly scheduled check. s.mu must be held.
+func (s *Scheduler) trackCheck(c check.Check) {
 	schedulerChecksEntered.Add(1)
-	if check.IsTelemetryEnabled() {
-		checkName := check.String()
-		s.tlmTrackedChecks[check.ID()] = checkName
+	if c.IsTelemetryEnabled() {
+		checkName := c.String()
+		s.tlmTrackedChecks[c.ID()] = checkName
 		tlmChecksEntered.Inc(checkName)
 	}
-	schedulerExpvars.Set("Queues", expvar.Func(expQueues(s)))
-	return nil
+}
+
+// untrackCheck updates the telemetry for an unscheduled check. s.mu must be held.
+func (s *Scheduler) untrackCheck(id check.ID) {
+	schedulerChecksEntered.Add(-1)
+	if checkName, ok := s.tlmTrackedChecks[id]; ok {
+		delete(s.tlmTrackedChecks, id)
+		tlmChecksEntered.Dec(checkName)
+	}
 }
 
 // Cancel remove a Check from the scheduled queue. If the check is not
@@ -129,6 +213,21 @@ func (s *Scheduler) Cancel(id check.ID) error {
 
 	log.Infof("Unscheduling check %s", string(id))
 
+	if timer, ok := s.delayedChecks[id]; ok {
+		timer.Stop()
+		delete(s.delayedChecks, id)
+		s.untrackCheck(id)
+		return nil
+	}
+
+	if job, ok := s.cronJobs[id]; ok {
+		job.stop()
+		delete(s.cronJobs, id)
+		s.untrackCheck(id)
+		schedulerExpvars.Set("CronJobs", expvar.Func(expCronJobs(s)))
+		return nil
+	}
+
 	if _, ok := s.checkToQueue[id]; !ok {
 		return nil
 	}
@@ -140,11 +239,7 @@ func (s *Scheduler) Cancel(id check.ID) error {
 	}
 	delete(s.checkToQueue, id)
 
-	schedulerChecksEntered.Add(-1)
-	if checkName, ok := s.tlmTrackedChecks[id]; ok {
-		delete(s.tlmTrackedChecks, id)
-		tlmChecksEntered.Dec(checkName)
-	}
+	s.untrackCheck(id)
 	schedulerExpvars.Set("Queues", expvar.Func(expQueues(s)))
 	return nil
 }
@@ -214,16 +309,33 @@ func (s *Scheduler) IsCheckScheduled(id check.ID) bool {
 	s.checkToQueueMutex.RLock()
 	defer s.checkToQueueMutex.RUnlock()
 
-	_, found := s.checkToQueue[id]
+	if _, found := s.checkToQueue[id]; found {
+		return true
+	}
+	if _, found := s.delayedChecks[id]; found {
+		return true
+	}
+	_, found := s.cronJobs[id]
 	return found
 }
 
-// stopQueues shuts down the timers for each active queue
+// stopQueues shuts down the timers for each active queue, the cron jobs and
+// the checks waiting for their start jitter.
 // Blocks until all the queues have fully stopped
 func (s *Scheduler) stopQueues() {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	s.checkToQueueMutex.Lock()
+	for id, timer := range s.delayedChecks {
+		timer.Stop()
+		delete(s.delayedChecks, id)
+	}
+	for _, job := range s.cronJobs {
+		job.stop()
+	}
+	s.checkToQueueMutex.Unlock()
+
 	log.Debugf("Stopping %v queue(s)", len(s.jobQueues))
 	for _, q := range s.jobQueues {
 		// check that the queue is actually running or this blocks
@@ -256,6 +368,14 @@ func (s *Scheduler) startQueue(q *jobQueue) {
 	}
 }
 
+// randomDuration returns a random duration between 0 and max
+func randomDuration(max time.Duration) time.Duration {
+	if max <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(max)))
+}
+
 // enqueueOnce enqueues a check once to the checksPipe.
 // Do not block, in case the runner has not started yet.
 // The queuing can be cancelled by closing the `cancelOneTime` channel.
@@ -274,6 +394,20 @@ func (s *Scheduler) enqueueOnce(check check.Check) {
 	schedulerChecksEntered.Add(1)
 }
 
+// expCronJobs return a function to get the stats for the cron jobs
+func expCronJobs(s *Scheduler) func() interface{} {
+	return func() interface{} {
+		s.checkToQueueMutex.RLock()
+		defer s.checkToQueueMutex.RUnlock()
+
+		jobs := make([]map[string]interface{}, 0, len(s.cronJobs))
+		for _, job := range s.cronJobs {
+			jobs = append(jobs, job.stats())
+		}
+		return jobs
+	}
+}
+
 // expQueues return a function to get the stats for the queues
 func expQueues(s *Scheduler) func() interface{} {
 	return func() interface{} {
@@ -196,3 +196,93 @@ func TestStopOneTimeSchedule(t *testing.T) {
 	// sleep to make the runtime schedule the hanging goroutines, if there are any
 	time.Sleep(time.Millisecond)
 }
+
+type TestScheduledCheck struct {
+	TestCheck
+	id   check.ID
+	opts check.ScheduleOptions
+}
+
+func (c *TestScheduledCheck) ID() check.ID                           { return c.id }
+func (c *TestScheduledCheck) ScheduleOptions() check.ScheduleOptions { return c.opts }
+
+func TestEnterStartJitter(t *testing.T) {
+	ch := make(chan check.Check)
+	stop := make(chan bool)
+	go consume(ch, stop)
+	defer func() { stop <- true }()
+
+	s := NewScheduler(ch)
+	defer s.Stop()
+	s.Run()
+
+	c := &TestScheduledCheck{
+		TestCheck: TestCheck{intl: time.Second},
+		id:        "jittered",
+		opts:      check.ScheduleOptions{StartJitter: 50 * time.Millisecond},
+	}
+	assert.Nil(t, s.Enter(c))
+	assert.True(t, s.IsCheckScheduled(c.ID()))
+
+	// the check enters its queue once its start jitter elapsed
+	assert.Eventually(t, func() bool {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		q, ok := s.jobQueues[time.Second]
+		return ok && q.buckets[0].size() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.True(t, s.IsCheckScheduled(c.ID()))
+
+	// a check cancelled during its start jitter never enters its queue
+	c = &TestScheduledCheck{
+		TestCheck: TestCheck{intl: 2 * time.Second},
+		id:        "cancelled",
+		opts:      check.ScheduleOptions{StartJitter: time.Hour},
+	}
+	assert.Nil(t, s.Enter(c))
+	assert.True(t, s.IsCheckScheduled(c.ID()))
+	assert.Nil(t, s.Cancel(c.ID()))
+	assert.False(t, s.IsCheckScheduled(c.ID()))
+	assert.Len(t, s.delayedChecks, 0)
+	assert.NotContains(t, s.jobQueues, 2*time.Second)
+}
+
+func TestEnterCron(t *testing.T) {
+	ch := make(chan check.Check)
+	stop := make(chan bool)
+	go consume(ch, stop)
+	defer func() { stop <- true }()
+
+	s := NewScheduler(ch)
+	defer s.Stop()
+	s.Run()
+
+	opts, err := check.NewScheduleOptions(0, 0, "0 2 * * *")
+	assert.Nil(t, err)
+	c := &TestScheduledCheck{
+		// the interval is ignored, and isn't subject to minAllowedInterval
+		TestCheck: TestCheck{intl: time.Millisecond},
+		id:        "cron",
+		opts:      opts,
+	}
+	assert.Nil(t, s.Enter(c))
+	assert.True(t, s.IsCheckScheduled(c.ID()))
+	assert.Len(t, s.jobQueues, 0)
+	assert.Len(t, s.cronJobs, 1)
+
+	job := s.cronJobs[c.ID()]
+	expected := opts.Schedule.Next(time.Now()).Unix()
+	assert.Eventually(t, func() bool {
+		return job.stats()["NextRun"] == expected
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, "0 2 * * *", job.stats()["Schedule"])
+
+	assert.Nil(t, s.Cancel(c.ID()))
+	assert.False(t, s.IsCheckScheduled(c.ID()))
+	assert.Len(t, s.cronJobs, 0)
+	select {
+	case <-job.done:
+	default:
+		assert.Fail(t, "the cron job wasn't stopped")
+	}
+}
@@ -0,0 +1,360 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// +build wasmchecks,go1.18
+
+package wasm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/DataDog/datadog-agent/pkg/aggregator"
+	"github.com/DataDog/datadog-agent/pkg/autodiscovery/integration"
+	"github.com/DataDog/datadog-agent/pkg/collector/corechecks"
+	"github.com/DataDog/datadog-agent/pkg/util"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+const (
+	// defaultMemoryLimit is the default maximum memory of a module, in MiB.
+	defaultMemoryLimit = 16
+	// maxMemoryLimit is the maximum memory a 32-bit module can address, in MiB.
+	maxMemoryLimit = 4096
+	// defaultCPUTimeLimit is the default maximum CPU time a check run can consume.
+	defaultCPUTimeLimit = 10 * time.Second
+	// defaultTimeout is the default maximum wall-clock duration of a check run.
+	defaultTimeout = 30 * time.Second
+	// maxCPUTimePollInterval is the maximum interval between two checks of the CPU time of a run.
+	maxCPUTimePollInterval = 100 * time.Millisecond
+	// wasmPageSize is the size of a WebAssembly memory page.
+	wasmPageSize = 64 * 1024
+	// runFunction is the name of the function exported by modules to run the check.
+	runFunction = "run"
+)
+
+// instanceOptions holds the instance options specific to WASM checks.
+type instanceOptions struct {
+	// MemoryLimit specifies the maximum memory the module can use, in MiB.
+	MemoryLimit *int `yaml:"wasm_memory_limit"`
+	// CPUTimeLimit specifies the maximum CPU time a run can consume, in seconds.
+	CPUTimeLimit *float64 `yaml:"wasm_cpu_time_limit"`
+	// Timeout specifies the maximum wall-clock duration of a run, in seconds. It interrupts the
+	// runs that wait without consuming CPU time, for instance in a WASI sleep.
+	Timeout *float64 `yaml:"wasm_timeout"`
+}
+
+// cpuClock returns the CPU time consumed so far.
+type cpuClock func() (time.Duration, error)
+
+// WASMCheck runs a check compiled to a WebAssembly module. The module runs sandboxed: it
+// has no access to the filesystem, network or environment, and interacts with the agent
+// only through the host API exposed by the "datadog" module (see host.go).
+type WASMCheck struct {
+	corechecks.CheckBase
+	modulePath   string
+	cache        wazero.CompilationCache
+	memoryLimit  uint32 // in pages
+	cpuTimeLimit time.Duration
+	timeout      time.Duration
+
+	instance   []byte // JSON
+	initConfig []byte // JSON
+
+	mu       sync.Mutex // guards the fields below
+	runtime  wazero.Runtime
+	compiled wazero.CompiledModule
+	module   api.Module
+	cancel   context.CancelFunc
+
+	// set by the module through the host API during a run, guarded by its own mutex as
+	// host functions may be called while mu is held
+	runErrorMu sync.Mutex
+	runError   string
+}
+
+func newWASMCheck(name, modulePath string, cache wazero.CompilationCache) *WASMCheck {
+	return &WASMCheck{
+		CheckBase:    corechecks.NewCheckBase(name),
+		modulePath:   modulePath,
+		cache:        cache,
+		memoryLimit:  defaultMemoryLimit * 1024 * 1024 / wasmPageSize,
+		cpuTimeLimit: defaultCPUTimeLimit,
+		timeout:      defaultTimeout,
+	}
+}
+
+// Configure configures the check, then compiles and instantiates its module.
+func (c *WASMCheck) Configure(data integration.Data, initConfig integration.Data, source string) error {
+	c.BuildID(data, initConfig)
+	if err := c.CheckBase.Configure(data, initConfig, source); err != nil {
+		return err
+	}
+
+	var opts instanceOptions
+	if err := yaml.Unmarshal(data, &opts); err != nil {
+		return err
+	}
+	if opts.MemoryLimit != nil {
+		if *opts.MemoryLimit <= 0 || *opts.MemoryLimit > maxMemoryLimit {
+			return fmt.Errorf("invalid wasm_memory_limit %d: must be between 1 and %d MiB", *opts.MemoryLimit, maxMemoryLimit)
+		}
+		c.memoryLimit = uint32(*opts.MemoryLimit * 1024 * 1024 / wasmPageSize)
+	}
+	if opts.CPUTimeLimit != nil {
+		if *opts.CPUTimeLimit <= 0 {
+			return fmt.Errorf("invalid wasm_cpu_time_limit %v: must be a positive number of seconds", *opts.CPUTimeLimit)
+		}
+		c.cpuTimeLimit = time.Duration(*opts.CPUTimeLimit * float64(time.Second))
+	}
+	if opts.Timeout != nil {
+		if *opts.Timeout <= 0 {
+			return fmt.Errorf("invalid wasm_timeout %v: must be a positive number of seconds", *opts.Timeout)
+		}
+		c.timeout = time.Duration(*opts.Timeout * float64(time.Second))
+	}
+
+	var err error
+	if c.instance, err = yamlToJSON(data); err != nil {
+		return fmt.Errorf("invalid instance: %s", err)
+	}
+	if c.initConfig, err = yamlToJSON(initConfig); err != nil {
+		return fmt.Errorf("invalid init_config: %s", err)
+	}
+
+	code, err := ioutil.ReadFile(c.modulePath)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ctx := context.Background()
+	c.runtime = wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfig().
+		WithMemoryLimitPages(c.memoryLimit).
+		WithCloseOnContextDone(true).
+		WithCompilationCache(c.cache))
+	if _, err := wasi_snapshot_preview1.Instantiate(ctx, c.runtime); err != nil {
+		c.closeLocked()
+		return err
+	}
+	if _, err := c.hostModule().Instantiate(ctx); err != nil {
+		c.closeLocked()
+		return err
+	}
+	if c.compiled, err = c.runtime.CompileModule(ctx, code); err != nil {
+		c.closeLocked()
+		return fmt.Errorf("unable to compile WASM module %s: %s", c.modulePath, err)
+	}
+	if _, ok := c.compiled.ExportedFunctions()[runFunction]; !ok {
+		c.closeLocked()
+		return fmt.Errorf("WASM module %s does not export a %q function", c.modulePath, runFunction)
+	}
+	if err := c.instantiateLocked(ctx); err != nil {
+		c.closeLocked()
+		return err
+	}
+	log.Debugf("wasm.loader: loaded module %s for check %s (memory limit: %d pages, cpu time limit: %s, timeout: %s)", c.modulePath, c.ID(), c.memoryLimit, c.cpuTimeLimit, c.timeout)
+	return nil
+}
+
+// instantiateLocked instantiates the compiled module. c.mu must be held.
+func (c *WASMCheck) instantiateLocked(ctx context.Context) error {
+	stdio := &logWriter{check: c}
+	mod, err := c.runtime.InstantiateModule(ctx, c.compiled, wazero.NewModuleConfig().
+		WithName(string(c.ID())).
+		WithStdout(stdio).
+		WithStderr(stdio).
+		// reactor modules initialize themselves in _initialize and are called through their exports
+		WithStartFunctions("_initialize"))
+	if err != nil {
+		return fmt.Errorf("unable to instantiate WASM module %s: %s", c.modulePath, err)
+	}
+	c.module = mod
+	return nil
+}
+
+// Run runs the check by calling the run function exported by the module. Runs exceeding the CPU
+// time limit or the timeout are interrupted, in which case the module is instantiated again on the
+// next run. The module runs on a locked OS thread whose CPU time is measured, or in wall-clock time
+// on the platforms where the CPU time of a thread can't be measured.
+func (c *WASMCheck) Run() error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	c.mu.Lock()
+	if c.runtime == nil {
+		c.mu.Unlock()
+		return errors.New("check has been cancelled")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	if c.module == nil {
+		if err := c.instantiateLocked(ctx); err != nil {
+			c.mu.Unlock()
+			return err
+		}
+	}
+	mod := c.module
+	c.cancel = cancel
+	c.mu.Unlock()
+	c.setRunError("")
+
+	clock, err := threadCPUClock()
+	if err != nil {
+		log.Debugf("wasm: the CPU time limit of check %s is applied to the wall-clock duration of its runs: %s", c.ID(), err)
+		start := time.Now()
+		clock = func() (time.Duration, error) { return time.Since(start), nil }
+	}
+	var cpuTimeExceeded int32
+	done := make(chan struct{})
+	go func() {
+		if c.watchCPUTime(clock, done) {
+			atomic.StoreInt32(&cpuTimeExceeded, 1)
+			cancel()
+		}
+	}()
+
+	results, err := mod.ExportedFunction(runFunction).Call(ctx)
+	close(done)
+
+	c.mu.Lock()
+	c.cancel = nil
+	if ctx.Err() != nil && c.module == mod {
+		// the module is closed when its context is done
+		c.module = nil
+	}
+	c.mu.Unlock()
+	runError := c.getRunError()
+
+	if s, serr := aggregator.GetSender(c.ID()); serr == nil {
+		// like Python checks, commit whatever was submitted before an eventual error
+		s.Commit()
+	}
+	switch {
+	case atomic.LoadInt32(&cpuTimeExceeded) == 1:
+		return fmt.Errorf("check run exceeded its CPU time limit of %s and was interrupted", c.cpuTimeLimit)
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		return fmt.Errorf("check run exceeded its timeout of %s and was interrupted", c.timeout)
+	case errors.Is(ctx.Err(), context.Canceled):
+		return errors.New("check run was stopped")
+	case err != nil:
+		return fmt.Errorf("check run failed: %s", err)
+	case len(results) > 0 && results[0] != 0:
+		if runError == "" {
+			runError = fmt.Sprintf("run returned status %d", int32(results[0]))
+		}
+		return errors.New(runError)
+	}
+	return nil
+}
+
+// watchCPUTime returns true once the CPU time measured by clock since it was called exceeds the
+// CPU time limit, or false when done is closed first.
+func (c *WASMCheck) watchCPUTime(clock cpuClock, done <-chan struct{}) bool {
+	start, err := clock()
+	if err != nil {
+		log.Warnf("wasm: unable to measure the CPU time of check %s: %s", c.ID(), err)
+		return false
+	}
+
+	interval := c.cpuTimeLimit / 10
+	if interval > maxCPUTimePollInterval {
+		interval = maxCPUTimePollInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return false
+		case <-ticker.C:
+			now, err := clock()
+			if err != nil {
+				log.Warnf("wasm: unable to measure the CPU time of check %s: %s", c.ID(), err)
+				return false
+			}
+			if now-start >= c.cpuTimeLimit {
+				return true
+			}
+		}
+	}
+}
+
+func (c *WASMCheck) setRunError(msg string) {
+	c.runErrorMu.Lock()
+	defer c.runErrorMu.Unlock()
+	c.runError = msg
+}
+
+func (c *WASMCheck) getRunError() string {
+	c.runErrorMu.Lock()
+	defer c.runErrorMu.Unlock()
+	return c.runError
+}
+
+// Stop interrupts the current run, if any.
+func (c *WASMCheck) Stop() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cancel != nil {
+		c.cancel()
+	}
+}
+
+// Cancel releases the resources of the module and deregisters the sender.
+func (c *WASMCheck) Cancel() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closeLocked()
+	c.CommonCancel()
+}
+
+// closeLocked closes the runtime of the check along with all its modules. c.mu must be held.
+func (c *WASMCheck) closeLocked() {
+	if c.runtime == nil {
+		return
+	}
+	if err := c.runtime.Close(context.Background()); err != nil {
+		log.Debugf("wasm: error closing runtime of check %s: %s", c.ID(), err)
+	}
+	c.runtime = nil
+	c.module = nil
+}
+
+// yamlToJSON converts the given YAML document to JSON.
+func yamlToJSON(data []byte) ([]byte, error) {
+	var v interface{}
+	if err := yaml.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+	if v == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(util.GetJSONSerializableMap(v))
+}
+
+// logWriter forwards the standard output and error of a module to the agent logs.
+type logWriter struct {
+	check *WASMCheck
+}
+
+// Write implements io.Writer.
+func (w *logWriter) Write(p []byte) (int, error) {
+	log.Debugf("wasm check %s: %s", w.check.ID(), p)
+	return len(p), nil
+}
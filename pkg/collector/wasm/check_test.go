@@ -0,0 +1,383 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// +build wasmchecks,go1.18
+
+package wasm
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tetratelabs/wazero"
+
+	"github.com/DataDog/datadog-agent/pkg/aggregator/mocksender"
+	"github.com/DataDog/datadog-agent/pkg/collector/check"
+	"github.com/DataDog/datadog-agent/pkg/config"
+	"github.com/DataDog/datadog-agent/pkg/metrics"
+)
+
+// The tests below use tiny hand-assembled modules, so that they don't depend on a
+// WebAssembly toolchain being available.
+
+const (
+	i32 byte = 0x7f
+	f64 byte = 0x7c
+)
+
+type testImport struct {
+	name    string
+	params  []byte
+	results []byte
+}
+
+var (
+	importSubmitMetric       = testImport{"submit_metric", []byte{i32, i32, i32, f64, i32, i32, i32, i32, i32}, nil}
+	importSubmitServiceCheck = testImport{"submit_service_check", []byte{i32, i32, i32, i32, i32, i32, i32, i32, i32}, nil}
+	importReportError        = testImport{"report_error", []byte{i32, i32}, nil}
+	importGetConfig          = testImport{"get_config", []byte{i32, i32, i32}, []byte{i32}}
+)
+
+// testModule describes a module importing the given host functions from the "datadog"
+// module, and exporting a single "run" function returning an i32.
+type testModule struct {
+	imports []testImport
+	pages   uint32
+	data    map[uint32]string
+	run     []byte // body of run, without the final end
+}
+
+func uleb(v uint32) []byte {
+	var b []byte
+	for {
+		c := byte(v & 0x7f)
+		v >>= 7
+		if v != 0 {
+			c |= 0x80
+		}
+		b = append(b, c)
+		if v == 0 {
+			return b
+		}
+	}
+}
+
+func sleb(v int32) []byte {
+	var b []byte
+	for {
+		c := byte(v & 0x7f)
+		v >>= 7
+		if (v == 0 && c&0x40 == 0) || (v == -1 && c&0x40 != 0) {
+			return append(b, c)
+		}
+		b = append(b, c|0x80)
+	}
+}
+
+func name(s string) []byte {
+	return append(uleb(uint32(len(s))), s...)
+}
+
+func section(id byte, count int, content []byte) []byte {
+	content = append(uleb(uint32(count)), content...)
+	return append(append([]byte{id}, uleb(uint32(len(content)))...), content...)
+}
+
+func (m testModule) bytes() []byte {
+	b := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+
+	var types, imports []byte
+	for i, imp := range m.imports {
+		types = append(types, 0x60)
+		types = append(append(types, uleb(uint32(len(imp.params)))...), imp.params...)
+		types = append(append(types, uleb(uint32(len(imp.results)))...), imp.results...)
+		imports = append(append(append(imports, name(hostModuleName)...), name(imp.name)...), 0x00)
+		imports = append(imports, uleb(uint32(i))...)
+	}
+	types = append(types, 0x60, 0x00, 0x01, i32)
+	runType := uint32(len(m.imports))
+
+	b = append(b, section(1, len(m.imports)+1, types)...)
+	if len(m.imports) > 0 {
+		b = append(b, section(2, len(m.imports), imports)...)
+	}
+	b = append(b, section(3, 1, uleb(runType))...)
+	if m.pages > 0 {
+		b = append(b, section(5, 1, append([]byte{0x00}, uleb(m.pages)...))...)
+	}
+	b = append(b, section(7, 1, append(append(name(runFunction), 0x00), uleb(runType)...))...)
+	body := append(append([]byte{0x00}, m.run...), 0x0b)
+	b = append(b, section(10, 1, append(uleb(uint32(len(body))), body...))...)
+	if len(m.data) > 0 {
+		var data []byte
+		for offset, s := range m.data {
+			data = append(append(data, 0x00, 0x41), sleb(int32(offset))...)
+			data = append(append(data, 0x0b), name(s)...)
+		}
+		b = append(b, section(11, len(m.data), data)...)
+	}
+	return b
+}
+
+// instructions
+func i32Const(v int32) []byte { return append([]byte{0x41}, sleb(v)...) }
+func f64Const(v float64) []byte {
+	b := make([]byte, 9)
+	b[0] = 0x44
+	binary.LittleEndian.PutUint64(b[1:], math.Float64bits(v))
+	return b
+}
+func call(idx uint32) []byte { return append([]byte{0x10}, uleb(idx)...) }
+func code(instrs ...[]byte) []byte {
+	var b []byte
+	for _, instr := range instrs {
+		b = append(b, instr...)
+	}
+	return b
+}
+
+var (
+	infiniteLoop = []byte{0x03, 0x40, 0x0c, 0x00, 0x0b} // loop br 0 end
+	memoryGrow   = []byte{0x40, 0x00}
+	i32Eq        = []byte{0x46}
+)
+
+func writeModule(t *testing.T, dir, name string, m testModule) string {
+	path := filepath.Join(dir, name+moduleExtension)
+	require.NoError(t, ioutil.WriteFile(path, m.bytes(), 0644))
+	return path
+}
+
+// newMockSender registers a mock sender for the test check configured with the given
+// instance and init_config.
+func newMockSender(instance, initConfig string) *mocksender.MockSender {
+	sender := mocksender.NewMockSender(check.BuildID("test", []byte(instance), []byte(initConfig)))
+	sender.SetupAcceptAll()
+	return sender
+}
+
+func newTestCheck(t *testing.T, m testModule, instance string) (*WASMCheck, *mocksender.MockSender) {
+	dir, err := ioutil.TempDir("", "wasm")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	sender := newMockSender(instance, "foo: bar")
+	c := newWASMCheck("test", writeModule(t, dir, "test", m), wazero.NewCompilationCache())
+	require.NoError(t, c.Configure([]byte(instance), []byte("foo: bar"), "test"))
+	t.Cleanup(c.Cancel)
+	return c, sender
+}
+
+func TestRunSubmit(t *testing.T) {
+	c, sender := newTestCheck(t, testModule{
+		imports: []testImport{importSubmitMetric, importSubmitServiceCheck},
+		pages:   1,
+		data:    map[uint32]string{0: "my.metric", 16: "tag:a\ntag:b", 32: "my.check", 48: "all good"},
+		run: code(
+			// submit_metric(gauge, "my.metric", 42, "", "tag:a\ntag:b", false)
+			i32Const(int32(metricTypeGauge)), i32Const(0), i32Const(9), f64Const(42), i32Const(0), i32Const(0), i32Const(16), i32Const(11), i32Const(0), call(0),
+			// submit_metric(monotonic_count, "my.metric", 3, "", "", true)
+			i32Const(int32(metricTypeMonotonicCount)), i32Const(0), i32Const(9), f64Const(3), i32Const(0), i32Const(0), i32Const(0), i32Const(0), i32Const(1), call(0),
+			// submit_service_check("my.check", critical, "", "tag:a\ntag:b", "all good")
+			i32Const(32), i32Const(8), i32Const(int32(metrics.ServiceCheckCritical)), i32Const(0), i32Const(0), i32Const(16), i32Const(11), i32Const(48), i32Const(8), call(1),
+			i32Const(0),
+		),
+	}, "min_collection_interval: 30")
+
+	require.NoError(t, c.Run())
+	sender.AssertMetric(t, "Gauge", "my.metric", 42, "", []string{"tag:a", "tag:b"})
+	sender.AssertMonotonicCount(t, "MonotonicCountWithFlushFirstValue", "my.metric", 3, "", []string(nil), true)
+	sender.AssertServiceCheck(t, "my.check", metrics.ServiceCheckCritical, "", []string{"tag:a", "tag:b"}, "all good")
+	sender.AssertNumberOfCalls(t, "Commit", 1)
+}
+
+func TestRunError(t *testing.T) {
+	c, sender := newTestCheck(t, testModule{
+		imports: []testImport{importReportError},
+		pages:   1,
+		data:    map[uint32]string{0: "cannot connect"},
+		run:     code(i32Const(0), i32Const(14), call(0), i32Const(1)),
+	}, "")
+
+	assert.EqualError(t, c.Run(), "cannot connect")
+	sender.AssertNumberOfCalls(t, "Commit", 1)
+
+	c, _ = newTestCheck(t, testModule{run: i32Const(2)}, "")
+	assert.EqualError(t, c.Run(), "run returned status 2")
+}
+
+func TestGetConfig(t *testing.T) {
+	// run returns get_config(instance, 0, 64): the length of the instance as JSON
+	m := testModule{
+		imports: []testImport{importGetConfig},
+		pages:   1,
+		run:     code(i32Const(int32(configInstance)), i32Const(0), i32Const(64), call(0)),
+	}
+	c, _ := newTestCheck(t, m, "url: http://localhost")
+	assert.EqualError(t, c.Run(), "run returned status 26")
+	assert.Equal(t, `{"url":"http://localhost"}`, string(c.instance))
+	assert.Equal(t, `{"foo":"bar"}`, string(c.initConfig))
+}
+
+func TestTimeout(t *testing.T) {
+	c, sender := newTestCheck(t, testModule{run: code(infiniteLoop, i32Const(0))}, "wasm_timeout: 0.1")
+	assert.Equal(t, "100ms", c.timeout.String())
+
+	err := c.Run()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "exceeded its timeout of 100ms")
+	sender.AssertNumberOfCalls(t, "Commit", 1)
+
+	// the module is instantiated again on the next run
+	err = c.Run()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "exceeded its timeout")
+}
+
+func TestCPUTimeLimit(t *testing.T) {
+	c, sender := newTestCheck(t, testModule{run: code(infiniteLoop, i32Const(0))}, "wasm_cpu_time_limit: 0.1")
+	assert.Equal(t, "100ms", c.cpuTimeLimit.String())
+
+	err := c.Run()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "exceeded its CPU time limit of 100ms")
+	sender.AssertNumberOfCalls(t, "Commit", 1)
+
+	// the module is instantiated again on the next run
+	err = c.Run()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "exceeded its CPU time limit")
+}
+
+func TestThreadCPUClock(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("the CPU time of a thread is only measured on Linux")
+	}
+
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	clock, err := threadCPUClock()
+	require.NoError(t, err)
+
+	// waiting doesn't consume CPU time
+	start, err := clock()
+	require.NoError(t, err)
+	time.Sleep(200 * time.Millisecond)
+	now, err := clock()
+	require.NoError(t, err)
+	assert.Less(t, int64(now-start), int64(100*time.Millisecond))
+
+	start = now
+	for deadline := time.Now().Add(200 * time.Millisecond); time.Now().Before(deadline); {
+	}
+	now, err = clock()
+	require.NoError(t, err)
+	assert.Greater(t, int64(now-start), int64(50*time.Millisecond))
+}
+
+func TestMemoryLimit(t *testing.T) {
+	// run returns 0 if growing the memory by 32 pages (2MiB) succeeds, 1 otherwise
+	m := testModule{pages: 1, run: code(i32Const(32), memoryGrow, i32Const(-1), i32Eq)}
+	c, _ := newTestCheck(t, m, "wasm_memory_limit: 1")
+	assert.EqualError(t, c.Run(), "run returned status 1")
+
+	c, _ = newTestCheck(t, m, "wasm_memory_limit: 4")
+	assert.NoError(t, c.Run())
+
+	// a module requiring more memory than allowed cannot be instantiated
+	dir, err := ioutil.TempDir("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	newMockSender("wasm_memory_limit: 1", "")
+	c = newWASMCheck("test", writeModule(t, dir, "test", testModule{pages: 32, run: i32Const(0)}), wazero.NewCompilationCache())
+	assert.Error(t, c.Configure([]byte("wasm_memory_limit: 1"), nil, "test"))
+}
+
+func TestInvalidLimits(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := writeModule(t, dir, "test", testModule{run: i32Const(0)})
+	for _, instance := range []string{
+		"wasm_memory_limit: 0",
+		"wasm_memory_limit: -1",
+		"wasm_memory_limit: 4097",
+		"wasm_cpu_time_limit: 0",
+		"wasm_cpu_time_limit: -1",
+		"wasm_timeout: 0",
+		"wasm_timeout: -1",
+	} {
+		newMockSender(instance, "")
+		c := newWASMCheck("test", path, wazero.NewCompilationCache())
+		assert.Error(t, c.Configure([]byte(instance), nil, "test"), instance)
+	}
+
+	newMockSender("wasm_memory_limit: 4096", "")
+	c := newWASMCheck("test", path, wazero.NewCompilationCache())
+	require.NoError(t, c.Configure([]byte("wasm_memory_limit: 4096"), nil, "test"))
+	defer c.Cancel()
+	assert.EqualValues(t, 65536, c.memoryLimit)
+}
+
+func TestConfigureNoRun(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	newMockSender("", "")
+
+	// a module without any export
+	path := filepath.Join(dir, "test.wasm")
+	require.NoError(t, ioutil.WriteFile(path, []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}, 0644))
+	c := newWASMCheck("test", path, wazero.NewCompilationCache())
+	assert.EqualError(t, c.Configure(nil, nil, "test"), `WASM module `+path+` does not export a "run" function`)
+
+	require.NoError(t, ioutil.WriteFile(path, []byte("not wasm"), 0644))
+	c = newWASMCheck("test", path, wazero.NewCompilationCache())
+	assert.Error(t, c.Configure(nil, nil, "test"))
+}
+
+func TestFindModule(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	other, err := ioutil.TempDir("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(other)
+
+	checksd := config.Datadog.GetString("additional_checksd")
+	defer config.Datadog.Set("additional_checksd", checksd)
+	config.Datadog.Set("additional_checksd", dir)
+
+	mod := testModule{run: i32Const(0)}
+	byName := writeModule(t, dir, "foo", mod)
+	relative := writeModule(t, dir, "bar", mod)
+	absolute := writeModule(t, other, "baz", mod)
+
+	path, err := findModule("foo", nil)
+	require.NoError(t, err)
+	assert.Equal(t, byName, path)
+
+	path, err = findModule("foo", []byte("wasm_module: bar.wasm"))
+	require.NoError(t, err)
+	assert.Equal(t, relative, path)
+
+	path, err = findModule("foo", []byte("wasm_module: "+absolute))
+	require.NoError(t, err)
+	assert.Equal(t, absolute, path)
+
+	_, err = findModule("qux", nil)
+	assert.Error(t, err)
+}
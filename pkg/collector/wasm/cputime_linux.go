@@ -0,0 +1,33 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// +build wasmchecks,go1.18
+
+package wasm
+
+import (
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// threadCPUClock returns a clock measuring the CPU time consumed by the calling OS thread. The
+// calling goroutine must be locked to its thread. The clock can be read from any goroutine.
+func threadCPUClock() (cpuClock, error) {
+	// MAKE_THREAD_CPUCLOCK(tid, CPUCLOCK_SCHED) from the kernel's posix-timers.h
+	clockID := int32(^uint32(unix.Gettid())<<3) | 6
+
+	read := func() (time.Duration, error) {
+		var ts unix.Timespec
+		if err := unix.ClockGettime(clockID, &ts); err != nil {
+			return 0, err
+		}
+		return time.Duration(ts.Nano()), nil
+	}
+	if _, err := read(); err != nil {
+		return nil, err
+	}
+	return read, nil
+}
@@ -0,0 +1,15 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// +build wasmchecks,go1.18,!linux
+
+package wasm
+
+import "errors"
+
+// threadCPUClock returns an error as the CPU time of a thread can't be measured on this platform.
+func threadCPUClock() (cpuClock, error) {
+	return nil, errors.New("the CPU time of a thread can't be measured on this platform")
+}
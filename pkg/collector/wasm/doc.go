@@ -0,0 +1,8 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package wasm implements a loader for checks compiled to WebAssembly modules. Its runtime
+// requires Go 1.18, so it is only built with the `wasmchecks` build tag.
+package wasm
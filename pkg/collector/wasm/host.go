@@ -0,0 +1,208 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// +build wasmchecks,go1.18
+
+package wasm
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+
+	"github.com/DataDog/datadog-agent/pkg/aggregator"
+	"github.com/DataDog/datadog-agent/pkg/metrics"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+// hostModuleName is the name of the module WebAssembly checks import the host API from.
+const hostModuleName = "datadog"
+
+// Metric types accepted by submit_metric, matching the ones of rtloader.
+const (
+	metricTypeGauge uint32 = iota
+	metricTypeRate
+	metricTypeCount
+	metricTypeMonotonicCount
+	metricTypeCounter
+	metricTypeHistogram
+	metricTypeHistorate
+)
+
+// Configuration sections readable through get_config.
+const (
+	configInstance uint32 = iota
+	configInitConfig
+)
+
+// Log levels accepted by log.
+const (
+	logLevelTrace uint32 = iota
+	logLevelDebug
+	logLevelInfo
+	logLevelWarn
+	logLevelError
+)
+
+// hostModule builds the "datadog" host module, which exposes to the WebAssembly module the
+// same API rtloader exposes to Python checks. Strings are passed as (pointer, length) pairs
+// in the memory of the module, and tag lists as newline-separated strings.
+//
+// All the functions are bound to the check, which thus doesn't have to pass its ID around.
+func (c *WASMCheck) hostModule() wazero.HostModuleBuilder {
+	return c.runtime.NewHostModuleBuilder(hostModuleName).
+		NewFunctionBuilder().WithFunc(c.submitMetric).Export("submit_metric").
+		NewFunctionBuilder().WithFunc(c.submitServiceCheck).Export("submit_service_check").
+		NewFunctionBuilder().WithFunc(c.submitEvent).Export("submit_event").
+		NewFunctionBuilder().WithFunc(c.log).Export("log").
+		NewFunctionBuilder().WithFunc(c.reportWarning).Export("report_warning").
+		NewFunctionBuilder().WithFunc(c.reportError).Export("report_error").
+		NewFunctionBuilder().WithFunc(c.getConfig).Export("get_config")
+}
+
+// submitMetric submits a metric of the given type.
+func (c *WASMCheck) submitMetric(_ context.Context, m api.Module, metricType, namePtr, nameLen uint32, value float64, hostnamePtr, hostnameLen, tagsPtr, tagsLen, flushFirstValue uint32) {
+	sender, err := aggregator.GetSender(c.ID())
+	if err != nil || sender == nil {
+		log.Errorf("Error submitting metric to the Sender: %v", err)
+		return
+	}
+
+	name := readString(m, namePtr, nameLen)
+	hostname := readString(m, hostnamePtr, hostnameLen)
+	tags := readTags(m, tagsPtr, tagsLen)
+
+	switch metricType {
+	case metricTypeGauge:
+		sender.Gauge(name, value, hostname, tags)
+	case metricTypeRate:
+		sender.Rate(name, value, hostname, tags)
+	case metricTypeCount:
+		sender.Count(name, value, hostname, tags)
+	case metricTypeMonotonicCount:
+		sender.MonotonicCountWithFlushFirstValue(name, value, hostname, tags, flushFirstValue != 0)
+	case metricTypeCounter:
+		sender.Counter(name, value, hostname, tags)
+	case metricTypeHistogram:
+		sender.Histogram(name, value, hostname, tags)
+	case metricTypeHistorate:
+		sender.Historate(name, value, hostname, tags)
+	default:
+		log.Warnf("wasm check %s: unknown metric type %d for metric %s", c.ID(), metricType, name)
+	}
+}
+
+// submitServiceCheck submits a service check.
+func (c *WASMCheck) submitServiceCheck(_ context.Context, m api.Module, namePtr, nameLen, status, hostnamePtr, hostnameLen, tagsPtr, tagsLen, messagePtr, messageLen uint32) {
+	sender, err := aggregator.GetSender(c.ID())
+	if err != nil || sender == nil {
+		log.Errorf("Error submitting service check to the Sender: %v", err)
+		return
+	}
+
+	sender.ServiceCheck(
+		readString(m, namePtr, nameLen),
+		metrics.ServiceCheckStatus(status),
+		readString(m, hostnamePtr, hostnameLen),
+		readTags(m, tagsPtr, tagsLen),
+		readString(m, messagePtr, messageLen),
+	)
+}
+
+// submitEvent submits an event, passed as a JSON object using the same keys as the ones
+// of the events submitted by Python checks (msg_title, msg_text, alert_type, ...).
+func (c *WASMCheck) submitEvent(_ context.Context, m api.Module, eventPtr, eventLen uint32) {
+	sender, err := aggregator.GetSender(c.ID())
+	if err != nil || sender == nil {
+		log.Errorf("Error submitting event to the Sender: %v", err)
+		return
+	}
+
+	var event metrics.Event
+	if err := json.Unmarshal([]byte(readString(m, eventPtr, eventLen)), &event); err != nil {
+		log.Errorf("wasm check %s: invalid event: %s", c.ID(), err)
+		return
+	}
+	sender.Event(event)
+}
+
+// log writes a message to the agent logs.
+func (c *WASMCheck) log(_ context.Context, m api.Module, level, msgPtr, msgLen uint32) {
+	msg := readString(m, msgPtr, msgLen)
+	switch level {
+	case logLevelTrace:
+		log.Tracef("wasm check %s: %s", c.ID(), msg)
+	case logLevelDebug:
+		log.Debugf("wasm check %s: %s", c.ID(), msg)
+	case logLevelInfo:
+		log.Infof("wasm check %s: %s", c.ID(), msg)
+	case logLevelWarn:
+		log.Warnf("wasm check %s: %s", c.ID(), msg)
+	default:
+		log.Errorf("wasm check %s: %s", c.ID(), msg)
+	}
+}
+
+// reportWarning adds a warning to the check, shown in the agent status.
+func (c *WASMCheck) reportWarning(_ context.Context, m api.Module, msgPtr, msgLen uint32) {
+	c.Warn(readString(m, msgPtr, msgLen)) //nolint:errcheck
+}
+
+// reportError sets the error returned by the current run, if run returns a non-zero status.
+func (c *WASMCheck) reportError(_ context.Context, m api.Module, msgPtr, msgLen uint32) {
+	c.setRunError(readString(m, msgPtr, msgLen))
+}
+
+// getConfig copies the JSON representation of the requested configuration section to the
+// given buffer. It returns the length of the JSON document: if it's larger than the buffer,
+// nothing is copied and the module is expected to call it again with a large enough buffer.
+// It returns -1 if the section is unknown or the buffer is out of the memory of the module.
+func (c *WASMCheck) getConfig(_ context.Context, m api.Module, section, bufPtr, bufLen uint32) int32 {
+	var data []byte
+	switch section {
+	case configInstance:
+		data = c.instance
+	case configInitConfig:
+		data = c.initConfig
+	default:
+		return -1
+	}
+	if uint32(len(data)) <= bufLen && (m.Memory() == nil || !m.Memory().Write(bufPtr, data)) {
+		return -1
+	}
+	return int32(len(data))
+}
+
+// readString reads a string from the memory of the module. Out of bounds strings are read as
+// empty strings.
+func readString(m api.Module, ptr, length uint32) string {
+	if length == 0 || m.Memory() == nil {
+		return ""
+	}
+	b, ok := m.Memory().Read(ptr, length)
+	if !ok {
+		log.Debugf("wasm: out of bounds read of %d bytes at offset %d in module %s", length, ptr, m.Name())
+		return ""
+	}
+	return string(b)
+}
+
+// readTags reads a newline-separated list of tags from the memory of the module.
+func readTags(m api.Module, ptr, length uint32) []string {
+	s := readString(m, ptr, length)
+	if s == "" {
+		return nil
+	}
+	var tags []string
+	for _, tag := range strings.Split(s, "\n") {
+		if tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
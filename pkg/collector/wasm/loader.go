@@ -0,0 +1,98 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// +build wasmchecks,go1.18
+
+package wasm
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/tetratelabs/wazero"
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/DataDog/datadog-agent/pkg/autodiscovery/integration"
+	"github.com/DataDog/datadog-agent/pkg/collector/check"
+	"github.com/DataDog/datadog-agent/pkg/collector/loaders"
+	"github.com/DataDog/datadog-agent/pkg/config"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+// moduleExtension is the file extension of the WebAssembly modules looked up by the loader.
+const moduleExtension = ".wasm"
+
+// WASMCheckLoader is a specific loader for checks compiled to WebAssembly modules
+type WASMCheckLoader struct {
+	// cache is shared by all the checks so that a module is only compiled once,
+	// no matter how many instances are configured.
+	cache wazero.CompilationCache
+}
+
+// NewWASMCheckLoader creates a loader for WebAssembly checks
+func NewWASMCheckLoader() (*WASMCheckLoader, error) {
+	return &WASMCheckLoader{cache: wazero.NewCompilationCache()}, nil
+}
+
+// Name returns WASM loader name
+func (wl *WASMCheckLoader) Name() string {
+	return "wasm"
+}
+
+// Load returns a WebAssembly check
+func (wl *WASMCheckLoader) Load(config integration.Config, instance integration.Data) (check.Check, error) {
+	var c check.Check
+
+	path, err := findModule(config.Name, config.InitConfig)
+	if err != nil {
+		return c, err
+	}
+
+	wc := newWASMCheck(config.Name, path, wl.cache)
+	if err := wc.Configure(instance, config.InitConfig, config.Source); err != nil {
+		log.Errorf("wasm.loader: could not configure check %s: %s", wc, err)
+		return c, fmt.Errorf("Could not configure check %s: %s", wc, err)
+	}
+
+	return wc, nil
+}
+
+func (wl *WASMCheckLoader) String() string {
+	return "WASM Check Loader"
+}
+
+// findModule returns the path to the WebAssembly module of the named check. The module is
+// either set explicitly in the init_config section using `wasm_module`, or named after the
+// check and located in the additional checks directory.
+func findModule(name string, initConfig integration.Data) (string, error) {
+	var opts struct {
+		Module string `yaml:"wasm_module"`
+	}
+	if err := yaml.Unmarshal(initConfig, &opts); err != nil {
+		return "", fmt.Errorf("invalid init_config section for check %s: %s", name, err)
+	}
+	checksd := config.Datadog.GetString("additional_checksd")
+	path := opts.Module
+	switch {
+	case path == "":
+		path = filepath.Join(checksd, name+moduleExtension)
+	case !filepath.IsAbs(path):
+		path = filepath.Join(checksd, path)
+	}
+	if _, err := os.Stat(path); err != nil {
+		return "", fmt.Errorf("unable to find a WASM module for check %s: %s", name, err)
+	}
+	return path, nil
+}
+
+func init() {
+	factory := func() (check.Loader, error) {
+		return NewWASMCheckLoader()
+	}
+
+	// WASM checks are tried last, so that they never shadow a Python or core check
+	loaders.RegisterLoader(40, factory)
+}
@@ -143,8 +143,7 @@ func (w *Worker) Run() {
 		w.utilizationTracker.CheckStarted(longRunning)
 
 		// Run the check
-		var checkErr error
-		checkErr = check.Run()
+		finished, checkErr := w.runCheck(check)
 
 		w.utilizationTracker.CheckFinished()
 
@@ -159,6 +158,7 @@ func (w *Worker) Run() {
 		}
 		serviceCheckTags := []string{fmt.Sprintf("check:%s", check.String())}
 		serviceCheckStatus := metrics.ServiceCheckOK
+		serviceCheckMessage := ""
 
 		hostname, _ := util.GetHostname(context.TODO())
 
@@ -171,15 +171,21 @@ func (w *Worker) Run() {
 			checkLogger.Error(checkErr)
 			expvars.AddErrorsCount(1)
 			serviceCheckStatus = metrics.ServiceCheckCritical
+			if !finished {
+				serviceCheckMessage = checkErr.Error()
+			}
 		}
 
 		if sender != nil && !longRunning {
-			sender.ServiceCheck(serviceCheckStatusKey, serviceCheckStatus, hostname, serviceCheckTags, "")
+			sender.ServiceCheck(serviceCheckStatusKey, serviceCheckStatus, hostname, serviceCheckTags, serviceCheckMessage)
 			sender.Commit()
 		}
 
-		// Remove the check from the running list
-		w.checksTracker.DeleteCheck(check.ID())
+		// Remove the check from the running list. Checks that timed out are removed once
+		// their run actually returns, so that they aren't run concurrently.
+		if finished {
+			w.checksTracker.DeleteCheck(check.ID())
+		}
 
 		// Publish statistics about this run
 		expvars.AddRunningCheckCount(-1)
@@ -199,3 +205,37 @@ func (w *Worker) Run() {
 
 	log.Debugf("Runner %d, worker %d: Finished processing checks.", w.runnerID, w.ID)
 }
+
+// runCheck runs the check, returning whether it finished and its error. Runs of checks
+// with a timeout are abandoned once the timeout is exceeded: the check is stopped, a
+// `check.TimeoutError` is returned and the run goes on in the background until it returns,
+// so that the worker can process other checks.
+func (w *Worker) runCheck(c check.Check) (bool, error) {
+	timeout := check.GetScheduleOptions(c).Timeout
+	if timeout == 0 {
+		return true, c.Run()
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- c.Run()
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case err := <-done:
+		return true, err
+	case <-timer.C:
+	}
+
+	log.Warnf("Runner %d, worker %d: check %s exceeded its timeout of %s, stopping it", w.runnerID, w.ID, c.ID(), timeout)
+	go func() {
+		c.Stop()
+		<-done
+		log.Infof("Runner %d, worker %d: check %s returned after its timeout", w.runnerID, w.ID, c.ID())
+		w.checksTracker.DeleteCheck(c.ID())
+	}()
+	return false, &check.TimeoutError{Timeout: timeout}
+}
@@ -73,6 +73,15 @@ func (c *testCheck) Run() error {
 	return nil
 }
 
+type scheduledTestCheck struct {
+	testCheck
+	opts    check.ScheduleOptions
+	stopped chan struct{}
+}
+
+func (c *scheduledTestCheck) ScheduleOptions() check.ScheduleOptions { return c.opts }
+func (c *scheduledTestCheck) Stop()                                  { close(c.stopped) }
+
 // Helpers
 
 // AssertAsyncWorkerCount returns the expvar count of the currently-running
@@ -617,3 +626,96 @@ func TestWorkerServiceCheckSendingLongRunningTasks(t *testing.T) {
 	mockSender.AssertNumberOfCalls(t, "Commit", 0)
 	mockSender.AssertNumberOfCalls(t, "ServiceCheck", 0)
 }
+
+func TestWorkerCheckTimeout(t *testing.T) {
+	expvars.Reset()
+	config.Datadog.Set("hostname", "myhost")
+
+	checksTracker := tracker.NewRunningChecksTracker()
+	pendingChecksChan := make(chan check.Check, 10)
+	mockShouldAddStatsFunc := func(id check.ID) bool { return true }
+
+	release := make(chan struct{})
+	hungCheck := &scheduledTestCheck{
+		testCheck: testCheck{
+			t:       t,
+			id:      "hung:123",
+			runFunc: func(check.ID) { <-release },
+		},
+		opts:    check.ScheduleOptions{Timeout: 50 * time.Millisecond},
+		stopped: make(chan struct{}),
+	}
+	goodCheck := &scheduledTestCheck{
+		testCheck: testCheck{t: t, id: "goodcheck:123"},
+		opts:      check.ScheduleOptions{Timeout: time.Minute},
+		stopped:   make(chan struct{}),
+	}
+
+	// the hung check is skipped while its timed out run goes on
+	pendingChecksChan <- hungCheck
+	pendingChecksChan <- hungCheck
+	pendingChecksChan <- goodCheck
+	close(pendingChecksChan)
+
+	mockSender := mocksender.NewMockSender("")
+	mockSender.On("Commit").Return().Times(2)
+	mockSender.On(
+		"ServiceCheck",
+		serviceCheckStatusKey,
+		metrics.ServiceCheckCritical,
+		"myhost",
+		[]string{"check:hung"},
+		"check run timed out after 50ms",
+	).Return().Times(1)
+	mockSender.On(
+		"ServiceCheck",
+		serviceCheckStatusKey,
+		metrics.ServiceCheckOK,
+		"myhost",
+		[]string{"check:goodcheck"},
+		"",
+	).Return().Times(1)
+
+	worker, err := newWorkerWithOptions(
+		100,
+		200,
+		pendingChecksChan,
+		checksTracker,
+		mockShouldAddStatsFunc,
+		func() (aggregator.Sender, error) {
+			return mockSender, nil
+		},
+		windowSize,
+		pollingInterval,
+	)
+	require.Nil(t, err)
+
+	// the worker isn't held by the hung check
+	worker.Run()
+
+	mockSender.AssertExpectations(t)
+	assert.Equal(t, 1, goodCheck.RunCount())
+	assert.Equal(t, 2, int(expvars.GetRunsCount()))
+	assert.Equal(t, 1, int(expvars.GetErrorsCount()))
+
+	stats, found := expvars.CheckStats(hungCheck.ID())
+	require.True(t, found)
+	assert.Equal(t, uint64(1), stats.TotalTimeouts)
+	assert.Equal(t, "check run timed out after 50ms", stats.LastError)
+
+	// the hung check was stopped, and is tracked as running until its run returns
+	select {
+	case <-hungCheck.stopped:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "the hung check wasn't stopped")
+	}
+	_, running := checksTracker.Check(hungCheck.ID())
+	assert.True(t, running)
+
+	close(release)
+	require.Eventually(t, func() bool {
+		_, running := checksTracker.Check(hungCheck.ID())
+		return !running
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 1, hungCheck.RunCount())
+}
@@ -90,6 +90,20 @@ func WithHostRootMount(hostRootMount string) BuilderOption {
 	}
 }
 
+// WithRootFS evaluates the rules against a root filesystem instead of the live host. Only the rules whose
+// resources can be resolved from the files, such as the file and package resources, are evaluated.
+func WithRootFS(rootFS *RootFS) BuilderOption {
+	return func(b *builder) error {
+		log.Infof("Rules will be evaluated against the %s %s", rootFS.Type, rootFS.Name)
+		b.rootFS = rootFS
+		b.pathMapper = &pathMapper{
+			hostMountPath:   rootFS.Path,
+			resolveSymlinks: true,
+		}
+		return nil
+	}
+}
+
 // WithDocker configures using docker
 func WithDocker() BuilderOption {
 	return func(b *builder) error {
@@ -283,6 +297,7 @@ type builder struct {
 
 	hostname     string
 	pathMapper   *pathMapper
+	rootFS       *RootFS
 	etcGroupPath string
 	nodeLabels   map[string]string
 
@@ -430,6 +445,14 @@ func (b *builder) checkFromRule(meta *compliance.SuiteMeta, rule *compliance.Con
 		return nil, err
 	}
 
+	if b.rootFS != nil {
+		if !rootFSSupportsResources(rule.Resources) {
+			log.Debugf("rule %s/%s discarded - resources not supported on a root filesystem", meta.Framework, rule.ID)
+			return nil, ErrRuleDoesNotApply
+		}
+		return b.newCheck(meta, ruleScope, rule, b.rootFSResourceReporter)
+	}
+
 	eligible, err := b.hostMatcher(ruleScope, rule.ID, rule.HostSelector)
 	if err != nil {
 		return nil, err
@@ -450,8 +473,17 @@ func (b *builder) checkFromRegoRule(meta *compliance.SuiteMeta, rule *compliance
 		return nil, err
 	}
 
-	// skip host match check if rego input is overridden
-	if b.regoInputOverride == nil {
+	if b.rootFS != nil {
+		for _, input := range rule.Inputs {
+			if !rootFSResourceKinds[input.Kind()] {
+				log.Debugf("rule %s/%s discarded - inputs not supported on a root filesystem", meta.Framework, rule.ID)
+				return nil, ErrRuleDoesNotApply
+			}
+		}
+	}
+
+	// skip host match check if rego input is overridden or if the rule is evaluated against a root filesystem
+	if b.regoInputOverride == nil && b.rootFS == nil {
 		eligible, err := b.hostMatcher(ruleScope, rule.ID, rule.HostSelector)
 		if err != nil {
 			return nil, err
@@ -466,6 +498,27 @@ func (b *builder) checkFromRegoRule(meta *compliance.SuiteMeta, rule *compliance
 	return b.newRegoCheck(meta, ruleScope, rule, fallthroughReporter)
 }
 
+// rootFSSupportsResources returns whether the resources of a rule, and their fallbacks, can be resolved from a
+// root filesystem
+func rootFSSupportsResources(resources []compliance.Resource) bool {
+	for _, resource := range resources {
+		if !rootFSResourceKinds[resource.Kind()] {
+			return false
+		}
+		if resource.Fallback != nil && !rootFSSupportsResources([]compliance.Resource{resource.Fallback.Resource}) {
+			return false
+		}
+	}
+	return true
+}
+
+func (b *builder) rootFSResourceReporter(report *compliance.Report) compliance.ReportResource {
+	return compliance.ReportResource{
+		ID:   b.rootFS.Name,
+		Type: b.rootFS.Type,
+	}
+}
+
 func fallthroughReporter(report *compliance.Report) compliance.ReportResource {
 	return report.Resource
 }
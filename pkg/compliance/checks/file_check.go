@@ -55,6 +55,9 @@ func resolveFile(_ context.Context, e env.Env, ruleID string, res compliance.Res
 	for _, path := range paths {
 		// Re-computing relative after glob filtering
 		relPath := e.RelativeToHostRoot(path)
+		// the match is normalized again so that its symbolic links are resolved under the root filesystem
+		// instead of the host
+		path = e.NormalizeToHostRoot(relPath)
 		fi, err := os.Stat(path)
 		if err != nil {
 			// This is not a failure unless we don't have any paths to act on
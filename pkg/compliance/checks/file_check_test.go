@@ -102,6 +102,7 @@ func TestFileCheck(t *testing.T) {
 				tempDir, filePaths := createTempFiles(t, 2)
 				for _, filePath := range filePaths {
 					env.On("RelativeToHostRoot", filePath).Return(path.Join("/etc/", path.Base(filePath)))
+					env.On("NormalizeToHostRoot", path.Join("/etc/", path.Base(filePath))).Return(filePath)
 				}
 
 				env.On("NormalizeToHostRoot", file.Path).Return(path.Join(tempDir, "/*.dat"))
@@ -9,18 +9,35 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/DataDog/datadog-agent/pkg/compliance/checks/env"
 	"github.com/DataDog/datadog-agent/pkg/compliance/eval"
 	"github.com/DataDog/datadog-agent/pkg/util/log"
 )
 
+// maxSymlinks is the maximum number of symbolic links followed when resolving a path under a root filesystem
+const maxSymlinks = 255
+
 type pathMapper struct {
 	hostMountPath string
+	// resolveSymlinks resolves the symbolic links of the paths as if the mount path was the root
+	// directory, so that the absolute links of a root filesystem don't point to the files of the host
+	resolveSymlinks bool
 }
 
 func (m pathMapper) normalizeToHostRoot(path string) string {
-	return filepath.Join(m.hostMountPath, path)
+	if !m.resolveSymlinks {
+		return filepath.Join(m.hostMountPath, path)
+	}
+
+	resolved, err := resolveInRoot(m.hostMountPath, path)
+	if err != nil {
+		// an empty path can't be opened, so that nothing outside of the root filesystem is read
+		log.Warnf("Unable to resolve %s: %v", path, err)
+		return ""
+	}
+	return resolved
 }
 
 func (m pathMapper) relativeToHostRoot(path string) string {
@@ -37,6 +54,51 @@ func (m pathMapper) relativeToHostRoot(path string) string {
 	return path
 }
 
+// resolveInRoot returns the path of a file under the root directory, following the symbolic links as if root
+// was the root directory: absolute links are resolved from root and `..` never goes above it. The components
+// that don't exist, such as glob patterns, are kept as is.
+func resolveInRoot(root, path string) (string, error) {
+	resolved := string(os.PathSeparator)
+	remaining := strings.Split(path, string(os.PathSeparator))
+	links := 0
+
+	for len(remaining) != 0 {
+		component := remaining[0]
+		remaining = remaining[1:]
+
+		switch component {
+		case "", ".":
+			continue
+		case "..":
+			resolved = filepath.Dir(resolved)
+			continue
+		}
+
+		next := filepath.Join(resolved, component)
+		fi, err := os.Lstat(filepath.Join(root, next))
+		if err != nil || fi.Mode()&os.ModeSymlink == 0 {
+			resolved = next
+			continue
+		}
+
+		links++
+		if links > maxSymlinks {
+			return "", fmt.Errorf("too many levels of symbolic links")
+		}
+
+		target, err := os.Readlink(filepath.Join(root, next))
+		if err != nil {
+			return "", err
+		}
+		if filepath.IsAbs(target) {
+			resolved = string(os.PathSeparator)
+		}
+		remaining = append(strings.Split(target, string(os.PathSeparator)), remaining...)
+	}
+
+	return filepath.Join(root, resolved), nil
+}
+
 func resolvePath(e env.Env, path string) (string, error) {
 	pathExpr, err := eval.Cache.ParsePath(path)
 	if err != nil {
@@ -8,6 +8,9 @@
 package checks
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	assert "github.com/stretchr/testify/require"
@@ -43,3 +46,63 @@ func TestMapperRelative(t *testing.T) {
 		})
 	}
 }
+
+func TestMapperResolveSymlinks(t *testing.T) {
+	assert := assert.New(t)
+
+	root, err := ioutil.TempDir("", "mapper-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	assert.NoError(os.MkdirAll(filepath.Join(root, "usr/share/zoneinfo"), 0755))
+	assert.NoError(ioutil.WriteFile(filepath.Join(root, "usr/share/zoneinfo/UTC"), []byte("UTC"), 0644))
+	assert.NoError(os.MkdirAll(filepath.Join(root, "etc"), 0755))
+	assert.NoError(os.Symlink("/usr/share/zoneinfo/UTC", filepath.Join(root, "etc/localtime")))
+	assert.NoError(os.Symlink("../../../../..", filepath.Join(root, "etc/up")))
+	assert.NoError(os.Symlink("/usr/share", filepath.Join(root, "share")))
+	assert.NoError(os.Symlink("loop", filepath.Join(root, "loop")))
+
+	tests := []struct {
+		name         string
+		path         string
+		expectedPath string
+	}{
+		{
+			name:         "absolute symlink",
+			path:         "/etc/localtime",
+			expectedPath: filepath.Join(root, "usr/share/zoneinfo/UTC"),
+		},
+		{
+			name:         "relative symlink above the root",
+			path:         "/etc/up/etc/passwd",
+			expectedPath: filepath.Join(root, "etc/passwd"),
+		},
+		{
+			name:         "symlinked directory",
+			path:         "/share/zoneinfo/*",
+			expectedPath: filepath.Join(root, "usr/share/zoneinfo/*"),
+		},
+		{
+			name:         "parent above the root",
+			path:         "/../../etc/localtime",
+			expectedPath: filepath.Join(root, "usr/share/zoneinfo/UTC"),
+		},
+		{
+			name:         "symlink loop",
+			path:         "/loop/file",
+			expectedPath: "",
+		},
+	}
+
+	m := pathMapper{
+		hostMountPath:   root,
+		resolveSymlinks: true,
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(test.expectedPath, m.normalizeToHostRoot(test.path))
+		})
+	}
+}
@@ -0,0 +1,146 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package checks
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/DataDog/datadog-agent/pkg/compliance"
+	"github.com/DataDog/datadog-agent/pkg/compliance/checks/env"
+	"github.com/DataDog/datadog-agent/pkg/compliance/eval"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+var kernelModuleReportedFields = []string{
+	compliance.KernelModuleFieldName,
+	compliance.KernelModuleFieldLoaded,
+	compliance.KernelModuleFieldDisabled,
+	compliance.KernelModuleFieldBlacklisted,
+}
+
+const procModulesPath = "/proc/modules"
+
+// modprobeConfigDirs are the directories of the modprobe configuration files
+var modprobeConfigDirs = []string{
+	"/etc/modprobe.d",
+	"/run/modprobe.d",
+	"/usr/lib/modprobe.d",
+	"/lib/modprobe.d",
+}
+
+func resolveKernelModule(_ context.Context, e env.Env, id string, res compliance.ResourceCommon, rego bool) (resolved, error) {
+	if res.KernelModule == nil {
+		return nil, fmt.Errorf("%s: expecting kernel module resource in kernel module check", id)
+	}
+
+	module := res.KernelModule
+
+	log.Debugf("%s: running kernel module check: %s", id, module.Name)
+
+	f, err := os.Open(e.NormalizeToHostRoot(procModulesPath))
+	if err != nil {
+		return nil, wrapErrorWithID(id, err)
+	}
+	defer f.Close()
+
+	loaded, err := isKernelModuleLoaded(f, module.Name)
+	if err != nil {
+		return nil, wrapErrorWithID(id, err)
+	}
+
+	var disabled, blacklisted bool
+	for _, dir := range modprobeConfigDirs {
+		paths, _ := filepath.Glob(filepath.Join(e.NormalizeToHostRoot(dir), "*.conf"))
+		for _, path := range paths {
+			d, b, err := readModprobeConfig(path, module.Name)
+			if err != nil {
+				log.Debugf("%s: failed to read %s: %v", id, path, err)
+				continue
+			}
+			disabled = disabled || d
+			blacklisted = blacklisted || b
+		}
+	}
+
+	instance := eval.NewInstance(
+		eval.VarMap{
+			compliance.KernelModuleFieldName:        module.Name,
+			compliance.KernelModuleFieldLoaded:      loaded,
+			compliance.KernelModuleFieldDisabled:    disabled,
+			compliance.KernelModuleFieldBlacklisted: blacklisted,
+		},
+		nil,
+		eval.RegoInputMap{
+			"name":        module.Name,
+			"loaded":      loaded,
+			"disabled":    disabled,
+			"blacklisted": blacklisted,
+		},
+	)
+
+	return newResolvedInstance(instance, module.Name, "kernelModule"), nil
+}
+
+// normalizeKernelModuleName returns the name of the module as listed in /proc/modules, where `-` is replaced by `_`
+func normalizeKernelModuleName(name string) string {
+	return strings.ReplaceAll(name, "-", "_")
+}
+
+func isKernelModuleLoaded(r io.Reader, name string) (bool, error) {
+	name = normalizeKernelModuleName(name)
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) > 0 && fields[0] == name {
+			return true, nil
+		}
+	}
+
+	return false, scanner.Err()
+}
+
+// readModprobeConfig returns whether the module is disabled, with an install command replaced by `/bin/true` or
+// `/bin/false`, and whether it is blacklisted in the given modprobe configuration file
+func readModprobeConfig(path string, name string) (bool, bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return false, false, err
+	}
+	defer f.Close()
+
+	name = normalizeKernelModuleName(name)
+
+	var disabled, blacklisted bool
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || strings.HasPrefix(fields[0], "#") || normalizeKernelModuleName(fields[1]) != name {
+			continue
+		}
+
+		switch fields[0] {
+		case "blacklist":
+			blacklisted = true
+		case "install":
+			if len(fields) > 2 {
+				switch filepath.Base(fields[2]) {
+				case "true", "false":
+					disabled = true
+				}
+			}
+		}
+	}
+
+	return disabled, blacklisted, scanner.Err()
+}
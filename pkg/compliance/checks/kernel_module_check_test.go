@@ -0,0 +1,109 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package checks
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/compliance"
+	"github.com/DataDog/datadog-agent/pkg/compliance/event"
+	"github.com/DataDog/datadog-agent/pkg/compliance/mocks"
+
+	"github.com/stretchr/testify/mock"
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestKernelModuleCheck(t *testing.T) {
+	tests := []struct {
+		name       string
+		moduleName string
+		condition  string
+
+		expectPassed bool
+		expectData   event.Data
+	}{
+		{
+			name:         "disabled module",
+			moduleName:   "cramfs",
+			condition:    `!kernelModule.loaded && kernelModule.disabled`,
+			expectPassed: true,
+			expectData: event.Data{
+				"kernelModule.name":        "cramfs",
+				"kernelModule.loaded":      false,
+				"kernelModule.disabled":    true,
+				"kernelModule.blacklisted": false,
+			},
+		},
+		{
+			name:         "blacklisted module",
+			moduleName:   "usb_storage",
+			condition:    `kernelModule.blacklisted`,
+			expectPassed: true,
+			expectData: event.Data{
+				"kernelModule.name":        "usb_storage",
+				"kernelModule.loaded":      false,
+				"kernelModule.disabled":    false,
+				"kernelModule.blacklisted": true,
+			},
+		},
+		{
+			name:         "loaded module",
+			moduleName:   "br-netfilter",
+			condition:    `!kernelModule.loaded`,
+			expectPassed: false,
+			expectData: event.Data{
+				"kernelModule.name":        "br-netfilter",
+				"kernelModule.loaded":      true,
+				"kernelModule.disabled":    false,
+				"kernelModule.blacklisted": false,
+			},
+		},
+		{
+			name:         "module installed with another command",
+			moduleName:   "udf",
+			condition:    `kernelModule.disabled`,
+			expectPassed: false,
+			expectData: event.Data{
+				"kernelModule.name":        "udf",
+				"kernelModule.loaded":      false,
+				"kernelModule.disabled":    false,
+				"kernelModule.blacklisted": false,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			env := &mocks.Env{}
+			env.On("NormalizeToHostRoot", mock.AnythingOfType("string")).Return(func(path string) string {
+				return filepath.Join("./testdata/kernel_module", path)
+			})
+
+			kernelModuleCheck, err := newResourceCheck(env, "rule-id", compliance.Resource{
+				ResourceCommon: compliance.ResourceCommon{
+					KernelModule: &compliance.KernelModule{
+						Name: test.moduleName,
+					},
+				},
+				Condition: test.condition,
+			})
+			assert.NoError(err)
+
+			reports := kernelModuleCheck.check(env)
+			assert.Equal(&compliance.Report{
+				Passed: test.expectPassed,
+				Data:   test.expectData,
+				Resource: compliance.ReportResource{
+					ID:   test.moduleName,
+					Type: "kernelModule",
+				},
+			}, reports[0])
+		})
+	}
+}
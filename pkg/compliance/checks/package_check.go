@@ -0,0 +1,377 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package checks
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/DataDog/datadog-agent/pkg/compliance"
+	"github.com/DataDog/datadog-agent/pkg/compliance/checks/env"
+	"github.com/DataDog/datadog-agent/pkg/compliance/eval"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+var packageReportedFields = []string{
+	compliance.PackageFieldName,
+	compliance.PackageFieldVersion,
+	compliance.PackageFieldInstalled,
+}
+
+const (
+	dpkgStatusPath   = "/var/lib/dpkg/status"
+	apkInstalledPath = "/lib/apk/db/installed"
+	rpmDatabasePath  = "/var/lib/rpm"
+)
+
+// ErrNoPackageDatabase is returned when none of the supported package databases is found on the host
+var ErrNoPackageDatabase = errors.New("no package database found")
+
+func resolvePackage(ctx context.Context, e env.Env, id string, res compliance.ResourceCommon, rego bool) (resolved, error) {
+	if res.Package == nil {
+		return nil, fmt.Errorf("%s: expecting package resource in package check", id)
+	}
+
+	pkg := res.Package
+
+	log.Debugf("%s: running package check: %s", id, pkg.Name)
+
+	version, installed, err := findPackageVersion(ctx, e, pkg.Name)
+	if err != nil {
+		return nil, wrapErrorWithID(id, err)
+	}
+
+	instance := eval.NewInstance(
+		eval.VarMap{
+			compliance.PackageFieldName:      pkg.Name,
+			compliance.PackageFieldVersion:   version,
+			compliance.PackageFieldInstalled: installed,
+		},
+		eval.FunctionMap{
+			compliance.PackageFuncVersionCompare: packageVersionCompare(version),
+		},
+		eval.RegoInputMap{
+			"name":      pkg.Name,
+			"version":   version,
+			"installed": installed,
+		},
+	)
+
+	return newResolvedInstance(instance, pkg.Name, "package"), nil
+}
+
+// findPackageVersion looks for the package in all the dpkg, apk and rpm databases of the host. When the package is
+// installed according to several databases, the version of the first one, in this order, is reported. The package is
+// reported as not installed only when all the existing databases could be read.
+func findPackageVersion(ctx context.Context, e env.Env, name string) (string, bool, error) {
+	databases := []struct {
+		path string
+		find func(path string) (string, bool, error)
+	}{
+		{
+			path: e.NormalizeToHostRoot(dpkgStatusPath),
+			find: func(path string) (string, bool, error) {
+				return findPackageInFile(path, name, findDpkgPackage)
+			},
+		},
+		{
+			path: e.NormalizeToHostRoot(apkInstalledPath),
+			find: func(path string) (string, bool, error) {
+				return findPackageInFile(path, name, findApkPackage)
+			},
+		},
+		{
+			path: e.NormalizeToHostRoot(rpmDatabasePath),
+			find: func(_ string) (string, bool, error) {
+				return findRpmPackage(ctx, e.NormalizeToHostRoot("/"), name)
+			},
+		},
+	}
+
+	found := false
+	var errs []string
+	for _, db := range databases {
+		if _, err := os.Stat(db.path); err != nil {
+			continue
+		}
+		found = true
+
+		version, installed, err := db.find(db.path)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		if installed {
+			return version, true, nil
+		}
+	}
+
+	if !found {
+		return "", false, ErrNoPackageDatabase
+	}
+	if len(errs) > 0 {
+		return "", false, errors.New(strings.Join(errs, ", "))
+	}
+
+	return "", false, nil
+}
+
+func findPackageInFile(path string, name string, find func(r io.Reader, name string) (string, bool, error)) (string, bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", false, err
+	}
+	defer f.Close()
+
+	return find(f, name)
+}
+
+// findDpkgPackage parses the paragraphs of the dpkg status file, only the packages in the `installed` state
+// are reported as installed
+func findDpkgPackage(r io.Reader, name string) (string, bool, error) {
+	var pkgName, version, status string
+
+	found := func() bool {
+		return pkgName == name && strings.HasSuffix(status, " installed")
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			if found() {
+				return version, true, nil
+			}
+			pkgName, version, status = "", "", ""
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(line, "Package: "):
+			pkgName = strings.TrimPrefix(line, "Package: ")
+		case strings.HasPrefix(line, "Version: "):
+			version = strings.TrimPrefix(line, "Version: ")
+		case strings.HasPrefix(line, "Status: "):
+			status = strings.TrimPrefix(line, "Status: ")
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return "", false, err
+	}
+
+	if found() {
+		return version, true, nil
+	}
+
+	return "", false, nil
+}
+
+// findApkPackage parses the apk database, made of paragraphs of `<field letter>:<value>` lines
+func findApkPackage(r io.Reader, name string) (string, bool, error) {
+	var pkgName, version string
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			if pkgName == name {
+				return version, true, nil
+			}
+			pkgName, version = "", ""
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(line, "P:"):
+			pkgName = line[2:]
+		case strings.HasPrefix(line, "V:"):
+			version = line[2:]
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return "", false, err
+	}
+
+	if pkgName == name {
+		return version, true, nil
+	}
+
+	return "", false, nil
+}
+
+// findRpmPackage queries the rpm database of the host with the rpm binary of the agent, which must support the
+// format of the database
+func findRpmPackage(ctx context.Context, root string, name string) (string, bool, error) {
+	cmd := exec.CommandContext(ctx, "rpm", "--root", root, "-q", "--queryformat", "%{EPOCH}:%{VERSION}-%{RELEASE}\\n", name)
+	output, err := cmd.Output()
+	if err != nil {
+		if errors.Is(err, exec.ErrNotFound) {
+			return "", false, errors.New("an rpm database was found but the rpm binary is missing")
+		}
+		// rpm exits with 1 when the package isn't installed, the errors reading the database being printed on stderr
+		if exitErr, ok := err.(*exec.ExitError); ok && exitErr.ExitCode() == 1 && len(exitErr.Stderr) == 0 {
+			return "", false, nil
+		}
+		return "", false, fmt.Errorf("failed to query the rpm database: %w", err)
+	}
+
+	version := latestRpmVersion(string(output))
+
+	return version, version != "", nil
+}
+
+// latestRpmVersion returns the latest of the versions output by the rpm query, one per line, several versions of a
+// package being installed at the same time for the kernel for example
+func latestRpmVersion(output string) string {
+	var latest string
+	for _, line := range strings.Split(output, "\n") {
+		version := strings.TrimPrefix(strings.TrimSpace(line), "(none):")
+		if version == "" {
+			continue
+		}
+		if latest == "" || compareVersions(version, latest) > 0 {
+			latest = version
+		}
+	}
+	return latest
+}
+
+func packageVersionCompare(version string) eval.Function {
+	return func(_ eval.Instance, args ...interface{}) (interface{}, error) {
+		if len(args) != 1 {
+			return nil, fmt.Errorf(`invalid number of arguments, expecting 1 got %d`, len(args))
+		}
+		other, ok := args[0].(string)
+		if !ok {
+			return nil, errors.New(`expecting string value for version argument`)
+		}
+		if version == "" {
+			return nil, errors.New(`package not installed`)
+		}
+		return compareVersions(version, other), nil
+	}
+}
+
+// compareVersions compares two package versions following the Debian policy, which is also a good approximation
+// of the rpm and apk ordering. It returns -1, 0 or 1 when a is older, equal or newer than b.
+func compareVersions(a, b string) int {
+	epochA, upstreamA, revisionA := splitVersion(a)
+	epochB, upstreamB, revisionB := splitVersion(b)
+
+	if epochA != epochB {
+		if epochA < epochB {
+			return -1
+		}
+		return 1
+	}
+
+	if cmp := compareVersionPart(upstreamA, upstreamB); cmp != 0 {
+		return cmp
+	}
+
+	return compareVersionPart(revisionA, revisionB)
+}
+
+func splitVersion(version string) (int, string, string) {
+	var epoch int
+	if i := strings.IndexByte(version, ':'); i != -1 {
+		if e, err := strconv.Atoi(version[:i]); err == nil {
+			epoch = e
+			version = version[i+1:]
+		}
+	}
+
+	if i := strings.LastIndexByte(version, '-'); i != -1 {
+		return epoch, version[:i], version[i+1:]
+	}
+
+	return epoch, version, ""
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// versionCharOrder orders the non digit characters: `~` first, then the end of the string, then the letters and
+// finally the other characters
+func versionCharOrder(s string, i int) int {
+	if i >= len(s) {
+		return 0
+	}
+
+	c := s[i]
+	switch {
+	case c == '~':
+		return -1
+	case isDigit(c):
+		return 0
+	case (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z'):
+		return int(c)
+	default:
+		return int(c) + 256
+	}
+}
+
+func compareVersionPart(a, b string) int {
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		// compare the non digit prefixes
+		for (i < len(a) && !isDigit(a[i])) || (j < len(b) && !isDigit(b[j])) {
+			orderA, orderB := versionCharOrder(a, i), versionCharOrder(b, j)
+			if orderA != orderB {
+				if orderA < orderB {
+					return -1
+				}
+				return 1
+			}
+			i++
+			j++
+		}
+
+		// then the numeric ones, skipping the leading zeros
+		for i < len(a) && a[i] == '0' {
+			i++
+		}
+		for j < len(b) && b[j] == '0' {
+			j++
+		}
+
+		firstDiff := 0
+		for i < len(a) && isDigit(a[i]) && j < len(b) && isDigit(b[j]) {
+			if firstDiff == 0 && a[i] != b[j] {
+				if a[i] < b[j] {
+					firstDiff = -1
+				} else {
+					firstDiff = 1
+				}
+			}
+			i++
+			j++
+		}
+
+		if i < len(a) && isDigit(a[i]) {
+			return 1
+		}
+		if j < len(b) && isDigit(b[j]) {
+			return -1
+		}
+		if firstDiff != 0 {
+			return firstDiff
+		}
+	}
+
+	return 0
+}
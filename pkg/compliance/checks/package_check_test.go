@@ -0,0 +1,224 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package checks
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/compliance"
+	"github.com/DataDog/datadog-agent/pkg/compliance/event"
+	"github.com/DataDog/datadog-agent/pkg/compliance/mocks"
+
+	"github.com/stretchr/testify/mock"
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestPackageCheck(t *testing.T) {
+	tests := []struct {
+		name     string
+		hostRoot string
+		resource compliance.Resource
+
+		expectReport *compliance.Report
+	}{
+		{
+			name:     "dpkg package up to date",
+			hostRoot: "./testdata/package/dpkg",
+			resource: compliance.Resource{
+				ResourceCommon: compliance.ResourceCommon{
+					Package: &compliance.Package{
+						Name: "openssl",
+					},
+				},
+				Condition: `package.installed && package.versionCompare("1.1.1d-0+deb10u5") >= 0`,
+			},
+
+			expectReport: &compliance.Report{
+				Passed: true,
+				Data: event.Data{
+					"package.name":      "openssl",
+					"package.version":   "1.1.1d-0+deb10u7",
+					"package.installed": true,
+				},
+				Resource: compliance.ReportResource{
+					ID:   "openssl",
+					Type: "package",
+				},
+			},
+		},
+		{
+			name:     "dpkg package removed",
+			hostRoot: "./testdata/package/dpkg",
+			resource: compliance.Resource{
+				ResourceCommon: compliance.ResourceCommon{
+					Package: &compliance.Package{
+						Name: "telnet",
+					},
+				},
+				Condition: `!package.installed`,
+			},
+
+			expectReport: &compliance.Report{
+				Passed: true,
+				Data: event.Data{
+					"package.name":      "telnet",
+					"package.version":   "",
+					"package.installed": false,
+				},
+				Resource: compliance.ReportResource{
+					ID:   "telnet",
+					Type: "package",
+				},
+			},
+		},
+		{
+			name:     "apk package outdated",
+			hostRoot: "./testdata/package/apk",
+			resource: compliance.Resource{
+				ResourceCommon: compliance.ResourceCommon{
+					Package: &compliance.Package{
+						Name: "openssl",
+					},
+				},
+				Condition: `package.versionCompare("1.1.1m-r0") >= 0`,
+			},
+
+			expectReport: &compliance.Report{
+				Passed: false,
+				Data: event.Data{
+					"package.name":      "openssl",
+					"package.version":   "1.1.1l-r0",
+					"package.installed": true,
+				},
+				Resource: compliance.ReportResource{
+					ID:   "openssl",
+					Type: "package",
+				},
+			},
+		},
+		{
+			name:     "package of the second database",
+			hostRoot: "./testdata/package/mixed",
+			resource: compliance.Resource{
+				ResourceCommon: compliance.ResourceCommon{
+					Package: &compliance.Package{
+						Name: "musl",
+					},
+				},
+				Condition: `package.installed`,
+			},
+
+			expectReport: &compliance.Report{
+				Passed: true,
+				Data: event.Data{
+					"package.name":      "musl",
+					"package.version":   "1.2.2-r3",
+					"package.installed": true,
+				},
+				Resource: compliance.ReportResource{
+					ID:   "musl",
+					Type: "package",
+				},
+			},
+		},
+		{
+			name:     "package of the first database",
+			hostRoot: "./testdata/package/mixed",
+			resource: compliance.Resource{
+				ResourceCommon: compliance.ResourceCommon{
+					Package: &compliance.Package{
+						Name: "openssl",
+					},
+				},
+				Condition: `package.installed`,
+			},
+
+			expectReport: &compliance.Report{
+				Passed: true,
+				Data: event.Data{
+					"package.name":      "openssl",
+					"package.version":   "1.1.1d-0+deb10u7",
+					"package.installed": true,
+				},
+				Resource: compliance.ReportResource{
+					ID:   "openssl",
+					Type: "package",
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			env := &mocks.Env{}
+			env.On("NormalizeToHostRoot", mock.AnythingOfType("string")).Return(func(path string) string {
+				return filepath.Join(test.hostRoot, path)
+			})
+
+			packageCheck, err := newResourceCheck(env, "rule-id", test.resource)
+			assert.NoError(err)
+
+			reports := packageCheck.check(env)
+			assert.Equal(test.expectReport, reports[0])
+		})
+	}
+}
+
+func TestPackageCheckNoDatabase(t *testing.T) {
+	assert := assert.New(t)
+
+	env := &mocks.Env{}
+	env.On("NormalizeToHostRoot", mock.AnythingOfType("string")).Return("./testdata/package/none")
+
+	packageCheck, err := newResourceCheck(env, "rule-id", compliance.Resource{
+		ResourceCommon: compliance.ResourceCommon{
+			Package: &compliance.Package{
+				Name: "openssl",
+			},
+		},
+		Condition: `package.installed`,
+	})
+	assert.NoError(err)
+
+	reports := packageCheck.check(env)
+	assert.Error(reports[0].Error)
+}
+
+func TestLatestRpmVersion(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("", latestRpmVersion(""))
+	assert.Equal("1.1.1k-5.el8_5", latestRpmVersion("(none):1.1.1k-5.el8_5\n"))
+	assert.Equal("4.18.0-348.el8", latestRpmVersion("(none):4.18.0-305.el8\n(none):4.18.0-348.el8\n(none):4.18.0-240.el8\n"))
+	assert.Equal("1:1.1.1k-5.el8_5", latestRpmVersion("1:1.1.1k-5.el8_5\n(none):1.1.1k-6.el8\n"))
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"1.0", "1.0", 0},
+		{"1.0", "1.1", -1},
+		{"1.10", "1.9", 1},
+		{"1.1.1d-0+deb10u7", "1.1.1d-0+deb10u5", 1},
+		{"1.1.1d", "1.1.1k", -1},
+		{"1.0~rc1", "1.0", -1},
+		{"1:0.9", "2.0", 1},
+		{"1.0-1", "1.0-10", -1},
+		{"1.2.2-r3", "1.2.2", 1},
+		{"2.0a", "2.0", 1},
+		{"2.0.1", "2.0a", 1},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, compareVersions(test.a, test.b), "%s <=> %s", test.a, test.b)
+		assert.Equal(t, -test.expected, compareVersions(test.b, test.a), "%s <=> %s", test.b, test.a)
+	}
+}
@@ -0,0 +1,519 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package checks
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/DataDog/datadog-agent/pkg/compliance"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+const (
+	// RootFSResourceType is the type of the resource reported for a root directory
+	RootFSResourceType = "rootfs"
+	// ImageResourceType is the type of the resource reported for a container image
+	ImageResourceType = "container_image"
+
+	ociLayoutFile         = "oci-layout"
+	ociIndexFile          = "index.json"
+	ociImageIndexMedia    = "application/vnd.oci.image.index.v1+json"
+	ociRefNameAnnotation  = "org.opencontainers.image.ref.name"
+	dockerManifestFile    = "manifest.json"
+	whiteoutPrefix        = ".wh."
+	whiteoutOpaqueDirName = ".wh..wh..opq"
+	maxOCIIndexDepth      = 4
+)
+
+// rootFSResourceKinds are the resource kinds that can be evaluated against a root filesystem, the other ones
+// requiring the live host
+var rootFSResourceKinds = map[compliance.ResourceKind]bool{
+	compliance.KindFile:      true,
+	compliance.KindPackage:   true,
+	compliance.KindConstants: true,
+}
+
+// RootFS is a root filesystem the checks are evaluated against instead of the live host. It is either a
+// directory or the unpacked layers of a container image.
+type RootFS struct {
+	// Path is the directory of the root filesystem
+	Path string
+	// Name identifies the root filesystem in the reports, the image reference or the path of the directory
+	Name string
+	// Type is the type of the resource reported
+	Type string
+
+	tempDir string
+}
+
+// Close removes the files unpacked from an archive or from an OCI layout
+func (r *RootFS) Close() error {
+	if r.tempDir == "" {
+		return nil
+	}
+	return removeAll(r.tempDir)
+}
+
+// OpenRootFS returns the root filesystem of a directory, of an OCI image layout or of a tar archive. The
+// archive contains either a root filesystem, an OCI image layout or an image saved with `docker save`.
+func OpenRootFS(path string) (*RootFS, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if fi.IsDir() {
+		if _, err := os.Stat(filepath.Join(path, ociLayoutFile)); err != nil {
+			return &RootFS{Path: path, Name: path, Type: RootFSResourceType}, nil
+		}
+
+		return unpackImage(path, path)
+	}
+
+	tempDir, err := ioutil.TempDir("", "compliance-rootfs")
+	if err != nil {
+		return nil, err
+	}
+
+	archiveDir := filepath.Join(tempDir, "archive")
+	if err := applyLayers([]string{path}, archiveDir); err != nil {
+		removeAll(tempDir)
+		return nil, fmt.Errorf("failed to extract %s: %w", path, err)
+	}
+
+	_, ociErr := os.Stat(filepath.Join(archiveDir, ociLayoutFile))
+	_, dockerErr := os.Stat(filepath.Join(archiveDir, dockerManifestFile))
+	if ociErr != nil && dockerErr != nil {
+		// the archive is a root filesystem
+		return &RootFS{Path: archiveDir, Name: path, Type: RootFSResourceType, tempDir: tempDir}, nil
+	}
+	defer removeAll(tempDir)
+
+	return unpackImage(archiveDir, path)
+}
+
+// unpackImage applies the layers of the image of an OCI layout or of a `docker save` directory
+func unpackImage(imageDir, defaultName string) (*RootFS, error) {
+	var (
+		name   string
+		layers []string
+		err    error
+	)
+
+	if _, statErr := os.Stat(filepath.Join(imageDir, ociLayoutFile)); statErr == nil {
+		name, layers, err = readOCILayers(imageDir)
+	} else {
+		name, layers, err = readDockerLayers(imageDir)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if name == "" {
+		name = defaultName
+	}
+
+	rootDir, err := ioutil.TempDir("", "compliance-image")
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("applying the %d layers of %s", len(layers), name)
+	if err := applyLayers(layers, rootDir); err != nil {
+		removeAll(rootDir)
+		return nil, err
+	}
+
+	return &RootFS{Path: rootDir, Name: name, Type: ImageResourceType, tempDir: rootDir}, nil
+}
+
+type ociDescriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	Platform    *struct {
+		Architecture string `json:"architecture"`
+		OS           string `json:"os"`
+	} `json:"platform,omitempty"`
+}
+
+type ociIndex struct {
+	Manifests []ociDescriptor `json:"manifests"`
+}
+
+type ociManifest struct {
+	Layers []ociDescriptor `json:"layers"`
+}
+
+func ociBlobPath(imageDir, digest string) (string, error) {
+	parts := strings.SplitN(digest, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || strings.ContainsAny(parts[1], `/\`) || parts[1] == ".." {
+		return "", fmt.Errorf("invalid digest `%s`", digest)
+	}
+	return filepath.Join(imageDir, "blobs", parts[0], parts[1]), nil
+}
+
+func readJSONFile(path string, v interface{}) error {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(content, v)
+}
+
+// selectOCIManifest returns the manifest of the platform of the agent, or the first one
+func selectOCIManifest(manifests []ociDescriptor) (ociDescriptor, error) {
+	if len(manifests) == 0 {
+		return ociDescriptor{}, errors.New("no manifest found in the image index")
+	}
+
+	for _, manifest := range manifests {
+		if manifest.Platform != nil && manifest.Platform.OS == "linux" && manifest.Platform.Architecture == runtime.GOARCH {
+			return manifest, nil
+		}
+	}
+
+	return manifests[0], nil
+}
+
+// readOCILayers returns the reference and the layers of the image of an OCI layout
+func readOCILayers(imageDir string) (string, []string, error) {
+	var index ociIndex
+	if err := readJSONFile(filepath.Join(imageDir, ociIndexFile), &index); err != nil {
+		return "", nil, err
+	}
+
+	manifest, err := selectOCIManifest(index.Manifests)
+	if err != nil {
+		return "", nil, err
+	}
+	name := manifest.Annotations[ociRefNameAnnotation]
+
+	// multi-platform images reference a nested index
+	for depth := 0; manifest.MediaType == ociImageIndexMedia; depth++ {
+		if depth == maxOCIIndexDepth {
+			return "", nil, errors.New("too many nested image indexes")
+		}
+
+		path, err := ociBlobPath(imageDir, manifest.Digest)
+		if err != nil {
+			return "", nil, err
+		}
+
+		var nested ociIndex
+		if err := readJSONFile(path, &nested); err != nil {
+			return "", nil, err
+		}
+
+		if manifest, err = selectOCIManifest(nested.Manifests); err != nil {
+			return "", nil, err
+		}
+	}
+
+	path, err := ociBlobPath(imageDir, manifest.Digest)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var imageManifest ociManifest
+	if err := readJSONFile(path, &imageManifest); err != nil {
+		return "", nil, err
+	}
+
+	var layers []string
+	for _, layer := range imageManifest.Layers {
+		path, err := ociBlobPath(imageDir, layer.Digest)
+		if err != nil {
+			return "", nil, err
+		}
+		layers = append(layers, path)
+	}
+
+	return name, layers, nil
+}
+
+type dockerManifest struct {
+	RepoTags []string `json:"RepoTags"`
+	Layers   []string `json:"Layers"`
+}
+
+// readDockerLayers returns the first tag and the layers of the first image saved with `docker save`
+func readDockerLayers(imageDir string) (string, []string, error) {
+	var manifests []dockerManifest
+	if err := readJSONFile(filepath.Join(imageDir, dockerManifestFile), &manifests); err != nil {
+		return "", nil, err
+	}
+
+	if len(manifests) == 0 {
+		return "", nil, errors.New("no image found in the docker manifest")
+	}
+
+	var name string
+	if len(manifests[0].RepoTags) > 0 {
+		name = manifests[0].RepoTags[0]
+	}
+
+	var layers []string
+	for _, layer := range manifests[0].Layers {
+		path, err := securePath(imageDir, layer)
+		if err != nil {
+			return "", nil, err
+		}
+		layers = append(layers, path)
+	}
+
+	return name, layers, nil
+}
+
+// openTar opens a tar archive, compressed with gzip or not
+func openTar(path string) (*tar.Reader, io.Closer, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	br := bufio.NewReader(f)
+	magic, err := br.Peek(2)
+	if err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(br)
+		if err != nil {
+			f.Close()
+			return nil, nil, err
+		}
+		return tar.NewReader(gz), f, nil
+	}
+
+	return tar.NewReader(br), f, nil
+}
+
+// securePath returns the path of an archive entry under the root directory, failing if the entry escapes
+// the root directory through `..` or a symbolic link
+func securePath(root, name string) (string, error) {
+	cleaned := filepath.Clean(string(os.PathSeparator) + name)
+	if cleaned == string(os.PathSeparator) {
+		return root, nil
+	}
+
+	path := root
+	components := strings.Split(strings.TrimPrefix(cleaned, string(os.PathSeparator)), string(os.PathSeparator))
+	for i, component := range components {
+		path = filepath.Join(path, component)
+		if i == len(components)-1 {
+			break
+		}
+
+		if fi, err := os.Lstat(path); err == nil && fi.Mode()&os.ModeSymlink != 0 {
+			return "", fmt.Errorf("entry `%s` is located under a symbolic link", name)
+		}
+	}
+
+	return path, nil
+}
+
+// applyLayers extracts the layers, in order, in the root directory. The directories are kept writable until
+// all the layers are extracted.
+func applyLayers(layers []string, root string) error {
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return err
+	}
+
+	dirModes := make(map[string]os.FileMode)
+	for _, layer := range layers {
+		if err := applyLayer(layer, root, dirModes); err != nil {
+			return fmt.Errorf("failed to apply layer %s: %w", layer, err)
+		}
+	}
+
+	for dir, mode := range dirModes {
+		if err := os.Chmod(dir, mode); err != nil && !os.IsNotExist(err) {
+			log.Debugf("failed to set the permissions of %s: %v", dir, err)
+		}
+	}
+
+	return nil
+}
+
+// applyLayer extracts a layer in the root directory, processing the whiteout files which remove the files of
+// the previous layers. The permissions of the directories are stored in dirModes to be applied once all the
+// layers are extracted.
+func applyLayer(layer, root string, dirModes map[string]os.FileMode) error {
+	tr, closer, err := openTar(layer)
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+
+	// the paths extracted from this layer, and their parent directories, which the opaque whiteouts keep
+	written := make(map[string]bool)
+
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		path, err := securePath(root, hdr.Name)
+		if err != nil {
+			log.Debugf("skipping %s: %v", hdr.Name, err)
+			continue
+		}
+		if path == root {
+			continue
+		}
+
+		base := filepath.Base(path)
+		dir := filepath.Dir(path)
+
+		if base == whiteoutOpaqueDirName {
+			if err := removeDirContent(dir, written); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if strings.HasPrefix(base, whiteoutPrefix) {
+			name := strings.TrimPrefix(base, whiteoutPrefix)
+			if name == "" || name == "." || name == ".." || strings.ContainsRune(name, os.PathSeparator) {
+				log.Debugf("skipping %s: invalid whiteout file", hdr.Name)
+				continue
+			}
+
+			target, err := securePath(root, filepath.Join(filepath.Dir(hdr.Name), name))
+			if err != nil || target == root || !strings.HasPrefix(target, root+string(os.PathSeparator)) {
+				log.Debugf("skipping %s: whiteout file outside of the root directory", hdr.Name)
+				continue
+			}
+
+			if err := removeAll(target); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return err
+		}
+
+		if err := extractEntry(tr, hdr, root, path, dirModes); err != nil {
+			return fmt.Errorf("failed to extract %s: %w", hdr.Name, err)
+		}
+
+		for p := path; p != root && !written[p]; p = filepath.Dir(p) {
+			written[p] = true
+		}
+	}
+}
+
+func extractEntry(tr *tar.Reader, hdr *tar.Header, root, path string, dirModes map[string]os.FileMode) error {
+	mode := os.FileMode(hdr.Mode) & os.ModePerm
+
+	// an entry replaces the file of a previous layer, unless both are directories
+	if fi, err := os.Lstat(path); err == nil && !(fi.IsDir() && hdr.Typeflag == tar.TypeDir) {
+		if err := removeAll(path); err != nil {
+			return err
+		}
+	}
+
+	switch hdr.Typeflag {
+	case tar.TypeDir:
+		if err := os.MkdirAll(path, 0755); err != nil {
+			return err
+		}
+		dirModes[path] = mode
+	case tar.TypeReg, tar.TypeRegA:
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(f, tr); err != nil {
+			f.Close()
+			return err
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+		if err := os.Chmod(path, mode); err != nil {
+			return err
+		}
+	case tar.TypeSymlink:
+		if err := os.Symlink(hdr.Linkname, path); err != nil {
+			return err
+		}
+	case tar.TypeLink:
+		target, err := securePath(root, hdr.Linkname)
+		if err != nil {
+			return err
+		}
+		if err := os.Link(target, path); err != nil {
+			return err
+		}
+	default:
+		// devices and fifos aren't needed by the checks
+		return nil
+	}
+
+	// the owners can only be set when running as root
+	if err := os.Lchown(path, hdr.Uid, hdr.Gid); err != nil {
+		log.Tracef("failed to set the owner of %s: %v", path, err)
+	}
+
+	return nil
+}
+
+// removeDirContent removes the content of a directory, except the paths in keep. The directories in keep are
+// emptied of the paths that aren't in keep.
+func removeDirContent(dir string, keep map[string]bool) error {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	for _, entry := range entries {
+		path := filepath.Join(dir, entry.Name())
+		if keep[path] {
+			if entry.IsDir() {
+				if err := removeDirContent(path, keep); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if err := removeAll(path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeAll removes a path, including the directories without write permission extracted from the layers
+func removeAll(path string) error {
+	_ = filepath.Walk(path, func(p string, info os.FileInfo, err error) error {
+		if err == nil && info.IsDir() {
+			_ = os.Chmod(p, 0700)
+		}
+		return nil
+	})
+	return os.RemoveAll(path)
+}
@@ -0,0 +1,409 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package checks
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/compliance"
+	"github.com/DataDog/datadog-agent/pkg/compliance/event"
+	"github.com/DataDog/datadog-agent/pkg/compliance/mocks"
+
+	"github.com/stretchr/testify/mock"
+	assert "github.com/stretchr/testify/require"
+)
+
+type tarEntry struct {
+	name     string
+	typeflag byte
+	mode     int64
+	content  string
+	linkname string
+}
+
+func buildTar(t *testing.T, entries []tarEntry, compress bool) []byte {
+	var buf bytes.Buffer
+
+	var tw *tar.Writer
+	var gz *gzip.Writer
+	if compress {
+		gz = gzip.NewWriter(&buf)
+		tw = tar.NewWriter(gz)
+	} else {
+		tw = tar.NewWriter(&buf)
+	}
+
+	for _, entry := range entries {
+		hdr := &tar.Header{
+			Name:     entry.name,
+			Typeflag: entry.typeflag,
+			Mode:     entry.mode,
+			Size:     int64(len(entry.content)),
+			Linkname: entry.linkname,
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(entry.content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if gz != nil {
+		if err := gz.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return buf.Bytes()
+}
+
+var (
+	testBaseLayer = []tarEntry{
+		{name: "etc/", typeflag: tar.TypeDir, mode: 0755},
+		{name: "etc/passwd", typeflag: tar.TypeReg, mode: 0644, content: "root:x:0:0:root:/root:/bin/sh\n"},
+		{name: "etc/shadow", typeflag: tar.TypeReg, mode: 0600, content: "root:*:18000:0:99999:7:::\n"},
+		{name: "etc/ssl/", typeflag: tar.TypeDir, mode: 0755},
+		{name: "etc/ssl/cert.pem", typeflag: tar.TypeReg, mode: 0644, content: "cert"},
+		{name: "usr/", typeflag: tar.TypeDir, mode: 0555},
+		{name: "usr/bin/", typeflag: tar.TypeDir, mode: 0755},
+		{name: "usr/bin/tool", typeflag: tar.TypeReg, mode: 0755, content: "#!/bin/sh\n"},
+		{name: "bin", typeflag: tar.TypeSymlink, linkname: "usr/bin"},
+		{name: "../../escape", typeflag: tar.TypeReg, mode: 0644, content: "escaped"},
+	}
+
+	testUpperLayer = []tarEntry{
+		{name: "etc/.wh.shadow", typeflag: tar.TypeReg},
+		{name: "etc/ssl/", typeflag: tar.TypeDir, mode: 0755},
+		{name: "etc/ssl/ca.pem", typeflag: tar.TypeReg, mode: 0644, content: "ca"},
+		{name: "etc/ssl/.wh..wh..opq", typeflag: tar.TypeReg},
+		{name: "etc/ssl/openssl.cnf", typeflag: tar.TypeReg, mode: 0600, content: "[openssl]"},
+		{name: "etc/passwd", typeflag: tar.TypeReg, mode: 0640, content: "root:x:0:0:root:/root:/bin/bash\n"},
+		{name: "bin/evil", typeflag: tar.TypeReg, mode: 0644, content: "written through a symlink"},
+	}
+)
+
+func assertUnpackedImage(t *testing.T, root string) {
+	assert := assert.New(t)
+
+	content, err := ioutil.ReadFile(filepath.Join(root, "etc/passwd"))
+	assert.NoError(err)
+	assert.Equal("root:x:0:0:root:/root:/bin/bash\n", string(content))
+
+	fi, err := os.Stat(filepath.Join(root, "etc/passwd"))
+	assert.NoError(err)
+	assert.Equal(os.FileMode(0640), fi.Mode().Perm())
+
+	_, err = os.Stat(filepath.Join(root, "etc/shadow"))
+	assert.True(os.IsNotExist(err))
+
+	_, err = os.Stat(filepath.Join(root, "etc/ssl/cert.pem"))
+	assert.True(os.IsNotExist(err))
+
+	_, err = os.Stat(filepath.Join(root, "etc/ssl/openssl.cnf"))
+	assert.NoError(err)
+
+	// the opaque whiteout only hides the files of the previous layers
+	_, err = os.Stat(filepath.Join(root, "etc/ssl/ca.pem"))
+	assert.NoError(err)
+
+	fi, err = os.Stat(filepath.Join(root, "usr"))
+	assert.NoError(err)
+	assert.Equal(os.FileMode(0555), fi.Mode().Perm())
+
+	// the entries escaping the root directory are skipped
+	_, err = os.Stat(filepath.Join(root, "usr/bin/evil"))
+	assert.True(os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(filepath.Dir(filepath.Dir(root)), "escape"))
+	assert.True(os.IsNotExist(err))
+}
+
+func TestOpenRootFSDockerArchive(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rootfs-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	manifest, _ := json.Marshal([]dockerManifest{{
+		RepoTags: []string{"myapp:1.0"},
+		Layers:   []string{"base/layer.tar", "upper/layer.tar"},
+	}})
+
+	archive := filepath.Join(dir, "image.tar")
+	err = ioutil.WriteFile(archive, buildTar(t, []tarEntry{
+		{name: "manifest.json", typeflag: tar.TypeReg, mode: 0644, content: string(manifest)},
+		{name: "base/layer.tar", typeflag: tar.TypeReg, mode: 0644, content: string(buildTar(t, testBaseLayer, false))},
+		{name: "upper/layer.tar", typeflag: tar.TypeReg, mode: 0644, content: string(buildTar(t, testUpperLayer, false))},
+	}, false), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rootFS, err := OpenRootFS(archive)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "myapp:1.0", rootFS.Name)
+	assert.Equal(t, ImageResourceType, rootFS.Type)
+	assertUnpackedImage(t, rootFS.Path)
+
+	assert.NoError(t, rootFS.Close())
+	_, err = os.Stat(rootFS.Path)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func writeOCIBlob(t *testing.T, dir string, content []byte) string {
+	digest := fmt.Sprintf("%x", sha256.Sum256(content))
+	if err := os.MkdirAll(filepath.Join(dir, "blobs", "sha256"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "blobs", "sha256", digest), content, 0644); err != nil {
+		t.Fatal(err)
+	}
+	return "sha256:" + digest
+}
+
+func TestOpenRootFSOCILayout(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rootfs-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	baseDigest := writeOCIBlob(t, dir, buildTar(t, testBaseLayer, true))
+	upperDigest := writeOCIBlob(t, dir, buildTar(t, testUpperLayer, true))
+
+	manifest, _ := json.Marshal(ociManifest{
+		Layers: []ociDescriptor{{Digest: baseDigest}, {Digest: upperDigest}},
+	})
+	manifestDigest := writeOCIBlob(t, dir, manifest)
+
+	index, _ := json.Marshal(ociIndex{
+		Manifests: []ociDescriptor{{
+			MediaType:   "application/vnd.oci.image.manifest.v1+json",
+			Digest:      manifestDigest,
+			Annotations: map[string]string{ociRefNameAnnotation: "myapp:2.0"},
+		}},
+	})
+	if err := ioutil.WriteFile(filepath.Join(dir, ociIndexFile), index, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, ociLayoutFile), []byte(`{"imageLayoutVersion":"1.0.0"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	rootFS, err := OpenRootFS(dir)
+	assert.NoError(t, err)
+	defer rootFS.Close()
+
+	assert.Equal(t, "myapp:2.0", rootFS.Name)
+	assert.Equal(t, ImageResourceType, rootFS.Type)
+	assertUnpackedImage(t, rootFS.Path)
+}
+
+func TestOpenRootFSDirectory(t *testing.T) {
+	rootFS, err := OpenRootFS("./testdata/package/dpkg")
+	assert.NoError(t, err)
+
+	assert.Equal(t, "./testdata/package/dpkg", rootFS.Path)
+	assert.Equal(t, RootFSResourceType, rootFS.Type)
+
+	// the directory isn't removed
+	assert.NoError(t, rootFS.Close())
+	_, err = os.Stat(rootFS.Path)
+	assert.NoError(t, err)
+}
+
+func TestApplyLayersInvalidWhiteouts(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "rootfs-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	sibling := filepath.Join(dir, "sibling")
+	assert.NoError(ioutil.WriteFile(sibling, []byte("sibling"), 0600))
+
+	layer := filepath.Join(dir, "layer.tar")
+	assert.NoError(ioutil.WriteFile(layer, buildTar(t, []tarEntry{
+		{name: "etc/", typeflag: tar.TypeDir, mode: 0755},
+		{name: "etc/passwd", typeflag: tar.TypeReg, mode: 0644, content: "root:x:0:0:root:/root:/bin/sh\n"},
+		{name: ".wh...", typeflag: tar.TypeReg},
+		{name: ".wh..", typeflag: tar.TypeReg},
+		{name: "etc/.wh...", typeflag: tar.TypeReg},
+		{name: "etc/.wh..", typeflag: tar.TypeReg},
+	}, false), 0600))
+
+	root := filepath.Join(dir, "root")
+	assert.NoError(applyLayers([]string{layer}, root))
+
+	_, err = os.Stat(sibling)
+	assert.NoError(err)
+	_, err = os.Stat(filepath.Join(root, "etc/passwd"))
+	assert.NoError(err)
+}
+
+const testRootFSSuite = `schema:
+  version: 1.0.0
+name: CIS Docker Generic
+framework: cis-docker
+version: 1.2.0
+rules:
+- id: file-rule
+  scope:
+    - docker
+  resources:
+    - file:
+        path: /etc/passwd
+      condition: file.permissions == 0644
+- id: package-rule
+  scope:
+    - docker
+  resources:
+    - package:
+        name: openssl
+      condition: package.versionCompare("1.1.1d") >= 0
+- id: process-rule
+  scope:
+    - docker
+  resources:
+    - process:
+        name: dockerd
+      condition: process.flag("--icc") == "false"
+`
+
+func TestBuilderWithRootFS(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "rootfs-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for path, content := range map[string]string{
+		"rootfs/etc/passwd":          "root:x:0:0:root:/root:/bin/sh\n",
+		"rootfs/var/lib/dpkg/status": "Package: openssl\nStatus: install ok installed\nVersion: 1.1.1d-0+deb10u7\n",
+		"suite.yaml":                 testRootFSSuite,
+	} {
+		path = filepath.Join(dir, path)
+		assert.NoError(os.MkdirAll(filepath.Dir(path), 0755))
+		assert.NoError(ioutil.WriteFile(path, []byte(content), 0600))
+	}
+
+	var events []*event.Event
+	reporter := &mocks.Reporter{}
+	reporter.On("Report", mock.Anything).Run(func(args mock.Arguments) {
+		events = append(events, args.Get(0).(*event.Event))
+	})
+
+	rootFS, err := OpenRootFS(filepath.Join(dir, "rootfs"))
+	assert.NoError(err)
+	defer rootFS.Close()
+
+	builder, err := NewBuilder(reporter, WithRootFS(rootFS))
+	assert.NoError(err)
+
+	var skipped []string
+	err = builder.ChecksFromFile(filepath.Join(dir, "suite.yaml"), func(rule *compliance.RuleCommon, check compliance.Check, err error) bool {
+		if err != nil {
+			assert.Equal(ErrRuleDoesNotApply, err)
+			skipped = append(skipped, rule.ID)
+			return true
+		}
+		assert.NoError(check.Run())
+		return true
+	})
+	assert.NoError(err)
+
+	assert.Equal([]string{"process-rule"}, skipped)
+	assert.Len(events, 2)
+
+	assert.Equal("file-rule", events[0].AgentRuleID)
+	assert.Equal(event.Failed, events[0].Result)
+	assert.Equal(filepath.Join(dir, "rootfs"), events[0].ResourceID)
+	assert.Equal(RootFSResourceType, events[0].ResourceType)
+	assert.Equal("/etc/passwd", events[0].Data.(event.Data)[compliance.FileFieldPath])
+
+	assert.Equal("package-rule", events[1].AgentRuleID)
+	assert.Equal(event.Passed, events[1].Result)
+}
+
+const testRootFSGlobSuite = `schema:
+  version: 1.0.0
+name: CIS Docker Generic
+framework: cis-docker
+version: 1.2.0
+rules:
+- id: glob-rule
+  scope:
+    - docker
+  resources:
+    - file:
+        path: /etc/ssh/sshd_config.d/*.conf
+      condition: file.content == "image"
+`
+
+func TestBuilderWithRootFSGlobSymlinks(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "rootfs-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// the absolute symbolic link targets a file of the host, which also exists in the root filesystem
+	hostFile := filepath.Join(dir, "shadow")
+	for path, content := range map[string]string{
+		hostFile:                               "host",
+		filepath.Join(dir, "rootfs", hostFile): "image",
+		filepath.Join(dir, "suite.yaml"):       testRootFSGlobSuite,
+	} {
+		assert.NoError(os.MkdirAll(filepath.Dir(path), 0755))
+		assert.NoError(ioutil.WriteFile(path, []byte(content), 0600))
+	}
+	assert.NoError(os.MkdirAll(filepath.Join(dir, "rootfs/etc/ssh/sshd_config.d"), 0755))
+	assert.NoError(os.Symlink(hostFile, filepath.Join(dir, "rootfs/etc/ssh/sshd_config.d/x.conf")))
+
+	var events []*event.Event
+	reporter := &mocks.Reporter{}
+	reporter.On("Report", mock.Anything).Run(func(args mock.Arguments) {
+		events = append(events, args.Get(0).(*event.Event))
+	})
+
+	rootFS, err := OpenRootFS(filepath.Join(dir, "rootfs"))
+	assert.NoError(err)
+	defer rootFS.Close()
+
+	builder, err := NewBuilder(reporter, WithRootFS(rootFS))
+	assert.NoError(err)
+
+	err = builder.ChecksFromFile(filepath.Join(dir, "suite.yaml"), func(rule *compliance.RuleCommon, check compliance.Check, err error) bool {
+		assert.NoError(err)
+		assert.NoError(check.Run())
+		return true
+	})
+	assert.NoError(err)
+
+	assert.Len(events, 1)
+	assert.Equal(event.Passed, events[0].Result)
+	assert.Equal("/etc/ssh/sshd_config.d/x.conf", events[0].Data.(event.Data)[compliance.FileFieldPath])
+}
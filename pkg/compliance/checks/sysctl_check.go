@@ -0,0 +1,64 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package checks
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/DataDog/datadog-agent/pkg/compliance"
+	"github.com/DataDog/datadog-agent/pkg/compliance/checks/env"
+	"github.com/DataDog/datadog-agent/pkg/compliance/eval"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+var sysctlReportedFields = []string{
+	compliance.SysctlFieldName,
+	compliance.SysctlFieldValue,
+}
+
+const procSysPath = "/proc/sys"
+
+func resolveSysctl(_ context.Context, e env.Env, id string, res compliance.ResourceCommon, rego bool) (resolved, error) {
+	if res.Sysctl == nil {
+		return nil, fmt.Errorf("%s: expecting sysctl resource in sysctl check", id)
+	}
+
+	sysctl := res.Sysctl
+
+	log.Debugf("%s: running sysctl check: %s", id, sysctl.Name)
+
+	// the dots of the parameter name separate the directories of /proc/sys
+	path := filepath.Join(procSysPath, strings.ReplaceAll(sysctl.Name, ".", "/"))
+
+	content, err := ioutil.ReadFile(e.NormalizeToHostRoot(path))
+	if err != nil {
+		if rego {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%s: failed to read kernel parameter %s: %w", id, sysctl.Name, err)
+	}
+
+	// multiple values are separated by tabs, as displayed by sysctl
+	value := strings.Join(strings.Fields(string(content)), " ")
+
+	instance := eval.NewInstance(
+		eval.VarMap{
+			compliance.SysctlFieldName:  sysctl.Name,
+			compliance.SysctlFieldValue: value,
+		},
+		nil,
+		eval.RegoInputMap{
+			"name":  sysctl.Name,
+			"value": value,
+		},
+	)
+
+	return newResolvedInstance(instance, sysctl.Name, "sysctl"), nil
+}
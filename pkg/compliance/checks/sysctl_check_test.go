@@ -0,0 +1,108 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package checks
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/compliance"
+	"github.com/DataDog/datadog-agent/pkg/compliance/event"
+	"github.com/DataDog/datadog-agent/pkg/compliance/mocks"
+
+	"github.com/stretchr/testify/mock"
+	assert "github.com/stretchr/testify/require"
+)
+
+func TestSysctlCheck(t *testing.T) {
+	tests := []struct {
+		name     string
+		resource compliance.Resource
+
+		expectReport *compliance.Report
+		expectError  bool
+	}{
+		{
+			name: "ip forwarding disabled",
+			resource: compliance.Resource{
+				ResourceCommon: compliance.ResourceCommon{
+					Sysctl: &compliance.Sysctl{
+						Name: "net.ipv4.ip_forward",
+					},
+				},
+				Condition: `sysctl.value == "0"`,
+			},
+
+			expectReport: &compliance.Report{
+				Passed: true,
+				Data: event.Data{
+					"sysctl.name":  "net.ipv4.ip_forward",
+					"sysctl.value": "0",
+				},
+				Resource: compliance.ReportResource{
+					ID:   "net.ipv4.ip_forward",
+					Type: "sysctl",
+				},
+			},
+		},
+		{
+			name: "multiple values",
+			resource: compliance.Resource{
+				ResourceCommon: compliance.ResourceCommon{
+					Sysctl: &compliance.Sysctl{
+						Name: "net.ipv4.tcp_rmem",
+					},
+				},
+				Condition: `sysctl.value == "4096 87380 4194304"`,
+			},
+
+			expectReport: &compliance.Report{
+				Passed: false,
+				Data: event.Data{
+					"sysctl.name":  "net.ipv4.tcp_rmem",
+					"sysctl.value": "4096 87380 6291456",
+				},
+				Resource: compliance.ReportResource{
+					ID:   "net.ipv4.tcp_rmem",
+					Type: "sysctl",
+				},
+			},
+		},
+		{
+			name: "unknown parameter",
+			resource: compliance.Resource{
+				ResourceCommon: compliance.ResourceCommon{
+					Sysctl: &compliance.Sysctl{
+						Name: "net.ipv4.unknown",
+					},
+				},
+				Condition: `sysctl.value == "0"`,
+			},
+			expectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			env := &mocks.Env{}
+			env.On("NormalizeToHostRoot", mock.AnythingOfType("string")).Return(func(path string) string {
+				return filepath.Join("./testdata/sysctl", path)
+			})
+
+			sysctlCheck, err := newResourceCheck(env, "rule-id", test.resource)
+			assert.NoError(err)
+
+			reports := sysctlCheck.check(env)
+			if test.expectError {
+				assert.Error(reports[0].Error)
+				return
+			}
+			assert.Equal(test.expectReport, reports[0])
+		})
+	}
+}
@@ -0,0 +1,345 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package event
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// Formats of the exported events
+const (
+	// FormatJSON is used to export the events along with a summary of the results
+	FormatJSON = "json"
+	// FormatSARIF is used to export the events as a SARIF 2.1.0 log
+	FormatSARIF = "sarif"
+	// FormatJUnit is used to export the events as a JUnit XML report, one test case per rule and resource
+	FormatJUnit = "junit"
+)
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+	toolName     = "datadog-security-agent"
+	toolURI      = "https://docs.datadoghq.com/security_platform/"
+)
+
+// Summary counts the events per result
+type Summary struct {
+	Passed int `json:"passed"`
+	Failed int `json:"failed"`
+	Error  int `json:"error"`
+}
+
+// ExportedEvents describes the events exported in JSON
+type ExportedEvents struct {
+	Summary Summary  `json:"summary"`
+	Events  []*Event `json:"events"`
+}
+
+// Export writes the events in the given format
+func Export(w io.Writer, format string, events []*Event) error {
+	events = sortEvents(events)
+
+	switch format {
+	case FormatJSON:
+		return exportJSON(w, events)
+	case FormatSARIF:
+		return exportSARIF(w, events)
+	case FormatJUnit:
+		return exportJUnit(w, events)
+	default:
+		return fmt.Errorf("unknown export format `%s`", format)
+	}
+}
+
+// sortEvents returns the events sorted by framework, rule and resource so that the reports can be compared
+func sortEvents(events []*Event) []*Event {
+	sorted := make([]*Event, len(events))
+	copy(sorted, events)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if a.AgentFrameworkID != b.AgentFrameworkID {
+			return a.AgentFrameworkID < b.AgentFrameworkID
+		}
+		if a.AgentRuleID != b.AgentRuleID {
+			return a.AgentRuleID < b.AgentRuleID
+		}
+		if a.ResourceType != b.ResourceType {
+			return a.ResourceType < b.ResourceType
+		}
+		return a.ResourceID < b.ResourceID
+	})
+
+	return sorted
+}
+
+func summarize(events []*Event) Summary {
+	var summary Summary
+	for _, event := range events {
+		switch event.Result {
+		case Passed:
+			summary.Passed++
+		case Failed:
+			summary.Failed++
+		default:
+			summary.Error++
+		}
+	}
+	return summary
+}
+
+func agentVersion(events []*Event) string {
+	for _, event := range events {
+		if event.AgentVersion != "" {
+			return event.AgentVersion
+		}
+	}
+	return ""
+}
+
+// eventError returns the error message of an event whose result is an error
+func eventError(event *Event) string {
+	if data, ok := event.Data.(Data); ok {
+		if err, ok := data["error"].(string); ok {
+			return err
+		}
+	}
+	return "unknown error"
+}
+
+func exportJSON(w io.Writer, events []*Event) error {
+	if events == nil {
+		events = []*Event{}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(ExportedEvents{
+		Summary: summarize(events),
+		Events:  events,
+	})
+}
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version,omitempty"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID         string                 `json:"id"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLogicalLocation struct {
+	Name string `json:"name"`
+	Kind string `json:"kind,omitempty"`
+}
+
+type sarifLocation struct {
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations"`
+}
+
+type sarifResult struct {
+	RuleID     string                 `json:"ruleId"`
+	RuleIndex  int                    `json:"ruleIndex"`
+	Kind       string                 `json:"kind"`
+	Level      string                 `json:"level"`
+	Message    sarifMessage           `json:"message"`
+	Locations  []sarifLocation        `json:"locations,omitempty"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
+}
+
+func exportSARIF(w io.Writer, events []*Event) error {
+	driver := sarifDriver{
+		Name:           toolName,
+		Version:        agentVersion(events),
+		InformationURI: toolURI,
+		Rules:          []sarifRule{},
+	}
+
+	ruleIndexes := make(map[string]int)
+	results := []sarifResult{}
+
+	for _, event := range events {
+		ruleIndex, found := ruleIndexes[event.AgentRuleID]
+		if !found {
+			ruleIndex = len(driver.Rules)
+			ruleIndexes[event.AgentRuleID] = ruleIndex
+
+			rule := sarifRule{ID: event.AgentRuleID}
+			if event.AgentFrameworkID != "" {
+				rule.Properties = map[string]interface{}{"framework": event.AgentFrameworkID}
+			}
+			driver.Rules = append(driver.Rules, rule)
+		}
+
+		// the level of the results which aren't failures has to be `none`
+		result := sarifResult{
+			RuleID:    event.AgentRuleID,
+			RuleIndex: ruleIndex,
+			Kind:      "pass",
+			Level:     "none",
+			Properties: map[string]interface{}{
+				"result":   event.Result,
+				"evidence": event.Data,
+			},
+		}
+
+		switch event.Result {
+		case Passed:
+			result.Message.Text = fmt.Sprintf("%s passed on %s %s", event.AgentRuleID, event.ResourceType, event.ResourceID)
+		case Failed:
+			result.Kind = "fail"
+			result.Level = "error"
+			result.Message.Text = fmt.Sprintf("%s failed on %s %s", event.AgentRuleID, event.ResourceType, event.ResourceID)
+		default:
+			result.Kind = "fail"
+			result.Level = "warning"
+			result.Message.Text = fmt.Sprintf("%s could not be evaluated: %s", event.AgentRuleID, eventError(event))
+		}
+
+		if event.ResourceID != "" {
+			result.Locations = []sarifLocation{{
+				LogicalLocations: []sarifLogicalLocation{{
+					Name: event.ResourceID,
+					Kind: event.ResourceType,
+				}},
+			}}
+		}
+
+		results = append(results, result)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []sarifRun{{
+			Tool:    sarifTool{Driver: driver},
+			Results: results,
+		}},
+	})
+}
+
+type junitTestSuites struct {
+	XMLName    xml.Name         `xml:"testsuites"`
+	Name       string           `xml:"name,attr"`
+	Tests      int              `xml:"tests,attr"`
+	Failures   int              `xml:"failures,attr"`
+	Errors     int              `xml:"errors,attr"`
+	TestSuites []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Errors    int             `xml:"errors,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr,omitempty"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+func exportJUnit(w io.Writer, events []*Event) error {
+	summary := summarize(events)
+	suites := junitTestSuites{
+		Name:     toolName,
+		Tests:    len(events),
+		Failures: summary.Failed,
+		Errors:   summary.Error,
+	}
+
+	// the events are sorted by framework, so that each suite is made of consecutive events
+	for _, event := range events {
+		framework := event.AgentFrameworkID
+		if len(suites.TestSuites) == 0 || suites.TestSuites[len(suites.TestSuites)-1].Name != framework {
+			suites.TestSuites = append(suites.TestSuites, junitTestSuite{Name: framework})
+		}
+		suite := &suites.TestSuites[len(suites.TestSuites)-1]
+
+		testCase := junitTestCase{
+			Name:      event.AgentRuleID,
+			ClassName: framework,
+		}
+		if event.ResourceID != "" {
+			testCase.Name = fmt.Sprintf("%s [%s %s]", event.AgentRuleID, event.ResourceType, event.ResourceID)
+		}
+
+		if event.Data != nil {
+			evidence, err := json.Marshal(event.Data)
+			if err != nil {
+				return err
+			}
+			testCase.SystemOut = string(evidence)
+		}
+
+		suite.Tests++
+		switch event.Result {
+		case Passed:
+		case Failed:
+			suite.Failures++
+			testCase.Failure = &junitMessage{Message: fmt.Sprintf("%s failed", event.AgentRuleID), Type: Failed}
+		default:
+			suite.Errors++
+			testCase.Error = &junitMessage{Message: eventError(event), Type: Error}
+		}
+
+		suite.TestCases = append(suite.TestCases, testCase)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(suites); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+	return err
+}
@@ -0,0 +1,132 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package event
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testEvents() []*Event {
+	return []*Event{
+		{
+			AgentRuleID:      "cis-docker-1",
+			AgentFrameworkID: "cis-docker",
+			AgentVersion:     "7.33.0",
+			Result:           Failed,
+			ResourceType:     "docker_daemon",
+			ResourceID:       "host_daemon",
+			Data:             Data{"process.name": "dockerd"},
+		},
+		{
+			AgentRuleID:      "cis-linux-1",
+			AgentFrameworkID: "cis-linux",
+			AgentVersion:     "7.33.0",
+			Result:           Error,
+			ResourceType:     "host",
+			ResourceID:       "host",
+			Data:             Data{"error": "no package database found"},
+		},
+		{
+			AgentRuleID:      "cis-docker-1",
+			AgentFrameworkID: "cis-docker",
+			AgentVersion:     "7.33.0",
+			Result:           Passed,
+			ResourceType:     "docker_container",
+			ResourceID:       "abc",
+			Data:             Data{"container.id": "abc"},
+		},
+	}
+}
+
+func TestExportJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Export(&buf, FormatJSON, testEvents()); err != nil {
+		t.Fatal(err)
+	}
+
+	var exported struct {
+		Summary Summary
+		Events  []Event
+	}
+	if err := json.Unmarshal(buf.Bytes(), &exported); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, Summary{Passed: 1, Failed: 1, Error: 1}, exported.Summary)
+	assert.Equal(t, 3, len(exported.Events))
+	assert.Equal(t, "docker_container", exported.Events[0].ResourceType)
+	assert.Equal(t, "docker_daemon", exported.Events[1].ResourceType)
+	assert.Equal(t, "cis-linux-1", exported.Events[2].AgentRuleID)
+}
+
+func TestExportSARIF(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Export(&buf, FormatSARIF, testEvents()); err != nil {
+		t.Fatal(err)
+	}
+
+	var exported sarifLog
+	if err := json.Unmarshal(buf.Bytes(), &exported); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "2.1.0", exported.Version)
+	assert.Equal(t, 1, len(exported.Runs))
+
+	run := exported.Runs[0]
+	assert.Equal(t, "7.33.0", run.Tool.Driver.Version)
+	assert.Equal(t, 2, len(run.Tool.Driver.Rules))
+	assert.Equal(t, "cis-linux-1", run.Tool.Driver.Rules[1].ID)
+
+	assert.Equal(t, 3, len(run.Results))
+	assert.Equal(t, "pass", run.Results[0].Kind)
+	assert.Equal(t, "none", run.Results[0].Level)
+	assert.Equal(t, "fail", run.Results[1].Kind)
+	assert.Equal(t, "error", run.Results[1].Level)
+	assert.Equal(t, "host_daemon", run.Results[1].Locations[0].LogicalLocations[0].Name)
+	assert.Equal(t, map[string]interface{}{"process.name": "dockerd"}, run.Results[1].Properties["evidence"])
+	assert.Equal(t, 1, run.Results[2].RuleIndex)
+	assert.Equal(t, "warning", run.Results[2].Level)
+	assert.Equal(t, "cis-linux-1 could not be evaluated: no package database found", run.Results[2].Message.Text)
+}
+
+func TestExportJUnit(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Export(&buf, FormatJUnit, testEvents()); err != nil {
+		t.Fatal(err)
+	}
+
+	var exported junitTestSuites
+	if err := xml.Unmarshal(buf.Bytes(), &exported); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, 3, exported.Tests)
+	assert.Equal(t, 1, exported.Failures)
+	assert.Equal(t, 1, exported.Errors)
+	assert.Equal(t, 2, len(exported.TestSuites))
+
+	docker := exported.TestSuites[0]
+	assert.Equal(t, "cis-docker", docker.Name)
+	assert.Equal(t, 2, docker.Tests)
+	assert.Equal(t, 1, docker.Failures)
+	assert.Equal(t, "cis-docker-1 [docker_daemon host_daemon]", docker.TestCases[1].Name)
+	assert.NotNil(t, docker.TestCases[1].Failure)
+	assert.Equal(t, `{"process.name":"dockerd"}`, docker.TestCases[1].SystemOut)
+
+	linux := exported.TestSuites[1]
+	assert.Equal(t, 1, linux.Errors)
+	assert.Equal(t, "no package database found", linux.TestCases[0].Error.Message)
+}
+
+func TestExportUnknownFormat(t *testing.T) {
+	assert.Error(t, Export(&bytes.Buffer{}, "csv", testEvents()))
+}
@@ -34,6 +34,12 @@ const (
 	KindConstants = ResourceKind("constants")
 	// KindCustom is used for a Custom check
 	KindCustom = ResourceKind("custom")
+	// KindPackage is used for a Package resource
+	KindPackage = ResourceKind("package")
+	// KindSysctl is used for a Sysctl resource
+	KindSysctl = ResourceKind("sysctl")
+	// KindKernelModule is used for a KernelModule resource
+	KindKernelModule = ResourceKind("kernelModule")
 )
 
 // ResourceCommon describes the base fields of resource types
@@ -47,6 +53,9 @@ type ResourceCommon struct {
 	KubeApiserver *KubernetesResource `yaml:"kubeApiserver,omitempty"`
 	Constants     *ConstantsResource  `yaml:"constants,omitempty"`
 	Custom        *Custom             `yaml:"custom,omitempty"`
+	Package       *Package            `yaml:"package,omitempty"`
+	Sysctl        *Sysctl             `yaml:"sysctl,omitempty"`
+	KernelModule  *KernelModule       `yaml:"kernelModule,omitempty"`
 }
 
 // Resource describes supported resource types observed by a Rule
@@ -96,6 +105,12 @@ func (r *ResourceCommon) Kind() ResourceKind {
 		return KindConstants
 	case r.Custom != nil:
 		return KindCustom
+	case r.Package != nil:
+		return KindPackage
+	case r.Sysctl != nil:
+		return KindSysctl
+	case r.KernelModule != nil:
+		return KindKernelModule
 	default:
 		return KindInvalid
 	}
@@ -301,3 +316,42 @@ type Custom struct {
 	Name      string            `yaml:"name"`
 	Variables map[string]string `yaml:"variables,omitempty"`
 }
+
+// Fields & functions available for Package
+const (
+	PackageFieldName      = "package.name"
+	PackageFieldVersion   = "package.version"
+	PackageFieldInstalled = "package.installed"
+
+	PackageFuncVersionCompare = "package.versionCompare"
+)
+
+// Package describes a package installed with the package manager of the host (dpkg, rpm or apk)
+type Package struct {
+	Name string `yaml:"name"`
+}
+
+// Fields & functions available for Sysctl
+const (
+	SysctlFieldName  = "sysctl.name"
+	SysctlFieldValue = "sysctl.value"
+)
+
+// Sysctl describes a kernel parameter resource
+type Sysctl struct {
+	Name string `yaml:"name"`
+}
+
+// Fields & functions available for KernelModule
+const (
+	KernelModuleFieldName        = "kernelModule.name"
+	KernelModuleFieldLoaded      = "kernelModule.loaded"
+	KernelModuleFieldDisabled    = "kernelModule.disabled"
+	KernelModuleFieldBlacklisted = "kernelModule.blacklisted"
+)
+
+// KernelModule describes a kernel module resource, its state being read from /proc/modules and the modprobe
+// configuration
+type KernelModule struct {
+	Name string `yaml:"name"`
+}
@@ -62,6 +62,25 @@ docker:
 condition: docker.template("{{ $.Config.Healthcheck }}") != ""
 `
 
+const testResourcePackage = `
+package:
+  name: openssl
+condition: package.versionCompare("1.1.1") >= 0
+`
+
+const testResourceSysctl = `
+sysctl:
+  name: net.ipv4.ip_forward
+condition: sysctl.value == "0"
+`
+
+const testResourceKernelModule = `
+kernelModule:
+  name: cramfs
+condition: >-
+  !kernelModule.loaded && kernelModule.disabled
+`
+
 func TestResources(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -166,6 +185,42 @@ func TestResources(t *testing.T) {
 				Condition: `docker.template("{{ $.Config.Healthcheck }}") != ""`,
 			},
 		},
+		{
+			name:  "package",
+			input: testResourcePackage,
+			expected: Resource{
+				ResourceCommon: ResourceCommon{
+					Package: &Package{
+						Name: "openssl",
+					},
+				},
+				Condition: `package.versionCompare("1.1.1") >= 0`,
+			},
+		},
+		{
+			name:  "sysctl",
+			input: testResourceSysctl,
+			expected: Resource{
+				ResourceCommon: ResourceCommon{
+					Sysctl: &Sysctl{
+						Name: "net.ipv4.ip_forward",
+					},
+				},
+				Condition: `sysctl.value == "0"`,
+			},
+		},
+		{
+			name:  "kernel module",
+			input: testResourceKernelModule,
+			expected: Resource{
+				ResourceCommon: ResourceCommon{
+					KernelModule: &KernelModule{
+						Name: "cramfs",
+					},
+				},
+				Condition: `!kernelModule.loaded && kernelModule.disabled`,
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -61,6 +61,8 @@ func setupAPM(config Config) {
 	config.BindEnv("apm_config.max_catalog_services", "DD_APM_MAX_CATALOG_SERVICES")
 	config.BindEnv("apm_config.receiver_timeout", "DD_APM_RECEIVER_TIMEOUT")
 	config.BindEnv("apm_config.max_payload_size", "DD_APM_MAX_PAYLOAD_SIZE")
+	config.BindEnv("apm_config.capture.file", "DD_APM_CAPTURE_FILE")
+	config.BindEnv("apm_config.capture.max_bytes", "DD_APM_CAPTURE_MAX_BYTES")
 	config.BindEnv("apm_config.log_file", "DD_APM_LOG_FILE")
 	config.BindEnv("apm_config.max_events_per_second", "DD_APM_MAX_EPS", "DD_MAX_EPS")
 	config.BindEnv("apm_config.max_traces_per_second", "DD_APM_MAX_TPS", "DD_MAX_TPS")
@@ -68,6 +70,8 @@ func setupAPM(config Config) {
 	config.BindEnv("apm_config.disable_rare_sampler", "DD_APM_DISABLE_RARE_SAMPLER")
 	config.BindEnv("apm_config.max_memory", "DD_APM_MAX_MEMORY")
 	config.BindEnv("apm_config.max_cpu_percent", "DD_APM_MAX_CPU_PERCENT")
+	config.BindEnv("apm_config.fair_share_rate_limiting.enabled", "DD_APM_FAIR_SHARE_RATE_LIMITING_ENABLED")
+	config.BindEnv("apm_config.fair_share_rate_limiting.service_weights", "DD_APM_FAIR_SHARE_RATE_LIMITING_SERVICE_WEIGHTS")
 	config.BindEnv("apm_config.env", "DD_APM_ENV")
 	config.BindEnv("apm_config.apm_non_local_traffic", "DD_APM_NON_LOCAL_TRAFFIC")
 	config.BindEnv("apm_config.apm_dd_url", "DD_APM_DD_URL")
@@ -87,6 +91,10 @@ func setupAPM(config Config) {
 	config.BindEnv("apm_config.internal_profiling.enabled", "DD_APM_INTERNAL_PROFILING_ENABLED")
 	config.BindEnv("apm_config.debugger_dd_url", "DD_APM_DEBUGGER_DD_URL")
 	config.BindEnv("apm_config.debugger_api_key", "DD_APM_DEBUGGER_API_KEY")
+	config.BindEnv("apm_config.otlp_exporter.endpoint", "DD_APM_OTLP_EXPORTER_ENDPOINT")
+	config.BindEnv("apm_config.otlp_exporter.headers", "DD_APM_OTLP_EXPORTER_HEADERS")
+	config.BindEnv("apm_config.zipkin.enabled", "DD_APM_ZIPKIN_ENABLED")
+	config.BindEnv("apm_config.jaeger.enabled", "DD_APM_JAEGER_ENABLED")
 	config.BindEnv("apm_config.obfuscation.credit_cards.enabled", "DD_APM_OBFUSCATION_CREDIT_CARDS_ENABLED")
 	config.BindEnv("apm_config.obfuscation.credit_cards.luhn", "DD_APM_OBFUSCATION_CREDIT_CARDS_LUHN")
 
@@ -115,6 +123,22 @@ func setupAPM(config Config) {
 		return out
 	})
 
+	config.SetEnvKeyTransformer("apm_config.otlp_exporter.headers", func(in string) interface{} {
+		var out map[string]string
+		if err := json.Unmarshal([]byte(in), &out); err != nil {
+			log.Warnf(`"apm_config.otlp_exporter.headers" can not be parsed: %v`, err)
+		}
+		return out
+	})
+
+	config.SetEnvKeyTransformer("apm_config.fair_share_rate_limiting.service_weights", func(in string) interface{} {
+		var out map[string]interface{}
+		if err := json.Unmarshal([]byte(in), &out); err != nil {
+			log.Warnf(`"apm_config.fair_share_rate_limiting.service_weights" can not be parsed: %v`, err)
+		}
+		return out
+	})
+
 	config.SetEnvKeyTransformer("apm_config.analyzed_spans", func(in string) interface{} {
 		out, err := parseAnalyzedSpans(in)
 		if err != nil {
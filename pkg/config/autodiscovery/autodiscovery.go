@@ -29,6 +29,12 @@ func DiscoverComponentsFromConfig() ([]config.ConfigurationProviders, []config.L
 		detectedProviders = append(detectedProviders, prometheusProvider)
 	}
 
+	// Auto-add the process listener based on `workloadmeta_process_collector.enabled`
+	if config.Datadog.GetBool("workloadmeta_process_collector.enabled") {
+		log.Info("Process collection is enabled: Adding the process listener")
+		detectedListeners = append(detectedListeners, config.Listeners{Name: "process"})
+	}
+
 	return detectedProviders, detectedListeners
 }
 
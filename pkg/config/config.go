@@ -127,6 +127,7 @@ type ConfigurationProviders struct {
 	KeyFile          string `mapstructure:"key_file"`
 	Token            string `mapstructure:"token"`
 	GraceTimeSeconds int    `mapstructure:"grace_time_seconds"`
+	PublicKeyFile    string `mapstructure:"public_key_file"`
 }
 
 // Listeners helps unmarshalling `listeners` config param
@@ -148,6 +149,27 @@ type MappingProfile struct {
 	Mappings []MetricMapping `mapstructure:"mappings" json:"mappings"`
 }
 
+// TagDerivationRule represents a rule deriving a tag from a field of the
+// workloadmeta entities of a kind
+type TagDerivationRule struct {
+	Tag         string `mapstructure:"tag" json:"tag"`
+	Field       string `mapstructure:"field" json:"field"`
+	Regex       string `mapstructure:"regex" json:"regex"`
+	Value       string `mapstructure:"value" json:"value"`
+	Default     string `mapstructure:"default" json:"default"`
+	Cardinality string `mapstructure:"cardinality" json:"cardinality"`
+}
+
+// ProcessSelector selects the processes collected by the workloadmeta process
+// collector, a process must match all the criteria set in the selector
+type ProcessSelector struct {
+	Name        string `mapstructure:"name" json:"name"`
+	ProcessName string `mapstructure:"process_name" json:"process_name"`
+	Cmdline     string `mapstructure:"cmdline" json:"cmdline"`
+	Ports       []int  `mapstructure:"ports" json:"ports"`
+	SystemdUnit string `mapstructure:"systemd_unit" json:"systemd_unit"`
+}
+
 // MetricMapping represent one mapping rule
 type MetricMapping struct {
 	Match     string            `mapstructure:"match" json:"match"`
@@ -220,6 +242,9 @@ func InitConfig(config Config) {
 	config.BindEnvAndSetDefault("enable_metadata_collection", true)
 	config.BindEnvAndSetDefault("enable_gohai", true)
 	config.BindEnvAndSetDefault("check_runners", int64(4))
+	config.BindEnvAndSetDefault("check_history.size", 10)
+	config.BindEnvAndSetDefault("check_history.include_samples", false)
+	config.BindEnvAndSetDefault("check_history.max_samples", 100)
 	config.BindEnvAndSetDefault("auth_token_file_path", "")
 	config.BindEnv("bind_host")
 	config.BindEnvAndSetDefault("ipc_address", "localhost")
@@ -508,8 +533,27 @@ func InitConfig(config Config) {
 	config.BindEnvAndSetDefault("kubernetes_pod_annotations_as_tags", map[string]string{})
 	config.BindEnvAndSetDefault("kubernetes_node_labels_as_tags", map[string]string{})
 	config.BindEnvAndSetDefault("kubernetes_namespace_labels_as_tags", map[string]string{})
+	config.BindEnv("tag_derivation_rules")
+	config.SetEnvKeyTransformer("tag_derivation_rules", func(in string) interface{} {
+		var rules map[string][]TagDerivationRule
+		if err := json.Unmarshal([]byte(in), &rules); err != nil {
+			log.Errorf(`"tag_derivation_rules" can not be parsed: %v`, err)
+		}
+		return rules
+	})
 	config.BindEnvAndSetDefault("container_cgroup_prefix", "")
 
+	// Workloadmeta process collector
+	config.BindEnvAndSetDefault("workloadmeta_process_collector.enabled", false)
+	config.BindEnv("workloadmeta_process_collector.selectors")
+	config.SetEnvKeyTransformer("workloadmeta_process_collector.selectors", func(in string) interface{} {
+		var selectors []ProcessSelector
+		if err := json.Unmarshal([]byte(in), &selectors); err != nil {
+			log.Errorf(`"workloadmeta_process_collector.selectors" can not be parsed: %v`, err)
+		}
+		return selectors
+	})
+
 	// CRI
 	config.BindEnvAndSetDefault("cri_socket_path", "")              // empty is disabled
 	config.BindEnvAndSetDefault("cri_connection_timeout", int64(1)) // in seconds
@@ -582,6 +626,8 @@ func InitConfig(config Config) {
 	config.BindEnvAndSetDefault("leader_election", false)
 	config.BindEnvAndSetDefault("kube_resources_namespace", "")
 	config.BindEnvAndSetDefault("kube_cache_sync_timeout_seconds", 5)
+	config.BindEnvAndSetDefault("kube_configmaps_provider.namespace", "")
+	config.BindEnvAndSetDefault("kube_configmaps_provider.label_selector", "ad.datadoghq.com/checks=true")
 
 	// Datadog cluster agent
 	config.BindEnvAndSetDefault("cluster_agent.enabled", false)
@@ -963,6 +1009,20 @@ func InitConfig(config Config) {
 	bindEnvAndSetLogsConfigKeys(config, "runtime_security_config.endpoints.")
 	config.BindEnvAndSetDefault("runtime_security_config.self_test.enabled", true)
 	config.BindEnvAndSetDefault("runtime_security_config.enable_remote_configuration", false)
+	config.BindEnvAndSetDefault("runtime_security_config.enforcement.enabled", false)
+	config.BindEnvAndSetDefault("runtime_security_config.network.enabled", true)
+	config.BindEnvAndSetDefault("runtime_security_config.activity_dump.enabled", false)
+	config.BindEnvAndSetDefault("runtime_security_config.activity_dump.output_dir", "/var/tmp/datadog-agent/runtime-security/activity_dumps")
+	config.BindEnvAndSetDefault("runtime_security_config.activity_dump.default_timeout", 600)
+	config.BindEnvAndSetDefault("runtime_security_config.activity_dump.profiles_dir", "")
+	config.BindEnv("runtime_security_config.exporters")
+	config.SetEnvKeyTransformer("runtime_security_config.exporters", func(in string) interface{} {
+		var exporters []map[string]interface{}
+		if err := json.Unmarshal([]byte(in), &exporters); err != nil {
+			log.Errorf(`"runtime_security_config.exporters" can not be parsed: %v`, err)
+		}
+		return exporters
+	})
 
 	// Serverless Agent
 	config.BindEnvAndSetDefault("serverless.logs_enabled", true)
@@ -991,6 +991,21 @@ func TestDogstatsdMappingProfilesEnv(t *testing.T) {
 	assert.Equal(t, mappings, expected)
 }
 
+func TestTagDerivationRulesEnv(t *testing.T) {
+	env := "DD_TAG_DERIVATION_RULES"
+	err := os.Setenv(env, `{"container":[{"tag":"team","field":"image.name","regex":"^registry\\.example\\.com/([^/]+)/"}],"kubernetes_pod":[{"tag":"cost_center","field":"annotations.cost-center","default":"unknown"}]}`)
+	assert.Nil(t, err)
+	defer os.Unsetenv(env)
+	expected := map[string][]TagDerivationRule{
+		"container":      {{Tag: "team", Field: "image.name", Regex: "^registry\\.example\\.com/([^/]+)/"}},
+		"kubernetes_pod": {{Tag: "cost_center", Field: "annotations.cost-center", Default: "unknown"}},
+	}
+	var rules map[string][]TagDerivationRule
+	err = Datadog.UnmarshalKey("tag_derivation_rules", &rules)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, rules)
+}
+
 func TestPrometheusScrapeChecksEnv(t *testing.T) {
 	env := "DD_PROMETHEUS_SCRAPE_CHECKS"
 	err := os.Setenv(env, `[{"configurations":[{"timeout":5,"send_distribution_buckets":true}],"autodiscovery":{"kubernetes_container_names":["my-app"],"kubernetes_annotations":{"include":{"custom_label":"true"}}}}]`)
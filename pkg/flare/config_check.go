@@ -9,11 +9,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/url"
 
 	"github.com/fatih/color"
 
 	"github.com/DataDog/datadog-agent/cmd/agent/api/response"
 	"github.com/DataDog/datadog-agent/pkg/api/util"
+	"github.com/DataDog/datadog-agent/pkg/autodiscovery"
 	"github.com/DataDog/datadog-agent/pkg/autodiscovery/integration"
 	"github.com/DataDog/datadog-agent/pkg/collector/check"
 	"github.com/DataDog/datadog-agent/pkg/config"
@@ -92,6 +94,100 @@ func GetConfigCheck(w io.Writer, withDebug bool) error {
 	return nil
 }
 
+// GetConfigCheckExplain prints how the templates known to the agent match the services
+// whose entity contains `query`, e.g. a container ID
+func GetConfigCheckExplain(w io.Writer, query string) error {
+	if w != color.Output {
+		color.NoColor = true
+	}
+
+	c := util.GetClient(false) // FIX: get certificates right then make this true
+
+	// Set session token
+	err := util.SetAuthToken()
+	if err != nil {
+		return err
+	}
+	ipcAddress, err := config.GetIPCAddress()
+	if err != nil {
+		return err
+	}
+	explainURL := fmt.Sprintf("https://%v:%v/agent/config-check/explain?service=%s", ipcAddress, config.Datadog.GetInt("cmd_port"), url.QueryEscape(query))
+	r, err := util.DoGet(c, explainURL)
+	if err != nil {
+		var errMap = make(map[string]string)
+		json.Unmarshal(r, &errMap) //nolint:errcheck
+		if e, found := errMap["error"]; found {
+			return fmt.Errorf("the agent ran into an error while explaining the configs: %s", e)
+		}
+		return fmt.Errorf("failed to query the agent (running?): %s", err)
+	}
+
+	var explanations []autodiscovery.ServiceExplanation
+	if err = json.Unmarshal(r, &explanations); err != nil {
+		return err
+	}
+
+	for _, e := range explanations {
+		PrintServiceExplanation(w, e)
+	}
+	return nil
+}
+
+// PrintServiceExplanation prints a human-readable representation of how the templates
+// match a service
+func PrintServiceExplanation(w io.Writer, e autodiscovery.ServiceExplanation) {
+	fmt.Fprintln(w, fmt.Sprintf("\n=== %s service ===", color.GreenString(e.Entity)))
+	listener := color.CyanString(e.Listener)
+	if e.Listener == "" {
+		listener = color.RedString("Unknown listener")
+	}
+	fmt.Fprintln(w, fmt.Sprintf("%s: %s", color.BlueString("Listener"), listener))
+	fmt.Fprintln(w, fmt.Sprintf("%s: %s", color.BlueString("Tagger entity"), color.CyanString(e.TaggerEntity)))
+	fmt.Fprintln(w, fmt.Sprintf("%s:", color.BlueString("Auto-discovery IDs")))
+	for _, id := range e.ADIdentifiers {
+		fmt.Fprintln(w, fmt.Sprintf("* %s", color.CyanString(id)))
+	}
+	if e.ADIdentifiersError != "" {
+		fmt.Fprintln(w, fmt.Sprintf("%s: %s", color.RedString("Error"), e.ADIdentifiersError))
+	}
+
+	var unmatched []integration.Config
+	for _, te := range e.Templates {
+		if !te.Matched() {
+			unmatched = append(unmatched, te.Template)
+			continue
+		}
+
+		fmt.Fprintln(w, fmt.Sprintf("\n--- %s template ---", color.GreenString(te.Template.Name)))
+		fmt.Fprintln(w, fmt.Sprintf("%s: %s", color.BlueString("Configuration provider"), color.CyanString(te.Template.Provider)))
+		fmt.Fprintln(w, fmt.Sprintf("%s: %s", color.BlueString("Configuration source"), color.CyanString(te.Template.Source)))
+		fmt.Fprintln(w, fmt.Sprintf("%s: %s", color.BlueString("Matched Auto-discovery IDs"), color.CyanString(fmt.Sprint(te.MatchedADIdentifiers))))
+		if te.Error != "" {
+			fmt.Fprintln(w, fmt.Sprintf("%s: %s", color.RedString("Resolution error"), te.Error))
+			continue
+		}
+		fmt.Fprintln(w, fmt.Sprintf("%s:", color.BlueString("Resolved instances")))
+		for _, inst := range te.Resolved.Instances {
+			fmt.Fprint(w, fmt.Sprintf("%s", inst))
+			fmt.Fprintln(w, "~")
+		}
+	}
+
+	if len(unmatched) > 0 {
+		fmt.Fprintln(w, fmt.Sprintf("\n%s:", color.YellowString("Templates not matching the Auto-discovery IDs of the service")))
+		for _, tpl := range unmatched {
+			fmt.Fprintln(w, fmt.Sprintf("* %s %s (%s: %s)", color.YellowString(tpl.Name), fmt.Sprint(tpl.ADIdentifiers), tpl.Provider, tpl.Source))
+		}
+	}
+
+	fmt.Fprintln(w, fmt.Sprintf("\n%s: %d", color.BlueString("Scheduled configurations"), len(e.ScheduledConfigs)))
+	for _, c := range e.ScheduledConfigs {
+		fmt.Fprintln(w, fmt.Sprintf("* %s (%s)", color.CyanString(c.Name), c.Provider))
+	}
+	fmt.Fprintln(w, "===")
+}
+
 // GetClusterAgentConfigCheck proxies GetConfigCheck overidding the URL
 func GetClusterAgentConfigCheck(w io.Writer, withDebug bool) error {
 	configCheckURL = fmt.Sprintf("https://localhost:%v/config-check", config.Datadog.GetInt("cluster_agent.cmd_port"))
@@ -27,6 +27,7 @@ import (
 type RuntimeSecurityAgent struct {
 	hostname      string
 	reporter      event.Reporter
+	exporters     []*filteredExporter
 	conn          *grpc.ClientConn
 	running       atomic.Value
 	wg            sync.WaitGroup
@@ -55,9 +56,13 @@ func NewRuntimeSecurityAgent(hostname string, reporter event.Reporter) (*Runtime
 		return nil, errors.Errorf("failed to initialize the telemetry reporter")
 	}
 
+	exporters := newExportersFromConfig()
+	tel.exporters = exporters
+
 	return &RuntimeSecurityAgent{
 		conn:      conn,
 		reporter:  reporter,
+		exporters: exporters,
 		hostname:  hostname,
 		telemetry: tel,
 	}, nil
@@ -80,6 +85,12 @@ func (rsa *RuntimeSecurityAgent) Stop() {
 	rsa.running.Store(false)
 	rsa.wg.Wait()
 	rsa.conn.Close()
+
+	for _, exporter := range rsa.exporters {
+		if err := exporter.Close(); err != nil {
+			log.Errorf("failed to close the %s exporter: %s", exporter.name, err)
+		}
+	}
 }
 
 // StartEventListener starts listening for new events from system-probe
@@ -134,8 +145,18 @@ func (rsa *RuntimeSecurityAgent) StartEventListener() {
 
 // DispatchEvent dispatches a security event message to the subsytems of the runtime security agent
 func (rsa *RuntimeSecurityAgent) DispatchEvent(evt *api.SecurityEventMessage) {
-	// For now simply log to Datadog
 	rsa.reporter.ReportRaw(evt.GetData(), evt.Service, evt.GetTags()...)
+
+	for _, exporter := range rsa.exporters {
+		if !exporter.filter.matches(evt.GetTags()) {
+			continue
+		}
+
+		// the events dropped because the queue of the exporter is full are counted and reported by the telemetry
+		if err := exporter.Export(evt.GetData()); err != nil && !errors.Is(err, errExporterQueueFull) {
+			log.Errorf("failed to export the event of rule `%s` with the %s exporter: %s", evt.RuleID, exporter.name, err)
+		}
+	}
 }
 
 // GetStatus returns the current status on the agent
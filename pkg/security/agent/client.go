@@ -54,6 +54,45 @@ func (c *RuntimeSecurityClient) RunSelfTest() (*api.SecuritySelfTestResultMessag
 	return response, nil
 }
 
+// DumpActivity starts an activity dump of a container or an image
+func (c *RuntimeSecurityClient) DumpActivity(containerID string, image string, timeout string) (*api.SecurityActivityDumpMessage, error) {
+	apiClient := api.NewSecurityModuleClient(c.conn)
+
+	response, err := apiClient.DumpActivity(context.Background(), &api.ActivityDumpParams{
+		ContainerID: containerID,
+		Image:       image,
+		Timeout:     timeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// ListActivityDumps returns the running activity dumps
+func (c *RuntimeSecurityClient) ListActivityDumps() (*api.SecurityActivityDumpListMessage, error) {
+	apiClient := api.NewSecurityModuleClient(c.conn)
+
+	response, err := apiClient.ListActivityDumps(context.Background(), &api.ActivityDumpListParams{})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// StopActivityDump stops an activity dump and returns the files it was written to
+func (c *RuntimeSecurityClient) StopActivityDump(name string) (*api.SecurityActivityDumpMessage, error) {
+	apiClient := api.NewSecurityModuleClient(c.conn)
+
+	response, err := apiClient.StopActivityDump(context.Background(), &api.ActivityDumpStopParams{
+		Name: name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 // Close closes the connection
 func (c *RuntimeSecurityClient) Close() {
 	c.conn.Close()
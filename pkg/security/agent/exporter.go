@@ -0,0 +1,210 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package agent
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"sync"
+	"sync/atomic"
+
+	coreconfig "github.com/DataDog/datadog-agent/pkg/config"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+const (
+	fileExporterType    = "file"
+	syslogExporterType  = "syslog"
+	webhookExporterType = "webhook"
+
+	exporterQueueSize = 1000
+)
+
+// errExporterQueueFull is returned when an event is dropped because the queue of an exporter is full
+var errExporterQueueFull = errors.New("exporter queue full, event dropped")
+
+// ExporterConfig defines an exporter of the runtime security events, along with the tags of the events
+// it exports
+type ExporterConfig struct {
+	Type string   `mapstructure:"type"`
+	Tags []string `mapstructure:"tags"`
+
+	// file exporter
+	Path       string `mapstructure:"path"`
+	MaxSize    int    `mapstructure:"max_size"`
+	MaxBackups int    `mapstructure:"max_backups"`
+
+	// syslog exporter
+	Network  string `mapstructure:"network"`
+	Address  string `mapstructure:"address"`
+	Facility string `mapstructure:"facility"`
+	Tag      string `mapstructure:"tag"`
+
+	// webhook exporter
+	URL           string            `mapstructure:"url"`
+	Headers       map[string]string `mapstructure:"headers"`
+	BatchSize     int               `mapstructure:"batch_size"`
+	FlushInterval int               `mapstructure:"flush_interval"`
+	MaxRetries    int               `mapstructure:"max_retries"`
+	Timeout       int               `mapstructure:"timeout"`
+}
+
+// Exporter sends the serialized runtime security events to a destination other than the Datadog intake
+type Exporter interface {
+	Export(data []byte) error
+	Close() error
+}
+
+// exporterStats counts the events an exporter accepted but didn't export
+type exporterStats struct {
+	dropped uint64
+	failed  uint64
+}
+
+// swapStats returns the number of events dropped because the queue was full, and of events that failed to be
+// exported, since the last call
+func (s *exporterStats) swapStats() (dropped uint64, failed uint64) {
+	return atomic.SwapUint64(&s.dropped, 0), atomic.SwapUint64(&s.failed, 0)
+}
+
+// statsExporter is implemented by the exporters counting the events they didn't export
+type statsExporter interface {
+	swapStats() (dropped uint64, failed uint64)
+}
+
+// queuedExporter exports the events of a synchronous exporter from a bounded queue, so that a slow destination
+// doesn't block the dispatch of the events. The events are dropped when the queue is full.
+type queuedExporter struct {
+	Exporter
+	exporterStats
+	events    chan []byte
+	wg        sync.WaitGroup
+	closeOnce sync.Once
+}
+
+func newQueuedExporter(exporter Exporter) *queuedExporter {
+	qe := &queuedExporter{
+		Exporter: exporter,
+		events:   make(chan []byte, exporterQueueSize),
+	}
+
+	qe.wg.Add(1)
+	go qe.run()
+
+	return qe
+}
+
+func (qe *queuedExporter) run() {
+	defer qe.wg.Done()
+
+	for data := range qe.events {
+		if err := qe.Exporter.Export(data); err != nil {
+			atomic.AddUint64(&qe.failed, 1)
+			log.Debugf("failed to export an event: %s", err)
+		}
+	}
+}
+
+// Export implements the Exporter interface
+func (qe *queuedExporter) Export(data []byte) error {
+	select {
+	case qe.events <- data:
+		return nil
+	default:
+		atomic.AddUint64(&qe.dropped, 1)
+		return errExporterQueueFull
+	}
+}
+
+// Close implements the Exporter interface, the queued events are exported before returning
+func (qe *queuedExporter) Close() error {
+	qe.closeOnce.Do(func() {
+		close(qe.events)
+	})
+	qe.wg.Wait()
+
+	return qe.Exporter.Close()
+}
+
+// tagFilter selects the events according to their tags. An event matches the filter when one of its tags
+// matches one of the patterns of the filter. An empty filter matches all the events.
+type tagFilter []string
+
+func (f tagFilter) matches(tags []string) bool {
+	if len(f) == 0 {
+		return true
+	}
+
+	for _, pattern := range f {
+		for _, tag := range tags {
+			if matched, _ := path.Match(pattern, tag); matched {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type filteredExporter struct {
+	Exporter
+	name   string
+	filter tagFilter
+}
+
+// NewExporter returns the exporter defined by the given configuration
+func NewExporter(cfg ExporterConfig) (Exporter, error) {
+	for _, pattern := range cfg.Tags {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid tag filter `%s`: %w", pattern, err)
+		}
+	}
+
+	switch cfg.Type {
+	case fileExporterType:
+		return newQueuedExporterOrError(newFileExporter(cfg))
+	case syslogExporterType:
+		return newQueuedExporterOrError(newSyslogExporter(cfg))
+	case webhookExporterType:
+		return newWebhookExporter(cfg)
+	default:
+		return nil, fmt.Errorf("unknown exporter type `%s`", cfg.Type)
+	}
+}
+
+func newQueuedExporterOrError(exporter Exporter, err error) (Exporter, error) {
+	if err != nil {
+		return nil, err
+	}
+	return newQueuedExporter(exporter), nil
+}
+
+// newExportersFromConfig instantiates the exporters of `runtime_security_config.exporters`. Invalid exporters are
+// logged and ignored so that the events are still sent to Datadog.
+func newExportersFromConfig() []*filteredExporter {
+	var cfgs []ExporterConfig
+	if err := coreconfig.Datadog.UnmarshalKey("runtime_security_config.exporters", &cfgs); err != nil {
+		log.Errorf("cannot parse runtime_security_config.exporters: %s", err)
+		return nil
+	}
+
+	var exporters []*filteredExporter
+	for i, cfg := range cfgs {
+		exporter, err := NewExporter(cfg)
+		if err != nil {
+			log.Errorf("failed to create the runtime security exporter %d: %s", i, err)
+			continue
+		}
+
+		exporters = append(exporters, &filteredExporter{
+			Exporter: exporter,
+			name:     fmt.Sprintf("%s#%d", cfg.Type, i),
+			filter:   tagFilter(cfg.Tags),
+		})
+	}
+
+	return exporters
+}
@@ -0,0 +1,139 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package agent
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+)
+
+const (
+	defaultFileExporterMaxSize    = 100
+	defaultFileExporterMaxBackups = 5
+)
+
+// fileExporter writes the events, one JSON document per line, to a file rotated once it reaches its maximum size
+type fileExporter struct {
+	sync.Mutex
+	path       string
+	maxSize    int64
+	maxBackups int
+	file       *os.File
+	size       int64
+}
+
+func newFileExporter(cfg ExporterConfig) (Exporter, error) {
+	if cfg.Path == "" {
+		return nil, errors.New("the path of the file exporter is required")
+	}
+
+	maxSize := cfg.MaxSize
+	if maxSize <= 0 {
+		maxSize = defaultFileExporterMaxSize
+	}
+
+	maxBackups := cfg.MaxBackups
+	if maxBackups <= 0 {
+		maxBackups = defaultFileExporterMaxBackups
+	}
+
+	fe := &fileExporter{
+		path:       cfg.Path,
+		maxSize:    int64(maxSize) * 1024 * 1024,
+		maxBackups: maxBackups,
+	}
+
+	if err := fe.open(); err != nil {
+		return nil, err
+	}
+
+	return fe, nil
+}
+
+func (fe *fileExporter) open() error {
+	file, err := os.OpenFile(fe.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0640)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	fe.file = file
+	fe.size = info.Size()
+
+	return nil
+}
+
+func (fe *fileExporter) backupPath(i int) string {
+	return fmt.Sprintf("%s.%d", fe.path, i)
+}
+
+// rotate shifts the backups, <path> becoming <path>.1, and reopens an empty file
+func (fe *fileExporter) rotate() error {
+	if err := fe.file.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Remove(fe.backupPath(fe.maxBackups)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	for i := fe.maxBackups - 1; i > 0; i-- {
+		if err := os.Rename(fe.backupPath(i), fe.backupPath(i+1)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	if err := os.Rename(fe.path, fe.backupPath(1)); err != nil {
+		return err
+	}
+
+	return fe.open()
+}
+
+// Export implements the Exporter interface
+func (fe *fileExporter) Export(data []byte) error {
+	fe.Lock()
+	defer fe.Unlock()
+
+	if fe.file == nil {
+		return errors.New("file exporter closed")
+	}
+
+	line := append(append(make([]byte, 0, len(data)+1), data...), '\n')
+
+	if fe.size > 0 && fe.size+int64(len(line)) > fe.maxSize {
+		if err := fe.rotate(); err != nil {
+			return fmt.Errorf("failed to rotate %s: %w", fe.path, err)
+		}
+	}
+
+	n, err := fe.file.Write(line)
+	fe.size += int64(n)
+
+	return err
+}
+
+// Close implements the Exporter interface
+func (fe *fileExporter) Close() error {
+	fe.Lock()
+	defer fe.Unlock()
+
+	if fe.file == nil {
+		return nil
+	}
+
+	err := fe.file.Close()
+	fe.file = nil
+
+	return err
+}
@@ -0,0 +1,70 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// +build !windows
+
+package agent
+
+import (
+	"fmt"
+	"log/syslog"
+)
+
+const defaultSyslogExporterTag = "datadog-cws"
+
+var syslogFacilities = map[string]syslog.Priority{
+	"kern":     syslog.LOG_KERN,
+	"user":     syslog.LOG_USER,
+	"daemon":   syslog.LOG_DAEMON,
+	"auth":     syslog.LOG_AUTH,
+	"syslog":   syslog.LOG_SYSLOG,
+	"authpriv": syslog.LOG_AUTHPRIV,
+	"local0":   syslog.LOG_LOCAL0,
+	"local1":   syslog.LOG_LOCAL1,
+	"local2":   syslog.LOG_LOCAL2,
+	"local3":   syslog.LOG_LOCAL3,
+	"local4":   syslog.LOG_LOCAL4,
+	"local5":   syslog.LOG_LOCAL5,
+	"local6":   syslog.LOG_LOCAL6,
+	"local7":   syslog.LOG_LOCAL7,
+}
+
+// syslogExporter sends the events to a syslog server, or to the local syslog daemon when no address is set
+type syslogExporter struct {
+	writer *syslog.Writer
+}
+
+func newSyslogExporter(cfg ExporterConfig) (Exporter, error) {
+	facility := syslog.LOG_DAEMON
+	if cfg.Facility != "" {
+		var found bool
+		if facility, found = syslogFacilities[cfg.Facility]; !found {
+			return nil, fmt.Errorf("unknown syslog facility `%s`", cfg.Facility)
+		}
+	}
+
+	tag := cfg.Tag
+	if tag == "" {
+		tag = defaultSyslogExporterTag
+	}
+
+	// the writer reconnects by itself when the connection is lost
+	writer, err := syslog.Dial(cfg.Network, cfg.Address, facility|syslog.LOG_WARNING, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	return &syslogExporter{writer: writer}, nil
+}
+
+// Export implements the Exporter interface
+func (se *syslogExporter) Export(data []byte) error {
+	return se.writer.Warning(string(data))
+}
+
+// Close implements the Exporter interface
+func (se *syslogExporter) Close() error {
+	return se.writer.Close()
+}
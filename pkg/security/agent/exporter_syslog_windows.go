@@ -0,0 +1,14 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// +build windows
+
+package agent
+
+import "errors"
+
+func newSyslogExporter(cfg ExporterConfig) (Exporter, error) {
+	return nil, errors.New("the syslog exporter isn't supported on Windows")
+}
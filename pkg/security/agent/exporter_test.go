@@ -0,0 +1,252 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package agent
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagFilter(t *testing.T) {
+	tags := []string{"rule_id:kernel_module", "severity:high"}
+
+	assert.True(t, tagFilter(nil).matches(tags))
+	assert.True(t, tagFilter{"rule_id:kernel_module"}.matches(tags))
+	assert.True(t, tagFilter{"rule_id:kernel_*"}.matches(tags))
+	assert.True(t, tagFilter{"rule_id:ptrace", "severity:high"}.matches(tags))
+	assert.False(t, tagFilter{"rule_id:ptrace", "severity:low"}.matches(tags))
+}
+
+func TestNewExporter(t *testing.T) {
+	_, err := NewExporter(ExporterConfig{Type: "kafka"})
+	assert.NotNil(t, err)
+
+	_, err = NewExporter(ExporterConfig{Type: fileExporterType})
+	assert.NotNil(t, err)
+
+	_, err = NewExporter(ExporterConfig{Type: webhookExporterType, Tags: []string{"rule_id:["}, URL: "http://localhost"})
+	assert.NotNil(t, err)
+}
+
+func TestFileExporterRotation(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cws-exporter")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "events.json")
+	exporter, err := NewExporter(ExporterConfig{Type: fileExporterType, Path: path, MaxBackups: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fe := exporter.(*queuedExporter).Exporter.(*fileExporter)
+	fe.maxSize = 10
+
+	for _, data := range []string{`{"a":1}`, `{"b":2}`, `{"c":3}`, `{"d":4}`} {
+		if err := exporter.Export([]byte(data)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the queued events are written on close
+	if err := exporter.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	for file, expected := range map[string]string{
+		path:        "{\"d\":4}\n",
+		path + ".1": "{\"c\":3}\n",
+		path + ".2": "{\"b\":2}\n",
+	} {
+		content, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, expected, string(content))
+	}
+
+	_, err = os.Stat(path + ".3")
+	assert.True(t, os.IsNotExist(err))
+}
+
+// blockingExporter blocks the export of the events until it is released
+type blockingExporter struct {
+	release  chan struct{}
+	exported [][]byte
+}
+
+func (be *blockingExporter) Export(data []byte) error {
+	<-be.release
+	if string(data) == "invalid" {
+		return errors.New("invalid event")
+	}
+	be.exported = append(be.exported, data)
+	return nil
+}
+
+func (be *blockingExporter) Close() error {
+	return nil
+}
+
+func TestQueuedExporter(t *testing.T) {
+	be := &blockingExporter{release: make(chan struct{})}
+	qe := newQueuedExporter(be)
+
+	// the exporter is blocked, the events beyond the capacity of the queue are dropped
+	var dropped uint64
+	for i := 0; i < exporterQueueSize+10; i++ {
+		if err := qe.Export([]byte(`{}`)); err != nil {
+			assert.Equal(t, errExporterQueueFull, err)
+			dropped++
+		}
+	}
+	assert.True(t, dropped >= 10)
+
+	close(be.release)
+	assert.Nil(t, qe.Close())
+	assert.Equal(t, exporterQueueSize+10-int(dropped), len(be.exported))
+
+	stats, failed := qe.swapStats()
+	assert.Equal(t, dropped, stats)
+	assert.Equal(t, uint64(0), failed)
+
+	// the stats are reset once reported
+	stats, _ = qe.swapStats()
+	assert.Equal(t, uint64(0), stats)
+
+	be = &blockingExporter{release: make(chan struct{})}
+	close(be.release)
+	qe = newQueuedExporter(be)
+	assert.Nil(t, qe.Export([]byte("invalid")))
+	assert.Nil(t, qe.Close())
+	_, failed = qe.swapStats()
+	assert.Equal(t, uint64(1), failed)
+}
+
+func TestWebhookExporter(t *testing.T) {
+	var (
+		lock     sync.Mutex
+		attempts int
+		batches  [][]map[string]interface{}
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, "secret", r.Header.Get("X-Api-Key"))
+
+		// the first attempt fails and has to be retried
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		var batch []map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&batch); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		batches = append(batches, batch)
+	}))
+	defer server.Close()
+
+	exporter, err := NewExporter(ExporterConfig{
+		Type:          webhookExporterType,
+		URL:           server.URL,
+		Headers:       map[string]string{"X-Api-Key": "secret"},
+		BatchSize:     2,
+		FlushInterval: 60,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	exporter.(*webhookExporter).initialInterval = time.Millisecond
+
+	for _, data := range []string{`{"a":1}`, `{"b":2}`, `{"c":3}`} {
+		if err := exporter.Export([]byte(data)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the last event is sent on close
+	exporter.Close()
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	assert.Equal(t, 3, attempts)
+	assert.Equal(t, [][]map[string]interface{}{
+		{{"a": float64(1)}, {"b": float64(2)}},
+		{{"c": float64(3)}},
+	}, batches)
+}
+
+func TestWebhookExporterSlowEndpoint(t *testing.T) {
+	release := make(chan struct{})
+	var (
+		lock    sync.Mutex
+		batches int
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+
+		lock.Lock()
+		defer lock.Unlock()
+		batches++
+	}))
+	defer server.Close()
+
+	exporter, err := NewExporter(ExporterConfig{
+		Type:          webhookExporterType,
+		URL:           server.URL,
+		BatchSize:     1,
+		FlushInterval: 60,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the events keep being batched while the first batch is blocked, the batches beyond the capacity of the batch
+	// queue being dropped
+	total := webhookExporterBatchQueueSize + 10
+	for i := 0; i < total; i++ {
+		if err := exporter.Export([]byte(`{}`)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	we := exporter.(*webhookExporter)
+	assert.Eventually(t, func() bool {
+		return len(we.events) == 0 && atomic.LoadUint64(&we.dropped) > 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	close(release)
+	exporter.Close()
+
+	dropped, failed := we.swapStats()
+	assert.Equal(t, uint64(0), failed)
+
+	lock.Lock()
+	defer lock.Unlock()
+	assert.Equal(t, total, batches+int(dropped))
+}
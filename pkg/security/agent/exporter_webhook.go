@@ -0,0 +1,200 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package agent
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cenkalti/backoff"
+
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+const (
+	defaultWebhookExporterBatchSize     = 100
+	defaultWebhookExporterFlushInterval = 5
+	defaultWebhookExporterMaxRetries    = 3
+	defaultWebhookExporterTimeout       = 10
+	// webhookExporterBatchQueueSize is the number of batches waiting to be sent while a batch is retried
+	webhookExporterBatchQueueSize = 10
+)
+
+// webhookExporter posts the events in batches, as a JSON array, to an HTTP endpoint. A batch is sent once it
+// reaches the batch size or when the flush interval is over, and retried with an exponential backoff. The batches
+// are sent from their own queue, so that the events keep being batched while a batch is retried.
+type webhookExporter struct {
+	exporterStats
+	url           string
+	headers       map[string]string
+	batchSize     int
+	flushInterval time.Duration
+	maxRetries    uint64
+	client        *http.Client
+	events        chan []byte
+	batches       chan [][]byte
+	wg            sync.WaitGroup
+	closeOnce     sync.Once
+	// initialInterval of the retries backoff, overridden by the tests
+	initialInterval time.Duration
+}
+
+func newWebhookExporter(cfg ExporterConfig) (Exporter, error) {
+	if cfg.URL == "" {
+		return nil, errors.New("the url of the webhook exporter is required")
+	}
+
+	we := &webhookExporter{
+		url:             cfg.URL,
+		headers:         cfg.Headers,
+		batchSize:       cfg.BatchSize,
+		flushInterval:   time.Duration(cfg.FlushInterval) * time.Second,
+		maxRetries:      defaultWebhookExporterMaxRetries,
+		events:          make(chan []byte, exporterQueueSize),
+		batches:         make(chan [][]byte, webhookExporterBatchQueueSize),
+		initialInterval: backoff.DefaultInitialInterval,
+	}
+
+	if we.batchSize <= 0 {
+		we.batchSize = defaultWebhookExporterBatchSize
+	}
+	if we.flushInterval <= 0 {
+		we.flushInterval = defaultWebhookExporterFlushInterval * time.Second
+	}
+	if cfg.MaxRetries > 0 {
+		we.maxRetries = uint64(cfg.MaxRetries)
+	}
+
+	timeout := time.Duration(cfg.Timeout) * time.Second
+	if timeout <= 0 {
+		timeout = defaultWebhookExporterTimeout * time.Second
+	}
+	we.client = &http.Client{Timeout: timeout}
+
+	we.wg.Add(2)
+	go we.run()
+	go we.sendBatches()
+
+	return we, nil
+}
+
+// run batches the events and queues the batches to be sent
+func (we *webhookExporter) run() {
+	defer we.wg.Done()
+
+	ticker := time.NewTicker(we.flushInterval)
+	defer ticker.Stop()
+
+	var batch [][]byte
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		select {
+		case we.batches <- batch:
+		default:
+			atomic.AddUint64(&we.dropped, uint64(len(batch)))
+		}
+		batch = nil
+	}
+
+	for {
+		select {
+		case data, ok := <-we.events:
+			if !ok {
+				flush()
+				close(we.batches)
+				return
+			}
+
+			batch = append(batch, data)
+			if len(batch) >= we.batchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		}
+	}
+}
+
+// sendBatches sends the queued batches
+func (we *webhookExporter) sendBatches() {
+	defer we.wg.Done()
+
+	for batch := range we.batches {
+		if err := we.send(batch); err != nil {
+			atomic.AddUint64(&we.failed, uint64(len(batch)))
+			log.Errorf("failed to send %d events to %s: %s", len(batch), we.url, err)
+		}
+	}
+}
+
+func (we *webhookExporter) send(batch [][]byte) error {
+	var body bytes.Buffer
+	body.WriteByte('[')
+	body.Write(bytes.Join(batch, []byte(",")))
+	body.WriteByte(']')
+	payload := body.Bytes()
+
+	post := func() error {
+		req, err := http.NewRequest(http.MethodPost, we.url, bytes.NewReader(payload))
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+
+		req.Header.Set("Content-Type", "application/json")
+		for key, value := range we.headers {
+			req.Header.Set(key, value)
+		}
+
+		resp, err := we.client.Do(req)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+
+		switch {
+		case resp.StatusCode < 300:
+			return nil
+		case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+			return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+		default:
+			// the request won't succeed by being sent again
+			return backoff.Permanent(fmt.Errorf("unexpected status code %d", resp.StatusCode))
+		}
+	}
+
+	expBackoff := backoff.NewExponentialBackOff()
+	expBackoff.InitialInterval = we.initialInterval
+
+	return backoff.Retry(post, backoff.WithMaxRetries(expBackoff, we.maxRetries))
+}
+
+// Export implements the Exporter interface. The event is dropped when the queue of the exporter is full.
+func (we *webhookExporter) Export(data []byte) error {
+	select {
+	case we.events <- data:
+		return nil
+	default:
+		atomic.AddUint64(&we.dropped, 1)
+		return errExporterQueueFull
+	}
+}
+
+// Close implements the Exporter interface, the pending events are sent before returning
+func (we *webhookExporter) Close() error {
+	we.closeOnce.Do(func() {
+		close(we.events)
+	})
+	we.wg.Wait()
+
+	return nil
+}
@@ -22,6 +22,7 @@ type telemetry struct {
 	sender                aggregator.Sender
 	detector              collectors.DetectorInterface
 	runtimeSecurityClient *RuntimeSecurityClient
+	exporters             []*filteredExporter
 }
 
 func newTelemetry() (*telemetry, error) {
@@ -56,10 +57,32 @@ func (t *telemetry) run(ctx context.Context) {
 			if err := t.reportContainers(); err != nil {
 				log.Debugf("couldn't report containers: %v", err)
 			}
+			t.reportExporters()
 		}
 	}
 }
 
+// reportExporters reports the events the exporters didn't export since the last report
+func (t *telemetry) reportExporters() {
+	for _, exporter := range t.exporters {
+		stats, ok := exporter.Exporter.(statsExporter)
+		if !ok {
+			continue
+		}
+
+		dropped, failed := stats.swapStats()
+		if dropped == 0 && failed == 0 {
+			continue
+		}
+
+		tags := []string{"exporter:" + exporter.name}
+		t.sender.Count(metrics.MetricSecurityAgentExporterDropped, float64(dropped), "", tags)
+		t.sender.Count(metrics.MetricSecurityAgentExporterFailed, float64(failed), "", tags)
+		log.Warnf("the %s exporter dropped %d events because its queue was full, and failed to export %d events", exporter.name, dropped, failed)
+	}
+	t.sender.Commit()
+}
+
 func (t *telemetry) reportContainers() error {
 	// retrieve the runtime security module config
 	cfg, err := t.runtimeSecurityClient.GetConfig()
@@ -88,6 +88,18 @@ type Config struct {
 	SelfTestEnabled bool
 	// EnableRemoteConfig defines if configuration should be fetched from the backend
 	EnableRemoteConfig bool
+	// EnforcementEnabled defines if the kill action of the rules sends signals to processes
+	EnforcementEnabled bool
+	// NetworkEnabled defines if the network events (connect, bind, accept and DNS) are enabled
+	NetworkEnabled bool
+	// ActivityDumpEnabled defines if the activity dumps and the enforcement of their profiles are enabled
+	ActivityDumpEnabled bool
+	// ActivityDumpOutputDir defines the directory where the activity dumps are written
+	ActivityDumpOutputDir string
+	// ActivityDumpDefaultTimeout defines the duration of an activity dump started without timeout
+	ActivityDumpDefaultTimeout time.Duration
+	// ActivityDumpProfilesDir defines the directory of the profiles to enforce
+	ActivityDumpProfilesDir string
 }
 
 // IsEnabled returns true if any feature is enabled. Has to be applied in config package too
@@ -128,6 +140,12 @@ func NewConfig(cfg *config.Config) (*Config, error) {
 		LogPatterns:                        aconfig.Datadog.GetStringSlice("runtime_security_config.log_patterns"),
 		SelfTestEnabled:                    aconfig.Datadog.GetBool("runtime_security_config.self_test.enabled"),
 		EnableRemoteConfig:                 aconfig.Datadog.GetBool("runtime_security_config.enable_remote_configuration"),
+		EnforcementEnabled:                 aconfig.Datadog.GetBool("runtime_security_config.enforcement.enabled"),
+		NetworkEnabled:                     aconfig.Datadog.GetBool("runtime_security_config.network.enabled"),
+		ActivityDumpEnabled:                aconfig.Datadog.GetBool("runtime_security_config.activity_dump.enabled"),
+		ActivityDumpOutputDir:              aconfig.Datadog.GetString("runtime_security_config.activity_dump.output_dir"),
+		ActivityDumpDefaultTimeout:         time.Duration(aconfig.Datadog.GetInt("runtime_security_config.activity_dump.default_timeout")) * time.Second,
+		ActivityDumpProfilesDir:            aconfig.Datadog.GetString("runtime_security_config.activity_dump.profiles_dir"),
 	}
 
 	// if runtime is enabled then we force fim
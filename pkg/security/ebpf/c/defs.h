@@ -213,6 +213,16 @@ enum event_type
     EVENT_MOUNT_RELEASED,
     EVENT_SELINUX,
     EVENT_BPF,
+    EVENT_CONNECT,
+    EVENT_BIND,
+    EVENT_ACCEPT,
+    EVENT_DNS,
+    EVENT_PTRACE,
+    EVENT_MMAP,
+    EVENT_MPROTECT,
+    EVENT_INIT_MODULE,
+    EVENT_DELETE_MODULE,
+    EVENT_SIGNAL,
     EVENT_MAX, // has to be the last one
 };
 
@@ -382,6 +392,26 @@ struct bpf_map_def SEC("maps/events_stats") events_stats = {
         }                                                                                                              \
     }                                                                                                                  \
 
+#define send_event_ptr(ctx, event_type, kernel_event)                                                                  \
+    kernel_event->event.type = event_type;                                                                             \
+    kernel_event->event.cpu = bpf_get_smp_processor_id();                                                              \
+    kernel_event->event.timestamp = bpf_ktime_get_ns();                                                                \
+                                                                                                                       \
+    u64 size = sizeof(*kernel_event);                                                                                  \
+    int perf_ret = bpf_perf_event_output(ctx, &events, kernel_event->event.cpu, kernel_event, size);                   \
+                                                                                                                       \
+    if (kernel_event->event.type < EVENT_MAX) {                                                                        \
+        struct perf_map_stats_t *stats = bpf_map_lookup_elem(&events_stats, &kernel_event->event.type);                \
+        if (stats != NULL) {                                                                                           \
+            if (!perf_ret) {                                                                                           \
+                __sync_fetch_and_add(&stats->bytes, size + 4);                                                         \
+                __sync_fetch_and_add(&stats->count, 1);                                                                \
+            } else {                                                                                                   \
+                __sync_fetch_and_add(&stats->lost, 1);                                                                 \
+            }                                                                                                          \
+        }                                                                                                              \
+    }                                                                                                                  \
+
 
 // implemented in the discarder.h file
 int __attribute__((always_inline)) bump_discarder_revision(u32 mount_id);
@@ -0,0 +1,136 @@
+#ifndef _MMAP_H_
+#define _MMAP_H_
+
+#include "syscalls.h"
+
+struct mmap_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct span_context_t span;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    struct file_t file;
+    u64 addr;
+    u64 offset;
+    u32 len;
+    int protection;
+    int flags;
+    u32 padding;
+};
+
+SYSCALL_KPROBE0(mmap) {
+    struct policy_t policy = fetch_policy(EVENT_MMAP);
+    if (is_discarded_by_process(policy.mode, EVENT_MMAP)) {
+        return 0;
+    }
+
+    struct syscall_cache_t syscall = {
+        .type = EVENT_MMAP,
+        .policy = policy,
+    };
+
+    cache_syscall(&syscall);
+    return 0;
+}
+
+SEC("kprobe/vm_mmap_pgoff")
+int kprobe_vm_mmap_pgoff(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = peek_syscall(EVENT_MMAP);
+    if (!syscall)
+        return 0;
+
+    struct file *file = (struct file *)PT_REGS_PARM1(ctx);
+    syscall->mmap.len = (u32)PT_REGS_PARM3(ctx);
+    syscall->mmap.protection = (int)PT_REGS_PARM4(ctx);
+    syscall->mmap.flags = (int)PT_REGS_PARM5(ctx);
+    syscall->mmap.offset = (u64)PT_REGS_PARM6(ctx) << PAGE_SHIFT;
+
+    // anonymous mappings aren't backed by a file
+    if (file != NULL) {
+        syscall->mmap.dentry = get_file_dentry(file);
+        syscall->mmap.file.path_key.mount_id = get_file_mount_id(file);
+        set_file_inode(syscall->mmap.dentry, &syscall->mmap.file, 0);
+    }
+    return 0;
+}
+
+int __attribute__((always_inline)) send_mmap_event(void *ctx, struct syscall_cache_t *syscall) {
+    struct mmap_event_t event = {
+        .syscall.retval = IS_ERR(syscall->mmap.addr) ? (s64)syscall->mmap.addr : 0,
+        .file = syscall->mmap.file,
+        .addr = IS_ERR(syscall->mmap.addr) ? 0 : syscall->mmap.addr,
+        .offset = syscall->mmap.offset,
+        .len = syscall->mmap.len,
+        .protection = syscall->mmap.protection,
+        .flags = syscall->mmap.flags,
+    };
+
+    if (syscall->mmap.dentry != NULL) {
+        fill_file_metadata(syscall->mmap.dentry, &event.file.metadata);
+    }
+    struct proc_cache_t *entry = fill_process_context(&event.process);
+    fill_container_context(entry, &event.container);
+    fill_span_context(&event.span);
+
+    send_event(ctx, EVENT_MMAP, event);
+    return 0;
+}
+
+int __attribute__((always_inline)) sys_mmap_ret(void *ctx, u64 addr, int dr_type) {
+    struct syscall_cache_t *syscall = peek_syscall(EVENT_MMAP);
+    if (!syscall)
+        return 0;
+
+    if (IS_ERR(addr) && IS_UNHANDLED_ERROR((s64)addr)) {
+        pop_syscall(EVENT_MMAP);
+        return 0;
+    }
+    syscall->mmap.addr = addr;
+
+    if (syscall->mmap.dentry == NULL) {
+        pop_syscall(EVENT_MMAP);
+        return send_mmap_event(ctx, syscall);
+    }
+
+    syscall->resolver.key = syscall->mmap.file.path_key;
+    syscall->resolver.dentry = syscall->mmap.dentry;
+    syscall->resolver.discarder_type = 0;
+    syscall->resolver.callback = dr_type == DR_KPROBE ? DR_MMAP_CALLBACK_KPROBE_KEY : DR_MMAP_CALLBACK_TRACEPOINT_KEY;
+    syscall->resolver.iteration = 0;
+    syscall->resolver.ret = 0;
+
+    resolve_dentry(ctx, dr_type);
+
+    // if the tail call fails, we need to pop the syscall cache entry
+    pop_syscall(EVENT_MMAP);
+    return 0;
+}
+
+SYSCALL_KRETPROBE(mmap) {
+    return sys_mmap_ret(ctx, (u64)PT_REGS_RC(ctx), DR_KPROBE);
+}
+
+SEC("tracepoint/syscalls/sys_exit_mmap")
+int tracepoint_syscalls_sys_exit_mmap(struct tracepoint_syscalls_sys_exit_t *args) {
+    return sys_mmap_ret(args, (u64)args->ret, DR_TRACEPOINT);
+}
+
+int __attribute__((always_inline)) dr_mmap_callback(void *ctx) {
+    struct syscall_cache_t *syscall = pop_syscall(EVENT_MMAP);
+    if (!syscall)
+        return 0;
+
+    return send_mmap_event(ctx, syscall);
+}
+
+SEC("kprobe/dr_mmap_callback")
+int __attribute__((always_inline)) kprobe_dr_mmap_callback(struct pt_regs *ctx) {
+    return dr_mmap_callback(ctx);
+}
+
+SEC("tracepoint/dr_mmap_callback")
+int __attribute__((always_inline)) tracepoint_dr_mmap_callback(struct tracepoint_syscalls_sys_exit_t *args) {
+    return dr_mmap_callback(args);
+}
+
+#endif
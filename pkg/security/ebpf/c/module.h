@@ -0,0 +1,210 @@
+#ifndef _MODULE_H_
+#define _MODULE_H_
+
+#include <linux/module.h>
+
+#include "syscalls.h"
+
+struct init_module_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct span_context_t span;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    struct file_t file;
+    char name[KMOD_NAME_LEN];
+    u32 loaded_from_memory;
+    u32 padding;
+};
+
+struct delete_module_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct span_context_t span;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    char name[KMOD_NAME_LEN];
+};
+
+int __attribute__((always_inline)) trace_init_module(u32 loaded_from_memory) {
+    struct policy_t policy = fetch_policy(EVENT_INIT_MODULE);
+    if (is_discarded_by_process(policy.mode, EVENT_INIT_MODULE)) {
+        return 0;
+    }
+
+    struct syscall_cache_t syscall = {
+        .type = EVENT_INIT_MODULE,
+        .policy = policy,
+        .init_module = {
+            .loaded_from_memory = loaded_from_memory,
+        },
+    };
+
+    cache_syscall(&syscall);
+    return 0;
+}
+
+SYSCALL_KPROBE0(init_module) {
+    return trace_init_module(1);
+}
+
+SYSCALL_KPROBE0(finit_module) {
+    return trace_init_module(0);
+}
+
+// the module file is read through security_kernel_read_file when it's loaded with finit_module
+SEC("kprobe/security_kernel_read_file")
+int kprobe_security_kernel_read_file(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = peek_syscall(EVENT_INIT_MODULE);
+    if (!syscall || syscall->init_module.loaded_from_memory)
+        return 0;
+
+    struct file *file = (struct file *)PT_REGS_PARM1(ctx);
+    syscall->init_module.dentry = get_file_dentry(file);
+    syscall->init_module.file.path_key.mount_id = get_file_mount_id(file);
+    set_file_inode(syscall->init_module.dentry, &syscall->init_module.file, 0);
+    return 0;
+}
+
+SEC("kprobe/do_init_module")
+int kprobe_do_init_module(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = peek_syscall(EVENT_INIT_MODULE);
+    if (!syscall)
+        return 0;
+
+    struct module *mod = (struct module *)PT_REGS_PARM1(ctx);
+    bpf_probe_read_str(&syscall->init_module.name, sizeof(syscall->init_module.name), &mod->name);
+    return 0;
+}
+
+int __attribute__((always_inline)) send_init_module_event(void *ctx, struct syscall_cache_t *syscall, int retval) {
+    struct init_module_event_t event = {
+        .syscall.retval = retval,
+        .file = syscall->init_module.file,
+        .loaded_from_memory = syscall->init_module.loaded_from_memory,
+    };
+    bpf_probe_read_str(&event.name, sizeof(event.name), &syscall->init_module.name);
+
+    if (syscall->init_module.dentry != NULL) {
+        fill_file_metadata(syscall->init_module.dentry, &event.file.metadata);
+    }
+    struct proc_cache_t *entry = fill_process_context(&event.process);
+    fill_container_context(entry, &event.container);
+    fill_span_context(&event.span);
+
+    send_event(ctx, EVENT_INIT_MODULE, event);
+    return 0;
+}
+
+int __attribute__((always_inline)) sys_init_module_ret(void *ctx, int retval, int dr_type) {
+    struct syscall_cache_t *syscall = peek_syscall(EVENT_INIT_MODULE);
+    if (!syscall)
+        return 0;
+
+    if (IS_UNHANDLED_ERROR(retval)) {
+        pop_syscall(EVENT_INIT_MODULE);
+        return 0;
+    }
+
+    if (syscall->init_module.dentry == NULL) {
+        pop_syscall(EVENT_INIT_MODULE);
+        return send_init_module_event(ctx, syscall, retval);
+    }
+
+    syscall->resolver.key = syscall->init_module.file.path_key;
+    syscall->resolver.dentry = syscall->init_module.dentry;
+    syscall->resolver.discarder_type = 0;
+    syscall->resolver.callback = dr_type == DR_KPROBE ? DR_INIT_MODULE_CALLBACK_KPROBE_KEY : DR_INIT_MODULE_CALLBACK_TRACEPOINT_KEY;
+    syscall->resolver.iteration = 0;
+    syscall->resolver.ret = 0;
+
+    resolve_dentry(ctx, dr_type);
+
+    // if the tail call fails, we need to pop the syscall cache entry
+    pop_syscall(EVENT_INIT_MODULE);
+    return 0;
+}
+
+SYSCALL_KRETPROBE(init_module) {
+    return sys_init_module_ret(ctx, (int)PT_REGS_RC(ctx), DR_KPROBE);
+}
+
+SEC("tracepoint/syscalls/sys_exit_init_module")
+int tracepoint_syscalls_sys_exit_init_module(struct tracepoint_syscalls_sys_exit_t *args) {
+    return sys_init_module_ret(args, args->ret, DR_TRACEPOINT);
+}
+
+SYSCALL_KRETPROBE(finit_module) {
+    return sys_init_module_ret(ctx, (int)PT_REGS_RC(ctx), DR_KPROBE);
+}
+
+SEC("tracepoint/syscalls/sys_exit_finit_module")
+int tracepoint_syscalls_sys_exit_finit_module(struct tracepoint_syscalls_sys_exit_t *args) {
+    return sys_init_module_ret(args, args->ret, DR_TRACEPOINT);
+}
+
+int __attribute__((always_inline)) dr_init_module_callback(void *ctx, int retval) {
+    struct syscall_cache_t *syscall = pop_syscall(EVENT_INIT_MODULE);
+    if (!syscall)
+        return 0;
+
+    return send_init_module_event(ctx, syscall, retval);
+}
+
+SEC("kprobe/dr_init_module_callback")
+int __attribute__((always_inline)) kprobe_dr_init_module_callback(struct pt_regs *ctx) {
+    return dr_init_module_callback(ctx, (int)PT_REGS_RC(ctx));
+}
+
+SEC("tracepoint/dr_init_module_callback")
+int __attribute__((always_inline)) tracepoint_dr_init_module_callback(struct tracepoint_syscalls_sys_exit_t *args) {
+    return dr_init_module_callback(args, args->ret);
+}
+
+SYSCALL_KPROBE1(delete_module, const char *, name_user) {
+    struct policy_t policy = fetch_policy(EVENT_DELETE_MODULE);
+    if (is_discarded_by_process(policy.mode, EVENT_DELETE_MODULE)) {
+        return 0;
+    }
+
+    struct syscall_cache_t syscall = {
+        .type = EVENT_DELETE_MODULE,
+        .policy = policy,
+    };
+    bpf_probe_read_str(&syscall.delete_module.name, sizeof(syscall.delete_module.name), (void *)name_user);
+
+    cache_syscall(&syscall);
+    return 0;
+}
+
+int __attribute__((always_inline)) sys_delete_module_ret(void *ctx, int retval) {
+    struct syscall_cache_t *syscall = pop_syscall(EVENT_DELETE_MODULE);
+    if (!syscall)
+        return 0;
+
+    if (IS_UNHANDLED_ERROR(retval))
+        return 0;
+
+    struct delete_module_event_t event = {
+        .syscall.retval = retval,
+    };
+    bpf_probe_read_str(&event.name, sizeof(event.name), &syscall->delete_module.name);
+
+    struct proc_cache_t *entry = fill_process_context(&event.process);
+    fill_container_context(entry, &event.container);
+    fill_span_context(&event.span);
+
+    send_event(ctx, EVENT_DELETE_MODULE, event);
+    return 0;
+}
+
+SYSCALL_KRETPROBE(delete_module) {
+    return sys_delete_module_ret(ctx, (int)PT_REGS_RC(ctx));
+}
+
+SEC("tracepoint/syscalls/sys_exit_delete_module")
+int tracepoint_syscalls_sys_exit_delete_module(struct tracepoint_syscalls_sys_exit_t *args) {
+    return sys_delete_module_ret(args, args->ret);
+}
+
+#endif
@@ -0,0 +1,86 @@
+#ifndef _MPROTECT_H_
+#define _MPROTECT_H_
+
+#include <linux/mm_types.h>
+
+#include "syscalls.h"
+
+struct mprotect_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct span_context_t span;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    u64 vm_start;
+    u64 vm_end;
+    u32 vm_protection;
+    u32 req_protection;
+};
+
+SYSCALL_KPROBE0(mprotect) {
+    struct policy_t policy = fetch_policy(EVENT_MPROTECT);
+    if (is_discarded_by_process(policy.mode, EVENT_MPROTECT)) {
+        return 0;
+    }
+
+    struct syscall_cache_t syscall = {
+        .type = EVENT_MPROTECT,
+        .policy = policy,
+    };
+
+    cache_syscall(&syscall);
+    return 0;
+}
+
+SEC("kprobe/security_file_mprotect")
+int kprobe_security_file_mprotect(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = peek_syscall(EVENT_MPROTECT);
+    if (!syscall)
+        return 0;
+
+    struct vm_area_struct *vma = (struct vm_area_struct *)PT_REGS_PARM1(ctx);
+    bpf_probe_read(&syscall->mprotect.vm_start, sizeof(syscall->mprotect.vm_start), &vma->vm_start);
+    bpf_probe_read(&syscall->mprotect.vm_end, sizeof(syscall->mprotect.vm_end), &vma->vm_end);
+
+    // the lower bits of the vm flags match the PROT_* values
+    u64 vm_flags = 0;
+    bpf_probe_read(&vm_flags, sizeof(vm_flags), &vma->vm_flags);
+    syscall->mprotect.vm_protection = vm_flags & (VM_READ | VM_WRITE | VM_EXEC);
+    syscall->mprotect.req_protection = (u32)PT_REGS_PARM2(ctx);
+    return 0;
+}
+
+int __attribute__((always_inline)) sys_mprotect_ret(void *ctx, int retval) {
+    struct syscall_cache_t *syscall = pop_syscall(EVENT_MPROTECT);
+    if (!syscall)
+        return 0;
+
+    if (IS_UNHANDLED_ERROR(retval))
+        return 0;
+
+    struct mprotect_event_t event = {
+        .syscall.retval = retval,
+        .vm_start = syscall->mprotect.vm_start,
+        .vm_end = syscall->mprotect.vm_end,
+        .vm_protection = syscall->mprotect.vm_protection,
+        .req_protection = syscall->mprotect.req_protection,
+    };
+
+    struct proc_cache_t *entry = fill_process_context(&event.process);
+    fill_container_context(entry, &event.container);
+    fill_span_context(&event.span);
+
+    send_event(ctx, EVENT_MPROTECT, event);
+    return 0;
+}
+
+SYSCALL_KRETPROBE(mprotect) {
+    return sys_mprotect_ret(ctx, (int)PT_REGS_RC(ctx));
+}
+
+SEC("tracepoint/syscalls/sys_exit_mprotect")
+int tracepoint_syscalls_sys_exit_mprotect(struct tracepoint_syscalls_sys_exit_t *args) {
+    return sys_mprotect_ret(args, args->ret);
+}
+
+#endif
@@ -0,0 +1,377 @@
+#ifndef _NETWORK_H_
+#define _NETWORK_H_
+
+#include <linux/in.h>
+#include <linux/in6.h>
+#include <linux/net.h>
+#include <linux/socket.h>
+#include <linux/uio.h>
+#include <net/sock.h>
+
+#include "syscalls.h"
+
+#define DNS_PORT 53
+#define DNS_HEADER_LENGTH 12
+#define DNS_MAX_LENGTH 256
+
+struct connect_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct span_context_t span;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    struct net_addr_t addr;
+};
+
+struct bind_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct span_context_t span;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    struct net_addr_t addr;
+};
+
+struct accept_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct span_context_t span;
+    struct container_context_t container;
+    struct syscall_t syscall;
+    struct net_addr_t addr;
+};
+
+struct dns_event_t {
+    struct kevent_t event;
+    struct process_context_t process;
+    struct span_context_t span;
+    struct container_context_t container;
+    u32 size;
+    u32 padding;
+    char payload[DNS_MAX_LENGTH];
+};
+
+struct bpf_map_def SEC("maps/dns_event") dns_event = {
+    .type = BPF_MAP_TYPE_PERCPU_ARRAY,
+    .key_size = sizeof(u32),
+    .value_size = sizeof(struct dns_event_t),
+    .max_entries = 1,
+    .pinning = 0,
+    .namespace = "",
+};
+
+// fill_net_addr copies the IP address and the port of an inet socket address, the port being kept in network byte order
+void __attribute__((always_inline)) fill_net_addr(struct net_addr_t *addr, struct sockaddr *sa) {
+    bpf_probe_read(&addr->family, sizeof(addr->family), &sa->sa_family);
+
+    switch (addr->family) {
+    case AF_INET:
+        bpf_probe_read(&addr->addr, sizeof(u32), &((struct sockaddr_in *)sa)->sin_addr.s_addr);
+        bpf_probe_read(&addr->port, sizeof(addr->port), &((struct sockaddr_in *)sa)->sin_port);
+        break;
+    case AF_INET6:
+        bpf_probe_read(&addr->addr, sizeof(addr->addr), &((struct sockaddr_in6 *)sa)->sin6_addr);
+        bpf_probe_read(&addr->port, sizeof(addr->port), &((struct sockaddr_in6 *)sa)->sin6_port);
+        break;
+    }
+}
+
+int __attribute__((always_inline)) is_inet_family(u16 family) {
+    return family == AF_INET || family == AF_INET6;
+}
+
+int __attribute__((always_inline)) trace__sys_network(u64 type) {
+    struct policy_t policy = fetch_policy(type);
+    if (is_discarded_by_process(policy.mode, type)) {
+        return 0;
+    }
+
+    struct syscall_cache_t syscall = {
+        .type = type,
+        .policy = policy,
+    };
+
+    cache_syscall(&syscall);
+
+    return 0;
+}
+
+SYSCALL_KPROBE3(connect, int, fd, struct sockaddr __user *, uservaddr, int, addrlen) {
+    return trace__sys_network(EVENT_CONNECT);
+}
+
+SYSCALL_KPROBE3(bind, int, fd, struct sockaddr __user *, umyaddr, int, addrlen) {
+    return trace__sys_network(EVENT_BIND);
+}
+
+SYSCALL_KPROBE3(accept, int, fd, struct sockaddr __user *, upeer_sockaddr, int __user *, upeer_addrlen) {
+    return trace__sys_network(EVENT_ACCEPT);
+}
+
+SYSCALL_KPROBE4(accept4, int, fd, struct sockaddr __user *, upeer_sockaddr, int __user *, upeer_addrlen, int, flags) {
+    return trace__sys_network(EVENT_ACCEPT);
+}
+
+SEC("kprobe/security_socket_connect")
+int kprobe_security_socket_connect(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = peek_syscall(EVENT_CONNECT);
+    if (!syscall)
+        return 0;
+
+    // the address was already copied from user space
+    fill_net_addr(&syscall->net.addr, (struct sockaddr *)PT_REGS_PARM2(ctx));
+    return 0;
+}
+
+SEC("kprobe/security_socket_bind")
+int kprobe_security_socket_bind(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = peek_syscall(EVENT_BIND);
+    if (!syscall)
+        return 0;
+
+    fill_net_addr(&syscall->net.addr, (struct sockaddr *)PT_REGS_PARM2(ctx));
+    return 0;
+}
+
+SEC("kretprobe/inet_csk_accept")
+int kretprobe_inet_csk_accept(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = peek_syscall(EVENT_ACCEPT);
+    if (!syscall)
+        return 0;
+
+    struct sock *sk = (struct sock *)PT_REGS_RC(ctx);
+    if (sk == NULL)
+        return 0;
+
+    // the address of the peer of the new connection
+    struct net_addr_t *addr = &syscall->net.addr;
+    bpf_probe_read(&addr->family, sizeof(addr->family), &sk->__sk_common.skc_family);
+    bpf_probe_read(&addr->port, sizeof(addr->port), &sk->__sk_common.skc_dport);
+
+    switch (addr->family) {
+    case AF_INET:
+        bpf_probe_read(&addr->addr, sizeof(u32), &sk->__sk_common.skc_daddr);
+        break;
+    case AF_INET6:
+        bpf_probe_read(&addr->addr, sizeof(addr->addr), &sk->__sk_common.skc_v6_daddr);
+        break;
+    }
+    return 0;
+}
+
+#define DECLARE_NETWORK_EVENT_RET(name, TYPE)                                                                          \
+int __attribute__((always_inline)) sys_##name##_ret(void *ctx, int retval) {                                           \
+    struct syscall_cache_t *syscall = pop_syscall(TYPE);                                                               \
+    if (!syscall)                                                                                                      \
+        return 0;                                                                                                      \
+                                                                                                                       \
+    if (!is_inet_family(syscall->net.addr.family))                                                                     \
+        return 0;                                                                                                      \
+                                                                                                                       \
+    if (IS_UNHANDLED_ERROR(retval) && retval != -EINPROGRESS)                                                          \
+        return 0;                                                                                                      \
+                                                                                                                       \
+    struct name##_event_t event = {                                                                                    \
+        .syscall.retval = retval,                                                                                      \
+        .addr = syscall->net.addr,                                                                                     \
+    };                                                                                                                 \
+                                                                                                                       \
+    struct proc_cache_t *entry = fill_process_context(&event.process);                                                 \
+    fill_container_context(entry, &event.container);                                                                   \
+    fill_span_context(&event.span);                                                                                    \
+                                                                                                                       \
+    send_event(ctx, TYPE, event);                                                                                      \
+    return 0;                                                                                                          \
+}
+
+DECLARE_NETWORK_EVENT_RET(connect, EVENT_CONNECT)
+DECLARE_NETWORK_EVENT_RET(bind, EVENT_BIND)
+DECLARE_NETWORK_EVENT_RET(accept, EVENT_ACCEPT)
+
+SYSCALL_KRETPROBE(connect) {
+    return sys_connect_ret(ctx, (int)PT_REGS_RC(ctx));
+}
+
+SEC("tracepoint/syscalls/sys_exit_connect")
+int tracepoint_syscalls_sys_exit_connect(struct tracepoint_syscalls_sys_exit_t *args) {
+    return sys_connect_ret(args, args->ret);
+}
+
+SYSCALL_KRETPROBE(bind) {
+    return sys_bind_ret(ctx, (int)PT_REGS_RC(ctx));
+}
+
+SEC("tracepoint/syscalls/sys_exit_bind")
+int tracepoint_syscalls_sys_exit_bind(struct tracepoint_syscalls_sys_exit_t *args) {
+    return sys_bind_ret(args, args->ret);
+}
+
+SYSCALL_KRETPROBE(accept) {
+    return sys_accept_ret(ctx, (int)PT_REGS_RC(ctx));
+}
+
+SEC("tracepoint/syscalls/sys_exit_accept")
+int tracepoint_syscalls_sys_exit_accept(struct tracepoint_syscalls_sys_exit_t *args) {
+    return sys_accept_ret(args, args->ret);
+}
+
+SYSCALL_KRETPROBE(accept4) {
+    return sys_accept_ret(ctx, (int)PT_REGS_RC(ctx));
+}
+
+SEC("tracepoint/syscalls/sys_exit_accept4")
+int tracepoint_syscalls_sys_exit_accept4(struct tracepoint_syscalls_sys_exit_t *args) {
+    return sys_accept_ret(args, args->ret);
+}
+
+int __attribute__((always_inline)) get_socket_type_offset() {
+    u64 offset;
+    LOAD_CONSTANT("socket_type_offset", offset);
+    return offset;
+}
+
+int __attribute__((always_inline)) get_socket_sk_offset() {
+    u64 offset;
+    LOAD_CONSTANT("socket_sk_offset", offset);
+    return offset;
+}
+
+int __attribute__((always_inline)) get_sock_common_dport_offset() {
+    u64 offset;
+    LOAD_CONSTANT("sock_common_dport_offset", offset);
+    return offset;
+}
+
+// the buffer of a DNS request is taken from the arguments of the syscall sending it, as the layout of the iov_iter
+// of the message depends on the kernel version
+int __attribute__((always_inline)) trace__sys_send(void *buffer, u64 size) {
+    if (buffer == NULL || size < DNS_HEADER_LENGTH)
+        return 0;
+
+    struct policy_t policy = fetch_policy(EVENT_DNS);
+    if (is_discarded_by_process(policy.mode, EVENT_DNS)) {
+        return 0;
+    }
+
+    struct syscall_cache_t syscall = {
+        .type = EVENT_DNS,
+        .policy = policy,
+        .dns = {
+            .buffer = buffer,
+            .size = size,
+        },
+    };
+
+    cache_syscall(&syscall);
+
+    return 0;
+}
+
+// only the first buffer of the message is captured
+int __attribute__((always_inline)) trace__sys_sendmsg(struct user_msghdr *msg) {
+    struct iovec *iov = NULL;
+    bpf_probe_read(&iov, sizeof(iov), &msg->msg_iov);
+    if (iov == NULL)
+        return 0;
+
+    struct iovec first = {};
+    bpf_probe_read(&first, sizeof(first), iov);
+
+    return trace__sys_send(first.iov_base, first.iov_len);
+}
+
+SYSCALL_KPROBE3(sendto, int, fd, void __user *, buff, size_t, len) {
+    return trace__sys_send(buff, len);
+}
+
+SYSCALL_KPROBE3(sendmsg, int, fd, struct user_msghdr __user *, msg, unsigned int, flags) {
+    return trace__sys_sendmsg(msg);
+}
+
+// only the first message is captured
+SYSCALL_KPROBE4(sendmmsg, int, fd, struct mmsghdr __user *, mmsg, unsigned int, vlen, unsigned int, flags) {
+    if (vlen == 0)
+        return 0;
+
+    return trace__sys_sendmsg(&mmsg->msg_hdr);
+}
+
+// requests can also be written to a connected socket
+SYSCALL_KPROBE3(write, unsigned int, fd, const char __user *, buf, size_t, count) {
+    return trace__sys_send((void *)buf, count);
+}
+
+int __attribute__((always_inline)) sys_send_ret() {
+    pop_syscall(EVENT_DNS);
+    return 0;
+}
+
+#define DECLARE_SEND_RET(name)                                                                                         \
+SYSCALL_KRETPROBE(name) {                                                                                              \
+    return sys_send_ret();                                                                                             \
+}                                                                                                                      \
+                                                                                                                       \
+SEC("tracepoint/syscalls/sys_exit_" #name)                                                                             \
+int tracepoint_syscalls_sys_exit_##name(struct tracepoint_syscalls_sys_exit_t *args) {                                 \
+    return sys_send_ret();                                                                                             \
+}
+
+DECLARE_SEND_RET(sendto)
+DECLARE_SEND_RET(sendmsg)
+DECLARE_SEND_RET(sendmmsg)
+DECLARE_SEND_RET(write)
+
+// DNS requests are captured when they're sent on a UDP socket, the request itself being decoded in user space
+SEC("kprobe/security_socket_sendmsg")
+int kprobe_security_socket_sendmsg(struct pt_regs *ctx) {
+    struct syscall_cache_t *syscall = peek_syscall(EVENT_DNS);
+    if (!syscall || syscall->dns.buffer == NULL)
+        return 0;
+
+    struct socket *sock = (struct socket *)PT_REGS_PARM1(ctx);
+    struct msghdr *msg = (struct msghdr *)PT_REGS_PARM2(ctx);
+
+    short type = 0;
+    bpf_probe_read(&type, sizeof(type), (char *)sock + get_socket_type_offset());
+    if (type != SOCK_DGRAM)
+        return 0;
+
+    // the destination is either given to sendto/sendmsg or set by a previous connect
+    u16 port = 0;
+    struct sockaddr *sa = NULL;
+    bpf_probe_read(&sa, sizeof(sa), &msg->msg_name);
+    if (sa != NULL) {
+        bpf_probe_read(&port, sizeof(port), &((struct sockaddr_in *)sa)->sin_port);
+    } else {
+        struct sock *sk = NULL;
+        bpf_probe_read(&sk, sizeof(sk), (char *)sock + get_socket_sk_offset());
+        bpf_probe_read(&port, sizeof(port), (char *)sk + get_sock_common_dport_offset());
+    }
+
+    if (port != htons(DNS_PORT))
+        return 0;
+
+    u32 key = 0;
+    struct dns_event_t *event = bpf_map_lookup_elem(&dns_event, &key);
+    if (event == NULL)
+        return 0;
+
+    u32 size = syscall->dns.size;
+    event->size = size;
+    if (size > DNS_MAX_LENGTH - 1) {
+        size = DNS_MAX_LENGTH - 1;
+    }
+    bpf_probe_read(&event->payload, size & (DNS_MAX_LENGTH - 1), syscall->dns.buffer);
+
+    // sendmmsg sends its other messages with the same syscall
+    syscall->dns.buffer = NULL;
+
+    struct proc_cache_t *entry = fill_process_context(&event->process);
+    fill_container_context(entry, &event->container);
+    fill_span_context(&event->span);
+
+    send_event_ptr(ctx, EVENT_DNS, event);
+    return 0;
+}
+
+#endif
@@ -41,6 +41,12 @@
 #include "ioctl.h"
 #include "selinux.h"
 #include "bpf.h"
+#include "network.h"
+#include "ptrace.h"
+#include "mmap.h"
+#include "mprotect.h"
+#include "module.h"
+#include "signal.h"
 #include "raw_syscalls.h"
 
 struct invalidate_dentry_event_t {
@@ -32,6 +32,15 @@ union selinux_write_payload_t {
     } status;
 };
 
+struct net_addr_t {
+    u64 addr[2];
+    u16 family;
+    u16 port;
+    u32 padding;
+};
+
+#define KMOD_NAME_LEN 56
+
 struct syscall_cache_t {
     struct policy_t policy;
     u64 type;
@@ -146,6 +155,54 @@ struct syscall_cache_t {
             u64 helpers[3];
             union bpf_attr_def *attr;
         } bpf;
+
+        struct {
+            struct net_addr_t addr;
+        } net;
+
+        struct {
+            void *buffer;
+            u32 size;
+        } dns;
+
+        struct {
+            u32 request;
+            u32 pid;
+            u64 addr;
+        } ptrace;
+
+        struct {
+            struct file_t file;
+            struct dentry *dentry;
+            u64 addr;
+            u64 offset;
+            u32 len;
+            int protection;
+            int flags;
+        } mmap;
+
+        struct {
+            u64 vm_start;
+            u64 vm_end;
+            u32 vm_protection;
+            u32 req_protection;
+        } mprotect;
+
+        struct {
+            struct file_t file;
+            struct dentry *dentry;
+            char name[KMOD_NAME_LEN];
+            u32 loaded_from_memory;
+        } init_module;
+
+        struct {
+            char name[KMOD_NAME_LEN];
+        } delete_module;
+
+        struct {
+            u32 pid;
+            u32 type;
+        } signal;
     };
 };
 
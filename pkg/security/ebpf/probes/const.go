@@ -51,6 +51,10 @@ const (
 	DentryResolverRenameCallbackKprobeKey
 	// DentryResolverSELinuxCallbackKprobeKey is the key to the callback program to execute after resolving the destination dentry of a selinux event
 	DentryResolverSELinuxCallbackKprobeKey
+	// DentryResolverMMapCallbackKprobeKey is the key to the callback program to execute after resolving the dentry of a mmap event
+	DentryResolverMMapCallbackKprobeKey
+	// DentryResolverInitModuleCallbackKprobeKey is the key to the callback program to execute after resolving the dentry of a load_module event
+	DentryResolverInitModuleCallbackKprobeKey
 )
 
 const (
@@ -64,4 +68,8 @@ const (
 	DentryResolverLinkDstCallbackTracepointKey
 	// DentryResolverRenameCallbackTracepointKey is the key to the callback program to execute after resolving the destination dentry of a rename event
 	DentryResolverRenameCallbackTracepointKey
+	// DentryResolverMMapCallbackTracepointKey is the key to the callback program to execute after resolving the dentry of a mmap event
+	DentryResolverMMapCallbackTracepointKey
+	// DentryResolverInitModuleCallbackTracepointKey is the key to the callback program to execute after resolving the dentry of a load_module event
+	DentryResolverInitModuleCallbackTracepointKey
 )
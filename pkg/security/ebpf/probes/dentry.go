@@ -119,6 +119,22 @@ func getDentryResolverTailCallRoutes(ERPCDentryResolutionEnabled bool) []manager
 				EBPFFuncName: "kprobe_dr_selinux_callback",
 			},
 		},
+		{
+			ProgArrayName: "dentry_resolver_kprobe_callbacks",
+			Key:           DentryResolverMMapCallbackKprobeKey,
+			ProbeIdentificationPair: manager.ProbeIdentificationPair{
+				EBPFSection:  "kprobe/dr_mmap_callback",
+				EBPFFuncName: "kprobe_dr_mmap_callback",
+			},
+		},
+		{
+			ProgArrayName: "dentry_resolver_kprobe_callbacks",
+			Key:           DentryResolverInitModuleCallbackKprobeKey,
+			ProbeIdentificationPair: manager.ProbeIdentificationPair{
+				EBPFSection:  "kprobe/dr_init_module_callback",
+				EBPFFuncName: "kprobe_dr_init_module_callback",
+			},
+		},
 
 		// dentry resolver tracepoint callbacks
 		{
@@ -161,6 +177,22 @@ func getDentryResolverTailCallRoutes(ERPCDentryResolutionEnabled bool) []manager
 				EBPFFuncName: "tracepoint_dr_rename_callback",
 			},
 		},
+		{
+			ProgArrayName: "dentry_resolver_tracepoint_callbacks",
+			Key:           DentryResolverMMapCallbackTracepointKey,
+			ProbeIdentificationPair: manager.ProbeIdentificationPair{
+				EBPFSection:  "tracepoint/dr_mmap_callback",
+				EBPFFuncName: "tracepoint_dr_mmap_callback",
+			},
+		},
+		{
+			ProgArrayName: "dentry_resolver_tracepoint_callbacks",
+			Key:           DentryResolverInitModuleCallbackTracepointKey,
+			ProbeIdentificationPair: manager.ProbeIdentificationPair{
+				EBPFSection:  "tracepoint/dr_init_module_callback",
+				EBPFFuncName: "tracepoint_dr_init_module_callback",
+			},
+		},
 	}
 
 	// add routes for programs with the bpf_probe_write_user only if necessary
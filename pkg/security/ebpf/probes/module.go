@@ -0,0 +1,40 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// +build linux
+
+package probes
+
+import manager "github.com/DataDog/ebpf-manager"
+
+// moduleProbes holds the list of probes used to track kernel module events
+var moduleProbes = []*manager.Probe{
+	{
+		ProbeIdentificationPair: manager.ProbeIdentificationPair{
+			UID:          SecurityAgentUID,
+			EBPFSection:  "kprobe/security_kernel_read_file",
+			EBPFFuncName: "kprobe_security_kernel_read_file",
+		},
+	},
+	{
+		ProbeIdentificationPair: manager.ProbeIdentificationPair{
+			UID:          SecurityAgentUID,
+			EBPFSection:  "kprobe/do_init_module",
+			EBPFFuncName: "kprobe_do_init_module",
+		},
+	},
+}
+
+func getModuleProbes() []*manager.Probe {
+	for _, name := range []string{"init_module", "finit_module", "delete_module"} {
+		moduleProbes = append(moduleProbes, ExpandSyscallProbes(&manager.Probe{
+			ProbeIdentificationPair: manager.ProbeIdentificationPair{
+				UID: SecurityAgentUID,
+			},
+			SyscallFuncName: name,
+		}, EntryAndExit)...)
+	}
+	return moduleProbes
+}
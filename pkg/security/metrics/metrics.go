@@ -145,6 +145,13 @@ var (
 	// MetricSecurityAgentFIMContainersRunning is used to report the count of running containers when the security agent
 	// `FIM` feature is enabled
 	MetricSecurityAgentFIMContainersRunning = newAgentMetric(".fim.containers_running")
+
+	// MetricSecurityAgentExporterDropped is the number of events dropped by an exporter because its queue was full
+	// Tags: exporter
+	MetricSecurityAgentExporterDropped = newAgentMetric(".exporter.dropped")
+	// MetricSecurityAgentExporterFailed is the number of events an exporter failed to export
+	// Tags: exporter
+	MetricSecurityAgentExporterFailed = newAgentMetric(".exporter.failed")
 )
 
 // SetTagsWithCardinality returns the array of tags and set the requested cardinality
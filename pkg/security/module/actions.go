@@ -0,0 +1,110 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// +build linux
+
+package module
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+
+	sprobe "github.com/DataDog/datadog-agent/pkg/security/probe"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/rules"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+const (
+	actionStatusPerformed = "performed"
+	actionStatusSkipped   = "skipped"
+	actionStatusDisabled  = "disabled"
+	actionStatusFailed    = "failed"
+)
+
+// applyActions applies the kill actions of a rule that matched, the set actions being
+// applied by the ruleset, and returns the reports of all its actions
+func (m *Module) applyActions(rule *rules.Rule, event *sprobe.Event) []ActionReport {
+	if rule.Definition == nil || len(rule.Definition.Actions) == 0 {
+		return nil
+	}
+
+	var reports []ActionReport
+	for _, action := range rule.Definition.Actions {
+		switch {
+		case action.Set != nil:
+			reports = append(reports, setActionReport(action.Set, event))
+		case action.Kill != nil:
+			reports = append(reports, m.kill(rule, action.Kill, event))
+		case action.RateLimit != nil:
+			reports = append(reports, ActionReport{
+				Type:   "rate_limit",
+				Limit:  action.RateLimit.Limit,
+				Period: action.RateLimit.Period.String(),
+				Burst:  action.RateLimit.Burst,
+			})
+		}
+	}
+
+	return reports
+}
+
+func setActionReport(set *rules.SetDefinition, event *sprobe.Event) ActionReport {
+	report := ActionReport{
+		Type:     "set",
+		Status:   actionStatusPerformed,
+		Variable: set.VariableName(),
+		Value:    fmt.Sprintf("%v", set.Value),
+	}
+
+	// variables are only set when the event belongs to their scope
+	if scopeFnc, exists := sprobe.SECLVariableScopes[set.Scope]; !exists || scopeFnc(eval.NewContext(event.GetPointer())) == "" {
+		report.Status = actionStatusSkipped
+	}
+
+	return report
+}
+
+// kill sends the signal of the action to the process of the event, if enforcement is enabled
+func (m *Module) kill(rule *rules.Rule, kill *rules.KillDefinition, event *sprobe.Event) ActionReport {
+	pid := event.ProcessContext.Pid
+	report := ActionReport{
+		Type:   "kill",
+		Signal: kill.Signal,
+		PID:    pid,
+	}
+
+	if !m.config.EnforcementEnabled {
+		report.Status = actionStatusDisabled
+		return report
+	}
+
+	// never kill the init process nor the agent itself
+	if pid <= 1 || int(pid) == os.Getpid() {
+		report.Status = actionStatusFailed
+		report.Error = fmt.Sprintf("refusing to kill process %d", pid)
+		return report
+	}
+
+	signal, exists := model.SignalConstants[kill.Signal]
+	if !exists {
+		report.Status = actionStatusFailed
+		report.Error = fmt.Sprintf("unknown signal `%s`", kill.Signal)
+		return report
+	}
+
+	if err := syscall.Kill(int(pid), syscall.Signal(signal)); err != nil {
+		report.Status = actionStatusFailed
+		report.Error = err.Error()
+		return report
+	}
+
+	log.Infof("Process %d killed with %s by rule `%s`", pid, kill.Signal, rule.ID)
+	report.Status = actionStatusPerformed
+
+	return report
+}
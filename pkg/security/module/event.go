@@ -10,11 +10,12 @@ package module
 // AgentContext serializes the agent context to JSON
 // easyjson:json
 type AgentContext struct {
-	RuleID        string `json:"rule_id"`
-	RuleVersion   string `json:"rule_version,omitempty"`
-	PolicyName    string `json:"policy_name,omitempty"`
-	PolicyVersion string `json:"policy_version,omitempty"`
-	Version       string `json:"version,omitempty"`
+	RuleID        string         `json:"rule_id"`
+	RuleVersion   string         `json:"rule_version,omitempty"`
+	PolicyName    string         `json:"policy_name,omitempty"`
+	PolicyVersion string         `json:"policy_version,omitempty"`
+	Version       string         `json:"version,omitempty"`
+	Actions       []ActionReport `json:"actions,omitempty"`
 }
 
 // Signal - Rule event wrapper used to send an event to the backend
@@ -23,3 +24,18 @@ type Signal struct {
 	AgentContext `json:"agent"`
 	Title        string `json:"title"`
 }
+
+// ActionReport serializes the outcome of a rule action to JSON
+// easyjson:json
+type ActionReport struct {
+	Type     string `json:"type"`
+	Status   string `json:"status,omitempty"`
+	Error    string `json:"error,omitempty"`
+	Variable string `json:"variable,omitempty"`
+	Value    string `json:"value,omitempty"`
+	Signal   string `json:"signal,omitempty"`
+	PID      uint32 `json:"pid,omitempty"`
+	Limit    int    `json:"limit,omitempty"`
+	Period   string `json:"period,omitempty"`
+	Burst    int    `json:"burst,omitempty"`
+}
@@ -63,6 +63,7 @@ type Module struct {
 	cancelSubscriber context.CancelFunc
 	rulesLoaded      func(rs *rules.RuleSet)
 	policiesVersions []string
+	variables        *rules.VariableStore
 
 	selfTester *SelfTester
 }
@@ -134,6 +135,15 @@ func (m *Module) Init() error {
 
 	m.probe.SetEventHandler(m)
 
+	// the probes and the filters are updated according to the event types recorded by the running dumps
+	if adm := m.probe.GetActivityDumpManager(); adm != nil {
+		adm.SetDumpsChangedHandler(func() {
+			if err := m.Reload(); err != nil {
+				log.Errorf("failed to reload configuration: %s", err)
+			}
+		})
+	}
+
 	// initialize the eBPF manager and load the programs and maps in the kernel. At this stage, the probes are not
 	// running yet.
 	if err := m.probe.Init(m.statsdClient); err != nil {
@@ -230,6 +240,14 @@ func (m *Module) getEventTypeEnabled() map[eval.EventType]bool {
 		}
 	}
 
+	if m.config.RuntimeEnabled && m.config.NetworkEnabled {
+		if eventTypes, exists := categories[model.NetworkCategory]; exists {
+			for _, eventType := range eventTypes {
+				enabled[eventType] = true
+			}
+		}
+	}
+
 	return enabled
 }
 
@@ -279,7 +297,7 @@ func (m *Module) Reload() error {
 	rsa := sprobe.NewRuleSetApplier(m.config, m.probe)
 
 	newRuleSetOpts := func() *rules.Opts {
-		return rules.NewOptsWithParams(
+		opts := rules.NewOptsWithParams(
 			model.SECLConstants,
 			sprobe.SECLVariables,
 			sprobe.SupportedDiscarders,
@@ -287,15 +305,28 @@ func (m *Module) Reload() error {
 			sprobe.AllCustomRuleIDs(),
 			model.SECLLegacyAttributes,
 			&seclog.PatternLogger{})
+
+		// the variables set by the rule actions are kept across reloads
+		opts.VariableScopes = sprobe.SECLVariableScopes
+		opts.VariableStore = m.variables
+		opts.Signals = model.SignalConstants
+
+		return opts
+	}
+
+	// rules and macros are filtered out according to the agent version and the kernel
+	ruleFilters, err := m.probe.GetRuleFilters()
+	if err != nil {
+		return err
 	}
 
 	ruleSet := m.probe.NewRuleSet(newRuleSetOpts())
 
-	loadErr := rules.LoadPolicies(policiesDir, ruleSet)
+	loadErr := rules.LoadPolicies(policiesDir, ruleSet, ruleFilters...)
 
 	model := &model.Model{}
 	approverRuleSet := rules.NewRuleSet(model, model.NewEvent, newRuleSetOpts())
-	loadApproversErr := rules.LoadPolicies(policiesDir, approverRuleSet)
+	loadApproversErr := rules.LoadPolicies(policiesDir, approverRuleSet, ruleFilters...)
 
 	if loadErr.ErrorOrNil() != nil {
 		logMultiErrors("error while loading policies: %+v", loadErr)
@@ -318,6 +349,12 @@ func (m *Module) Reload() error {
 		return err
 	}
 
+	if adm := m.probe.GetActivityDumpManager(); adm != nil {
+		if err := adm.LoadProfiles(); err != nil {
+			log.Errorf("failed to load the activity profiles: %s", err)
+		}
+	}
+
 	m.policiesVersions = getPoliciesVersions(ruleSet)
 
 	ruleSet.AddListener(m)
@@ -341,7 +378,7 @@ func (m *Module) Reload() error {
 	ruleIDs = append(ruleIDs, sprobe.AllCustomRuleIDs()...)
 
 	m.apiServer.Apply(ruleIDs)
-	m.rateLimiter.Apply(ruleIDs)
+	m.rateLimiter.Apply(ruleSet, ruleIDs)
 
 	m.displayReport(report)
 
@@ -393,11 +430,23 @@ func (m *Module) HandleEvent(event *sprobe.Event) {
 	if ruleSet := m.GetRuleSet(); ruleSet != nil {
 		ruleSet.Evaluate(event)
 	}
+
+	// release the variables set by the rule actions on the process, and on its container
+	// when it was the last process of the container
+	if event.GetEventType() == model.ExitEventType {
+		m.variables.ReleaseScope(rules.ScopeProcess, sprobe.ProcessScopeKey(event.ProcessContext.Pid))
+
+		containerID := event.ResolveContainerID(&event.ContainerContext)
+		if containerID != "" && m.variables.HasScope(rules.ScopeContainer, containerID) &&
+			!m.probe.GetResolvers().ProcessResolver.HasContainerProcess(containerID, event.ProcessContext.Pid) {
+			m.variables.ReleaseScope(rules.ScopeContainer, containerID)
+		}
+	}
 }
 
 // HandleCustomEvent is called by the probe when an event should be sent to Datadog but doesn't need evaluation
 func (m *Module) HandleCustomEvent(rule *rules.Rule, event *sprobe.CustomEvent) {
-	m.SendEvent(rule, event, func() []string { return nil }, "")
+	m.SendEvent(rule, event, nil, func() []string { return nil }, "")
 }
 
 // RuleMatch is called by the ruleset when a rule matches
@@ -429,13 +478,17 @@ func (m *Module) RuleMatch(rule *rules.Rule, event eval.Event) {
 	if m.selfTester != nil {
 		m.selfTester.SendEventIfExpecting(rule, event)
 	}
-	m.SendEvent(rule, event, extTagsCb, service)
+
+	// the actions are applied even if the event is then dropped by the rate limiter
+	actions := m.applyActions(rule, event.(*sprobe.Event))
+
+	m.SendEvent(rule, event, actions, extTagsCb, service)
 }
 
 // SendEvent sends an event to the backend after checking that the rate limiter allows it for the provided rule
-func (m *Module) SendEvent(rule *rules.Rule, event Event, extTagsCb func() []string, service string) {
+func (m *Module) SendEvent(rule *rules.Rule, event Event, actions []ActionReport, extTagsCb func() []string, service string) {
 	if m.rateLimiter.Allow(rule.ID) {
-		m.apiServer.SendEvent(rule, event, extTagsCb, service)
+		m.apiServer.SendEvent(rule, event, actions, extTagsCb, service)
 	} else {
 		seclog.Tracef("Event on rule %s was dropped due to rate limiting", rule.ID)
 	}
@@ -558,6 +611,7 @@ func NewModule(cfg *sconfig.Config) (module.Module, error) {
 		ctx:            ctx,
 		cancelFnc:      cancelFnc,
 		selfTester:     selfTester,
+		variables:      rules.NewVariableStore(),
 	}
 	m.apiServer.module = m
 
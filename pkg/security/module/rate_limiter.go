@@ -10,6 +10,7 @@ package module
 import (
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/DataDog/datadog-go/statsd"
 	"golang.org/x/time/rate"
@@ -73,23 +74,35 @@ func NewRateLimiter(client *statsd.Client, opts LimiterOpts) *RateLimiter {
 	}
 }
 
-// Apply a set of rules
-func (rl *RateLimiter) Apply(rules []rules.RuleID) {
+// Apply a set of rules, the limits of the rate limit actions of the rules prevailing over the
+// default and custom ones
+func (rl *RateLimiter) Apply(ruleSet *rules.RuleSet, ruleIDs []rules.RuleID) {
 	rl.Lock()
 	defer rl.Unlock()
 
+	ruleDefs := ruleSet.GetRules()
+
 	newLimiters := make(map[string]*Limiter)
-	for _, id := range rules {
-		if limiter, found := rl.limiters[id]; found {
-			newLimiters[id] = limiter
-		} else {
-			limit := defaultLimit
-			burst := defaultBurst
+	for _, id := range ruleIDs {
+		limit := defaultLimit
+		burst := defaultBurst
+
+		if l, exists := rl.opts.Limits[id]; exists {
+			limit = rate.Limit(l.Limit)
+			burst = l.Burst
+		}
 
-			if l, exists := rl.opts.Limits[id]; exists {
-				limit = rate.Limit(l.Limit)
-				burst = l.Burst
+		if rule, exists := ruleDefs[id]; exists && rule.Definition != nil {
+			if rateLimit := rule.Definition.GetRateLimit(); rateLimit != nil {
+				limit = rate.Every(rateLimit.Period / time.Duration(rateLimit.Limit))
+				burst = rateLimit.Burst
 			}
+		}
+
+		// keep the state of the limiters whose limits didn't change
+		if limiter, found := rl.limiters[id]; found && limiter.limiter.Limit() == limit && limiter.limiter.Burst() == burst {
+			newLimiters[id] = limiter
+		} else {
 			newLimiters[id] = NewLimiter(limit, burst)
 		}
 	}
@@ -234,12 +234,85 @@ func (a *APIServer) RunSelfTest(ctx context.Context, params *api.RunSelfTestPara
 	}, nil
 }
 
+func newActivityDumpMessage(ad *sprobe.ActivityDump) *api.SecurityActivityDumpMessage {
+	return &api.SecurityActivityDumpMessage{
+		Name:           ad.Name,
+		ContainerID:    ad.Selector.ContainerID,
+		Image:          ad.Selector.Image,
+		Start:          ad.Start.Format(time.RFC3339),
+		Timeout:        ad.Timeout.String(),
+		OutputFilename: ad.OutputFilename,
+		PolicyFilename: ad.PolicyFilename,
+	}
+}
+
+// DumpActivity starts recording the activity of a container or an image
+func (a *APIServer) DumpActivity(ctx context.Context, params *api.ActivityDumpParams) (*api.SecurityActivityDumpMessage, error) {
+	adm := a.probe.GetActivityDumpManager()
+	if adm == nil {
+		return &api.SecurityActivityDumpMessage{Error: "activity dumps are disabled"}, nil
+	}
+
+	var timeout time.Duration
+	if params.Timeout != "" {
+		var err error
+		if timeout, err = time.ParseDuration(params.Timeout); err != nil {
+			return &api.SecurityActivityDumpMessage{Error: fmt.Sprintf("invalid timeout: %s", err)}, nil
+		}
+	}
+
+	selector := sprobe.ActivityDumpSelector{
+		ContainerID: params.ContainerID,
+		Image:       params.Image,
+	}
+
+	ad, err := adm.StartDump(selector, timeout)
+	if err != nil {
+		return &api.SecurityActivityDumpMessage{Error: err.Error()}, nil
+	}
+
+	return newActivityDumpMessage(ad), nil
+}
+
+// ListActivityDumps returns the running activity dumps
+func (a *APIServer) ListActivityDumps(ctx context.Context, params *api.ActivityDumpListParams) (*api.SecurityActivityDumpListMessage, error) {
+	adm := a.probe.GetActivityDumpManager()
+	if adm == nil {
+		return &api.SecurityActivityDumpListMessage{Error: "activity dumps are disabled"}, nil
+	}
+
+	var dumps []*api.SecurityActivityDumpMessage
+	for _, ad := range adm.ListDumps() {
+		dumps = append(dumps, newActivityDumpMessage(ad))
+	}
+
+	return &api.SecurityActivityDumpListMessage{
+		Dumps: dumps,
+	}, nil
+}
+
+// StopActivityDump stops an activity dump and writes its profile and its policy
+func (a *APIServer) StopActivityDump(ctx context.Context, params *api.ActivityDumpStopParams) (*api.SecurityActivityDumpMessage, error) {
+	adm := a.probe.GetActivityDumpManager()
+	if adm == nil {
+		return &api.SecurityActivityDumpMessage{Error: "activity dumps are disabled"}, nil
+	}
+
+	ad, err := adm.StopDump(params.Name)
+	if err != nil {
+		return &api.SecurityActivityDumpMessage{Error: err.Error()}, nil
+	}
+
+	return newActivityDumpMessage(ad), nil
+}
+
 // SendEvent forwards events sent by the runtime security module to Datadog
-func (a *APIServer) SendEvent(rule *rules.Rule, event Event, extTagsCb func() []string, service string) {
+func (a *APIServer) SendEvent(rule *rules.Rule, event Event, actions []ActionReport, extTagsCb func() []string, service string) {
 	agentContext := AgentContext{
 		RuleID:      rule.Definition.ID,
 		RuleVersion: rule.Definition.Version,
 		Version:     version.AgentVersion,
+		Actions:     actions,
 	}
 
 	ruleEvent := &Signal{
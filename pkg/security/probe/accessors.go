@@ -1,3 +1,4 @@
+//go:build linux
 // +build linux
 
 // Code generated - DO NOT EDIT.
@@ -5,6 +6,7 @@
 package probe
 
 import (
+	"net"
 	"reflect"
 	"unsafe"
 
@@ -15,6 +17,7 @@ import (
 // suppress unused package warning
 var (
 	_ *unsafe.Pointer
+	_ net.IPNet
 )
 
 func (m *Model) GetIterator(field eval.Field) (eval.Iterator, error) {
@@ -31,6 +34,10 @@ func (m *Model) GetIterator(field eval.Field) (eval.Iterator, error) {
 func (m *Model) GetEventTypes() []eval.EventType {
 	return []eval.EventType{
 
+		eval.EventType("accept"),
+
+		eval.EventType("bind"),
+
 		eval.EventType("bpf"),
 
 		eval.EventType("capset"),
@@ -39,14 +46,26 @@ func (m *Model) GetEventTypes() []eval.EventType {
 
 		eval.EventType("chown"),
 
+		eval.EventType("connect"),
+
+		eval.EventType("dns"),
+
 		eval.EventType("exec"),
 
 		eval.EventType("link"),
 
+		eval.EventType("load_module"),
+
 		eval.EventType("mkdir"),
 
+		eval.EventType("mmap"),
+
+		eval.EventType("mprotect"),
+
 		eval.EventType("open"),
 
+		eval.EventType("ptrace"),
+
 		eval.EventType("removexattr"),
 
 		eval.EventType("rename"),
@@ -61,8 +80,12 @@ func (m *Model) GetEventTypes() []eval.EventType {
 
 		eval.EventType("setxattr"),
 
+		eval.EventType("signal"),
+
 		eval.EventType("unlink"),
 
+		eval.EventType("unload_module"),
+
 		eval.EventType("utimes"),
 	}
 }
@@ -70,6 +93,86 @@ func (m *Model) GetEventTypes() []eval.EventType {
 func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Evaluator, error) {
 	switch field {
 
+	case "accept.addr.family":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Accept.AddrFamily)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "accept.addr.ip":
+		return &eval.CIDREvaluator{
+			EvalFnc: func(ctx *eval.Context) net.IPNet {
+
+				return (*Event)(ctx.Object).Accept.Addr.IPNet
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "accept.addr.port":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Accept.Addr.Port)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "accept.retval":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Accept.SyscallEvent.Retval)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "bind.addr.family":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Bind.AddrFamily)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "bind.addr.ip":
+		return &eval.CIDREvaluator{
+			EvalFnc: func(ctx *eval.Context) net.IPNet {
+
+				return (*Event)(ctx.Object).Bind.Addr.IPNet
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "bind.addr.port":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Bind.Addr.Port)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "bind.retval":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Bind.SyscallEvent.Retval)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
 	case "bpf.cmd":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -500,6 +603,46 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Weight: eval.FunctionWeight,
 		}, nil
 
+	case "connect.addr.family":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Connect.AddrFamily)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "connect.addr.ip":
+		return &eval.CIDREvaluator{
+			EvalFnc: func(ctx *eval.Context) net.IPNet {
+
+				return (*Event)(ctx.Object).Connect.Addr.IPNet
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "connect.addr.port":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Connect.Addr.Port)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "connect.retval":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Connect.SyscallEvent.Retval)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
 	case "container.id":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
@@ -521,6 +664,66 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Weight: 9999,
 		}, nil
 
+	case "dns.id":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).DNS.ID)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "dns.question.class":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).DNS.Class)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "dns.question.count":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).DNS.Count)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "dns.question.name":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).DNS.Name
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "dns.question.size":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).DNS.Size)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "dns.question.type":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).DNS.Type)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
 	case "exec.args":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
@@ -1245,502 +1448,717 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "mkdir.file.change_time":
-		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int {
-
-				return int((*Event)(ctx.Object).Mkdir.File.FileFields.CTime)
-			},
-			Field:  field,
-			Weight: eval.FunctionWeight,
-		}, nil
-
-	case "mkdir.file.destination.mode":
-		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int {
-
-				return int((*Event)(ctx.Object).Mkdir.Mode)
-			},
-			Field:  field,
-			Weight: eval.FunctionWeight,
-		}, nil
-
-	case "mkdir.file.destination.rights":
+	case "load_module.file.change_time":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Mkdir.Mode)
+				return int((*Event)(ctx.Object).LoadModule.File.FileFields.CTime)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "mkdir.file.filesystem":
+	case "load_module.file.filesystem":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFileFilesystem(&(*Event)(ctx.Object).Mkdir.File)
+				return (*Event)(ctx.Object).ResolveFileFilesystem(&(*Event)(ctx.Object).LoadModule.File)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "mkdir.file.gid":
+	case "load_module.file.gid":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Mkdir.File.FileFields.GID)
+				return int((*Event)(ctx.Object).LoadModule.File.FileFields.GID)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "mkdir.file.group":
+	case "load_module.file.group":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFileFieldsGroup(&(*Event)(ctx.Object).Mkdir.File.FileFields)
+				return (*Event)(ctx.Object).ResolveFileFieldsGroup(&(*Event)(ctx.Object).LoadModule.File.FileFields)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "mkdir.file.in_upper_layer":
+	case "load_module.file.in_upper_layer":
 		return &eval.BoolEvaluator{
 			EvalFnc: func(ctx *eval.Context) bool {
 
-				return (*Event)(ctx.Object).ResolveFileFieldsInUpperLayer(&(*Event)(ctx.Object).Mkdir.File.FileFields)
+				return (*Event)(ctx.Object).ResolveFileFieldsInUpperLayer(&(*Event)(ctx.Object).LoadModule.File.FileFields)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "mkdir.file.inode":
+	case "load_module.file.inode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Mkdir.File.FileFields.Inode)
+				return int((*Event)(ctx.Object).LoadModule.File.FileFields.Inode)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "mkdir.file.mode":
+	case "load_module.file.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Mkdir.File.FileFields.Mode)
+				return int((*Event)(ctx.Object).LoadModule.File.FileFields.Mode)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "mkdir.file.modification_time":
+	case "load_module.file.modification_time":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Mkdir.File.FileFields.MTime)
+				return int((*Event)(ctx.Object).LoadModule.File.FileFields.MTime)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "mkdir.file.mount_id":
+	case "load_module.file.mount_id":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Mkdir.File.FileFields.MountID)
+				return int((*Event)(ctx.Object).LoadModule.File.FileFields.MountID)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "mkdir.file.name":
+	case "load_module.file.name":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFileBasename(&(*Event)(ctx.Object).Mkdir.File)
+				return (*Event)(ctx.Object).ResolveFileBasename(&(*Event)(ctx.Object).LoadModule.File)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "mkdir.file.path":
+	case "load_module.file.path":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFilePath(&(*Event)(ctx.Object).Mkdir.File)
+				return (*Event)(ctx.Object).ResolveFilePath(&(*Event)(ctx.Object).LoadModule.File)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "mkdir.file.rights":
+	case "load_module.file.rights":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).ResolveRights(&(*Event)(ctx.Object).Mkdir.File.FileFields))
+				return int((*Event)(ctx.Object).ResolveRights(&(*Event)(ctx.Object).LoadModule.File.FileFields))
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "mkdir.file.uid":
+	case "load_module.file.uid":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Mkdir.File.FileFields.UID)
+				return int((*Event)(ctx.Object).LoadModule.File.FileFields.UID)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "mkdir.file.user":
+	case "load_module.file.user":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFileFieldsUser(&(*Event)(ctx.Object).Mkdir.File.FileFields)
+				return (*Event)(ctx.Object).ResolveFileFieldsUser(&(*Event)(ctx.Object).LoadModule.File.FileFields)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "mkdir.retval":
+	case "load_module.loaded_from_memory":
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+
+				return (*Event)(ctx.Object).LoadModule.LoadedFromMemory
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "load_module.name":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).LoadModule.Name
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "load_module.retval":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Mkdir.SyscallEvent.Retval)
+				return int((*Event)(ctx.Object).LoadModule.SyscallEvent.Retval)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "open.file.change_time":
+	case "mkdir.file.change_time":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Open.File.FileFields.CTime)
+				return int((*Event)(ctx.Object).Mkdir.File.FileFields.CTime)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "open.file.destination.mode":
+	case "mkdir.file.destination.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Open.Mode)
+				return int((*Event)(ctx.Object).Mkdir.Mode)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "open.file.filesystem":
+	case "mkdir.file.destination.rights":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Mkdir.Mode)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "mkdir.file.filesystem":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFileFilesystem(&(*Event)(ctx.Object).Open.File)
+				return (*Event)(ctx.Object).ResolveFileFilesystem(&(*Event)(ctx.Object).Mkdir.File)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "open.file.gid":
+	case "mkdir.file.gid":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Open.File.FileFields.GID)
+				return int((*Event)(ctx.Object).Mkdir.File.FileFields.GID)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "open.file.group":
+	case "mkdir.file.group":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFileFieldsGroup(&(*Event)(ctx.Object).Open.File.FileFields)
+				return (*Event)(ctx.Object).ResolveFileFieldsGroup(&(*Event)(ctx.Object).Mkdir.File.FileFields)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "open.file.in_upper_layer":
+	case "mkdir.file.in_upper_layer":
 		return &eval.BoolEvaluator{
 			EvalFnc: func(ctx *eval.Context) bool {
 
-				return (*Event)(ctx.Object).ResolveFileFieldsInUpperLayer(&(*Event)(ctx.Object).Open.File.FileFields)
+				return (*Event)(ctx.Object).ResolveFileFieldsInUpperLayer(&(*Event)(ctx.Object).Mkdir.File.FileFields)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "open.file.inode":
+	case "mkdir.file.inode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Open.File.FileFields.Inode)
+				return int((*Event)(ctx.Object).Mkdir.File.FileFields.Inode)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "open.file.mode":
+	case "mkdir.file.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Open.File.FileFields.Mode)
+				return int((*Event)(ctx.Object).Mkdir.File.FileFields.Mode)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "open.file.modification_time":
+	case "mkdir.file.modification_time":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Open.File.FileFields.MTime)
+				return int((*Event)(ctx.Object).Mkdir.File.FileFields.MTime)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "open.file.mount_id":
+	case "mkdir.file.mount_id":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Open.File.FileFields.MountID)
+				return int((*Event)(ctx.Object).Mkdir.File.FileFields.MountID)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "open.file.name":
+	case "mkdir.file.name":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFileBasename(&(*Event)(ctx.Object).Open.File)
+				return (*Event)(ctx.Object).ResolveFileBasename(&(*Event)(ctx.Object).Mkdir.File)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "open.file.path":
+	case "mkdir.file.path":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFilePath(&(*Event)(ctx.Object).Open.File)
+				return (*Event)(ctx.Object).ResolveFilePath(&(*Event)(ctx.Object).Mkdir.File)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "open.file.rights":
+	case "mkdir.file.rights":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).ResolveRights(&(*Event)(ctx.Object).Open.File.FileFields))
+				return int((*Event)(ctx.Object).ResolveRights(&(*Event)(ctx.Object).Mkdir.File.FileFields))
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "open.file.uid":
+	case "mkdir.file.uid":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Open.File.FileFields.UID)
+				return int((*Event)(ctx.Object).Mkdir.File.FileFields.UID)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "open.file.user":
+	case "mkdir.file.user":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFileFieldsUser(&(*Event)(ctx.Object).Open.File.FileFields)
+				return (*Event)(ctx.Object).ResolveFileFieldsUser(&(*Event)(ctx.Object).Mkdir.File.FileFields)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "open.flags":
+	case "mkdir.retval":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Open.Flags)
+				return int((*Event)(ctx.Object).Mkdir.SyscallEvent.Retval)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "open.retval":
+	case "mmap.file.change_time":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Open.SyscallEvent.Retval)
+				return int((*Event)(ctx.Object).MMap.File.FileFields.CTime)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "process.ancestors.cap_effective":
-		return &eval.IntArrayEvaluator{
-			EvalFnc: func(ctx *eval.Context) []int {
-				if ptr := ctx.Cache[field]; ptr != nil {
-					if result := (*[]int)(ptr); result != nil {
-						return *result
-					}
-				}
-				var results []int
-
-				iterator := &model.ProcessAncestorsIterator{}
-
-				value := iterator.Front(ctx)
-				for value != nil {
-					var result int
+	case "mmap.file.filesystem":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
 
-					element := (*model.ProcessCacheEntry)(value)
+				return (*Event)(ctx.Object).ResolveFileFilesystem(&(*Event)(ctx.Object).MMap.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 
-					result = int(element.ProcessContext.Process.Credentials.CapEffective)
+	case "mmap.file.gid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
 
-					results = append(results, result)
+				return int((*Event)(ctx.Object).MMap.File.FileFields.GID)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
 
-					value = iterator.Next()
-				}
-				ctx.Cache[field] = unsafe.Pointer(&results)
+	case "mmap.file.group":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
 
-				return results
-			}, Field: field,
-			Weight: eval.IteratorWeight,
+				return (*Event)(ctx.Object).ResolveFileFieldsGroup(&(*Event)(ctx.Object).MMap.File.FileFields)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "process.ancestors.cap_permitted":
-		return &eval.IntArrayEvaluator{
-			EvalFnc: func(ctx *eval.Context) []int {
-				if ptr := ctx.Cache[field]; ptr != nil {
-					if result := (*[]int)(ptr); result != nil {
-						return *result
-					}
-				}
-				var results []int
+	case "mmap.file.in_upper_layer":
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
 
-				iterator := &model.ProcessAncestorsIterator{}
+				return (*Event)(ctx.Object).ResolveFileFieldsInUpperLayer(&(*Event)(ctx.Object).MMap.File.FileFields)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 
-				value := iterator.Front(ctx)
-				for value != nil {
-					var result int
+	case "mmap.file.inode":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
 
-					element := (*model.ProcessCacheEntry)(value)
+				return int((*Event)(ctx.Object).MMap.File.FileFields.Inode)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
 
-					result = int(element.ProcessContext.Process.Credentials.CapPermitted)
+	case "mmap.file.mode":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
 
-					results = append(results, result)
+				return int((*Event)(ctx.Object).MMap.File.FileFields.Mode)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
 
-					value = iterator.Next()
-				}
-				ctx.Cache[field] = unsafe.Pointer(&results)
+	case "mmap.file.modification_time":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
 
-				return results
-			}, Field: field,
-			Weight: eval.IteratorWeight,
+				return int((*Event)(ctx.Object).MMap.File.FileFields.MTime)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "process.ancestors.comm":
-		return &eval.StringArrayEvaluator{
-			EvalFnc: func(ctx *eval.Context) []string {
-				if ptr := ctx.Cache[field]; ptr != nil {
-					if result := (*[]string)(ptr); result != nil {
-						return *result
-					}
-				}
-				var results []string
+	case "mmap.file.mount_id":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
 
-				iterator := &model.ProcessAncestorsIterator{}
+				return int((*Event)(ctx.Object).MMap.File.FileFields.MountID)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
 
-				value := iterator.Front(ctx)
-				for value != nil {
-					var result string
+	case "mmap.file.name":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
 
-					element := (*model.ProcessCacheEntry)(value)
+				return (*Event)(ctx.Object).ResolveFileBasename(&(*Event)(ctx.Object).MMap.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 
-					result = element.ProcessContext.Process.Comm
+	case "mmap.file.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
 
-					results = append(results, result)
+				return (*Event)(ctx.Object).ResolveFilePath(&(*Event)(ctx.Object).MMap.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 
-					value = iterator.Next()
-				}
-				ctx.Cache[field] = unsafe.Pointer(&results)
+	case "mmap.file.rights":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
 
-				return results
-			}, Field: field,
-			Weight: eval.IteratorWeight,
+				return int((*Event)(ctx.Object).ResolveRights(&(*Event)(ctx.Object).MMap.File.FileFields))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "process.ancestors.container.id":
-		return &eval.StringArrayEvaluator{
-			EvalFnc: func(ctx *eval.Context) []string {
-				if ptr := ctx.Cache[field]; ptr != nil {
-					if result := (*[]string)(ptr); result != nil {
-						return *result
-					}
-				}
-				var results []string
+	case "mmap.file.uid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
 
-				iterator := &model.ProcessAncestorsIterator{}
+				return int((*Event)(ctx.Object).MMap.File.FileFields.UID)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
 
-				value := iterator.Front(ctx)
-				for value != nil {
-					var result string
+	case "mmap.file.user":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
 
-					element := (*model.ProcessCacheEntry)(value)
+				return (*Event)(ctx.Object).ResolveFileFieldsUser(&(*Event)(ctx.Object).MMap.File.FileFields)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 
-					result = element.ProcessContext.Process.ContainerID
+	case "mmap.flags":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
 
-					results = append(results, result)
+				return (*Event)(ctx.Object).MMap.Flags
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
 
-					value = iterator.Next()
-				}
-				ctx.Cache[field] = unsafe.Pointer(&results)
+	case "mmap.protection":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
 
-				return results
-			}, Field: field,
-			Weight: eval.IteratorWeight,
+				return (*Event)(ctx.Object).MMap.Protection
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "process.ancestors.cookie":
-		return &eval.IntArrayEvaluator{
-			EvalFnc: func(ctx *eval.Context) []int {
-				if ptr := ctx.Cache[field]; ptr != nil {
-					if result := (*[]int)(ptr); result != nil {
-						return *result
-					}
-				}
-				var results []int
+	case "mmap.retval":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
 
-				iterator := &model.ProcessAncestorsIterator{}
+				return int((*Event)(ctx.Object).MMap.SyscallEvent.Retval)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
 
-				value := iterator.Front(ctx)
-				for value != nil {
-					var result int
+	case "mprotect.req_protection":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
 
-					element := (*model.ProcessCacheEntry)(value)
+				return (*Event)(ctx.Object).MProtect.ReqProtection
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
 
-					result = int(element.ProcessContext.Process.Cookie)
+	case "mprotect.retval":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
 
-					results = append(results, result)
+				return int((*Event)(ctx.Object).MProtect.SyscallEvent.Retval)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
 
-					value = iterator.Next()
-				}
-				ctx.Cache[field] = unsafe.Pointer(&results)
+	case "mprotect.vm_protection":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
 
-				return results
-			}, Field: field,
-			Weight: eval.IteratorWeight,
+				return (*Event)(ctx.Object).MProtect.VMProtection
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "process.ancestors.created_at":
+	case "open.file.change_time":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Open.File.FileFields.CTime)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "open.file.destination.mode":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Open.Mode)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "open.file.filesystem":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).ResolveFileFilesystem(&(*Event)(ctx.Object).Open.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+
+	case "open.file.gid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Open.File.FileFields.GID)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "open.file.group":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).ResolveFileFieldsGroup(&(*Event)(ctx.Object).Open.File.FileFields)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+
+	case "open.file.in_upper_layer":
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+
+				return (*Event)(ctx.Object).ResolveFileFieldsInUpperLayer(&(*Event)(ctx.Object).Open.File.FileFields)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+
+	case "open.file.inode":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Open.File.FileFields.Inode)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "open.file.mode":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Open.File.FileFields.Mode)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "open.file.modification_time":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Open.File.FileFields.MTime)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "open.file.mount_id":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Open.File.FileFields.MountID)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "open.file.name":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).ResolveFileBasename(&(*Event)(ctx.Object).Open.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+
+	case "open.file.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).ResolveFilePath(&(*Event)(ctx.Object).Open.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+
+	case "open.file.rights":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).ResolveRights(&(*Event)(ctx.Object).Open.File.FileFields))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+
+	case "open.file.uid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Open.File.FileFields.UID)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "open.file.user":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).ResolveFileFieldsUser(&(*Event)(ctx.Object).Open.File.FileFields)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+
+	case "open.flags":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Open.Flags)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "open.retval":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Open.SyscallEvent.Retval)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "process.ancestors.cap_effective":
 		return &eval.IntArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []int {
 				if ptr := ctx.Cache[field]; ptr != nil {
@@ -1758,7 +2176,7 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 
 					element := (*model.ProcessCacheEntry)(value)
 
-					result = int((*Event)(ctx.Object).ResolveProcessCreatedAt(&element.Process))
+					result = int(element.ProcessContext.Process.Credentials.CapEffective)
 
 					results = append(results, result)
 
@@ -1771,7 +2189,7 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Weight: eval.IteratorWeight,
 		}, nil
 
-	case "process.ancestors.egid":
+	case "process.ancestors.cap_permitted":
 		return &eval.IntArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []int {
 				if ptr := ctx.Cache[field]; ptr != nil {
@@ -1789,7 +2207,7 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 
 					element := (*model.ProcessCacheEntry)(value)
 
-					result = int(element.ProcessContext.Process.Credentials.EGID)
+					result = int(element.ProcessContext.Process.Credentials.CapPermitted)
 
 					results = append(results, result)
 
@@ -1802,7 +2220,7 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Weight: eval.IteratorWeight,
 		}, nil
 
-	case "process.ancestors.egroup":
+	case "process.ancestors.comm":
 		return &eval.StringArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []string {
 				if ptr := ctx.Cache[field]; ptr != nil {
@@ -1820,7 +2238,7 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 
 					element := (*model.ProcessCacheEntry)(value)
 
-					result = element.ProcessContext.Process.Credentials.EGroup
+					result = element.ProcessContext.Process.Comm
 
 					results = append(results, result)
 
@@ -1833,7 +2251,38 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Weight: eval.IteratorWeight,
 		}, nil
 
-	case "process.ancestors.euid":
+	case "process.ancestors.container.id":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				if ptr := ctx.Cache[field]; ptr != nil {
+					if result := (*[]string)(ptr); result != nil {
+						return *result
+					}
+				}
+				var results []string
+
+				iterator := &model.ProcessAncestorsIterator{}
+
+				value := iterator.Front(ctx)
+				for value != nil {
+					var result string
+
+					element := (*model.ProcessCacheEntry)(value)
+
+					result = element.ProcessContext.Process.ContainerID
+
+					results = append(results, result)
+
+					value = iterator.Next()
+				}
+				ctx.Cache[field] = unsafe.Pointer(&results)
+
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+
+	case "process.ancestors.cookie":
 		return &eval.IntArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []int {
 				if ptr := ctx.Cache[field]; ptr != nil {
@@ -1851,7 +2300,7 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 
 					element := (*model.ProcessCacheEntry)(value)
 
-					result = int(element.ProcessContext.Process.Credentials.EUID)
+					result = int(element.ProcessContext.Process.Cookie)
 
 					results = append(results, result)
 
@@ -1864,25 +2313,25 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Weight: eval.IteratorWeight,
 		}, nil
 
-	case "process.ancestors.euser":
-		return &eval.StringArrayEvaluator{
-			EvalFnc: func(ctx *eval.Context) []string {
+	case "process.ancestors.created_at":
+		return &eval.IntArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []int {
 				if ptr := ctx.Cache[field]; ptr != nil {
-					if result := (*[]string)(ptr); result != nil {
+					if result := (*[]int)(ptr); result != nil {
 						return *result
 					}
 				}
-				var results []string
+				var results []int
 
 				iterator := &model.ProcessAncestorsIterator{}
 
 				value := iterator.Front(ctx)
 				for value != nil {
-					var result string
+					var result int
 
 					element := (*model.ProcessCacheEntry)(value)
 
-					result = element.ProcessContext.Process.Credentials.EUser
+					result = int((*Event)(ctx.Object).ResolveProcessCreatedAt(&element.Process))
 
 					results = append(results, result)
 
@@ -1895,7 +2344,7 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Weight: eval.IteratorWeight,
 		}, nil
 
-	case "process.ancestors.file.change_time":
+	case "process.ancestors.egid":
 		return &eval.IntArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []int {
 				if ptr := ctx.Cache[field]; ptr != nil {
@@ -1913,7 +2362,7 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 
 					element := (*model.ProcessCacheEntry)(value)
 
-					result = int(element.ProcessContext.Process.FileFields.CTime)
+					result = int(element.ProcessContext.Process.Credentials.EGID)
 
 					results = append(results, result)
 
@@ -1926,7 +2375,7 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Weight: eval.IteratorWeight,
 		}, nil
 
-	case "process.ancestors.file.filesystem":
+	case "process.ancestors.egroup":
 		return &eval.StringArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []string {
 				if ptr := ctx.Cache[field]; ptr != nil {
@@ -1944,7 +2393,7 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 
 					element := (*model.ProcessCacheEntry)(value)
 
-					result = element.ProcessContext.Process.Filesystem
+					result = element.ProcessContext.Process.Credentials.EGroup
 
 					results = append(results, result)
 
@@ -1957,7 +2406,7 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Weight: eval.IteratorWeight,
 		}, nil
 
-	case "process.ancestors.file.gid":
+	case "process.ancestors.euid":
 		return &eval.IntArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []int {
 				if ptr := ctx.Cache[field]; ptr != nil {
@@ -1975,7 +2424,7 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 
 					element := (*model.ProcessCacheEntry)(value)
 
-					result = int(element.ProcessContext.Process.FileFields.GID)
+					result = int(element.ProcessContext.Process.Credentials.EUID)
 
 					results = append(results, result)
 
@@ -1988,7 +2437,7 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Weight: eval.IteratorWeight,
 		}, nil
 
-	case "process.ancestors.file.group":
+	case "process.ancestors.euser":
 		return &eval.StringArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []string {
 				if ptr := ctx.Cache[field]; ptr != nil {
@@ -2006,7 +2455,7 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 
 					element := (*model.ProcessCacheEntry)(value)
 
-					result = (*Event)(ctx.Object).ResolveFileFieldsGroup(&element.FileFields)
+					result = element.ProcessContext.Process.Credentials.EUser
 
 					results = append(results, result)
 
@@ -2019,15 +2468,139 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Weight: eval.IteratorWeight,
 		}, nil
 
-	case "process.ancestors.file.in_upper_layer":
-		return &eval.BoolArrayEvaluator{
-			EvalFnc: func(ctx *eval.Context) []bool {
+	case "process.ancestors.file.change_time":
+		return &eval.IntArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []int {
 				if ptr := ctx.Cache[field]; ptr != nil {
-					if result := (*[]bool)(ptr); result != nil {
+					if result := (*[]int)(ptr); result != nil {
 						return *result
 					}
 				}
-				var results []bool
+				var results []int
+
+				iterator := &model.ProcessAncestorsIterator{}
+
+				value := iterator.Front(ctx)
+				for value != nil {
+					var result int
+
+					element := (*model.ProcessCacheEntry)(value)
+
+					result = int(element.ProcessContext.Process.FileFields.CTime)
+
+					results = append(results, result)
+
+					value = iterator.Next()
+				}
+				ctx.Cache[field] = unsafe.Pointer(&results)
+
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+
+	case "process.ancestors.file.filesystem":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				if ptr := ctx.Cache[field]; ptr != nil {
+					if result := (*[]string)(ptr); result != nil {
+						return *result
+					}
+				}
+				var results []string
+
+				iterator := &model.ProcessAncestorsIterator{}
+
+				value := iterator.Front(ctx)
+				for value != nil {
+					var result string
+
+					element := (*model.ProcessCacheEntry)(value)
+
+					result = element.ProcessContext.Process.Filesystem
+
+					results = append(results, result)
+
+					value = iterator.Next()
+				}
+				ctx.Cache[field] = unsafe.Pointer(&results)
+
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+
+	case "process.ancestors.file.gid":
+		return &eval.IntArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []int {
+				if ptr := ctx.Cache[field]; ptr != nil {
+					if result := (*[]int)(ptr); result != nil {
+						return *result
+					}
+				}
+				var results []int
+
+				iterator := &model.ProcessAncestorsIterator{}
+
+				value := iterator.Front(ctx)
+				for value != nil {
+					var result int
+
+					element := (*model.ProcessCacheEntry)(value)
+
+					result = int(element.ProcessContext.Process.FileFields.GID)
+
+					results = append(results, result)
+
+					value = iterator.Next()
+				}
+				ctx.Cache[field] = unsafe.Pointer(&results)
+
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+
+	case "process.ancestors.file.group":
+		return &eval.StringArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []string {
+				if ptr := ctx.Cache[field]; ptr != nil {
+					if result := (*[]string)(ptr); result != nil {
+						return *result
+					}
+				}
+				var results []string
+
+				iterator := &model.ProcessAncestorsIterator{}
+
+				value := iterator.Front(ctx)
+				for value != nil {
+					var result string
+
+					element := (*model.ProcessCacheEntry)(value)
+
+					result = (*Event)(ctx.Object).ResolveFileFieldsGroup(&element.FileFields)
+
+					results = append(results, result)
+
+					value = iterator.Next()
+				}
+				ctx.Cache[field] = unsafe.Pointer(&results)
+
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+
+	case "process.ancestors.file.in_upper_layer":
+		return &eval.BoolArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []bool {
+				if ptr := ctx.Cache[field]; ptr != nil {
+					if result := (*[]bool)(ptr); result != nil {
+						return *result
+					}
+				}
+				var results []bool
 
 				iterator := &model.ProcessAncestorsIterator{}
 
@@ -3061,10019 +3634,13988 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "removexattr.file.change_time":
-		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int {
-
-				return int((*Event)(ctx.Object).RemoveXAttr.File.FileFields.CTime)
-			},
-			Field:  field,
-			Weight: eval.FunctionWeight,
-		}, nil
-
-	case "removexattr.file.destination.name":
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-
-				return (*Event)(ctx.Object).ResolveXAttrName(&(*Event)(ctx.Object).RemoveXAttr)
-			},
-			Field:  field,
-			Weight: eval.HandlerWeight,
-		}, nil
-
-	case "removexattr.file.destination.namespace":
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-
-				return (*Event)(ctx.Object).ResolveXAttrNamespace(&(*Event)(ctx.Object).RemoveXAttr)
-			},
-			Field:  field,
-			Weight: eval.HandlerWeight,
-		}, nil
-
-	case "removexattr.file.filesystem":
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-
-				return (*Event)(ctx.Object).ResolveFileFilesystem(&(*Event)(ctx.Object).RemoveXAttr.File)
-			},
-			Field:  field,
-			Weight: eval.HandlerWeight,
-		}, nil
-
-	case "removexattr.file.gid":
+	case "ptrace.request":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).RemoveXAttr.File.FileFields.GID)
+				return int((*Event)(ctx.Object).PTrace.Request)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "removexattr.file.group":
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-
-				return (*Event)(ctx.Object).ResolveFileFieldsGroup(&(*Event)(ctx.Object).RemoveXAttr.File.FileFields)
-			},
-			Field:  field,
-			Weight: eval.HandlerWeight,
-		}, nil
-
-	case "removexattr.file.in_upper_layer":
-		return &eval.BoolEvaluator{
-			EvalFnc: func(ctx *eval.Context) bool {
-
-				return (*Event)(ctx.Object).ResolveFileFieldsInUpperLayer(&(*Event)(ctx.Object).RemoveXAttr.File.FileFields)
-			},
-			Field:  field,
-			Weight: eval.HandlerWeight,
-		}, nil
-
-	case "removexattr.file.inode":
+	case "ptrace.retval":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).RemoveXAttr.File.FileFields.Inode)
+				return int((*Event)(ctx.Object).PTrace.SyscallEvent.Retval)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "removexattr.file.mode":
+	case "ptrace.tracee.cap_effective":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).RemoveXAttr.File.FileFields.Mode)
+				return int((*Event)(ctx.Object).PTrace.Tracee.Credentials.CapEffective)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "removexattr.file.modification_time":
+	case "ptrace.tracee.cap_permitted":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).RemoveXAttr.File.FileFields.MTime)
+				return int((*Event)(ctx.Object).PTrace.Tracee.Credentials.CapPermitted)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "removexattr.file.mount_id":
-		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int {
+	case "ptrace.tracee.comm":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
 
-				return int((*Event)(ctx.Object).RemoveXAttr.File.FileFields.MountID)
+				return (*Event)(ctx.Object).PTrace.Tracee.Comm
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "removexattr.file.name":
+	case "ptrace.tracee.container.id":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFileBasename(&(*Event)(ctx.Object).RemoveXAttr.File)
+				return (*Event)(ctx.Object).PTrace.Tracee.ContainerID
 			},
 			Field:  field,
-			Weight: eval.HandlerWeight,
+			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "removexattr.file.path":
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
+	case "ptrace.tracee.cookie":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
 
-				return (*Event)(ctx.Object).ResolveFilePath(&(*Event)(ctx.Object).RemoveXAttr.File)
+				return int((*Event)(ctx.Object).PTrace.Tracee.Cookie)
 			},
 			Field:  field,
-			Weight: eval.HandlerWeight,
+			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "removexattr.file.rights":
+	case "ptrace.tracee.created_at":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).ResolveRights(&(*Event)(ctx.Object).RemoveXAttr.File.FileFields))
+				return int((*Event)(ctx.Object).ResolveProcessCreatedAt(&(*Event)(ctx.Object).PTrace.Tracee))
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "removexattr.file.uid":
+	case "ptrace.tracee.egid":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).RemoveXAttr.File.FileFields.UID)
+				return int((*Event)(ctx.Object).PTrace.Tracee.Credentials.EGID)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "removexattr.file.user":
+	case "ptrace.tracee.egroup":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFileFieldsUser(&(*Event)(ctx.Object).RemoveXAttr.File.FileFields)
+				return (*Event)(ctx.Object).PTrace.Tracee.Credentials.EGroup
 			},
 			Field:  field,
-			Weight: eval.HandlerWeight,
+			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "removexattr.retval":
+	case "ptrace.tracee.euid":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).RemoveXAttr.SyscallEvent.Retval)
+				return int((*Event)(ctx.Object).PTrace.Tracee.Credentials.EUID)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "rename.file.change_time":
-		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int {
+	case "ptrace.tracee.euser":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
 
-				return int((*Event)(ctx.Object).Rename.Old.FileFields.CTime)
+				return (*Event)(ctx.Object).PTrace.Tracee.Credentials.EUser
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "rename.file.destination.change_time":
+	case "ptrace.tracee.file.change_time":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Rename.New.FileFields.CTime)
+				return int((*Event)(ctx.Object).PTrace.Tracee.FileFields.CTime)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "rename.file.destination.filesystem":
+	case "ptrace.tracee.file.filesystem":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFileFilesystem(&(*Event)(ctx.Object).Rename.New)
+				return (*Event)(ctx.Object).PTrace.Tracee.Filesystem
 			},
 			Field:  field,
-			Weight: eval.HandlerWeight,
+			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "rename.file.destination.gid":
+	case "ptrace.tracee.file.gid":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Rename.New.FileFields.GID)
+				return int((*Event)(ctx.Object).PTrace.Tracee.FileFields.GID)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "rename.file.destination.group":
+	case "ptrace.tracee.file.group":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFileFieldsGroup(&(*Event)(ctx.Object).Rename.New.FileFields)
+				return (*Event)(ctx.Object).ResolveFileFieldsGroup(&(*Event)(ctx.Object).PTrace.Tracee.FileFields)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "rename.file.destination.in_upper_layer":
+	case "ptrace.tracee.file.in_upper_layer":
 		return &eval.BoolEvaluator{
 			EvalFnc: func(ctx *eval.Context) bool {
 
-				return (*Event)(ctx.Object).ResolveFileFieldsInUpperLayer(&(*Event)(ctx.Object).Rename.New.FileFields)
+				return (*Event)(ctx.Object).ResolveFileFieldsInUpperLayer(&(*Event)(ctx.Object).PTrace.Tracee.FileFields)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "rename.file.destination.inode":
+	case "ptrace.tracee.file.inode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Rename.New.FileFields.Inode)
+				return int((*Event)(ctx.Object).PTrace.Tracee.FileFields.Inode)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "rename.file.destination.mode":
+	case "ptrace.tracee.file.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Rename.New.FileFields.Mode)
+				return int((*Event)(ctx.Object).PTrace.Tracee.FileFields.Mode)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "rename.file.destination.modification_time":
+	case "ptrace.tracee.file.modification_time":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Rename.New.FileFields.MTime)
+				return int((*Event)(ctx.Object).PTrace.Tracee.FileFields.MTime)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "rename.file.destination.mount_id":
+	case "ptrace.tracee.file.mount_id":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Rename.New.FileFields.MountID)
+				return int((*Event)(ctx.Object).PTrace.Tracee.FileFields.MountID)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "rename.file.destination.name":
+	case "ptrace.tracee.file.name":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFileBasename(&(*Event)(ctx.Object).Rename.New)
+				return (*Event)(ctx.Object).PTrace.Tracee.BasenameStr
 			},
 			Field:  field,
-			Weight: eval.HandlerWeight,
+			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "rename.file.destination.path":
+	case "ptrace.tracee.file.path":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFilePath(&(*Event)(ctx.Object).Rename.New)
+				return (*Event)(ctx.Object).PTrace.Tracee.PathnameStr
 			},
 			Field:  field,
-			Weight: eval.HandlerWeight,
+			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "rename.file.destination.rights":
+	case "ptrace.tracee.file.rights":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).ResolveRights(&(*Event)(ctx.Object).Rename.New.FileFields))
+				return int((*Event)(ctx.Object).ResolveRights(&(*Event)(ctx.Object).PTrace.Tracee.FileFields))
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "rename.file.destination.uid":
+	case "ptrace.tracee.file.uid":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Rename.New.FileFields.UID)
+				return int((*Event)(ctx.Object).PTrace.Tracee.FileFields.UID)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "rename.file.destination.user":
+	case "ptrace.tracee.file.user":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFileFieldsUser(&(*Event)(ctx.Object).Rename.New.FileFields)
+				return (*Event)(ctx.Object).ResolveFileFieldsUser(&(*Event)(ctx.Object).PTrace.Tracee.FileFields)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "rename.file.filesystem":
+	case "ptrace.tracee.fsgid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).PTrace.Tracee.Credentials.FSGID)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "ptrace.tracee.fsgroup":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFileFilesystem(&(*Event)(ctx.Object).Rename.Old)
+				return (*Event)(ctx.Object).PTrace.Tracee.Credentials.FSGroup
 			},
 			Field:  field,
-			Weight: eval.HandlerWeight,
+			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "rename.file.gid":
+	case "ptrace.tracee.fsuid":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Rename.Old.FileFields.GID)
+				return int((*Event)(ctx.Object).PTrace.Tracee.Credentials.FSUID)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "rename.file.group":
+	case "ptrace.tracee.fsuser":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFileFieldsGroup(&(*Event)(ctx.Object).Rename.Old.FileFields)
+				return (*Event)(ctx.Object).PTrace.Tracee.Credentials.FSUser
 			},
 			Field:  field,
-			Weight: eval.HandlerWeight,
+			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "rename.file.in_upper_layer":
-		return &eval.BoolEvaluator{
-			EvalFnc: func(ctx *eval.Context) bool {
+	case "ptrace.tracee.gid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
 
-				return (*Event)(ctx.Object).ResolveFileFieldsInUpperLayer(&(*Event)(ctx.Object).Rename.Old.FileFields)
+				return int((*Event)(ctx.Object).PTrace.Tracee.Credentials.GID)
 			},
 			Field:  field,
-			Weight: eval.HandlerWeight,
+			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "rename.file.inode":
-		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int {
+	case "ptrace.tracee.group":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
 
-				return int((*Event)(ctx.Object).Rename.Old.FileFields.Inode)
+				return (*Event)(ctx.Object).PTrace.Tracee.Credentials.Group
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "rename.file.mode":
+	case "ptrace.tracee.pid":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Rename.Old.FileFields.Mode)
+				return int((*Event)(ctx.Object).PTrace.Tracee.Pid)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "rename.file.modification_time":
+	case "ptrace.tracee.ppid":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Rename.Old.FileFields.MTime)
+				return int((*Event)(ctx.Object).PTrace.Tracee.PPid)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "rename.file.mount_id":
+	case "ptrace.tracee.tid":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Rename.Old.FileFields.MountID)
+				return int((*Event)(ctx.Object).PTrace.Tracee.Tid)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "rename.file.name":
+	case "ptrace.tracee.tty_name":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFileBasename(&(*Event)(ctx.Object).Rename.Old)
+				return (*Event)(ctx.Object).PTrace.Tracee.TTYName
 			},
 			Field:  field,
-			Weight: eval.HandlerWeight,
+			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "rename.file.path":
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
+	case "ptrace.tracee.uid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
 
-				return (*Event)(ctx.Object).ResolveFilePath(&(*Event)(ctx.Object).Rename.Old)
+				return int((*Event)(ctx.Object).PTrace.Tracee.Credentials.UID)
 			},
 			Field:  field,
-			Weight: eval.HandlerWeight,
+			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "rename.file.rights":
-		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int {
+	case "ptrace.tracee.user":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
 
-				return int((*Event)(ctx.Object).ResolveRights(&(*Event)(ctx.Object).Rename.Old.FileFields))
+				return (*Event)(ctx.Object).PTrace.Tracee.Credentials.User
 			},
 			Field:  field,
-			Weight: eval.HandlerWeight,
+			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "rename.file.uid":
+	case "removexattr.file.change_time":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Rename.Old.FileFields.UID)
+				return int((*Event)(ctx.Object).RemoveXAttr.File.FileFields.CTime)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "rename.file.user":
+	case "removexattr.file.destination.name":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFileFieldsUser(&(*Event)(ctx.Object).Rename.Old.FileFields)
+				return (*Event)(ctx.Object).ResolveXAttrName(&(*Event)(ctx.Object).RemoveXAttr)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "rename.retval":
-		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int {
-
-				return int((*Event)(ctx.Object).Rename.SyscallEvent.Retval)
-			},
-			Field:  field,
-			Weight: eval.FunctionWeight,
-		}, nil
-
-	case "rmdir.file.change_time":
-		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int {
+	case "removexattr.file.destination.namespace":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
 
-				return int((*Event)(ctx.Object).Rmdir.File.FileFields.CTime)
+				return (*Event)(ctx.Object).ResolveXAttrNamespace(&(*Event)(ctx.Object).RemoveXAttr)
 			},
 			Field:  field,
-			Weight: eval.FunctionWeight,
+			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "rmdir.file.filesystem":
+	case "removexattr.file.filesystem":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFileFilesystem(&(*Event)(ctx.Object).Rmdir.File)
+				return (*Event)(ctx.Object).ResolveFileFilesystem(&(*Event)(ctx.Object).RemoveXAttr.File)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "rmdir.file.gid":
+	case "removexattr.file.gid":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Rmdir.File.FileFields.GID)
+				return int((*Event)(ctx.Object).RemoveXAttr.File.FileFields.GID)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "rmdir.file.group":
+	case "removexattr.file.group":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFileFieldsGroup(&(*Event)(ctx.Object).Rmdir.File.FileFields)
+				return (*Event)(ctx.Object).ResolveFileFieldsGroup(&(*Event)(ctx.Object).RemoveXAttr.File.FileFields)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "rmdir.file.in_upper_layer":
+	case "removexattr.file.in_upper_layer":
 		return &eval.BoolEvaluator{
 			EvalFnc: func(ctx *eval.Context) bool {
 
-				return (*Event)(ctx.Object).ResolveFileFieldsInUpperLayer(&(*Event)(ctx.Object).Rmdir.File.FileFields)
+				return (*Event)(ctx.Object).ResolveFileFieldsInUpperLayer(&(*Event)(ctx.Object).RemoveXAttr.File.FileFields)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "rmdir.file.inode":
+	case "removexattr.file.inode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Rmdir.File.FileFields.Inode)
+				return int((*Event)(ctx.Object).RemoveXAttr.File.FileFields.Inode)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "rmdir.file.mode":
+	case "removexattr.file.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Rmdir.File.FileFields.Mode)
+				return int((*Event)(ctx.Object).RemoveXAttr.File.FileFields.Mode)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "rmdir.file.modification_time":
+	case "removexattr.file.modification_time":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Rmdir.File.FileFields.MTime)
+				return int((*Event)(ctx.Object).RemoveXAttr.File.FileFields.MTime)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "rmdir.file.mount_id":
+	case "removexattr.file.mount_id":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Rmdir.File.FileFields.MountID)
+				return int((*Event)(ctx.Object).RemoveXAttr.File.FileFields.MountID)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "rmdir.file.name":
+	case "removexattr.file.name":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFileBasename(&(*Event)(ctx.Object).Rmdir.File)
+				return (*Event)(ctx.Object).ResolveFileBasename(&(*Event)(ctx.Object).RemoveXAttr.File)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "rmdir.file.path":
+	case "removexattr.file.path":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFilePath(&(*Event)(ctx.Object).Rmdir.File)
+				return (*Event)(ctx.Object).ResolveFilePath(&(*Event)(ctx.Object).RemoveXAttr.File)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "rmdir.file.rights":
+	case "removexattr.file.rights":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).ResolveRights(&(*Event)(ctx.Object).Rmdir.File.FileFields))
+				return int((*Event)(ctx.Object).ResolveRights(&(*Event)(ctx.Object).RemoveXAttr.File.FileFields))
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "rmdir.file.uid":
+	case "removexattr.file.uid":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Rmdir.File.FileFields.UID)
+				return int((*Event)(ctx.Object).RemoveXAttr.File.FileFields.UID)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "rmdir.file.user":
+	case "removexattr.file.user":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFileFieldsUser(&(*Event)(ctx.Object).Rmdir.File.FileFields)
+				return (*Event)(ctx.Object).ResolveFileFieldsUser(&(*Event)(ctx.Object).RemoveXAttr.File.FileFields)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "rmdir.retval":
+	case "removexattr.retval":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Rmdir.SyscallEvent.Retval)
+				return int((*Event)(ctx.Object).RemoveXAttr.SyscallEvent.Retval)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "selinux.bool.name":
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-
-				return (*Event)(ctx.Object).ResolveSELinuxBoolName(&(*Event)(ctx.Object).SELinux)
-			},
-			Field:  field,
-			Weight: eval.HandlerWeight,
-		}, nil
-
-	case "selinux.bool.state":
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
+	case "rename.file.change_time":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
 
-				return (*Event)(ctx.Object).SELinux.BoolChangeValue
+				return int((*Event)(ctx.Object).Rename.Old.FileFields.CTime)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "selinux.bool_commit.state":
-		return &eval.BoolEvaluator{
-			EvalFnc: func(ctx *eval.Context) bool {
+	case "rename.file.destination.change_time":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
 
-				return (*Event)(ctx.Object).SELinux.BoolCommitValue
+				return int((*Event)(ctx.Object).Rename.New.FileFields.CTime)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "selinux.enforce.status":
+	case "rename.file.destination.filesystem":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).SELinux.EnforceStatus
+				return (*Event)(ctx.Object).ResolveFileFilesystem(&(*Event)(ctx.Object).Rename.New)
 			},
 			Field:  field,
-			Weight: eval.FunctionWeight,
+			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "setgid.egid":
+	case "rename.file.destination.gid":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).SetGID.EGID)
+				return int((*Event)(ctx.Object).Rename.New.FileFields.GID)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "setgid.egroup":
+	case "rename.file.destination.group":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveSetgidEGroup(&(*Event)(ctx.Object).SetGID)
+				return (*Event)(ctx.Object).ResolveFileFieldsGroup(&(*Event)(ctx.Object).Rename.New.FileFields)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "setgid.fsgid":
-		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int {
+	case "rename.file.destination.in_upper_layer":
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
 
-				return int((*Event)(ctx.Object).SetGID.FSGID)
+				return (*Event)(ctx.Object).ResolveFileFieldsInUpperLayer(&(*Event)(ctx.Object).Rename.New.FileFields)
 			},
 			Field:  field,
-			Weight: eval.FunctionWeight,
+			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "setgid.fsgroup":
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
+	case "rename.file.destination.inode":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
 
-				return (*Event)(ctx.Object).ResolveSetgidFSGroup(&(*Event)(ctx.Object).SetGID)
+				return int((*Event)(ctx.Object).Rename.New.FileFields.Inode)
 			},
 			Field:  field,
-			Weight: eval.HandlerWeight,
+			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "setgid.gid":
+	case "rename.file.destination.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).SetGID.GID)
+				return int((*Event)(ctx.Object).Rename.New.FileFields.Mode)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "setgid.group":
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
+	case "rename.file.destination.modification_time":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
 
-				return (*Event)(ctx.Object).ResolveSetgidGroup(&(*Event)(ctx.Object).SetGID)
+				return int((*Event)(ctx.Object).Rename.New.FileFields.MTime)
 			},
 			Field:  field,
-			Weight: eval.HandlerWeight,
+			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "setuid.euid":
+	case "rename.file.destination.mount_id":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).SetUID.EUID)
+				return int((*Event)(ctx.Object).Rename.New.FileFields.MountID)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "setuid.euser":
+	case "rename.file.destination.name":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveSetuidEUser(&(*Event)(ctx.Object).SetUID)
+				return (*Event)(ctx.Object).ResolveFileBasename(&(*Event)(ctx.Object).Rename.New)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "setuid.fsuid":
-		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int {
+	case "rename.file.destination.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
 
-				return int((*Event)(ctx.Object).SetUID.FSUID)
+				return (*Event)(ctx.Object).ResolveFilePath(&(*Event)(ctx.Object).Rename.New)
 			},
 			Field:  field,
-			Weight: eval.FunctionWeight,
+			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "setuid.fsuser":
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
+	case "rename.file.destination.rights":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
 
-				return (*Event)(ctx.Object).ResolveSetuidFSUser(&(*Event)(ctx.Object).SetUID)
+				return int((*Event)(ctx.Object).ResolveRights(&(*Event)(ctx.Object).Rename.New.FileFields))
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "setuid.uid":
+	case "rename.file.destination.uid":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).SetUID.UID)
+				return int((*Event)(ctx.Object).Rename.New.FileFields.UID)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "setuid.user":
+	case "rename.file.destination.user":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveSetuidUser(&(*Event)(ctx.Object).SetUID)
+				return (*Event)(ctx.Object).ResolveFileFieldsUser(&(*Event)(ctx.Object).Rename.New.FileFields)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "setxattr.file.change_time":
-		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int {
-
-				return int((*Event)(ctx.Object).SetXAttr.File.FileFields.CTime)
-			},
-			Field:  field,
-			Weight: eval.FunctionWeight,
-		}, nil
-
-	case "setxattr.file.destination.name":
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-
-				return (*Event)(ctx.Object).ResolveXAttrName(&(*Event)(ctx.Object).SetXAttr)
-			},
-			Field:  field,
-			Weight: eval.HandlerWeight,
-		}, nil
-
-	case "setxattr.file.destination.namespace":
-		return &eval.StringEvaluator{
-			EvalFnc: func(ctx *eval.Context) string {
-
-				return (*Event)(ctx.Object).ResolveXAttrNamespace(&(*Event)(ctx.Object).SetXAttr)
-			},
-			Field:  field,
-			Weight: eval.HandlerWeight,
-		}, nil
-
-	case "setxattr.file.filesystem":
+	case "rename.file.filesystem":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFileFilesystem(&(*Event)(ctx.Object).SetXAttr.File)
+				return (*Event)(ctx.Object).ResolveFileFilesystem(&(*Event)(ctx.Object).Rename.Old)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "setxattr.file.gid":
+	case "rename.file.gid":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).SetXAttr.File.FileFields.GID)
+				return int((*Event)(ctx.Object).Rename.Old.FileFields.GID)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "setxattr.file.group":
+	case "rename.file.group":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFileFieldsGroup(&(*Event)(ctx.Object).SetXAttr.File.FileFields)
+				return (*Event)(ctx.Object).ResolveFileFieldsGroup(&(*Event)(ctx.Object).Rename.Old.FileFields)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "setxattr.file.in_upper_layer":
+	case "rename.file.in_upper_layer":
 		return &eval.BoolEvaluator{
 			EvalFnc: func(ctx *eval.Context) bool {
 
-				return (*Event)(ctx.Object).ResolveFileFieldsInUpperLayer(&(*Event)(ctx.Object).SetXAttr.File.FileFields)
+				return (*Event)(ctx.Object).ResolveFileFieldsInUpperLayer(&(*Event)(ctx.Object).Rename.Old.FileFields)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "setxattr.file.inode":
+	case "rename.file.inode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).SetXAttr.File.FileFields.Inode)
+				return int((*Event)(ctx.Object).Rename.Old.FileFields.Inode)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "setxattr.file.mode":
+	case "rename.file.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).SetXAttr.File.FileFields.Mode)
+				return int((*Event)(ctx.Object).Rename.Old.FileFields.Mode)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "setxattr.file.modification_time":
+	case "rename.file.modification_time":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).SetXAttr.File.FileFields.MTime)
+				return int((*Event)(ctx.Object).Rename.Old.FileFields.MTime)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "setxattr.file.mount_id":
+	case "rename.file.mount_id":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).SetXAttr.File.FileFields.MountID)
+				return int((*Event)(ctx.Object).Rename.Old.FileFields.MountID)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "setxattr.file.name":
+	case "rename.file.name":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFileBasename(&(*Event)(ctx.Object).SetXAttr.File)
+				return (*Event)(ctx.Object).ResolveFileBasename(&(*Event)(ctx.Object).Rename.Old)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "setxattr.file.path":
+	case "rename.file.path":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFilePath(&(*Event)(ctx.Object).SetXAttr.File)
+				return (*Event)(ctx.Object).ResolveFilePath(&(*Event)(ctx.Object).Rename.Old)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "setxattr.file.rights":
+	case "rename.file.rights":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).ResolveRights(&(*Event)(ctx.Object).SetXAttr.File.FileFields))
+				return int((*Event)(ctx.Object).ResolveRights(&(*Event)(ctx.Object).Rename.Old.FileFields))
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "setxattr.file.uid":
+	case "rename.file.uid":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).SetXAttr.File.FileFields.UID)
+				return int((*Event)(ctx.Object).Rename.Old.FileFields.UID)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "setxattr.file.user":
+	case "rename.file.user":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFileFieldsUser(&(*Event)(ctx.Object).SetXAttr.File.FileFields)
+				return (*Event)(ctx.Object).ResolveFileFieldsUser(&(*Event)(ctx.Object).Rename.Old.FileFields)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "setxattr.retval":
+	case "rename.retval":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).SetXAttr.SyscallEvent.Retval)
+				return int((*Event)(ctx.Object).Rename.SyscallEvent.Retval)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "unlink.file.change_time":
+	case "rmdir.file.change_time":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Unlink.File.FileFields.CTime)
+				return int((*Event)(ctx.Object).Rmdir.File.FileFields.CTime)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "unlink.file.filesystem":
+	case "rmdir.file.filesystem":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFileFilesystem(&(*Event)(ctx.Object).Unlink.File)
+				return (*Event)(ctx.Object).ResolveFileFilesystem(&(*Event)(ctx.Object).Rmdir.File)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "unlink.file.gid":
+	case "rmdir.file.gid":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Unlink.File.FileFields.GID)
+				return int((*Event)(ctx.Object).Rmdir.File.FileFields.GID)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "unlink.file.group":
+	case "rmdir.file.group":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFileFieldsGroup(&(*Event)(ctx.Object).Unlink.File.FileFields)
+				return (*Event)(ctx.Object).ResolveFileFieldsGroup(&(*Event)(ctx.Object).Rmdir.File.FileFields)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "unlink.file.in_upper_layer":
+	case "rmdir.file.in_upper_layer":
 		return &eval.BoolEvaluator{
 			EvalFnc: func(ctx *eval.Context) bool {
 
-				return (*Event)(ctx.Object).ResolveFileFieldsInUpperLayer(&(*Event)(ctx.Object).Unlink.File.FileFields)
+				return (*Event)(ctx.Object).ResolveFileFieldsInUpperLayer(&(*Event)(ctx.Object).Rmdir.File.FileFields)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "unlink.file.inode":
+	case "rmdir.file.inode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Unlink.File.FileFields.Inode)
+				return int((*Event)(ctx.Object).Rmdir.File.FileFields.Inode)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "unlink.file.mode":
+	case "rmdir.file.mode":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Unlink.File.FileFields.Mode)
+				return int((*Event)(ctx.Object).Rmdir.File.FileFields.Mode)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "unlink.file.modification_time":
+	case "rmdir.file.modification_time":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Unlink.File.FileFields.MTime)
+				return int((*Event)(ctx.Object).Rmdir.File.FileFields.MTime)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "unlink.file.mount_id":
+	case "rmdir.file.mount_id":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Unlink.File.FileFields.MountID)
+				return int((*Event)(ctx.Object).Rmdir.File.FileFields.MountID)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "unlink.file.name":
+	case "rmdir.file.name":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFileBasename(&(*Event)(ctx.Object).Unlink.File)
+				return (*Event)(ctx.Object).ResolveFileBasename(&(*Event)(ctx.Object).Rmdir.File)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "unlink.file.path":
+	case "rmdir.file.path":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFilePath(&(*Event)(ctx.Object).Unlink.File)
+				return (*Event)(ctx.Object).ResolveFilePath(&(*Event)(ctx.Object).Rmdir.File)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "unlink.file.rights":
+	case "rmdir.file.rights":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).ResolveRights(&(*Event)(ctx.Object).Unlink.File.FileFields))
+				return int((*Event)(ctx.Object).ResolveRights(&(*Event)(ctx.Object).Rmdir.File.FileFields))
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "unlink.file.uid":
+	case "rmdir.file.uid":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Unlink.File.FileFields.UID)
+				return int((*Event)(ctx.Object).Rmdir.File.FileFields.UID)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "unlink.file.user":
+	case "rmdir.file.user":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFileFieldsUser(&(*Event)(ctx.Object).Unlink.File.FileFields)
+				return (*Event)(ctx.Object).ResolveFileFieldsUser(&(*Event)(ctx.Object).Rmdir.File.FileFields)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "unlink.retval":
+	case "rmdir.retval":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Unlink.SyscallEvent.Retval)
+				return int((*Event)(ctx.Object).Rmdir.SyscallEvent.Retval)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "utimes.file.change_time":
-		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int {
+	case "selinux.bool.name":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
 
-				return int((*Event)(ctx.Object).Utimes.File.FileFields.CTime)
+				return (*Event)(ctx.Object).ResolveSELinuxBoolName(&(*Event)(ctx.Object).SELinux)
 			},
 			Field:  field,
-			Weight: eval.FunctionWeight,
+			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "utimes.file.filesystem":
+	case "selinux.bool.state":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFileFilesystem(&(*Event)(ctx.Object).Utimes.File)
+				return (*Event)(ctx.Object).SELinux.BoolChangeValue
 			},
 			Field:  field,
-			Weight: eval.HandlerWeight,
+			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "utimes.file.gid":
-		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int {
+	case "selinux.bool_commit.state":
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
 
-				return int((*Event)(ctx.Object).Utimes.File.FileFields.GID)
+				return (*Event)(ctx.Object).SELinux.BoolCommitValue
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "utimes.file.group":
+	case "selinux.enforce.status":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFileFieldsGroup(&(*Event)(ctx.Object).Utimes.File.FileFields)
+				return (*Event)(ctx.Object).SELinux.EnforceStatus
 			},
 			Field:  field,
-			Weight: eval.HandlerWeight,
+			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "utimes.file.in_upper_layer":
-		return &eval.BoolEvaluator{
-			EvalFnc: func(ctx *eval.Context) bool {
+	case "setgid.egid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
 
-				return (*Event)(ctx.Object).ResolveFileFieldsInUpperLayer(&(*Event)(ctx.Object).Utimes.File.FileFields)
+				return int((*Event)(ctx.Object).SetGID.EGID)
 			},
 			Field:  field,
-			Weight: eval.HandlerWeight,
+			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "utimes.file.inode":
-		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int {
+	case "setgid.egroup":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
 
-				return int((*Event)(ctx.Object).Utimes.File.FileFields.Inode)
+				return (*Event)(ctx.Object).ResolveSetgidEGroup(&(*Event)(ctx.Object).SetGID)
 			},
 			Field:  field,
-			Weight: eval.FunctionWeight,
+			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "utimes.file.mode":
+	case "setgid.fsgid":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Utimes.File.FileFields.Mode)
+				return int((*Event)(ctx.Object).SetGID.FSGID)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "utimes.file.modification_time":
-		return &eval.IntEvaluator{
-			EvalFnc: func(ctx *eval.Context) int {
+	case "setgid.fsgroup":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
 
-				return int((*Event)(ctx.Object).Utimes.File.FileFields.MTime)
+				return (*Event)(ctx.Object).ResolveSetgidFSGroup(&(*Event)(ctx.Object).SetGID)
 			},
 			Field:  field,
-			Weight: eval.FunctionWeight,
+			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "utimes.file.mount_id":
+	case "setgid.gid":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Utimes.File.FileFields.MountID)
+				return int((*Event)(ctx.Object).SetGID.GID)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "utimes.file.name":
+	case "setgid.group":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFileBasename(&(*Event)(ctx.Object).Utimes.File)
+				return (*Event)(ctx.Object).ResolveSetgidGroup(&(*Event)(ctx.Object).SetGID)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "utimes.file.path":
+	case "setuid.euid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).SetUID.EUID)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "setuid.euser":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFilePath(&(*Event)(ctx.Object).Utimes.File)
+				return (*Event)(ctx.Object).ResolveSetuidEUser(&(*Event)(ctx.Object).SetUID)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "utimes.file.rights":
+	case "setuid.fsuid":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).ResolveRights(&(*Event)(ctx.Object).Utimes.File.FileFields))
+				return int((*Event)(ctx.Object).SetUID.FSUID)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "setuid.fsuser":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).ResolveSetuidFSUser(&(*Event)(ctx.Object).SetUID)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "utimes.file.uid":
+	case "setuid.uid":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Utimes.File.FileFields.UID)
+				return int((*Event)(ctx.Object).SetUID.UID)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
-	case "utimes.file.user":
+	case "setuid.user":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
 
-				return (*Event)(ctx.Object).ResolveFileFieldsUser(&(*Event)(ctx.Object).Utimes.File.FileFields)
+				return (*Event)(ctx.Object).ResolveSetuidUser(&(*Event)(ctx.Object).SetUID)
 			},
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
 
-	case "utimes.retval":
+	case "setxattr.file.change_time":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
 
-				return int((*Event)(ctx.Object).Utimes.SyscallEvent.Retval)
+				return int((*Event)(ctx.Object).SetXAttr.File.FileFields.CTime)
 			},
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
 
+	case "setxattr.file.destination.name":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).ResolveXAttrName(&(*Event)(ctx.Object).SetXAttr)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+
+	case "setxattr.file.destination.namespace":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).ResolveXAttrNamespace(&(*Event)(ctx.Object).SetXAttr)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+
+	case "setxattr.file.filesystem":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).ResolveFileFilesystem(&(*Event)(ctx.Object).SetXAttr.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+
+	case "setxattr.file.gid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).SetXAttr.File.FileFields.GID)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "setxattr.file.group":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).ResolveFileFieldsGroup(&(*Event)(ctx.Object).SetXAttr.File.FileFields)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+
+	case "setxattr.file.in_upper_layer":
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+
+				return (*Event)(ctx.Object).ResolveFileFieldsInUpperLayer(&(*Event)(ctx.Object).SetXAttr.File.FileFields)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+
+	case "setxattr.file.inode":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).SetXAttr.File.FileFields.Inode)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "setxattr.file.mode":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).SetXAttr.File.FileFields.Mode)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "setxattr.file.modification_time":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).SetXAttr.File.FileFields.MTime)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "setxattr.file.mount_id":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).SetXAttr.File.FileFields.MountID)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "setxattr.file.name":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).ResolveFileBasename(&(*Event)(ctx.Object).SetXAttr.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+
+	case "setxattr.file.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).ResolveFilePath(&(*Event)(ctx.Object).SetXAttr.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+
+	case "setxattr.file.rights":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).ResolveRights(&(*Event)(ctx.Object).SetXAttr.File.FileFields))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+
+	case "setxattr.file.uid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).SetXAttr.File.FileFields.UID)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "setxattr.file.user":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).ResolveFileFieldsUser(&(*Event)(ctx.Object).SetXAttr.File.FileFields)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+
+	case "setxattr.retval":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).SetXAttr.SyscallEvent.Retval)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "signal.pid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Signal.PID)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "signal.retval":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Signal.SyscallEvent.Retval)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "signal.target.cap_effective":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Signal.Target.Credentials.CapEffective)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "signal.target.cap_permitted":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Signal.Target.Credentials.CapPermitted)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "signal.target.comm":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).Signal.Target.Comm
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "signal.target.container.id":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).Signal.Target.ContainerID
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "signal.target.cookie":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Signal.Target.Cookie)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "signal.target.created_at":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).ResolveProcessCreatedAt(&(*Event)(ctx.Object).Signal.Target))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+
+	case "signal.target.egid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Signal.Target.Credentials.EGID)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "signal.target.egroup":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).Signal.Target.Credentials.EGroup
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "signal.target.euid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Signal.Target.Credentials.EUID)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "signal.target.euser":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).Signal.Target.Credentials.EUser
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "signal.target.file.change_time":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Signal.Target.FileFields.CTime)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "signal.target.file.filesystem":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).Signal.Target.Filesystem
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "signal.target.file.gid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Signal.Target.FileFields.GID)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "signal.target.file.group":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).ResolveFileFieldsGroup(&(*Event)(ctx.Object).Signal.Target.FileFields)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+
+	case "signal.target.file.in_upper_layer":
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+
+				return (*Event)(ctx.Object).ResolveFileFieldsInUpperLayer(&(*Event)(ctx.Object).Signal.Target.FileFields)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+
+	case "signal.target.file.inode":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Signal.Target.FileFields.Inode)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "signal.target.file.mode":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Signal.Target.FileFields.Mode)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "signal.target.file.modification_time":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Signal.Target.FileFields.MTime)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "signal.target.file.mount_id":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Signal.Target.FileFields.MountID)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "signal.target.file.name":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).Signal.Target.BasenameStr
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "signal.target.file.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).Signal.Target.PathnameStr
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "signal.target.file.rights":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).ResolveRights(&(*Event)(ctx.Object).Signal.Target.FileFields))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+
+	case "signal.target.file.uid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Signal.Target.FileFields.UID)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "signal.target.file.user":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).ResolveFileFieldsUser(&(*Event)(ctx.Object).Signal.Target.FileFields)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+
+	case "signal.target.fsgid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Signal.Target.Credentials.FSGID)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "signal.target.fsgroup":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).Signal.Target.Credentials.FSGroup
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "signal.target.fsuid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Signal.Target.Credentials.FSUID)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "signal.target.fsuser":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).Signal.Target.Credentials.FSUser
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "signal.target.gid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Signal.Target.Credentials.GID)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "signal.target.group":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).Signal.Target.Credentials.Group
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "signal.target.pid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Signal.Target.Pid)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "signal.target.ppid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Signal.Target.PPid)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "signal.target.tid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Signal.Target.Tid)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "signal.target.tty_name":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).Signal.Target.TTYName
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "signal.target.uid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Signal.Target.Credentials.UID)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "signal.target.user":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).Signal.Target.Credentials.User
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "signal.type":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Signal.Type)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "unlink.file.change_time":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Unlink.File.FileFields.CTime)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "unlink.file.filesystem":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).ResolveFileFilesystem(&(*Event)(ctx.Object).Unlink.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+
+	case "unlink.file.gid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Unlink.File.FileFields.GID)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "unlink.file.group":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).ResolveFileFieldsGroup(&(*Event)(ctx.Object).Unlink.File.FileFields)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+
+	case "unlink.file.in_upper_layer":
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+
+				return (*Event)(ctx.Object).ResolveFileFieldsInUpperLayer(&(*Event)(ctx.Object).Unlink.File.FileFields)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+
+	case "unlink.file.inode":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Unlink.File.FileFields.Inode)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "unlink.file.mode":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Unlink.File.FileFields.Mode)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "unlink.file.modification_time":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Unlink.File.FileFields.MTime)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "unlink.file.mount_id":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Unlink.File.FileFields.MountID)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "unlink.file.name":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).ResolveFileBasename(&(*Event)(ctx.Object).Unlink.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+
+	case "unlink.file.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).ResolveFilePath(&(*Event)(ctx.Object).Unlink.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+
+	case "unlink.file.rights":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).ResolveRights(&(*Event)(ctx.Object).Unlink.File.FileFields))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+
+	case "unlink.file.uid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Unlink.File.FileFields.UID)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "unlink.file.user":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).ResolveFileFieldsUser(&(*Event)(ctx.Object).Unlink.File.FileFields)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+
+	case "unlink.retval":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Unlink.SyscallEvent.Retval)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "unload_module.name":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).UnloadModule.Name
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "unload_module.retval":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).UnloadModule.SyscallEvent.Retval)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "utimes.file.change_time":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Utimes.File.FileFields.CTime)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "utimes.file.filesystem":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).ResolveFileFilesystem(&(*Event)(ctx.Object).Utimes.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+
+	case "utimes.file.gid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Utimes.File.FileFields.GID)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "utimes.file.group":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).ResolveFileFieldsGroup(&(*Event)(ctx.Object).Utimes.File.FileFields)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+
+	case "utimes.file.in_upper_layer":
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+
+				return (*Event)(ctx.Object).ResolveFileFieldsInUpperLayer(&(*Event)(ctx.Object).Utimes.File.FileFields)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+
+	case "utimes.file.inode":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Utimes.File.FileFields.Inode)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "utimes.file.mode":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Utimes.File.FileFields.Mode)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "utimes.file.modification_time":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Utimes.File.FileFields.MTime)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "utimes.file.mount_id":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Utimes.File.FileFields.MountID)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "utimes.file.name":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).ResolveFileBasename(&(*Event)(ctx.Object).Utimes.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+
+	case "utimes.file.path":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).ResolveFilePath(&(*Event)(ctx.Object).Utimes.File)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+
+	case "utimes.file.rights":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).ResolveRights(&(*Event)(ctx.Object).Utimes.File.FileFields))
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+
+	case "utimes.file.uid":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Utimes.File.FileFields.UID)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	case "utimes.file.user":
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string {
+
+				return (*Event)(ctx.Object).ResolveFileFieldsUser(&(*Event)(ctx.Object).Utimes.File.FileFields)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+
+	case "utimes.retval":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+
+				return int((*Event)(ctx.Object).Utimes.SyscallEvent.Retval)
+			},
+			Field:  field,
+			Weight: eval.FunctionWeight,
+		}, nil
+
+	}
+
+	return nil, &eval.ErrFieldNotFound{Field: field}
+}
+
+func (e *Event) GetFields() []eval.Field {
+	return []eval.Field{
+
+		"accept.addr.family",
+
+		"accept.addr.ip",
+
+		"accept.addr.port",
+
+		"accept.retval",
+
+		"bind.addr.family",
+
+		"bind.addr.ip",
+
+		"bind.addr.port",
+
+		"bind.retval",
+
+		"bpf.cmd",
+
+		"bpf.map.type",
+
+		"bpf.prog.attach_type",
+
+		"bpf.prog.type",
+
+		"bpf.retval",
+
+		"capset.cap_effective",
+
+		"capset.cap_permitted",
+
+		"chmod.file.change_time",
+
+		"chmod.file.destination.mode",
+
+		"chmod.file.destination.rights",
+
+		"chmod.file.filesystem",
+
+		"chmod.file.gid",
+
+		"chmod.file.group",
+
+		"chmod.file.in_upper_layer",
+
+		"chmod.file.inode",
+
+		"chmod.file.mode",
+
+		"chmod.file.modification_time",
+
+		"chmod.file.mount_id",
+
+		"chmod.file.name",
+
+		"chmod.file.path",
+
+		"chmod.file.rights",
+
+		"chmod.file.uid",
+
+		"chmod.file.user",
+
+		"chmod.retval",
+
+		"chown.file.change_time",
+
+		"chown.file.destination.gid",
+
+		"chown.file.destination.group",
+
+		"chown.file.destination.uid",
+
+		"chown.file.destination.user",
+
+		"chown.file.filesystem",
+
+		"chown.file.gid",
+
+		"chown.file.group",
+
+		"chown.file.in_upper_layer",
+
+		"chown.file.inode",
+
+		"chown.file.mode",
+
+		"chown.file.modification_time",
+
+		"chown.file.mount_id",
+
+		"chown.file.name",
+
+		"chown.file.path",
+
+		"chown.file.rights",
+
+		"chown.file.uid",
+
+		"chown.file.user",
+
+		"chown.retval",
+
+		"connect.addr.family",
+
+		"connect.addr.ip",
+
+		"connect.addr.port",
+
+		"connect.retval",
+
+		"container.id",
+
+		"container.tags",
+
+		"dns.id",
+
+		"dns.question.class",
+
+		"dns.question.count",
+
+		"dns.question.name",
+
+		"dns.question.size",
+
+		"dns.question.type",
+
+		"exec.args",
+
+		"exec.args_flags",
+
+		"exec.args_options",
+
+		"exec.args_truncated",
+
+		"exec.argv",
+
+		"exec.cap_effective",
+
+		"exec.cap_permitted",
+
+		"exec.comm",
+
+		"exec.container.id",
+
+		"exec.cookie",
+
+		"exec.created_at",
+
+		"exec.egid",
+
+		"exec.egroup",
+
+		"exec.envs",
+
+		"exec.envs_truncated",
+
+		"exec.euid",
+
+		"exec.euser",
+
+		"exec.file.change_time",
+
+		"exec.file.filesystem",
+
+		"exec.file.gid",
+
+		"exec.file.group",
+
+		"exec.file.in_upper_layer",
+
+		"exec.file.inode",
+
+		"exec.file.mode",
+
+		"exec.file.modification_time",
+
+		"exec.file.mount_id",
+
+		"exec.file.name",
+
+		"exec.file.path",
+
+		"exec.file.rights",
+
+		"exec.file.uid",
+
+		"exec.file.user",
+
+		"exec.fsgid",
+
+		"exec.fsgroup",
+
+		"exec.fsuid",
+
+		"exec.fsuser",
+
+		"exec.gid",
+
+		"exec.group",
+
+		"exec.pid",
+
+		"exec.ppid",
+
+		"exec.tid",
+
+		"exec.tty_name",
+
+		"exec.uid",
+
+		"exec.user",
+
+		"link.file.change_time",
+
+		"link.file.destination.change_time",
+
+		"link.file.destination.filesystem",
+
+		"link.file.destination.gid",
+
+		"link.file.destination.group",
+
+		"link.file.destination.in_upper_layer",
+
+		"link.file.destination.inode",
+
+		"link.file.destination.mode",
+
+		"link.file.destination.modification_time",
+
+		"link.file.destination.mount_id",
+
+		"link.file.destination.name",
+
+		"link.file.destination.path",
+
+		"link.file.destination.rights",
+
+		"link.file.destination.uid",
+
+		"link.file.destination.user",
+
+		"link.file.filesystem",
+
+		"link.file.gid",
+
+		"link.file.group",
+
+		"link.file.in_upper_layer",
+
+		"link.file.inode",
+
+		"link.file.mode",
+
+		"link.file.modification_time",
+
+		"link.file.mount_id",
+
+		"link.file.name",
+
+		"link.file.path",
+
+		"link.file.rights",
+
+		"link.file.uid",
+
+		"link.file.user",
+
+		"link.retval",
+
+		"load_module.file.change_time",
+
+		"load_module.file.filesystem",
+
+		"load_module.file.gid",
+
+		"load_module.file.group",
+
+		"load_module.file.in_upper_layer",
+
+		"load_module.file.inode",
+
+		"load_module.file.mode",
+
+		"load_module.file.modification_time",
+
+		"load_module.file.mount_id",
+
+		"load_module.file.name",
+
+		"load_module.file.path",
+
+		"load_module.file.rights",
+
+		"load_module.file.uid",
+
+		"load_module.file.user",
+
+		"load_module.loaded_from_memory",
+
+		"load_module.name",
+
+		"load_module.retval",
+
+		"mkdir.file.change_time",
+
+		"mkdir.file.destination.mode",
+
+		"mkdir.file.destination.rights",
+
+		"mkdir.file.filesystem",
+
+		"mkdir.file.gid",
+
+		"mkdir.file.group",
+
+		"mkdir.file.in_upper_layer",
+
+		"mkdir.file.inode",
+
+		"mkdir.file.mode",
+
+		"mkdir.file.modification_time",
+
+		"mkdir.file.mount_id",
+
+		"mkdir.file.name",
+
+		"mkdir.file.path",
+
+		"mkdir.file.rights",
+
+		"mkdir.file.uid",
+
+		"mkdir.file.user",
+
+		"mkdir.retval",
+
+		"mmap.file.change_time",
+
+		"mmap.file.filesystem",
+
+		"mmap.file.gid",
+
+		"mmap.file.group",
+
+		"mmap.file.in_upper_layer",
+
+		"mmap.file.inode",
+
+		"mmap.file.mode",
+
+		"mmap.file.modification_time",
+
+		"mmap.file.mount_id",
+
+		"mmap.file.name",
+
+		"mmap.file.path",
+
+		"mmap.file.rights",
+
+		"mmap.file.uid",
+
+		"mmap.file.user",
+
+		"mmap.flags",
+
+		"mmap.protection",
+
+		"mmap.retval",
+
+		"mprotect.req_protection",
+
+		"mprotect.retval",
+
+		"mprotect.vm_protection",
+
+		"open.file.change_time",
+
+		"open.file.destination.mode",
+
+		"open.file.filesystem",
+
+		"open.file.gid",
+
+		"open.file.group",
+
+		"open.file.in_upper_layer",
+
+		"open.file.inode",
+
+		"open.file.mode",
+
+		"open.file.modification_time",
+
+		"open.file.mount_id",
+
+		"open.file.name",
+
+		"open.file.path",
+
+		"open.file.rights",
+
+		"open.file.uid",
+
+		"open.file.user",
+
+		"open.flags",
+
+		"open.retval",
+
+		"process.ancestors.cap_effective",
+
+		"process.ancestors.cap_permitted",
+
+		"process.ancestors.comm",
+
+		"process.ancestors.container.id",
+
+		"process.ancestors.cookie",
+
+		"process.ancestors.created_at",
+
+		"process.ancestors.egid",
+
+		"process.ancestors.egroup",
+
+		"process.ancestors.euid",
+
+		"process.ancestors.euser",
+
+		"process.ancestors.file.change_time",
+
+		"process.ancestors.file.filesystem",
+
+		"process.ancestors.file.gid",
+
+		"process.ancestors.file.group",
+
+		"process.ancestors.file.in_upper_layer",
+
+		"process.ancestors.file.inode",
+
+		"process.ancestors.file.mode",
+
+		"process.ancestors.file.modification_time",
+
+		"process.ancestors.file.mount_id",
+
+		"process.ancestors.file.name",
+
+		"process.ancestors.file.path",
+
+		"process.ancestors.file.rights",
+
+		"process.ancestors.file.uid",
+
+		"process.ancestors.file.user",
+
+		"process.ancestors.fsgid",
+
+		"process.ancestors.fsgroup",
+
+		"process.ancestors.fsuid",
+
+		"process.ancestors.fsuser",
+
+		"process.ancestors.gid",
+
+		"process.ancestors.group",
+
+		"process.ancestors.pid",
+
+		"process.ancestors.ppid",
+
+		"process.ancestors.tid",
+
+		"process.ancestors.tty_name",
+
+		"process.ancestors.uid",
+
+		"process.ancestors.user",
+
+		"process.cap_effective",
+
+		"process.cap_permitted",
+
+		"process.comm",
+
+		"process.container.id",
+
+		"process.cookie",
+
+		"process.created_at",
+
+		"process.egid",
+
+		"process.egroup",
+
+		"process.euid",
+
+		"process.euser",
+
+		"process.file.change_time",
+
+		"process.file.filesystem",
+
+		"process.file.gid",
+
+		"process.file.group",
+
+		"process.file.in_upper_layer",
+
+		"process.file.inode",
+
+		"process.file.mode",
+
+		"process.file.modification_time",
+
+		"process.file.mount_id",
+
+		"process.file.name",
+
+		"process.file.path",
+
+		"process.file.rights",
+
+		"process.file.uid",
+
+		"process.file.user",
+
+		"process.fsgid",
+
+		"process.fsgroup",
+
+		"process.fsuid",
+
+		"process.fsuser",
+
+		"process.gid",
+
+		"process.group",
+
+		"process.pid",
+
+		"process.ppid",
+
+		"process.tid",
+
+		"process.tty_name",
+
+		"process.uid",
+
+		"process.user",
+
+		"ptrace.request",
+
+		"ptrace.retval",
+
+		"ptrace.tracee.cap_effective",
+
+		"ptrace.tracee.cap_permitted",
+
+		"ptrace.tracee.comm",
+
+		"ptrace.tracee.container.id",
+
+		"ptrace.tracee.cookie",
+
+		"ptrace.tracee.created_at",
+
+		"ptrace.tracee.egid",
+
+		"ptrace.tracee.egroup",
+
+		"ptrace.tracee.euid",
+
+		"ptrace.tracee.euser",
+
+		"ptrace.tracee.file.change_time",
+
+		"ptrace.tracee.file.filesystem",
+
+		"ptrace.tracee.file.gid",
+
+		"ptrace.tracee.file.group",
+
+		"ptrace.tracee.file.in_upper_layer",
+
+		"ptrace.tracee.file.inode",
+
+		"ptrace.tracee.file.mode",
+
+		"ptrace.tracee.file.modification_time",
+
+		"ptrace.tracee.file.mount_id",
+
+		"ptrace.tracee.file.name",
+
+		"ptrace.tracee.file.path",
+
+		"ptrace.tracee.file.rights",
+
+		"ptrace.tracee.file.uid",
+
+		"ptrace.tracee.file.user",
+
+		"ptrace.tracee.fsgid",
+
+		"ptrace.tracee.fsgroup",
+
+		"ptrace.tracee.fsuid",
+
+		"ptrace.tracee.fsuser",
+
+		"ptrace.tracee.gid",
+
+		"ptrace.tracee.group",
+
+		"ptrace.tracee.pid",
+
+		"ptrace.tracee.ppid",
+
+		"ptrace.tracee.tid",
+
+		"ptrace.tracee.tty_name",
+
+		"ptrace.tracee.uid",
+
+		"ptrace.tracee.user",
+
+		"removexattr.file.change_time",
+
+		"removexattr.file.destination.name",
+
+		"removexattr.file.destination.namespace",
+
+		"removexattr.file.filesystem",
+
+		"removexattr.file.gid",
+
+		"removexattr.file.group",
+
+		"removexattr.file.in_upper_layer",
+
+		"removexattr.file.inode",
+
+		"removexattr.file.mode",
+
+		"removexattr.file.modification_time",
+
+		"removexattr.file.mount_id",
+
+		"removexattr.file.name",
+
+		"removexattr.file.path",
+
+		"removexattr.file.rights",
+
+		"removexattr.file.uid",
+
+		"removexattr.file.user",
+
+		"removexattr.retval",
+
+		"rename.file.change_time",
+
+		"rename.file.destination.change_time",
+
+		"rename.file.destination.filesystem",
+
+		"rename.file.destination.gid",
+
+		"rename.file.destination.group",
+
+		"rename.file.destination.in_upper_layer",
+
+		"rename.file.destination.inode",
+
+		"rename.file.destination.mode",
+
+		"rename.file.destination.modification_time",
+
+		"rename.file.destination.mount_id",
+
+		"rename.file.destination.name",
+
+		"rename.file.destination.path",
+
+		"rename.file.destination.rights",
+
+		"rename.file.destination.uid",
+
+		"rename.file.destination.user",
+
+		"rename.file.filesystem",
+
+		"rename.file.gid",
+
+		"rename.file.group",
+
+		"rename.file.in_upper_layer",
+
+		"rename.file.inode",
+
+		"rename.file.mode",
+
+		"rename.file.modification_time",
+
+		"rename.file.mount_id",
+
+		"rename.file.name",
+
+		"rename.file.path",
+
+		"rename.file.rights",
+
+		"rename.file.uid",
+
+		"rename.file.user",
+
+		"rename.retval",
+
+		"rmdir.file.change_time",
+
+		"rmdir.file.filesystem",
+
+		"rmdir.file.gid",
+
+		"rmdir.file.group",
+
+		"rmdir.file.in_upper_layer",
+
+		"rmdir.file.inode",
+
+		"rmdir.file.mode",
+
+		"rmdir.file.modification_time",
+
+		"rmdir.file.mount_id",
+
+		"rmdir.file.name",
+
+		"rmdir.file.path",
+
+		"rmdir.file.rights",
+
+		"rmdir.file.uid",
+
+		"rmdir.file.user",
+
+		"rmdir.retval",
+
+		"selinux.bool.name",
+
+		"selinux.bool.state",
+
+		"selinux.bool_commit.state",
+
+		"selinux.enforce.status",
+
+		"setgid.egid",
+
+		"setgid.egroup",
+
+		"setgid.fsgid",
+
+		"setgid.fsgroup",
+
+		"setgid.gid",
+
+		"setgid.group",
+
+		"setuid.euid",
+
+		"setuid.euser",
+
+		"setuid.fsuid",
+
+		"setuid.fsuser",
+
+		"setuid.uid",
+
+		"setuid.user",
+
+		"setxattr.file.change_time",
+
+		"setxattr.file.destination.name",
+
+		"setxattr.file.destination.namespace",
+
+		"setxattr.file.filesystem",
+
+		"setxattr.file.gid",
+
+		"setxattr.file.group",
+
+		"setxattr.file.in_upper_layer",
+
+		"setxattr.file.inode",
+
+		"setxattr.file.mode",
+
+		"setxattr.file.modification_time",
+
+		"setxattr.file.mount_id",
+
+		"setxattr.file.name",
+
+		"setxattr.file.path",
+
+		"setxattr.file.rights",
+
+		"setxattr.file.uid",
+
+		"setxattr.file.user",
+
+		"setxattr.retval",
+
+		"signal.pid",
+
+		"signal.retval",
+
+		"signal.target.cap_effective",
+
+		"signal.target.cap_permitted",
+
+		"signal.target.comm",
+
+		"signal.target.container.id",
+
+		"signal.target.cookie",
+
+		"signal.target.created_at",
+
+		"signal.target.egid",
+
+		"signal.target.egroup",
+
+		"signal.target.euid",
+
+		"signal.target.euser",
+
+		"signal.target.file.change_time",
+
+		"signal.target.file.filesystem",
+
+		"signal.target.file.gid",
+
+		"signal.target.file.group",
+
+		"signal.target.file.in_upper_layer",
+
+		"signal.target.file.inode",
+
+		"signal.target.file.mode",
+
+		"signal.target.file.modification_time",
+
+		"signal.target.file.mount_id",
+
+		"signal.target.file.name",
+
+		"signal.target.file.path",
+
+		"signal.target.file.rights",
+
+		"signal.target.file.uid",
+
+		"signal.target.file.user",
+
+		"signal.target.fsgid",
+
+		"signal.target.fsgroup",
+
+		"signal.target.fsuid",
+
+		"signal.target.fsuser",
+
+		"signal.target.gid",
+
+		"signal.target.group",
+
+		"signal.target.pid",
+
+		"signal.target.ppid",
+
+		"signal.target.tid",
+
+		"signal.target.tty_name",
+
+		"signal.target.uid",
+
+		"signal.target.user",
+
+		"signal.type",
+
+		"unlink.file.change_time",
+
+		"unlink.file.filesystem",
+
+		"unlink.file.gid",
+
+		"unlink.file.group",
+
+		"unlink.file.in_upper_layer",
+
+		"unlink.file.inode",
+
+		"unlink.file.mode",
+
+		"unlink.file.modification_time",
+
+		"unlink.file.mount_id",
+
+		"unlink.file.name",
+
+		"unlink.file.path",
+
+		"unlink.file.rights",
+
+		"unlink.file.uid",
+
+		"unlink.file.user",
+
+		"unlink.retval",
+
+		"unload_module.name",
+
+		"unload_module.retval",
+
+		"utimes.file.change_time",
+
+		"utimes.file.filesystem",
+
+		"utimes.file.gid",
+
+		"utimes.file.group",
+
+		"utimes.file.in_upper_layer",
+
+		"utimes.file.inode",
+
+		"utimes.file.mode",
+
+		"utimes.file.modification_time",
+
+		"utimes.file.mount_id",
+
+		"utimes.file.name",
+
+		"utimes.file.path",
+
+		"utimes.file.rights",
+
+		"utimes.file.uid",
+
+		"utimes.file.user",
+
+		"utimes.retval",
 	}
+}
+
+func (e *Event) GetFieldValue(field eval.Field) (interface{}, error) {
+	switch field {
+
+	case "accept.addr.family":
+
+		return int(e.Accept.AddrFamily), nil
+
+	case "accept.addr.ip":
+
+		return e.Accept.Addr.IPNet, nil
+
+	case "accept.addr.port":
+
+		return int(e.Accept.Addr.Port), nil
+
+	case "accept.retval":
+
+		return int(e.Accept.SyscallEvent.Retval), nil
+
+	case "bind.addr.family":
+
+		return int(e.Bind.AddrFamily), nil
+
+	case "bind.addr.ip":
+
+		return e.Bind.Addr.IPNet, nil
+
+	case "bind.addr.port":
+
+		return int(e.Bind.Addr.Port), nil
+
+	case "bind.retval":
+
+		return int(e.Bind.SyscallEvent.Retval), nil
+
+	case "bpf.cmd":
+
+		return int(e.BPF.Cmd), nil
+
+	case "bpf.map.type":
+
+		return int(e.BPF.Map.Type), nil
+
+	case "bpf.prog.attach_type":
+
+		return int(e.BPF.Program.AttachType), nil
+
+	case "bpf.prog.type":
+
+		return int(e.BPF.Program.Type), nil
+
+	case "bpf.retval":
+
+		return int(e.BPF.SyscallEvent.Retval), nil
+
+	case "capset.cap_effective":
+
+		return int(e.Capset.CapEffective), nil
+
+	case "capset.cap_permitted":
+
+		return int(e.Capset.CapPermitted), nil
+
+	case "chmod.file.change_time":
+
+		return int(e.Chmod.File.FileFields.CTime), nil
+
+	case "chmod.file.destination.mode":
+
+		return int(e.Chmod.Mode), nil
+
+	case "chmod.file.destination.rights":
+
+		return int(e.Chmod.Mode), nil
+
+	case "chmod.file.filesystem":
+
+		return e.ResolveFileFilesystem(&e.Chmod.File), nil
+
+	case "chmod.file.gid":
+
+		return int(e.Chmod.File.FileFields.GID), nil
+
+	case "chmod.file.group":
+
+		return e.ResolveFileFieldsGroup(&e.Chmod.File.FileFields), nil
+
+	case "chmod.file.in_upper_layer":
+
+		return e.ResolveFileFieldsInUpperLayer(&e.Chmod.File.FileFields), nil
+
+	case "chmod.file.inode":
+
+		return int(e.Chmod.File.FileFields.Inode), nil
+
+	case "chmod.file.mode":
+
+		return int(e.Chmod.File.FileFields.Mode), nil
+
+	case "chmod.file.modification_time":
+
+		return int(e.Chmod.File.FileFields.MTime), nil
+
+	case "chmod.file.mount_id":
+
+		return int(e.Chmod.File.FileFields.MountID), nil
+
+	case "chmod.file.name":
+
+		return e.ResolveFileBasename(&e.Chmod.File), nil
+
+	case "chmod.file.path":
+
+		return e.ResolveFilePath(&e.Chmod.File), nil
+
+	case "chmod.file.rights":
+
+		return int(e.ResolveRights(&e.Chmod.File.FileFields)), nil
+
+	case "chmod.file.uid":
+
+		return int(e.Chmod.File.FileFields.UID), nil
+
+	case "chmod.file.user":
+
+		return e.ResolveFileFieldsUser(&e.Chmod.File.FileFields), nil
+
+	case "chmod.retval":
+
+		return int(e.Chmod.SyscallEvent.Retval), nil
+
+	case "chown.file.change_time":
+
+		return int(e.Chown.File.FileFields.CTime), nil
+
+	case "chown.file.destination.gid":
+
+		return int(e.Chown.GID), nil
+
+	case "chown.file.destination.group":
+
+		return e.ResolveChownGID(&e.Chown), nil
+
+	case "chown.file.destination.uid":
+
+		return int(e.Chown.UID), nil
+
+	case "chown.file.destination.user":
+
+		return e.ResolveChownUID(&e.Chown), nil
+
+	case "chown.file.filesystem":
+
+		return e.ResolveFileFilesystem(&e.Chown.File), nil
+
+	case "chown.file.gid":
+
+		return int(e.Chown.File.FileFields.GID), nil
+
+	case "chown.file.group":
+
+		return e.ResolveFileFieldsGroup(&e.Chown.File.FileFields), nil
+
+	case "chown.file.in_upper_layer":
+
+		return e.ResolveFileFieldsInUpperLayer(&e.Chown.File.FileFields), nil
+
+	case "chown.file.inode":
+
+		return int(e.Chown.File.FileFields.Inode), nil
+
+	case "chown.file.mode":
+
+		return int(e.Chown.File.FileFields.Mode), nil
+
+	case "chown.file.modification_time":
+
+		return int(e.Chown.File.FileFields.MTime), nil
+
+	case "chown.file.mount_id":
+
+		return int(e.Chown.File.FileFields.MountID), nil
+
+	case "chown.file.name":
+
+		return e.ResolveFileBasename(&e.Chown.File), nil
+
+	case "chown.file.path":
+
+		return e.ResolveFilePath(&e.Chown.File), nil
+
+	case "chown.file.rights":
+
+		return int(e.ResolveRights(&e.Chown.File.FileFields)), nil
+
+	case "chown.file.uid":
+
+		return int(e.Chown.File.FileFields.UID), nil
+
+	case "chown.file.user":
+
+		return e.ResolveFileFieldsUser(&e.Chown.File.FileFields), nil
+
+	case "chown.retval":
+
+		return int(e.Chown.SyscallEvent.Retval), nil
+
+	case "connect.addr.family":
+
+		return int(e.Connect.AddrFamily), nil
+
+	case "connect.addr.ip":
+
+		return e.Connect.Addr.IPNet, nil
+
+	case "connect.addr.port":
+
+		return int(e.Connect.Addr.Port), nil
+
+	case "connect.retval":
+
+		return int(e.Connect.SyscallEvent.Retval), nil
+
+	case "container.id":
+
+		return e.ResolveContainerID(&e.ContainerContext), nil
+
+	case "container.tags":
+
+		return e.ResolveContainerTags(&e.ContainerContext), nil
+
+	case "dns.id":
+
+		return int(e.DNS.ID), nil
+
+	case "dns.question.class":
+
+		return int(e.DNS.Class), nil
+
+	case "dns.question.count":
+
+		return int(e.DNS.Count), nil
+
+	case "dns.question.name":
+
+		return e.DNS.Name, nil
+
+	case "dns.question.size":
+
+		return int(e.DNS.Size), nil
+
+	case "dns.question.type":
+
+		return int(e.DNS.Type), nil
+
+	case "exec.args":
+
+		return e.ResolveExecArgs(&e.Exec), nil
+
+	case "exec.args_flags":
+
+		return e.ResolveExecArgsFlags(&e.Exec), nil
+
+	case "exec.args_options":
+
+		return e.ResolveExecArgsOptions(&e.Exec), nil
+
+	case "exec.args_truncated":
+
+		return e.ResolveExecArgsTruncated(&e.Exec), nil
+
+	case "exec.argv":
+
+		return e.ResolveExecArgv(&e.Exec), nil
+
+	case "exec.cap_effective":
+
+		return int(e.Exec.Process.Credentials.CapEffective), nil
+
+	case "exec.cap_permitted":
+
+		return int(e.Exec.Process.Credentials.CapPermitted), nil
+
+	case "exec.comm":
+
+		return e.Exec.Process.Comm, nil
+
+	case "exec.container.id":
+
+		return e.Exec.Process.ContainerID, nil
+
+	case "exec.cookie":
+
+		return int(e.Exec.Process.Cookie), nil
+
+	case "exec.created_at":
+
+		return int(e.ResolveProcessCreatedAt(&e.Exec.Process)), nil
+
+	case "exec.egid":
+
+		return int(e.Exec.Process.Credentials.EGID), nil
+
+	case "exec.egroup":
+
+		return e.Exec.Process.Credentials.EGroup, nil
+
+	case "exec.envs":
+
+		return e.ResolveExecEnvs(&e.Exec), nil
+
+	case "exec.envs_truncated":
+
+		return e.ResolveExecEnvsTruncated(&e.Exec), nil
+
+	case "exec.euid":
+
+		return int(e.Exec.Process.Credentials.EUID), nil
+
+	case "exec.euser":
+
+		return e.Exec.Process.Credentials.EUser, nil
+
+	case "exec.file.change_time":
+
+		return int(e.Exec.Process.FileFields.CTime), nil
+
+	case "exec.file.filesystem":
+
+		return e.Exec.Process.Filesystem, nil
+
+	case "exec.file.gid":
+
+		return int(e.Exec.Process.FileFields.GID), nil
+
+	case "exec.file.group":
+
+		return e.ResolveFileFieldsGroup(&e.Exec.Process.FileFields), nil
+
+	case "exec.file.in_upper_layer":
+
+		return e.ResolveFileFieldsInUpperLayer(&e.Exec.Process.FileFields), nil
+
+	case "exec.file.inode":
+
+		return int(e.Exec.Process.FileFields.Inode), nil
+
+	case "exec.file.mode":
+
+		return int(e.Exec.Process.FileFields.Mode), nil
+
+	case "exec.file.modification_time":
+
+		return int(e.Exec.Process.FileFields.MTime), nil
+
+	case "exec.file.mount_id":
+
+		return int(e.Exec.Process.FileFields.MountID), nil
+
+	case "exec.file.name":
+
+		return e.Exec.Process.BasenameStr, nil
+
+	case "exec.file.path":
+
+		return e.Exec.Process.PathnameStr, nil
+
+	case "exec.file.rights":
+
+		return int(e.ResolveRights(&e.Exec.Process.FileFields)), nil
+
+	case "exec.file.uid":
+
+		return int(e.Exec.Process.FileFields.UID), nil
+
+	case "exec.file.user":
+
+		return e.ResolveFileFieldsUser(&e.Exec.Process.FileFields), nil
+
+	case "exec.fsgid":
+
+		return int(e.Exec.Process.Credentials.FSGID), nil
+
+	case "exec.fsgroup":
+
+		return e.Exec.Process.Credentials.FSGroup, nil
+
+	case "exec.fsuid":
+
+		return int(e.Exec.Process.Credentials.FSUID), nil
+
+	case "exec.fsuser":
+
+		return e.Exec.Process.Credentials.FSUser, nil
+
+	case "exec.gid":
+
+		return int(e.Exec.Process.Credentials.GID), nil
+
+	case "exec.group":
+
+		return e.Exec.Process.Credentials.Group, nil
+
+	case "exec.pid":
+
+		return int(e.Exec.Process.Pid), nil
+
+	case "exec.ppid":
+
+		return int(e.Exec.Process.PPid), nil
+
+	case "exec.tid":
+
+		return int(e.Exec.Process.Tid), nil
+
+	case "exec.tty_name":
+
+		return e.Exec.Process.TTYName, nil
+
+	case "exec.uid":
+
+		return int(e.Exec.Process.Credentials.UID), nil
+
+	case "exec.user":
+
+		return e.Exec.Process.Credentials.User, nil
+
+	case "link.file.change_time":
+
+		return int(e.Link.Source.FileFields.CTime), nil
+
+	case "link.file.destination.change_time":
+
+		return int(e.Link.Target.FileFields.CTime), nil
+
+	case "link.file.destination.filesystem":
+
+		return e.ResolveFileFilesystem(&e.Link.Target), nil
+
+	case "link.file.destination.gid":
+
+		return int(e.Link.Target.FileFields.GID), nil
+
+	case "link.file.destination.group":
+
+		return e.ResolveFileFieldsGroup(&e.Link.Target.FileFields), nil
+
+	case "link.file.destination.in_upper_layer":
+
+		return e.ResolveFileFieldsInUpperLayer(&e.Link.Target.FileFields), nil
+
+	case "link.file.destination.inode":
+
+		return int(e.Link.Target.FileFields.Inode), nil
+
+	case "link.file.destination.mode":
+
+		return int(e.Link.Target.FileFields.Mode), nil
+
+	case "link.file.destination.modification_time":
+
+		return int(e.Link.Target.FileFields.MTime), nil
+
+	case "link.file.destination.mount_id":
+
+		return int(e.Link.Target.FileFields.MountID), nil
+
+	case "link.file.destination.name":
+
+		return e.ResolveFileBasename(&e.Link.Target), nil
+
+	case "link.file.destination.path":
+
+		return e.ResolveFilePath(&e.Link.Target), nil
+
+	case "link.file.destination.rights":
+
+		return int(e.ResolveRights(&e.Link.Target.FileFields)), nil
+
+	case "link.file.destination.uid":
+
+		return int(e.Link.Target.FileFields.UID), nil
+
+	case "link.file.destination.user":
+
+		return e.ResolveFileFieldsUser(&e.Link.Target.FileFields), nil
+
+	case "link.file.filesystem":
+
+		return e.ResolveFileFilesystem(&e.Link.Source), nil
+
+	case "link.file.gid":
+
+		return int(e.Link.Source.FileFields.GID), nil
+
+	case "link.file.group":
+
+		return e.ResolveFileFieldsGroup(&e.Link.Source.FileFields), nil
+
+	case "link.file.in_upper_layer":
+
+		return e.ResolveFileFieldsInUpperLayer(&e.Link.Source.FileFields), nil
+
+	case "link.file.inode":
+
+		return int(e.Link.Source.FileFields.Inode), nil
+
+	case "link.file.mode":
+
+		return int(e.Link.Source.FileFields.Mode), nil
+
+	case "link.file.modification_time":
+
+		return int(e.Link.Source.FileFields.MTime), nil
+
+	case "link.file.mount_id":
+
+		return int(e.Link.Source.FileFields.MountID), nil
+
+	case "link.file.name":
+
+		return e.ResolveFileBasename(&e.Link.Source), nil
+
+	case "link.file.path":
+
+		return e.ResolveFilePath(&e.Link.Source), nil
+
+	case "link.file.rights":
+
+		return int(e.ResolveRights(&e.Link.Source.FileFields)), nil
+
+	case "link.file.uid":
+
+		return int(e.Link.Source.FileFields.UID), nil
+
+	case "link.file.user":
+
+		return e.ResolveFileFieldsUser(&e.Link.Source.FileFields), nil
+
+	case "link.retval":
+
+		return int(e.Link.SyscallEvent.Retval), nil
+
+	case "load_module.file.change_time":
+
+		return int(e.LoadModule.File.FileFields.CTime), nil
+
+	case "load_module.file.filesystem":
+
+		return e.ResolveFileFilesystem(&e.LoadModule.File), nil
+
+	case "load_module.file.gid":
+
+		return int(e.LoadModule.File.FileFields.GID), nil
+
+	case "load_module.file.group":
+
+		return e.ResolveFileFieldsGroup(&e.LoadModule.File.FileFields), nil
+
+	case "load_module.file.in_upper_layer":
+
+		return e.ResolveFileFieldsInUpperLayer(&e.LoadModule.File.FileFields), nil
+
+	case "load_module.file.inode":
+
+		return int(e.LoadModule.File.FileFields.Inode), nil
+
+	case "load_module.file.mode":
+
+		return int(e.LoadModule.File.FileFields.Mode), nil
+
+	case "load_module.file.modification_time":
+
+		return int(e.LoadModule.File.FileFields.MTime), nil
+
+	case "load_module.file.mount_id":
+
+		return int(e.LoadModule.File.FileFields.MountID), nil
+
+	case "load_module.file.name":
+
+		return e.ResolveFileBasename(&e.LoadModule.File), nil
+
+	case "load_module.file.path":
+
+		return e.ResolveFilePath(&e.LoadModule.File), nil
+
+	case "load_module.file.rights":
+
+		return int(e.ResolveRights(&e.LoadModule.File.FileFields)), nil
+
+	case "load_module.file.uid":
+
+		return int(e.LoadModule.File.FileFields.UID), nil
+
+	case "load_module.file.user":
+
+		return e.ResolveFileFieldsUser(&e.LoadModule.File.FileFields), nil
+
+	case "load_module.loaded_from_memory":
+
+		return e.LoadModule.LoadedFromMemory, nil
+
+	case "load_module.name":
+
+		return e.LoadModule.Name, nil
+
+	case "load_module.retval":
+
+		return int(e.LoadModule.SyscallEvent.Retval), nil
+
+	case "mkdir.file.change_time":
+
+		return int(e.Mkdir.File.FileFields.CTime), nil
+
+	case "mkdir.file.destination.mode":
+
+		return int(e.Mkdir.Mode), nil
+
+	case "mkdir.file.destination.rights":
+
+		return int(e.Mkdir.Mode), nil
+
+	case "mkdir.file.filesystem":
+
+		return e.ResolveFileFilesystem(&e.Mkdir.File), nil
+
+	case "mkdir.file.gid":
+
+		return int(e.Mkdir.File.FileFields.GID), nil
+
+	case "mkdir.file.group":
+
+		return e.ResolveFileFieldsGroup(&e.Mkdir.File.FileFields), nil
+
+	case "mkdir.file.in_upper_layer":
+
+		return e.ResolveFileFieldsInUpperLayer(&e.Mkdir.File.FileFields), nil
+
+	case "mkdir.file.inode":
+
+		return int(e.Mkdir.File.FileFields.Inode), nil
+
+	case "mkdir.file.mode":
+
+		return int(e.Mkdir.File.FileFields.Mode), nil
+
+	case "mkdir.file.modification_time":
+
+		return int(e.Mkdir.File.FileFields.MTime), nil
+
+	case "mkdir.file.mount_id":
+
+		return int(e.Mkdir.File.FileFields.MountID), nil
+
+	case "mkdir.file.name":
+
+		return e.ResolveFileBasename(&e.Mkdir.File), nil
+
+	case "mkdir.file.path":
+
+		return e.ResolveFilePath(&e.Mkdir.File), nil
+
+	case "mkdir.file.rights":
+
+		return int(e.ResolveRights(&e.Mkdir.File.FileFields)), nil
+
+	case "mkdir.file.uid":
+
+		return int(e.Mkdir.File.FileFields.UID), nil
+
+	case "mkdir.file.user":
+
+		return e.ResolveFileFieldsUser(&e.Mkdir.File.FileFields), nil
+
+	case "mkdir.retval":
+
+		return int(e.Mkdir.SyscallEvent.Retval), nil
+
+	case "mmap.file.change_time":
+
+		return int(e.MMap.File.FileFields.CTime), nil
+
+	case "mmap.file.filesystem":
+
+		return e.ResolveFileFilesystem(&e.MMap.File), nil
+
+	case "mmap.file.gid":
+
+		return int(e.MMap.File.FileFields.GID), nil
+
+	case "mmap.file.group":
+
+		return e.ResolveFileFieldsGroup(&e.MMap.File.FileFields), nil
+
+	case "mmap.file.in_upper_layer":
+
+		return e.ResolveFileFieldsInUpperLayer(&e.MMap.File.FileFields), nil
+
+	case "mmap.file.inode":
+
+		return int(e.MMap.File.FileFields.Inode), nil
+
+	case "mmap.file.mode":
+
+		return int(e.MMap.File.FileFields.Mode), nil
+
+	case "mmap.file.modification_time":
+
+		return int(e.MMap.File.FileFields.MTime), nil
+
+	case "mmap.file.mount_id":
+
+		return int(e.MMap.File.FileFields.MountID), nil
+
+	case "mmap.file.name":
+
+		return e.ResolveFileBasename(&e.MMap.File), nil
+
+	case "mmap.file.path":
+
+		return e.ResolveFilePath(&e.MMap.File), nil
+
+	case "mmap.file.rights":
+
+		return int(e.ResolveRights(&e.MMap.File.FileFields)), nil
+
+	case "mmap.file.uid":
+
+		return int(e.MMap.File.FileFields.UID), nil
+
+	case "mmap.file.user":
+
+		return e.ResolveFileFieldsUser(&e.MMap.File.FileFields), nil
+
+	case "mmap.flags":
+
+		return e.MMap.Flags, nil
+
+	case "mmap.protection":
+
+		return e.MMap.Protection, nil
+
+	case "mmap.retval":
+
+		return int(e.MMap.SyscallEvent.Retval), nil
+
+	case "mprotect.req_protection":
+
+		return e.MProtect.ReqProtection, nil
+
+	case "mprotect.retval":
+
+		return int(e.MProtect.SyscallEvent.Retval), nil
+
+	case "mprotect.vm_protection":
+
+		return e.MProtect.VMProtection, nil
+
+	case "open.file.change_time":
+
+		return int(e.Open.File.FileFields.CTime), nil
+
+	case "open.file.destination.mode":
+
+		return int(e.Open.Mode), nil
+
+	case "open.file.filesystem":
+
+		return e.ResolveFileFilesystem(&e.Open.File), nil
+
+	case "open.file.gid":
+
+		return int(e.Open.File.FileFields.GID), nil
+
+	case "open.file.group":
+
+		return e.ResolveFileFieldsGroup(&e.Open.File.FileFields), nil
+
+	case "open.file.in_upper_layer":
+
+		return e.ResolveFileFieldsInUpperLayer(&e.Open.File.FileFields), nil
+
+	case "open.file.inode":
+
+		return int(e.Open.File.FileFields.Inode), nil
+
+	case "open.file.mode":
+
+		return int(e.Open.File.FileFields.Mode), nil
+
+	case "open.file.modification_time":
+
+		return int(e.Open.File.FileFields.MTime), nil
+
+	case "open.file.mount_id":
+
+		return int(e.Open.File.FileFields.MountID), nil
+
+	case "open.file.name":
+
+		return e.ResolveFileBasename(&e.Open.File), nil
+
+	case "open.file.path":
+
+		return e.ResolveFilePath(&e.Open.File), nil
+
+	case "open.file.rights":
+
+		return int(e.ResolveRights(&e.Open.File.FileFields)), nil
+
+	case "open.file.uid":
+
+		return int(e.Open.File.FileFields.UID), nil
+
+	case "open.file.user":
+
+		return e.ResolveFileFieldsUser(&e.Open.File.FileFields), nil
+
+	case "open.flags":
+
+		return int(e.Open.Flags), nil
+
+	case "open.retval":
+
+		return int(e.Open.SyscallEvent.Retval), nil
+
+	case "process.ancestors.cap_effective":
+
+		var values []int
+
+		ctx := eval.NewContext(unsafe.Pointer(e))
+
+		iterator := &model.ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+
+		for ptr != nil {
+
+			element := (*model.ProcessCacheEntry)(ptr)
+
+			result := int(element.ProcessContext.Process.Credentials.CapEffective)
+
+			values = append(values, result)
+
+			ptr = iterator.Next()
+		}
+
+		return values, nil
+
+	case "process.ancestors.cap_permitted":
+
+		var values []int
+
+		ctx := eval.NewContext(unsafe.Pointer(e))
+
+		iterator := &model.ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+
+		for ptr != nil {
+
+			element := (*model.ProcessCacheEntry)(ptr)
+
+			result := int(element.ProcessContext.Process.Credentials.CapPermitted)
+
+			values = append(values, result)
+
+			ptr = iterator.Next()
+		}
+
+		return values, nil
+
+	case "process.ancestors.comm":
+
+		var values []string
+
+		ctx := eval.NewContext(unsafe.Pointer(e))
+
+		iterator := &model.ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+
+		for ptr != nil {
+
+			element := (*model.ProcessCacheEntry)(ptr)
+
+			result := element.ProcessContext.Process.Comm
+
+			values = append(values, result)
+
+			ptr = iterator.Next()
+		}
+
+		return values, nil
+
+	case "process.ancestors.container.id":
+
+		var values []string
+
+		ctx := eval.NewContext(unsafe.Pointer(e))
+
+		iterator := &model.ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+
+		for ptr != nil {
+
+			element := (*model.ProcessCacheEntry)(ptr)
+
+			result := element.ProcessContext.Process.ContainerID
+
+			values = append(values, result)
+
+			ptr = iterator.Next()
+		}
+
+		return values, nil
+
+	case "process.ancestors.cookie":
+
+		var values []int
+
+		ctx := eval.NewContext(unsafe.Pointer(e))
+
+		iterator := &model.ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+
+		for ptr != nil {
+
+			element := (*model.ProcessCacheEntry)(ptr)
+
+			result := int(element.ProcessContext.Process.Cookie)
+
+			values = append(values, result)
+
+			ptr = iterator.Next()
+		}
+
+		return values, nil
+
+	case "process.ancestors.created_at":
+
+		var values []int
+
+		ctx := eval.NewContext(unsafe.Pointer(e))
+
+		iterator := &model.ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+
+		for ptr != nil {
+
+			element := (*model.ProcessCacheEntry)(ptr)
+
+			result := int((*Event)(ctx.Object).ResolveProcessCreatedAt(&element.Process))
+
+			values = append(values, result)
+
+			ptr = iterator.Next()
+		}
+
+		return values, nil
+
+	case "process.ancestors.egid":
+
+		var values []int
+
+		ctx := eval.NewContext(unsafe.Pointer(e))
+
+		iterator := &model.ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+
+		for ptr != nil {
+
+			element := (*model.ProcessCacheEntry)(ptr)
+
+			result := int(element.ProcessContext.Process.Credentials.EGID)
+
+			values = append(values, result)
+
+			ptr = iterator.Next()
+		}
+
+		return values, nil
+
+	case "process.ancestors.egroup":
+
+		var values []string
+
+		ctx := eval.NewContext(unsafe.Pointer(e))
+
+		iterator := &model.ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+
+		for ptr != nil {
+
+			element := (*model.ProcessCacheEntry)(ptr)
+
+			result := element.ProcessContext.Process.Credentials.EGroup
+
+			values = append(values, result)
+
+			ptr = iterator.Next()
+		}
+
+		return values, nil
+
+	case "process.ancestors.euid":
+
+		var values []int
+
+		ctx := eval.NewContext(unsafe.Pointer(e))
+
+		iterator := &model.ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+
+		for ptr != nil {
+
+			element := (*model.ProcessCacheEntry)(ptr)
+
+			result := int(element.ProcessContext.Process.Credentials.EUID)
+
+			values = append(values, result)
+
+			ptr = iterator.Next()
+		}
+
+		return values, nil
+
+	case "process.ancestors.euser":
+
+		var values []string
+
+		ctx := eval.NewContext(unsafe.Pointer(e))
+
+		iterator := &model.ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+
+		for ptr != nil {
+
+			element := (*model.ProcessCacheEntry)(ptr)
+
+			result := element.ProcessContext.Process.Credentials.EUser
+
+			values = append(values, result)
+
+			ptr = iterator.Next()
+		}
+
+		return values, nil
+
+	case "process.ancestors.file.change_time":
+
+		var values []int
+
+		ctx := eval.NewContext(unsafe.Pointer(e))
+
+		iterator := &model.ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+
+		for ptr != nil {
+
+			element := (*model.ProcessCacheEntry)(ptr)
+
+			result := int(element.ProcessContext.Process.FileFields.CTime)
+
+			values = append(values, result)
+
+			ptr = iterator.Next()
+		}
+
+		return values, nil
+
+	case "process.ancestors.file.filesystem":
+
+		var values []string
+
+		ctx := eval.NewContext(unsafe.Pointer(e))
+
+		iterator := &model.ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+
+		for ptr != nil {
+
+			element := (*model.ProcessCacheEntry)(ptr)
+
+			result := element.ProcessContext.Process.Filesystem
+
+			values = append(values, result)
+
+			ptr = iterator.Next()
+		}
+
+		return values, nil
+
+	case "process.ancestors.file.gid":
+
+		var values []int
+
+		ctx := eval.NewContext(unsafe.Pointer(e))
+
+		iterator := &model.ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+
+		for ptr != nil {
+
+			element := (*model.ProcessCacheEntry)(ptr)
+
+			result := int(element.ProcessContext.Process.FileFields.GID)
+
+			values = append(values, result)
+
+			ptr = iterator.Next()
+		}
+
+		return values, nil
+
+	case "process.ancestors.file.group":
+
+		var values []string
+
+		ctx := eval.NewContext(unsafe.Pointer(e))
+
+		iterator := &model.ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+
+		for ptr != nil {
+
+			element := (*model.ProcessCacheEntry)(ptr)
+
+			result := (*Event)(ctx.Object).ResolveFileFieldsGroup(&element.FileFields)
+
+			values = append(values, result)
+
+			ptr = iterator.Next()
+		}
+
+		return values, nil
+
+	case "process.ancestors.file.in_upper_layer":
+
+		var values []bool
+
+		ctx := eval.NewContext(unsafe.Pointer(e))
+
+		iterator := &model.ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+
+		for ptr != nil {
+
+			element := (*model.ProcessCacheEntry)(ptr)
+
+			result := (*Event)(ctx.Object).ResolveFileFieldsInUpperLayer(&element.FileFields)
+
+			values = append(values, result)
+
+			ptr = iterator.Next()
+		}
+
+		return values, nil
+
+	case "process.ancestors.file.inode":
+
+		var values []int
+
+		ctx := eval.NewContext(unsafe.Pointer(e))
+
+		iterator := &model.ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+
+		for ptr != nil {
+
+			element := (*model.ProcessCacheEntry)(ptr)
+
+			result := int(element.ProcessContext.Process.FileFields.Inode)
+
+			values = append(values, result)
+
+			ptr = iterator.Next()
+		}
+
+		return values, nil
+
+	case "process.ancestors.file.mode":
+
+		var values []int
+
+		ctx := eval.NewContext(unsafe.Pointer(e))
+
+		iterator := &model.ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+
+		for ptr != nil {
+
+			element := (*model.ProcessCacheEntry)(ptr)
+
+			result := int(element.ProcessContext.Process.FileFields.Mode)
+
+			values = append(values, result)
+
+			ptr = iterator.Next()
+		}
+
+		return values, nil
+
+	case "process.ancestors.file.modification_time":
+
+		var values []int
+
+		ctx := eval.NewContext(unsafe.Pointer(e))
+
+		iterator := &model.ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+
+		for ptr != nil {
+
+			element := (*model.ProcessCacheEntry)(ptr)
+
+			result := int(element.ProcessContext.Process.FileFields.MTime)
+
+			values = append(values, result)
+
+			ptr = iterator.Next()
+		}
+
+		return values, nil
+
+	case "process.ancestors.file.mount_id":
+
+		var values []int
+
+		ctx := eval.NewContext(unsafe.Pointer(e))
+
+		iterator := &model.ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+
+		for ptr != nil {
+
+			element := (*model.ProcessCacheEntry)(ptr)
+
+			result := int(element.ProcessContext.Process.FileFields.MountID)
+
+			values = append(values, result)
+
+			ptr = iterator.Next()
+		}
+
+		return values, nil
+
+	case "process.ancestors.file.name":
+
+		var values []string
+
+		ctx := eval.NewContext(unsafe.Pointer(e))
+
+		iterator := &model.ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+
+		for ptr != nil {
+
+			element := (*model.ProcessCacheEntry)(ptr)
+
+			result := element.ProcessContext.Process.BasenameStr
+
+			values = append(values, result)
+
+			ptr = iterator.Next()
+		}
+
+		return values, nil
+
+	case "process.ancestors.file.path":
+
+		var values []string
+
+		ctx := eval.NewContext(unsafe.Pointer(e))
+
+		iterator := &model.ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+
+		for ptr != nil {
+
+			element := (*model.ProcessCacheEntry)(ptr)
+
+			result := element.ProcessContext.Process.PathnameStr
+
+			values = append(values, result)
+
+			ptr = iterator.Next()
+		}
+
+		return values, nil
+
+	case "process.ancestors.file.rights":
+
+		var values []int
+
+		ctx := eval.NewContext(unsafe.Pointer(e))
+
+		iterator := &model.ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+
+		for ptr != nil {
+
+			element := (*model.ProcessCacheEntry)(ptr)
+
+			result := int((*Event)(ctx.Object).ResolveRights(&element.FileFields))
+
+			values = append(values, result)
+
+			ptr = iterator.Next()
+		}
+
+		return values, nil
+
+	case "process.ancestors.file.uid":
+
+		var values []int
+
+		ctx := eval.NewContext(unsafe.Pointer(e))
+
+		iterator := &model.ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+
+		for ptr != nil {
 
-	return nil, &eval.ErrFieldNotFound{Field: field}
-}
+			element := (*model.ProcessCacheEntry)(ptr)
 
-func (e *Event) GetFields() []eval.Field {
-	return []eval.Field{
+			result := int(element.ProcessContext.Process.FileFields.UID)
 
-		"bpf.cmd",
+			values = append(values, result)
 
-		"bpf.map.type",
+			ptr = iterator.Next()
+		}
 
-		"bpf.prog.attach_type",
+		return values, nil
 
-		"bpf.prog.type",
+	case "process.ancestors.file.user":
 
-		"bpf.retval",
+		var values []string
 
-		"capset.cap_effective",
+		ctx := eval.NewContext(unsafe.Pointer(e))
 
-		"capset.cap_permitted",
+		iterator := &model.ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
 
-		"chmod.file.change_time",
+		for ptr != nil {
 
-		"chmod.file.destination.mode",
+			element := (*model.ProcessCacheEntry)(ptr)
 
-		"chmod.file.destination.rights",
+			result := (*Event)(ctx.Object).ResolveFileFieldsUser(&element.FileFields)
 
-		"chmod.file.filesystem",
+			values = append(values, result)
 
-		"chmod.file.gid",
+			ptr = iterator.Next()
+		}
 
-		"chmod.file.group",
+		return values, nil
 
-		"chmod.file.in_upper_layer",
+	case "process.ancestors.fsgid":
 
-		"chmod.file.inode",
+		var values []int
 
-		"chmod.file.mode",
+		ctx := eval.NewContext(unsafe.Pointer(e))
 
-		"chmod.file.modification_time",
+		iterator := &model.ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
 
-		"chmod.file.mount_id",
+		for ptr != nil {
 
-		"chmod.file.name",
+			element := (*model.ProcessCacheEntry)(ptr)
 
-		"chmod.file.path",
+			result := int(element.ProcessContext.Process.Credentials.FSGID)
 
-		"chmod.file.rights",
+			values = append(values, result)
 
-		"chmod.file.uid",
+			ptr = iterator.Next()
+		}
 
-		"chmod.file.user",
+		return values, nil
 
-		"chmod.retval",
+	case "process.ancestors.fsgroup":
 
-		"chown.file.change_time",
+		var values []string
 
-		"chown.file.destination.gid",
+		ctx := eval.NewContext(unsafe.Pointer(e))
 
-		"chown.file.destination.group",
+		iterator := &model.ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
 
-		"chown.file.destination.uid",
+		for ptr != nil {
 
-		"chown.file.destination.user",
+			element := (*model.ProcessCacheEntry)(ptr)
 
-		"chown.file.filesystem",
+			result := element.ProcessContext.Process.Credentials.FSGroup
 
-		"chown.file.gid",
+			values = append(values, result)
 
-		"chown.file.group",
+			ptr = iterator.Next()
+		}
 
-		"chown.file.in_upper_layer",
+		return values, nil
 
-		"chown.file.inode",
+	case "process.ancestors.fsuid":
 
-		"chown.file.mode",
+		var values []int
 
-		"chown.file.modification_time",
+		ctx := eval.NewContext(unsafe.Pointer(e))
 
-		"chown.file.mount_id",
+		iterator := &model.ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
 
-		"chown.file.name",
+		for ptr != nil {
 
-		"chown.file.path",
+			element := (*model.ProcessCacheEntry)(ptr)
 
-		"chown.file.rights",
+			result := int(element.ProcessContext.Process.Credentials.FSUID)
 
-		"chown.file.uid",
+			values = append(values, result)
 
-		"chown.file.user",
+			ptr = iterator.Next()
+		}
 
-		"chown.retval",
+		return values, nil
 
-		"container.id",
+	case "process.ancestors.fsuser":
 
-		"container.tags",
+		var values []string
 
-		"exec.args",
+		ctx := eval.NewContext(unsafe.Pointer(e))
 
-		"exec.args_flags",
+		iterator := &model.ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
 
-		"exec.args_options",
+		for ptr != nil {
 
-		"exec.args_truncated",
+			element := (*model.ProcessCacheEntry)(ptr)
 
-		"exec.argv",
+			result := element.ProcessContext.Process.Credentials.FSUser
 
-		"exec.cap_effective",
+			values = append(values, result)
 
-		"exec.cap_permitted",
+			ptr = iterator.Next()
+		}
 
-		"exec.comm",
+		return values, nil
 
-		"exec.container.id",
+	case "process.ancestors.gid":
 
-		"exec.cookie",
+		var values []int
 
-		"exec.created_at",
+		ctx := eval.NewContext(unsafe.Pointer(e))
 
-		"exec.egid",
+		iterator := &model.ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
 
-		"exec.egroup",
+		for ptr != nil {
 
-		"exec.envs",
+			element := (*model.ProcessCacheEntry)(ptr)
 
-		"exec.envs_truncated",
+			result := int(element.ProcessContext.Process.Credentials.GID)
 
-		"exec.euid",
+			values = append(values, result)
 
-		"exec.euser",
+			ptr = iterator.Next()
+		}
 
-		"exec.file.change_time",
+		return values, nil
 
-		"exec.file.filesystem",
+	case "process.ancestors.group":
 
-		"exec.file.gid",
+		var values []string
 
-		"exec.file.group",
+		ctx := eval.NewContext(unsafe.Pointer(e))
 
-		"exec.file.in_upper_layer",
+		iterator := &model.ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
 
-		"exec.file.inode",
+		for ptr != nil {
 
-		"exec.file.mode",
+			element := (*model.ProcessCacheEntry)(ptr)
 
-		"exec.file.modification_time",
+			result := element.ProcessContext.Process.Credentials.Group
 
-		"exec.file.mount_id",
+			values = append(values, result)
 
-		"exec.file.name",
+			ptr = iterator.Next()
+		}
 
-		"exec.file.path",
+		return values, nil
 
-		"exec.file.rights",
+	case "process.ancestors.pid":
 
-		"exec.file.uid",
+		var values []int
 
-		"exec.file.user",
+		ctx := eval.NewContext(unsafe.Pointer(e))
 
-		"exec.fsgid",
+		iterator := &model.ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
 
-		"exec.fsgroup",
+		for ptr != nil {
 
-		"exec.fsuid",
+			element := (*model.ProcessCacheEntry)(ptr)
 
-		"exec.fsuser",
+			result := int(element.ProcessContext.Process.Pid)
 
-		"exec.gid",
+			values = append(values, result)
 
-		"exec.group",
+			ptr = iterator.Next()
+		}
 
-		"exec.pid",
+		return values, nil
 
-		"exec.ppid",
+	case "process.ancestors.ppid":
 
-		"exec.tid",
+		var values []int
 
-		"exec.tty_name",
+		ctx := eval.NewContext(unsafe.Pointer(e))
 
-		"exec.uid",
+		iterator := &model.ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
 
-		"exec.user",
+		for ptr != nil {
 
-		"link.file.change_time",
+			element := (*model.ProcessCacheEntry)(ptr)
 
-		"link.file.destination.change_time",
+			result := int(element.ProcessContext.Process.PPid)
 
-		"link.file.destination.filesystem",
+			values = append(values, result)
 
-		"link.file.destination.gid",
+			ptr = iterator.Next()
+		}
 
-		"link.file.destination.group",
+		return values, nil
 
-		"link.file.destination.in_upper_layer",
+	case "process.ancestors.tid":
 
-		"link.file.destination.inode",
+		var values []int
 
-		"link.file.destination.mode",
+		ctx := eval.NewContext(unsafe.Pointer(e))
 
-		"link.file.destination.modification_time",
+		iterator := &model.ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
 
-		"link.file.destination.mount_id",
+		for ptr != nil {
 
-		"link.file.destination.name",
+			element := (*model.ProcessCacheEntry)(ptr)
 
-		"link.file.destination.path",
+			result := int(element.ProcessContext.Process.Tid)
 
-		"link.file.destination.rights",
+			values = append(values, result)
 
-		"link.file.destination.uid",
+			ptr = iterator.Next()
+		}
 
-		"link.file.destination.user",
+		return values, nil
 
-		"link.file.filesystem",
+	case "process.ancestors.tty_name":
 
-		"link.file.gid",
+		var values []string
+
+		ctx := eval.NewContext(unsafe.Pointer(e))
+
+		iterator := &model.ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+
+		for ptr != nil {
+
+			element := (*model.ProcessCacheEntry)(ptr)
+
+			result := element.ProcessContext.Process.TTYName
+
+			values = append(values, result)
+
+			ptr = iterator.Next()
+		}
+
+		return values, nil
 
-		"link.file.group",
+	case "process.ancestors.uid":
 
-		"link.file.in_upper_layer",
+		var values []int
 
-		"link.file.inode",
+		ctx := eval.NewContext(unsafe.Pointer(e))
 
-		"link.file.mode",
+		iterator := &model.ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
 
-		"link.file.modification_time",
+		for ptr != nil {
 
-		"link.file.mount_id",
+			element := (*model.ProcessCacheEntry)(ptr)
 
-		"link.file.name",
+			result := int(element.ProcessContext.Process.Credentials.UID)
 
-		"link.file.path",
+			values = append(values, result)
 
-		"link.file.rights",
+			ptr = iterator.Next()
+		}
 
-		"link.file.uid",
+		return values, nil
 
-		"link.file.user",
+	case "process.ancestors.user":
 
-		"link.retval",
+		var values []string
 
-		"mkdir.file.change_time",
+		ctx := eval.NewContext(unsafe.Pointer(e))
 
-		"mkdir.file.destination.mode",
+		iterator := &model.ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
 
-		"mkdir.file.destination.rights",
+		for ptr != nil {
 
-		"mkdir.file.filesystem",
+			element := (*model.ProcessCacheEntry)(ptr)
 
-		"mkdir.file.gid",
+			result := element.ProcessContext.Process.Credentials.User
 
-		"mkdir.file.group",
+			values = append(values, result)
 
-		"mkdir.file.in_upper_layer",
+			ptr = iterator.Next()
+		}
 
-		"mkdir.file.inode",
+		return values, nil
 
-		"mkdir.file.mode",
+	case "process.cap_effective":
 
-		"mkdir.file.modification_time",
+		return int(e.ProcessContext.Process.Credentials.CapEffective), nil
 
-		"mkdir.file.mount_id",
+	case "process.cap_permitted":
 
-		"mkdir.file.name",
+		return int(e.ProcessContext.Process.Credentials.CapPermitted), nil
 
-		"mkdir.file.path",
+	case "process.comm":
 
-		"mkdir.file.rights",
+		return e.ProcessContext.Process.Comm, nil
 
-		"mkdir.file.uid",
+	case "process.container.id":
 
-		"mkdir.file.user",
+		return e.ProcessContext.Process.ContainerID, nil
 
-		"mkdir.retval",
+	case "process.cookie":
 
-		"open.file.change_time",
+		return int(e.ProcessContext.Process.Cookie), nil
 
-		"open.file.destination.mode",
+	case "process.created_at":
 
-		"open.file.filesystem",
+		return int(e.ResolveProcessCreatedAt(&e.ProcessContext.Process)), nil
 
-		"open.file.gid",
+	case "process.egid":
 
-		"open.file.group",
+		return int(e.ProcessContext.Process.Credentials.EGID), nil
 
-		"open.file.in_upper_layer",
+	case "process.egroup":
 
-		"open.file.inode",
+		return e.ProcessContext.Process.Credentials.EGroup, nil
 
-		"open.file.mode",
+	case "process.euid":
 
-		"open.file.modification_time",
+		return int(e.ProcessContext.Process.Credentials.EUID), nil
 
-		"open.file.mount_id",
+	case "process.euser":
 
-		"open.file.name",
+		return e.ProcessContext.Process.Credentials.EUser, nil
 
-		"open.file.path",
+	case "process.file.change_time":
 
-		"open.file.rights",
+		return int(e.ProcessContext.Process.FileFields.CTime), nil
 
-		"open.file.uid",
+	case "process.file.filesystem":
 
-		"open.file.user",
+		return e.ProcessContext.Process.Filesystem, nil
 
-		"open.flags",
+	case "process.file.gid":
 
-		"open.retval",
+		return int(e.ProcessContext.Process.FileFields.GID), nil
 
-		"process.ancestors.cap_effective",
+	case "process.file.group":
 
-		"process.ancestors.cap_permitted",
+		return e.ResolveFileFieldsGroup(&e.ProcessContext.Process.FileFields), nil
 
-		"process.ancestors.comm",
+	case "process.file.in_upper_layer":
 
-		"process.ancestors.container.id",
+		return e.ResolveFileFieldsInUpperLayer(&e.ProcessContext.Process.FileFields), nil
 
-		"process.ancestors.cookie",
+	case "process.file.inode":
 
-		"process.ancestors.created_at",
+		return int(e.ProcessContext.Process.FileFields.Inode), nil
 
-		"process.ancestors.egid",
+	case "process.file.mode":
 
-		"process.ancestors.egroup",
+		return int(e.ProcessContext.Process.FileFields.Mode), nil
 
-		"process.ancestors.euid",
+	case "process.file.modification_time":
 
-		"process.ancestors.euser",
+		return int(e.ProcessContext.Process.FileFields.MTime), nil
 
-		"process.ancestors.file.change_time",
+	case "process.file.mount_id":
 
-		"process.ancestors.file.filesystem",
+		return int(e.ProcessContext.Process.FileFields.MountID), nil
 
-		"process.ancestors.file.gid",
+	case "process.file.name":
 
-		"process.ancestors.file.group",
+		return e.ProcessContext.Process.BasenameStr, nil
 
-		"process.ancestors.file.in_upper_layer",
+	case "process.file.path":
 
-		"process.ancestors.file.inode",
+		return e.ProcessContext.Process.PathnameStr, nil
 
-		"process.ancestors.file.mode",
+	case "process.file.rights":
 
-		"process.ancestors.file.modification_time",
+		return int(e.ResolveRights(&e.ProcessContext.Process.FileFields)), nil
 
-		"process.ancestors.file.mount_id",
+	case "process.file.uid":
 
-		"process.ancestors.file.name",
+		return int(e.ProcessContext.Process.FileFields.UID), nil
 
-		"process.ancestors.file.path",
+	case "process.file.user":
 
-		"process.ancestors.file.rights",
+		return e.ResolveFileFieldsUser(&e.ProcessContext.Process.FileFields), nil
 
-		"process.ancestors.file.uid",
+	case "process.fsgid":
 
-		"process.ancestors.file.user",
+		return int(e.ProcessContext.Process.Credentials.FSGID), nil
 
-		"process.ancestors.fsgid",
+	case "process.fsgroup":
 
-		"process.ancestors.fsgroup",
+		return e.ProcessContext.Process.Credentials.FSGroup, nil
 
-		"process.ancestors.fsuid",
+	case "process.fsuid":
 
-		"process.ancestors.fsuser",
+		return int(e.ProcessContext.Process.Credentials.FSUID), nil
 
-		"process.ancestors.gid",
+	case "process.fsuser":
 
-		"process.ancestors.group",
+		return e.ProcessContext.Process.Credentials.FSUser, nil
 
-		"process.ancestors.pid",
+	case "process.gid":
 
-		"process.ancestors.ppid",
+		return int(e.ProcessContext.Process.Credentials.GID), nil
 
-		"process.ancestors.tid",
+	case "process.group":
 
-		"process.ancestors.tty_name",
+		return e.ProcessContext.Process.Credentials.Group, nil
 
-		"process.ancestors.uid",
+	case "process.pid":
 
-		"process.ancestors.user",
+		return int(e.ProcessContext.Process.Pid), nil
 
-		"process.cap_effective",
+	case "process.ppid":
 
-		"process.cap_permitted",
+		return int(e.ProcessContext.Process.PPid), nil
 
-		"process.comm",
+	case "process.tid":
 
-		"process.container.id",
+		return int(e.ProcessContext.Process.Tid), nil
 
-		"process.cookie",
+	case "process.tty_name":
 
-		"process.created_at",
+		return e.ProcessContext.Process.TTYName, nil
 
-		"process.egid",
+	case "process.uid":
 
-		"process.egroup",
+		return int(e.ProcessContext.Process.Credentials.UID), nil
 
-		"process.euid",
+	case "process.user":
 
-		"process.euser",
+		return e.ProcessContext.Process.Credentials.User, nil
 
-		"process.file.change_time",
+	case "ptrace.request":
 
-		"process.file.filesystem",
+		return int(e.PTrace.Request), nil
 
-		"process.file.gid",
+	case "ptrace.retval":
 
-		"process.file.group",
+		return int(e.PTrace.SyscallEvent.Retval), nil
 
-		"process.file.in_upper_layer",
+	case "ptrace.tracee.cap_effective":
 
-		"process.file.inode",
+		return int(e.PTrace.Tracee.Credentials.CapEffective), nil
 
-		"process.file.mode",
+	case "ptrace.tracee.cap_permitted":
 
-		"process.file.modification_time",
+		return int(e.PTrace.Tracee.Credentials.CapPermitted), nil
 
-		"process.file.mount_id",
+	case "ptrace.tracee.comm":
 
-		"process.file.name",
+		return e.PTrace.Tracee.Comm, nil
 
-		"process.file.path",
+	case "ptrace.tracee.container.id":
 
-		"process.file.rights",
+		return e.PTrace.Tracee.ContainerID, nil
 
-		"process.file.uid",
+	case "ptrace.tracee.cookie":
 
-		"process.file.user",
+		return int(e.PTrace.Tracee.Cookie), nil
 
-		"process.fsgid",
+	case "ptrace.tracee.created_at":
 
-		"process.fsgroup",
+		return int(e.ResolveProcessCreatedAt(&e.PTrace.Tracee)), nil
 
-		"process.fsuid",
+	case "ptrace.tracee.egid":
 
-		"process.fsuser",
+		return int(e.PTrace.Tracee.Credentials.EGID), nil
 
-		"process.gid",
+	case "ptrace.tracee.egroup":
 
-		"process.group",
+		return e.PTrace.Tracee.Credentials.EGroup, nil
 
-		"process.pid",
+	case "ptrace.tracee.euid":
 
-		"process.ppid",
+		return int(e.PTrace.Tracee.Credentials.EUID), nil
 
-		"process.tid",
+	case "ptrace.tracee.euser":
 
-		"process.tty_name",
+		return e.PTrace.Tracee.Credentials.EUser, nil
 
-		"process.uid",
+	case "ptrace.tracee.file.change_time":
 
-		"process.user",
+		return int(e.PTrace.Tracee.FileFields.CTime), nil
 
-		"removexattr.file.change_time",
+	case "ptrace.tracee.file.filesystem":
 
-		"removexattr.file.destination.name",
+		return e.PTrace.Tracee.Filesystem, nil
 
-		"removexattr.file.destination.namespace",
+	case "ptrace.tracee.file.gid":
 
-		"removexattr.file.filesystem",
+		return int(e.PTrace.Tracee.FileFields.GID), nil
 
-		"removexattr.file.gid",
+	case "ptrace.tracee.file.group":
 
-		"removexattr.file.group",
+		return e.ResolveFileFieldsGroup(&e.PTrace.Tracee.FileFields), nil
 
-		"removexattr.file.in_upper_layer",
+	case "ptrace.tracee.file.in_upper_layer":
 
-		"removexattr.file.inode",
+		return e.ResolveFileFieldsInUpperLayer(&e.PTrace.Tracee.FileFields), nil
 
-		"removexattr.file.mode",
+	case "ptrace.tracee.file.inode":
 
-		"removexattr.file.modification_time",
+		return int(e.PTrace.Tracee.FileFields.Inode), nil
 
-		"removexattr.file.mount_id",
+	case "ptrace.tracee.file.mode":
 
-		"removexattr.file.name",
+		return int(e.PTrace.Tracee.FileFields.Mode), nil
 
-		"removexattr.file.path",
+	case "ptrace.tracee.file.modification_time":
 
-		"removexattr.file.rights",
+		return int(e.PTrace.Tracee.FileFields.MTime), nil
 
-		"removexattr.file.uid",
+	case "ptrace.tracee.file.mount_id":
 
-		"removexattr.file.user",
+		return int(e.PTrace.Tracee.FileFields.MountID), nil
 
-		"removexattr.retval",
+	case "ptrace.tracee.file.name":
 
-		"rename.file.change_time",
+		return e.PTrace.Tracee.BasenameStr, nil
 
-		"rename.file.destination.change_time",
+	case "ptrace.tracee.file.path":
 
-		"rename.file.destination.filesystem",
+		return e.PTrace.Tracee.PathnameStr, nil
 
-		"rename.file.destination.gid",
+	case "ptrace.tracee.file.rights":
 
-		"rename.file.destination.group",
+		return int(e.ResolveRights(&e.PTrace.Tracee.FileFields)), nil
 
-		"rename.file.destination.in_upper_layer",
+	case "ptrace.tracee.file.uid":
 
-		"rename.file.destination.inode",
+		return int(e.PTrace.Tracee.FileFields.UID), nil
 
-		"rename.file.destination.mode",
+	case "ptrace.tracee.file.user":
 
-		"rename.file.destination.modification_time",
+		return e.ResolveFileFieldsUser(&e.PTrace.Tracee.FileFields), nil
 
-		"rename.file.destination.mount_id",
+	case "ptrace.tracee.fsgid":
 
-		"rename.file.destination.name",
+		return int(e.PTrace.Tracee.Credentials.FSGID), nil
 
-		"rename.file.destination.path",
+	case "ptrace.tracee.fsgroup":
 
-		"rename.file.destination.rights",
+		return e.PTrace.Tracee.Credentials.FSGroup, nil
 
-		"rename.file.destination.uid",
+	case "ptrace.tracee.fsuid":
 
-		"rename.file.destination.user",
+		return int(e.PTrace.Tracee.Credentials.FSUID), nil
 
-		"rename.file.filesystem",
+	case "ptrace.tracee.fsuser":
 
-		"rename.file.gid",
+		return e.PTrace.Tracee.Credentials.FSUser, nil
 
-		"rename.file.group",
+	case "ptrace.tracee.gid":
 
-		"rename.file.in_upper_layer",
+		return int(e.PTrace.Tracee.Credentials.GID), nil
 
-		"rename.file.inode",
+	case "ptrace.tracee.group":
 
-		"rename.file.mode",
+		return e.PTrace.Tracee.Credentials.Group, nil
 
-		"rename.file.modification_time",
+	case "ptrace.tracee.pid":
 
-		"rename.file.mount_id",
+		return int(e.PTrace.Tracee.Pid), nil
 
-		"rename.file.name",
+	case "ptrace.tracee.ppid":
 
-		"rename.file.path",
+		return int(e.PTrace.Tracee.PPid), nil
 
-		"rename.file.rights",
+	case "ptrace.tracee.tid":
 
-		"rename.file.uid",
+		return int(e.PTrace.Tracee.Tid), nil
 
-		"rename.file.user",
+	case "ptrace.tracee.tty_name":
 
-		"rename.retval",
+		return e.PTrace.Tracee.TTYName, nil
 
-		"rmdir.file.change_time",
+	case "ptrace.tracee.uid":
 
-		"rmdir.file.filesystem",
+		return int(e.PTrace.Tracee.Credentials.UID), nil
 
-		"rmdir.file.gid",
+	case "ptrace.tracee.user":
 
-		"rmdir.file.group",
+		return e.PTrace.Tracee.Credentials.User, nil
 
-		"rmdir.file.in_upper_layer",
+	case "removexattr.file.change_time":
 
-		"rmdir.file.inode",
+		return int(e.RemoveXAttr.File.FileFields.CTime), nil
 
-		"rmdir.file.mode",
+	case "removexattr.file.destination.name":
 
-		"rmdir.file.modification_time",
+		return e.ResolveXAttrName(&e.RemoveXAttr), nil
 
-		"rmdir.file.mount_id",
+	case "removexattr.file.destination.namespace":
 
-		"rmdir.file.name",
+		return e.ResolveXAttrNamespace(&e.RemoveXAttr), nil
 
-		"rmdir.file.path",
+	case "removexattr.file.filesystem":
 
-		"rmdir.file.rights",
+		return e.ResolveFileFilesystem(&e.RemoveXAttr.File), nil
 
-		"rmdir.file.uid",
+	case "removexattr.file.gid":
 
-		"rmdir.file.user",
+		return int(e.RemoveXAttr.File.FileFields.GID), nil
 
-		"rmdir.retval",
+	case "removexattr.file.group":
 
-		"selinux.bool.name",
+		return e.ResolveFileFieldsGroup(&e.RemoveXAttr.File.FileFields), nil
 
-		"selinux.bool.state",
+	case "removexattr.file.in_upper_layer":
 
-		"selinux.bool_commit.state",
+		return e.ResolveFileFieldsInUpperLayer(&e.RemoveXAttr.File.FileFields), nil
 
-		"selinux.enforce.status",
+	case "removexattr.file.inode":
 
-		"setgid.egid",
+		return int(e.RemoveXAttr.File.FileFields.Inode), nil
 
-		"setgid.egroup",
+	case "removexattr.file.mode":
 
-		"setgid.fsgid",
+		return int(e.RemoveXAttr.File.FileFields.Mode), nil
 
-		"setgid.fsgroup",
+	case "removexattr.file.modification_time":
 
-		"setgid.gid",
+		return int(e.RemoveXAttr.File.FileFields.MTime), nil
 
-		"setgid.group",
+	case "removexattr.file.mount_id":
 
-		"setuid.euid",
+		return int(e.RemoveXAttr.File.FileFields.MountID), nil
 
-		"setuid.euser",
+	case "removexattr.file.name":
 
-		"setuid.fsuid",
+		return e.ResolveFileBasename(&e.RemoveXAttr.File), nil
 
-		"setuid.fsuser",
+	case "removexattr.file.path":
 
-		"setuid.uid",
+		return e.ResolveFilePath(&e.RemoveXAttr.File), nil
 
-		"setuid.user",
+	case "removexattr.file.rights":
 
-		"setxattr.file.change_time",
+		return int(e.ResolveRights(&e.RemoveXAttr.File.FileFields)), nil
 
-		"setxattr.file.destination.name",
+	case "removexattr.file.uid":
 
-		"setxattr.file.destination.namespace",
+		return int(e.RemoveXAttr.File.FileFields.UID), nil
 
-		"setxattr.file.filesystem",
+	case "removexattr.file.user":
 
-		"setxattr.file.gid",
+		return e.ResolveFileFieldsUser(&e.RemoveXAttr.File.FileFields), nil
 
-		"setxattr.file.group",
+	case "removexattr.retval":
 
-		"setxattr.file.in_upper_layer",
+		return int(e.RemoveXAttr.SyscallEvent.Retval), nil
 
-		"setxattr.file.inode",
+	case "rename.file.change_time":
 
-		"setxattr.file.mode",
+		return int(e.Rename.Old.FileFields.CTime), nil
 
-		"setxattr.file.modification_time",
+	case "rename.file.destination.change_time":
 
-		"setxattr.file.mount_id",
+		return int(e.Rename.New.FileFields.CTime), nil
 
-		"setxattr.file.name",
+	case "rename.file.destination.filesystem":
 
-		"setxattr.file.path",
+		return e.ResolveFileFilesystem(&e.Rename.New), nil
 
-		"setxattr.file.rights",
+	case "rename.file.destination.gid":
 
-		"setxattr.file.uid",
+		return int(e.Rename.New.FileFields.GID), nil
 
-		"setxattr.file.user",
+	case "rename.file.destination.group":
 
-		"setxattr.retval",
+		return e.ResolveFileFieldsGroup(&e.Rename.New.FileFields), nil
 
-		"unlink.file.change_time",
+	case "rename.file.destination.in_upper_layer":
 
-		"unlink.file.filesystem",
+		return e.ResolveFileFieldsInUpperLayer(&e.Rename.New.FileFields), nil
 
-		"unlink.file.gid",
+	case "rename.file.destination.inode":
 
-		"unlink.file.group",
+		return int(e.Rename.New.FileFields.Inode), nil
 
-		"unlink.file.in_upper_layer",
+	case "rename.file.destination.mode":
 
-		"unlink.file.inode",
+		return int(e.Rename.New.FileFields.Mode), nil
 
-		"unlink.file.mode",
+	case "rename.file.destination.modification_time":
 
-		"unlink.file.modification_time",
+		return int(e.Rename.New.FileFields.MTime), nil
 
-		"unlink.file.mount_id",
+	case "rename.file.destination.mount_id":
 
-		"unlink.file.name",
+		return int(e.Rename.New.FileFields.MountID), nil
 
-		"unlink.file.path",
+	case "rename.file.destination.name":
 
-		"unlink.file.rights",
+		return e.ResolveFileBasename(&e.Rename.New), nil
 
-		"unlink.file.uid",
+	case "rename.file.destination.path":
 
-		"unlink.file.user",
+		return e.ResolveFilePath(&e.Rename.New), nil
 
-		"unlink.retval",
+	case "rename.file.destination.rights":
 
-		"utimes.file.change_time",
+		return int(e.ResolveRights(&e.Rename.New.FileFields)), nil
 
-		"utimes.file.filesystem",
+	case "rename.file.destination.uid":
 
-		"utimes.file.gid",
+		return int(e.Rename.New.FileFields.UID), nil
 
-		"utimes.file.group",
+	case "rename.file.destination.user":
 
-		"utimes.file.in_upper_layer",
+		return e.ResolveFileFieldsUser(&e.Rename.New.FileFields), nil
 
-		"utimes.file.inode",
+	case "rename.file.filesystem":
 
-		"utimes.file.mode",
+		return e.ResolveFileFilesystem(&e.Rename.Old), nil
 
-		"utimes.file.modification_time",
+	case "rename.file.gid":
 
-		"utimes.file.mount_id",
+		return int(e.Rename.Old.FileFields.GID), nil
 
-		"utimes.file.name",
+	case "rename.file.group":
 
-		"utimes.file.path",
+		return e.ResolveFileFieldsGroup(&e.Rename.Old.FileFields), nil
 
-		"utimes.file.rights",
+	case "rename.file.in_upper_layer":
 
-		"utimes.file.uid",
+		return e.ResolveFileFieldsInUpperLayer(&e.Rename.Old.FileFields), nil
 
-		"utimes.file.user",
+	case "rename.file.inode":
 
-		"utimes.retval",
-	}
-}
+		return int(e.Rename.Old.FileFields.Inode), nil
 
-func (e *Event) GetFieldValue(field eval.Field) (interface{}, error) {
-	switch field {
+	case "rename.file.mode":
 
-	case "bpf.cmd":
+		return int(e.Rename.Old.FileFields.Mode), nil
 
-		return int(e.BPF.Cmd), nil
+	case "rename.file.modification_time":
 
-	case "bpf.map.type":
+		return int(e.Rename.Old.FileFields.MTime), nil
 
-		return int(e.BPF.Map.Type), nil
+	case "rename.file.mount_id":
 
-	case "bpf.prog.attach_type":
+		return int(e.Rename.Old.FileFields.MountID), nil
 
-		return int(e.BPF.Program.AttachType), nil
+	case "rename.file.name":
 
-	case "bpf.prog.type":
+		return e.ResolveFileBasename(&e.Rename.Old), nil
 
-		return int(e.BPF.Program.Type), nil
+	case "rename.file.path":
 
-	case "bpf.retval":
+		return e.ResolveFilePath(&e.Rename.Old), nil
 
-		return int(e.BPF.SyscallEvent.Retval), nil
+	case "rename.file.rights":
 
-	case "capset.cap_effective":
+		return int(e.ResolveRights(&e.Rename.Old.FileFields)), nil
 
-		return int(e.Capset.CapEffective), nil
+	case "rename.file.uid":
 
-	case "capset.cap_permitted":
+		return int(e.Rename.Old.FileFields.UID), nil
 
-		return int(e.Capset.CapPermitted), nil
+	case "rename.file.user":
 
-	case "chmod.file.change_time":
+		return e.ResolveFileFieldsUser(&e.Rename.Old.FileFields), nil
 
-		return int(e.Chmod.File.FileFields.CTime), nil
+	case "rename.retval":
 
-	case "chmod.file.destination.mode":
+		return int(e.Rename.SyscallEvent.Retval), nil
 
-		return int(e.Chmod.Mode), nil
+	case "rmdir.file.change_time":
 
-	case "chmod.file.destination.rights":
+		return int(e.Rmdir.File.FileFields.CTime), nil
 
-		return int(e.Chmod.Mode), nil
+	case "rmdir.file.filesystem":
 
-	case "chmod.file.filesystem":
+		return e.ResolveFileFilesystem(&e.Rmdir.File), nil
 
-		return e.ResolveFileFilesystem(&e.Chmod.File), nil
+	case "rmdir.file.gid":
 
-	case "chmod.file.gid":
+		return int(e.Rmdir.File.FileFields.GID), nil
 
-		return int(e.Chmod.File.FileFields.GID), nil
+	case "rmdir.file.group":
 
-	case "chmod.file.group":
+		return e.ResolveFileFieldsGroup(&e.Rmdir.File.FileFields), nil
 
-		return e.ResolveFileFieldsGroup(&e.Chmod.File.FileFields), nil
+	case "rmdir.file.in_upper_layer":
 
-	case "chmod.file.in_upper_layer":
+		return e.ResolveFileFieldsInUpperLayer(&e.Rmdir.File.FileFields), nil
 
-		return e.ResolveFileFieldsInUpperLayer(&e.Chmod.File.FileFields), nil
+	case "rmdir.file.inode":
 
-	case "chmod.file.inode":
+		return int(e.Rmdir.File.FileFields.Inode), nil
 
-		return int(e.Chmod.File.FileFields.Inode), nil
+	case "rmdir.file.mode":
 
-	case "chmod.file.mode":
+		return int(e.Rmdir.File.FileFields.Mode), nil
 
-		return int(e.Chmod.File.FileFields.Mode), nil
+	case "rmdir.file.modification_time":
 
-	case "chmod.file.modification_time":
+		return int(e.Rmdir.File.FileFields.MTime), nil
 
-		return int(e.Chmod.File.FileFields.MTime), nil
+	case "rmdir.file.mount_id":
 
-	case "chmod.file.mount_id":
+		return int(e.Rmdir.File.FileFields.MountID), nil
 
-		return int(e.Chmod.File.FileFields.MountID), nil
+	case "rmdir.file.name":
 
-	case "chmod.file.name":
+		return e.ResolveFileBasename(&e.Rmdir.File), nil
 
-		return e.ResolveFileBasename(&e.Chmod.File), nil
+	case "rmdir.file.path":
 
-	case "chmod.file.path":
+		return e.ResolveFilePath(&e.Rmdir.File), nil
 
-		return e.ResolveFilePath(&e.Chmod.File), nil
+	case "rmdir.file.rights":
 
-	case "chmod.file.rights":
+		return int(e.ResolveRights(&e.Rmdir.File.FileFields)), nil
 
-		return int(e.ResolveRights(&e.Chmod.File.FileFields)), nil
+	case "rmdir.file.uid":
 
-	case "chmod.file.uid":
+		return int(e.Rmdir.File.FileFields.UID), nil
 
-		return int(e.Chmod.File.FileFields.UID), nil
+	case "rmdir.file.user":
 
-	case "chmod.file.user":
+		return e.ResolveFileFieldsUser(&e.Rmdir.File.FileFields), nil
 
-		return e.ResolveFileFieldsUser(&e.Chmod.File.FileFields), nil
+	case "rmdir.retval":
 
-	case "chmod.retval":
+		return int(e.Rmdir.SyscallEvent.Retval), nil
 
-		return int(e.Chmod.SyscallEvent.Retval), nil
+	case "selinux.bool.name":
 
-	case "chown.file.change_time":
+		return e.ResolveSELinuxBoolName(&e.SELinux), nil
 
-		return int(e.Chown.File.FileFields.CTime), nil
+	case "selinux.bool.state":
 
-	case "chown.file.destination.gid":
+		return e.SELinux.BoolChangeValue, nil
 
-		return int(e.Chown.GID), nil
+	case "selinux.bool_commit.state":
 
-	case "chown.file.destination.group":
+		return e.SELinux.BoolCommitValue, nil
 
-		return e.ResolveChownGID(&e.Chown), nil
+	case "selinux.enforce.status":
 
-	case "chown.file.destination.uid":
+		return e.SELinux.EnforceStatus, nil
 
-		return int(e.Chown.UID), nil
+	case "setgid.egid":
 
-	case "chown.file.destination.user":
+		return int(e.SetGID.EGID), nil
 
-		return e.ResolveChownUID(&e.Chown), nil
+	case "setgid.egroup":
 
-	case "chown.file.filesystem":
+		return e.ResolveSetgidEGroup(&e.SetGID), nil
 
-		return e.ResolveFileFilesystem(&e.Chown.File), nil
+	case "setgid.fsgid":
 
-	case "chown.file.gid":
+		return int(e.SetGID.FSGID), nil
 
-		return int(e.Chown.File.FileFields.GID), nil
+	case "setgid.fsgroup":
 
-	case "chown.file.group":
+		return e.ResolveSetgidFSGroup(&e.SetGID), nil
 
-		return e.ResolveFileFieldsGroup(&e.Chown.File.FileFields), nil
+	case "setgid.gid":
 
-	case "chown.file.in_upper_layer":
+		return int(e.SetGID.GID), nil
 
-		return e.ResolveFileFieldsInUpperLayer(&e.Chown.File.FileFields), nil
+	case "setgid.group":
 
-	case "chown.file.inode":
+		return e.ResolveSetgidGroup(&e.SetGID), nil
 
-		return int(e.Chown.File.FileFields.Inode), nil
+	case "setuid.euid":
 
-	case "chown.file.mode":
+		return int(e.SetUID.EUID), nil
 
-		return int(e.Chown.File.FileFields.Mode), nil
+	case "setuid.euser":
 
-	case "chown.file.modification_time":
+		return e.ResolveSetuidEUser(&e.SetUID), nil
 
-		return int(e.Chown.File.FileFields.MTime), nil
+	case "setuid.fsuid":
 
-	case "chown.file.mount_id":
+		return int(e.SetUID.FSUID), nil
 
-		return int(e.Chown.File.FileFields.MountID), nil
+	case "setuid.fsuser":
 
-	case "chown.file.name":
+		return e.ResolveSetuidFSUser(&e.SetUID), nil
 
-		return e.ResolveFileBasename(&e.Chown.File), nil
+	case "setuid.uid":
 
-	case "chown.file.path":
+		return int(e.SetUID.UID), nil
 
-		return e.ResolveFilePath(&e.Chown.File), nil
+	case "setuid.user":
 
-	case "chown.file.rights":
+		return e.ResolveSetuidUser(&e.SetUID), nil
 
-		return int(e.ResolveRights(&e.Chown.File.FileFields)), nil
+	case "setxattr.file.change_time":
 
-	case "chown.file.uid":
+		return int(e.SetXAttr.File.FileFields.CTime), nil
 
-		return int(e.Chown.File.FileFields.UID), nil
+	case "setxattr.file.destination.name":
 
-	case "chown.file.user":
+		return e.ResolveXAttrName(&e.SetXAttr), nil
 
-		return e.ResolveFileFieldsUser(&e.Chown.File.FileFields), nil
+	case "setxattr.file.destination.namespace":
 
-	case "chown.retval":
+		return e.ResolveXAttrNamespace(&e.SetXAttr), nil
 
-		return int(e.Chown.SyscallEvent.Retval), nil
+	case "setxattr.file.filesystem":
 
-	case "container.id":
+		return e.ResolveFileFilesystem(&e.SetXAttr.File), nil
 
-		return e.ResolveContainerID(&e.ContainerContext), nil
+	case "setxattr.file.gid":
 
-	case "container.tags":
+		return int(e.SetXAttr.File.FileFields.GID), nil
 
-		return e.ResolveContainerTags(&e.ContainerContext), nil
+	case "setxattr.file.group":
 
-	case "exec.args":
+		return e.ResolveFileFieldsGroup(&e.SetXAttr.File.FileFields), nil
 
-		return e.ResolveExecArgs(&e.Exec), nil
+	case "setxattr.file.in_upper_layer":
 
-	case "exec.args_flags":
+		return e.ResolveFileFieldsInUpperLayer(&e.SetXAttr.File.FileFields), nil
 
-		return e.ResolveExecArgsFlags(&e.Exec), nil
+	case "setxattr.file.inode":
 
-	case "exec.args_options":
+		return int(e.SetXAttr.File.FileFields.Inode), nil
 
-		return e.ResolveExecArgsOptions(&e.Exec), nil
+	case "setxattr.file.mode":
 
-	case "exec.args_truncated":
+		return int(e.SetXAttr.File.FileFields.Mode), nil
 
-		return e.ResolveExecArgsTruncated(&e.Exec), nil
+	case "setxattr.file.modification_time":
 
-	case "exec.argv":
+		return int(e.SetXAttr.File.FileFields.MTime), nil
 
-		return e.ResolveExecArgv(&e.Exec), nil
+	case "setxattr.file.mount_id":
 
-	case "exec.cap_effective":
+		return int(e.SetXAttr.File.FileFields.MountID), nil
 
-		return int(e.Exec.Process.Credentials.CapEffective), nil
+	case "setxattr.file.name":
 
-	case "exec.cap_permitted":
+		return e.ResolveFileBasename(&e.SetXAttr.File), nil
 
-		return int(e.Exec.Process.Credentials.CapPermitted), nil
+	case "setxattr.file.path":
 
-	case "exec.comm":
+		return e.ResolveFilePath(&e.SetXAttr.File), nil
 
-		return e.Exec.Process.Comm, nil
+	case "setxattr.file.rights":
 
-	case "exec.container.id":
+		return int(e.ResolveRights(&e.SetXAttr.File.FileFields)), nil
 
-		return e.Exec.Process.ContainerID, nil
+	case "setxattr.file.uid":
 
-	case "exec.cookie":
+		return int(e.SetXAttr.File.FileFields.UID), nil
 
-		return int(e.Exec.Process.Cookie), nil
+	case "setxattr.file.user":
 
-	case "exec.created_at":
+		return e.ResolveFileFieldsUser(&e.SetXAttr.File.FileFields), nil
 
-		return int(e.ResolveProcessCreatedAt(&e.Exec.Process)), nil
+	case "setxattr.retval":
 
-	case "exec.egid":
+		return int(e.SetXAttr.SyscallEvent.Retval), nil
 
-		return int(e.Exec.Process.Credentials.EGID), nil
+	case "signal.pid":
 
-	case "exec.egroup":
+		return int(e.Signal.PID), nil
 
-		return e.Exec.Process.Credentials.EGroup, nil
+	case "signal.retval":
 
-	case "exec.envs":
+		return int(e.Signal.SyscallEvent.Retval), nil
 
-		return e.ResolveExecEnvs(&e.Exec), nil
+	case "signal.target.cap_effective":
 
-	case "exec.envs_truncated":
+		return int(e.Signal.Target.Credentials.CapEffective), nil
 
-		return e.ResolveExecEnvsTruncated(&e.Exec), nil
+	case "signal.target.cap_permitted":
 
-	case "exec.euid":
+		return int(e.Signal.Target.Credentials.CapPermitted), nil
 
-		return int(e.Exec.Process.Credentials.EUID), nil
+	case "signal.target.comm":
 
-	case "exec.euser":
+		return e.Signal.Target.Comm, nil
 
-		return e.Exec.Process.Credentials.EUser, nil
+	case "signal.target.container.id":
 
-	case "exec.file.change_time":
+		return e.Signal.Target.ContainerID, nil
 
-		return int(e.Exec.Process.FileFields.CTime), nil
+	case "signal.target.cookie":
 
-	case "exec.file.filesystem":
+		return int(e.Signal.Target.Cookie), nil
 
-		return e.Exec.Process.Filesystem, nil
+	case "signal.target.created_at":
 
-	case "exec.file.gid":
+		return int(e.ResolveProcessCreatedAt(&e.Signal.Target)), nil
 
-		return int(e.Exec.Process.FileFields.GID), nil
+	case "signal.target.egid":
 
-	case "exec.file.group":
+		return int(e.Signal.Target.Credentials.EGID), nil
 
-		return e.ResolveFileFieldsGroup(&e.Exec.Process.FileFields), nil
+	case "signal.target.egroup":
 
-	case "exec.file.in_upper_layer":
+		return e.Signal.Target.Credentials.EGroup, nil
 
-		return e.ResolveFileFieldsInUpperLayer(&e.Exec.Process.FileFields), nil
+	case "signal.target.euid":
 
-	case "exec.file.inode":
+		return int(e.Signal.Target.Credentials.EUID), nil
 
-		return int(e.Exec.Process.FileFields.Inode), nil
+	case "signal.target.euser":
 
-	case "exec.file.mode":
+		return e.Signal.Target.Credentials.EUser, nil
 
-		return int(e.Exec.Process.FileFields.Mode), nil
+	case "signal.target.file.change_time":
 
-	case "exec.file.modification_time":
+		return int(e.Signal.Target.FileFields.CTime), nil
 
-		return int(e.Exec.Process.FileFields.MTime), nil
+	case "signal.target.file.filesystem":
 
-	case "exec.file.mount_id":
+		return e.Signal.Target.Filesystem, nil
 
-		return int(e.Exec.Process.FileFields.MountID), nil
+	case "signal.target.file.gid":
 
-	case "exec.file.name":
+		return int(e.Signal.Target.FileFields.GID), nil
 
-		return e.Exec.Process.BasenameStr, nil
+	case "signal.target.file.group":
 
-	case "exec.file.path":
+		return e.ResolveFileFieldsGroup(&e.Signal.Target.FileFields), nil
 
-		return e.Exec.Process.PathnameStr, nil
+	case "signal.target.file.in_upper_layer":
 
-	case "exec.file.rights":
+		return e.ResolveFileFieldsInUpperLayer(&e.Signal.Target.FileFields), nil
 
-		return int(e.ResolveRights(&e.Exec.Process.FileFields)), nil
+	case "signal.target.file.inode":
 
-	case "exec.file.uid":
+		return int(e.Signal.Target.FileFields.Inode), nil
 
-		return int(e.Exec.Process.FileFields.UID), nil
+	case "signal.target.file.mode":
 
-	case "exec.file.user":
+		return int(e.Signal.Target.FileFields.Mode), nil
 
-		return e.ResolveFileFieldsUser(&e.Exec.Process.FileFields), nil
+	case "signal.target.file.modification_time":
 
-	case "exec.fsgid":
+		return int(e.Signal.Target.FileFields.MTime), nil
 
-		return int(e.Exec.Process.Credentials.FSGID), nil
+	case "signal.target.file.mount_id":
 
-	case "exec.fsgroup":
+		return int(e.Signal.Target.FileFields.MountID), nil
 
-		return e.Exec.Process.Credentials.FSGroup, nil
+	case "signal.target.file.name":
 
-	case "exec.fsuid":
+		return e.Signal.Target.BasenameStr, nil
 
-		return int(e.Exec.Process.Credentials.FSUID), nil
+	case "signal.target.file.path":
 
-	case "exec.fsuser":
+		return e.Signal.Target.PathnameStr, nil
 
-		return e.Exec.Process.Credentials.FSUser, nil
+	case "signal.target.file.rights":
 
-	case "exec.gid":
+		return int(e.ResolveRights(&e.Signal.Target.FileFields)), nil
 
-		return int(e.Exec.Process.Credentials.GID), nil
+	case "signal.target.file.uid":
 
-	case "exec.group":
+		return int(e.Signal.Target.FileFields.UID), nil
 
-		return e.Exec.Process.Credentials.Group, nil
+	case "signal.target.file.user":
 
-	case "exec.pid":
+		return e.ResolveFileFieldsUser(&e.Signal.Target.FileFields), nil
 
-		return int(e.Exec.Process.Pid), nil
+	case "signal.target.fsgid":
 
-	case "exec.ppid":
+		return int(e.Signal.Target.Credentials.FSGID), nil
 
-		return int(e.Exec.Process.PPid), nil
+	case "signal.target.fsgroup":
 
-	case "exec.tid":
+		return e.Signal.Target.Credentials.FSGroup, nil
 
-		return int(e.Exec.Process.Tid), nil
+	case "signal.target.fsuid":
 
-	case "exec.tty_name":
+		return int(e.Signal.Target.Credentials.FSUID), nil
 
-		return e.Exec.Process.TTYName, nil
+	case "signal.target.fsuser":
 
-	case "exec.uid":
+		return e.Signal.Target.Credentials.FSUser, nil
 
-		return int(e.Exec.Process.Credentials.UID), nil
+	case "signal.target.gid":
 
-	case "exec.user":
+		return int(e.Signal.Target.Credentials.GID), nil
 
-		return e.Exec.Process.Credentials.User, nil
+	case "signal.target.group":
 
-	case "link.file.change_time":
+		return e.Signal.Target.Credentials.Group, nil
 
-		return int(e.Link.Source.FileFields.CTime), nil
+	case "signal.target.pid":
 
-	case "link.file.destination.change_time":
+		return int(e.Signal.Target.Pid), nil
 
-		return int(e.Link.Target.FileFields.CTime), nil
+	case "signal.target.ppid":
 
-	case "link.file.destination.filesystem":
+		return int(e.Signal.Target.PPid), nil
 
-		return e.ResolveFileFilesystem(&e.Link.Target), nil
+	case "signal.target.tid":
 
-	case "link.file.destination.gid":
+		return int(e.Signal.Target.Tid), nil
 
-		return int(e.Link.Target.FileFields.GID), nil
+	case "signal.target.tty_name":
 
-	case "link.file.destination.group":
+		return e.Signal.Target.TTYName, nil
 
-		return e.ResolveFileFieldsGroup(&e.Link.Target.FileFields), nil
+	case "signal.target.uid":
 
-	case "link.file.destination.in_upper_layer":
+		return int(e.Signal.Target.Credentials.UID), nil
 
-		return e.ResolveFileFieldsInUpperLayer(&e.Link.Target.FileFields), nil
+	case "signal.target.user":
 
-	case "link.file.destination.inode":
+		return e.Signal.Target.Credentials.User, nil
 
-		return int(e.Link.Target.FileFields.Inode), nil
+	case "signal.type":
 
-	case "link.file.destination.mode":
+		return int(e.Signal.Type), nil
 
-		return int(e.Link.Target.FileFields.Mode), nil
+	case "unlink.file.change_time":
 
-	case "link.file.destination.modification_time":
+		return int(e.Unlink.File.FileFields.CTime), nil
 
-		return int(e.Link.Target.FileFields.MTime), nil
+	case "unlink.file.filesystem":
 
-	case "link.file.destination.mount_id":
+		return e.ResolveFileFilesystem(&e.Unlink.File), nil
 
-		return int(e.Link.Target.FileFields.MountID), nil
+	case "unlink.file.gid":
 
-	case "link.file.destination.name":
+		return int(e.Unlink.File.FileFields.GID), nil
 
-		return e.ResolveFileBasename(&e.Link.Target), nil
+	case "unlink.file.group":
 
-	case "link.file.destination.path":
+		return e.ResolveFileFieldsGroup(&e.Unlink.File.FileFields), nil
 
-		return e.ResolveFilePath(&e.Link.Target), nil
+	case "unlink.file.in_upper_layer":
 
-	case "link.file.destination.rights":
+		return e.ResolveFileFieldsInUpperLayer(&e.Unlink.File.FileFields), nil
 
-		return int(e.ResolveRights(&e.Link.Target.FileFields)), nil
+	case "unlink.file.inode":
 
-	case "link.file.destination.uid":
+		return int(e.Unlink.File.FileFields.Inode), nil
 
-		return int(e.Link.Target.FileFields.UID), nil
+	case "unlink.file.mode":
 
-	case "link.file.destination.user":
+		return int(e.Unlink.File.FileFields.Mode), nil
 
-		return e.ResolveFileFieldsUser(&e.Link.Target.FileFields), nil
+	case "unlink.file.modification_time":
 
-	case "link.file.filesystem":
+		return int(e.Unlink.File.FileFields.MTime), nil
 
-		return e.ResolveFileFilesystem(&e.Link.Source), nil
+	case "unlink.file.mount_id":
 
-	case "link.file.gid":
+		return int(e.Unlink.File.FileFields.MountID), nil
 
-		return int(e.Link.Source.FileFields.GID), nil
+	case "unlink.file.name":
 
-	case "link.file.group":
+		return e.ResolveFileBasename(&e.Unlink.File), nil
 
-		return e.ResolveFileFieldsGroup(&e.Link.Source.FileFields), nil
+	case "unlink.file.path":
 
-	case "link.file.in_upper_layer":
+		return e.ResolveFilePath(&e.Unlink.File), nil
 
-		return e.ResolveFileFieldsInUpperLayer(&e.Link.Source.FileFields), nil
+	case "unlink.file.rights":
 
-	case "link.file.inode":
+		return int(e.ResolveRights(&e.Unlink.File.FileFields)), nil
 
-		return int(e.Link.Source.FileFields.Inode), nil
+	case "unlink.file.uid":
 
-	case "link.file.mode":
+		return int(e.Unlink.File.FileFields.UID), nil
 
-		return int(e.Link.Source.FileFields.Mode), nil
+	case "unlink.file.user":
 
-	case "link.file.modification_time":
+		return e.ResolveFileFieldsUser(&e.Unlink.File.FileFields), nil
 
-		return int(e.Link.Source.FileFields.MTime), nil
+	case "unlink.retval":
 
-	case "link.file.mount_id":
+		return int(e.Unlink.SyscallEvent.Retval), nil
 
-		return int(e.Link.Source.FileFields.MountID), nil
+	case "unload_module.name":
 
-	case "link.file.name":
+		return e.UnloadModule.Name, nil
 
-		return e.ResolveFileBasename(&e.Link.Source), nil
+	case "unload_module.retval":
 
-	case "link.file.path":
+		return int(e.UnloadModule.SyscallEvent.Retval), nil
 
-		return e.ResolveFilePath(&e.Link.Source), nil
+	case "utimes.file.change_time":
 
-	case "link.file.rights":
+		return int(e.Utimes.File.FileFields.CTime), nil
 
-		return int(e.ResolveRights(&e.Link.Source.FileFields)), nil
+	case "utimes.file.filesystem":
 
-	case "link.file.uid":
+		return e.ResolveFileFilesystem(&e.Utimes.File), nil
 
-		return int(e.Link.Source.FileFields.UID), nil
+	case "utimes.file.gid":
 
-	case "link.file.user":
+		return int(e.Utimes.File.FileFields.GID), nil
 
-		return e.ResolveFileFieldsUser(&e.Link.Source.FileFields), nil
+	case "utimes.file.group":
 
-	case "link.retval":
+		return e.ResolveFileFieldsGroup(&e.Utimes.File.FileFields), nil
 
-		return int(e.Link.SyscallEvent.Retval), nil
+	case "utimes.file.in_upper_layer":
 
-	case "mkdir.file.change_time":
+		return e.ResolveFileFieldsInUpperLayer(&e.Utimes.File.FileFields), nil
 
-		return int(e.Mkdir.File.FileFields.CTime), nil
+	case "utimes.file.inode":
 
-	case "mkdir.file.destination.mode":
+		return int(e.Utimes.File.FileFields.Inode), nil
 
-		return int(e.Mkdir.Mode), nil
+	case "utimes.file.mode":
 
-	case "mkdir.file.destination.rights":
+		return int(e.Utimes.File.FileFields.Mode), nil
 
-		return int(e.Mkdir.Mode), nil
+	case "utimes.file.modification_time":
 
-	case "mkdir.file.filesystem":
+		return int(e.Utimes.File.FileFields.MTime), nil
 
-		return e.ResolveFileFilesystem(&e.Mkdir.File), nil
+	case "utimes.file.mount_id":
 
-	case "mkdir.file.gid":
+		return int(e.Utimes.File.FileFields.MountID), nil
 
-		return int(e.Mkdir.File.FileFields.GID), nil
+	case "utimes.file.name":
 
-	case "mkdir.file.group":
+		return e.ResolveFileBasename(&e.Utimes.File), nil
 
-		return e.ResolveFileFieldsGroup(&e.Mkdir.File.FileFields), nil
+	case "utimes.file.path":
 
-	case "mkdir.file.in_upper_layer":
+		return e.ResolveFilePath(&e.Utimes.File), nil
 
-		return e.ResolveFileFieldsInUpperLayer(&e.Mkdir.File.FileFields), nil
+	case "utimes.file.rights":
 
-	case "mkdir.file.inode":
+		return int(e.ResolveRights(&e.Utimes.File.FileFields)), nil
 
-		return int(e.Mkdir.File.FileFields.Inode), nil
+	case "utimes.file.uid":
 
-	case "mkdir.file.mode":
+		return int(e.Utimes.File.FileFields.UID), nil
 
-		return int(e.Mkdir.File.FileFields.Mode), nil
+	case "utimes.file.user":
 
-	case "mkdir.file.modification_time":
+		return e.ResolveFileFieldsUser(&e.Utimes.File.FileFields), nil
 
-		return int(e.Mkdir.File.FileFields.MTime), nil
+	case "utimes.retval":
 
-	case "mkdir.file.mount_id":
+		return int(e.Utimes.SyscallEvent.Retval), nil
 
-		return int(e.Mkdir.File.FileFields.MountID), nil
+	}
 
-	case "mkdir.file.name":
+	return nil, &eval.ErrFieldNotFound{Field: field}
+}
 
-		return e.ResolveFileBasename(&e.Mkdir.File), nil
+func (e *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
+	switch field {
 
-	case "mkdir.file.path":
+	case "accept.addr.family":
+		return "accept", nil
 
-		return e.ResolveFilePath(&e.Mkdir.File), nil
+	case "accept.addr.ip":
+		return "accept", nil
 
-	case "mkdir.file.rights":
+	case "accept.addr.port":
+		return "accept", nil
 
-		return int(e.ResolveRights(&e.Mkdir.File.FileFields)), nil
+	case "accept.retval":
+		return "accept", nil
 
-	case "mkdir.file.uid":
+	case "bind.addr.family":
+		return "bind", nil
 
-		return int(e.Mkdir.File.FileFields.UID), nil
+	case "bind.addr.ip":
+		return "bind", nil
 
-	case "mkdir.file.user":
+	case "bind.addr.port":
+		return "bind", nil
 
-		return e.ResolveFileFieldsUser(&e.Mkdir.File.FileFields), nil
+	case "bind.retval":
+		return "bind", nil
 
-	case "mkdir.retval":
+	case "bpf.cmd":
+		return "bpf", nil
 
-		return int(e.Mkdir.SyscallEvent.Retval), nil
+	case "bpf.map.type":
+		return "bpf", nil
 
-	case "open.file.change_time":
+	case "bpf.prog.attach_type":
+		return "bpf", nil
 
-		return int(e.Open.File.FileFields.CTime), nil
+	case "bpf.prog.type":
+		return "bpf", nil
 
-	case "open.file.destination.mode":
+	case "bpf.retval":
+		return "bpf", nil
 
-		return int(e.Open.Mode), nil
+	case "capset.cap_effective":
+		return "capset", nil
 
-	case "open.file.filesystem":
+	case "capset.cap_permitted":
+		return "capset", nil
 
-		return e.ResolveFileFilesystem(&e.Open.File), nil
+	case "chmod.file.change_time":
+		return "chmod", nil
 
-	case "open.file.gid":
+	case "chmod.file.destination.mode":
+		return "chmod", nil
 
-		return int(e.Open.File.FileFields.GID), nil
+	case "chmod.file.destination.rights":
+		return "chmod", nil
 
-	case "open.file.group":
+	case "chmod.file.filesystem":
+		return "chmod", nil
 
-		return e.ResolveFileFieldsGroup(&e.Open.File.FileFields), nil
+	case "chmod.file.gid":
+		return "chmod", nil
 
-	case "open.file.in_upper_layer":
+	case "chmod.file.group":
+		return "chmod", nil
 
-		return e.ResolveFileFieldsInUpperLayer(&e.Open.File.FileFields), nil
+	case "chmod.file.in_upper_layer":
+		return "chmod", nil
 
-	case "open.file.inode":
+	case "chmod.file.inode":
+		return "chmod", nil
 
-		return int(e.Open.File.FileFields.Inode), nil
+	case "chmod.file.mode":
+		return "chmod", nil
 
-	case "open.file.mode":
+	case "chmod.file.modification_time":
+		return "chmod", nil
 
-		return int(e.Open.File.FileFields.Mode), nil
+	case "chmod.file.mount_id":
+		return "chmod", nil
 
-	case "open.file.modification_time":
+	case "chmod.file.name":
+		return "chmod", nil
 
-		return int(e.Open.File.FileFields.MTime), nil
+	case "chmod.file.path":
+		return "chmod", nil
 
-	case "open.file.mount_id":
+	case "chmod.file.rights":
+		return "chmod", nil
 
-		return int(e.Open.File.FileFields.MountID), nil
+	case "chmod.file.uid":
+		return "chmod", nil
 
-	case "open.file.name":
+	case "chmod.file.user":
+		return "chmod", nil
 
-		return e.ResolveFileBasename(&e.Open.File), nil
+	case "chmod.retval":
+		return "chmod", nil
 
-	case "open.file.path":
+	case "chown.file.change_time":
+		return "chown", nil
 
-		return e.ResolveFilePath(&e.Open.File), nil
+	case "chown.file.destination.gid":
+		return "chown", nil
 
-	case "open.file.rights":
+	case "chown.file.destination.group":
+		return "chown", nil
 
-		return int(e.ResolveRights(&e.Open.File.FileFields)), nil
+	case "chown.file.destination.uid":
+		return "chown", nil
 
-	case "open.file.uid":
+	case "chown.file.destination.user":
+		return "chown", nil
 
-		return int(e.Open.File.FileFields.UID), nil
+	case "chown.file.filesystem":
+		return "chown", nil
 
-	case "open.file.user":
+	case "chown.file.gid":
+		return "chown", nil
 
-		return e.ResolveFileFieldsUser(&e.Open.File.FileFields), nil
+	case "chown.file.group":
+		return "chown", nil
 
-	case "open.flags":
+	case "chown.file.in_upper_layer":
+		return "chown", nil
 
-		return int(e.Open.Flags), nil
+	case "chown.file.inode":
+		return "chown", nil
 
-	case "open.retval":
+	case "chown.file.mode":
+		return "chown", nil
 
-		return int(e.Open.SyscallEvent.Retval), nil
+	case "chown.file.modification_time":
+		return "chown", nil
 
-	case "process.ancestors.cap_effective":
+	case "chown.file.mount_id":
+		return "chown", nil
 
-		var values []int
+	case "chown.file.name":
+		return "chown", nil
 
-		ctx := eval.NewContext(unsafe.Pointer(e))
+	case "chown.file.path":
+		return "chown", nil
 
-		iterator := &model.ProcessAncestorsIterator{}
-		ptr := iterator.Front(ctx)
+	case "chown.file.rights":
+		return "chown", nil
 
-		for ptr != nil {
+	case "chown.file.uid":
+		return "chown", nil
 
-			element := (*model.ProcessCacheEntry)(ptr)
+	case "chown.file.user":
+		return "chown", nil
 
-			result := int(element.ProcessContext.Process.Credentials.CapEffective)
+	case "chown.retval":
+		return "chown", nil
 
-			values = append(values, result)
+	case "connect.addr.family":
+		return "connect", nil
 
-			ptr = iterator.Next()
-		}
+	case "connect.addr.ip":
+		return "connect", nil
 
-		return values, nil
+	case "connect.addr.port":
+		return "connect", nil
 
-	case "process.ancestors.cap_permitted":
+	case "connect.retval":
+		return "connect", nil
 
-		var values []int
+	case "container.id":
+		return "*", nil
 
-		ctx := eval.NewContext(unsafe.Pointer(e))
+	case "container.tags":
+		return "*", nil
 
-		iterator := &model.ProcessAncestorsIterator{}
-		ptr := iterator.Front(ctx)
+	case "dns.id":
+		return "dns", nil
 
-		for ptr != nil {
+	case "dns.question.class":
+		return "dns", nil
 
-			element := (*model.ProcessCacheEntry)(ptr)
+	case "dns.question.count":
+		return "dns", nil
 
-			result := int(element.ProcessContext.Process.Credentials.CapPermitted)
+	case "dns.question.name":
+		return "dns", nil
 
-			values = append(values, result)
+	case "dns.question.size":
+		return "dns", nil
 
-			ptr = iterator.Next()
-		}
+	case "dns.question.type":
+		return "dns", nil
 
-		return values, nil
+	case "exec.args":
+		return "exec", nil
 
-	case "process.ancestors.comm":
+	case "exec.args_flags":
+		return "exec", nil
 
-		var values []string
+	case "exec.args_options":
+		return "exec", nil
 
-		ctx := eval.NewContext(unsafe.Pointer(e))
+	case "exec.args_truncated":
+		return "exec", nil
 
-		iterator := &model.ProcessAncestorsIterator{}
-		ptr := iterator.Front(ctx)
+	case "exec.argv":
+		return "exec", nil
 
-		for ptr != nil {
+	case "exec.cap_effective":
+		return "exec", nil
 
-			element := (*model.ProcessCacheEntry)(ptr)
+	case "exec.cap_permitted":
+		return "exec", nil
 
-			result := element.ProcessContext.Process.Comm
+	case "exec.comm":
+		return "exec", nil
 
-			values = append(values, result)
+	case "exec.container.id":
+		return "exec", nil
 
-			ptr = iterator.Next()
-		}
+	case "exec.cookie":
+		return "exec", nil
 
-		return values, nil
+	case "exec.created_at":
+		return "exec", nil
 
-	case "process.ancestors.container.id":
+	case "exec.egid":
+		return "exec", nil
 
-		var values []string
+	case "exec.egroup":
+		return "exec", nil
 
-		ctx := eval.NewContext(unsafe.Pointer(e))
+	case "exec.envs":
+		return "exec", nil
 
-		iterator := &model.ProcessAncestorsIterator{}
-		ptr := iterator.Front(ctx)
+	case "exec.envs_truncated":
+		return "exec", nil
 
-		for ptr != nil {
+	case "exec.euid":
+		return "exec", nil
 
-			element := (*model.ProcessCacheEntry)(ptr)
+	case "exec.euser":
+		return "exec", nil
 
-			result := element.ProcessContext.Process.ContainerID
+	case "exec.file.change_time":
+		return "exec", nil
 
-			values = append(values, result)
+	case "exec.file.filesystem":
+		return "exec", nil
 
-			ptr = iterator.Next()
-		}
+	case "exec.file.gid":
+		return "exec", nil
 
-		return values, nil
+	case "exec.file.group":
+		return "exec", nil
 
-	case "process.ancestors.cookie":
+	case "exec.file.in_upper_layer":
+		return "exec", nil
 
-		var values []int
+	case "exec.file.inode":
+		return "exec", nil
 
-		ctx := eval.NewContext(unsafe.Pointer(e))
+	case "exec.file.mode":
+		return "exec", nil
 
-		iterator := &model.ProcessAncestorsIterator{}
-		ptr := iterator.Front(ctx)
+	case "exec.file.modification_time":
+		return "exec", nil
 
-		for ptr != nil {
+	case "exec.file.mount_id":
+		return "exec", nil
 
-			element := (*model.ProcessCacheEntry)(ptr)
+	case "exec.file.name":
+		return "exec", nil
 
-			result := int(element.ProcessContext.Process.Cookie)
+	case "exec.file.path":
+		return "exec", nil
 
-			values = append(values, result)
+	case "exec.file.rights":
+		return "exec", nil
 
-			ptr = iterator.Next()
-		}
+	case "exec.file.uid":
+		return "exec", nil
 
-		return values, nil
+	case "exec.file.user":
+		return "exec", nil
 
-	case "process.ancestors.created_at":
+	case "exec.fsgid":
+		return "exec", nil
 
-		var values []int
+	case "exec.fsgroup":
+		return "exec", nil
 
-		ctx := eval.NewContext(unsafe.Pointer(e))
+	case "exec.fsuid":
+		return "exec", nil
 
-		iterator := &model.ProcessAncestorsIterator{}
-		ptr := iterator.Front(ctx)
+	case "exec.fsuser":
+		return "exec", nil
 
-		for ptr != nil {
+	case "exec.gid":
+		return "exec", nil
 
-			element := (*model.ProcessCacheEntry)(ptr)
+	case "exec.group":
+		return "exec", nil
 
-			result := int((*Event)(ctx.Object).ResolveProcessCreatedAt(&element.Process))
+	case "exec.pid":
+		return "exec", nil
 
-			values = append(values, result)
+	case "exec.ppid":
+		return "exec", nil
 
-			ptr = iterator.Next()
-		}
+	case "exec.tid":
+		return "exec", nil
 
-		return values, nil
+	case "exec.tty_name":
+		return "exec", nil
 
-	case "process.ancestors.egid":
+	case "exec.uid":
+		return "exec", nil
 
-		var values []int
+	case "exec.user":
+		return "exec", nil
 
-		ctx := eval.NewContext(unsafe.Pointer(e))
+	case "link.file.change_time":
+		return "link", nil
 
-		iterator := &model.ProcessAncestorsIterator{}
-		ptr := iterator.Front(ctx)
+	case "link.file.destination.change_time":
+		return "link", nil
 
-		for ptr != nil {
+	case "link.file.destination.filesystem":
+		return "link", nil
 
-			element := (*model.ProcessCacheEntry)(ptr)
+	case "link.file.destination.gid":
+		return "link", nil
 
-			result := int(element.ProcessContext.Process.Credentials.EGID)
+	case "link.file.destination.group":
+		return "link", nil
 
-			values = append(values, result)
+	case "link.file.destination.in_upper_layer":
+		return "link", nil
 
-			ptr = iterator.Next()
-		}
+	case "link.file.destination.inode":
+		return "link", nil
 
-		return values, nil
+	case "link.file.destination.mode":
+		return "link", nil
 
-	case "process.ancestors.egroup":
+	case "link.file.destination.modification_time":
+		return "link", nil
 
-		var values []string
+	case "link.file.destination.mount_id":
+		return "link", nil
 
-		ctx := eval.NewContext(unsafe.Pointer(e))
+	case "link.file.destination.name":
+		return "link", nil
 
-		iterator := &model.ProcessAncestorsIterator{}
-		ptr := iterator.Front(ctx)
+	case "link.file.destination.path":
+		return "link", nil
 
-		for ptr != nil {
+	case "link.file.destination.rights":
+		return "link", nil
 
-			element := (*model.ProcessCacheEntry)(ptr)
+	case "link.file.destination.uid":
+		return "link", nil
 
-			result := element.ProcessContext.Process.Credentials.EGroup
+	case "link.file.destination.user":
+		return "link", nil
 
-			values = append(values, result)
+	case "link.file.filesystem":
+		return "link", nil
 
-			ptr = iterator.Next()
-		}
+	case "link.file.gid":
+		return "link", nil
 
-		return values, nil
+	case "link.file.group":
+		return "link", nil
 
-	case "process.ancestors.euid":
+	case "link.file.in_upper_layer":
+		return "link", nil
 
-		var values []int
+	case "link.file.inode":
+		return "link", nil
 
-		ctx := eval.NewContext(unsafe.Pointer(e))
+	case "link.file.mode":
+		return "link", nil
 
-		iterator := &model.ProcessAncestorsIterator{}
-		ptr := iterator.Front(ctx)
+	case "link.file.modification_time":
+		return "link", nil
 
-		for ptr != nil {
+	case "link.file.mount_id":
+		return "link", nil
 
-			element := (*model.ProcessCacheEntry)(ptr)
+	case "link.file.name":
+		return "link", nil
 
-			result := int(element.ProcessContext.Process.Credentials.EUID)
+	case "link.file.path":
+		return "link", nil
 
-			values = append(values, result)
+	case "link.file.rights":
+		return "link", nil
 
-			ptr = iterator.Next()
-		}
+	case "link.file.uid":
+		return "link", nil
 
-		return values, nil
+	case "link.file.user":
+		return "link", nil
 
-	case "process.ancestors.euser":
+	case "link.retval":
+		return "link", nil
 
-		var values []string
+	case "load_module.file.change_time":
+		return "load_module", nil
 
-		ctx := eval.NewContext(unsafe.Pointer(e))
+	case "load_module.file.filesystem":
+		return "load_module", nil
 
-		iterator := &model.ProcessAncestorsIterator{}
-		ptr := iterator.Front(ctx)
+	case "load_module.file.gid":
+		return "load_module", nil
 
-		for ptr != nil {
+	case "load_module.file.group":
+		return "load_module", nil
 
-			element := (*model.ProcessCacheEntry)(ptr)
+	case "load_module.file.in_upper_layer":
+		return "load_module", nil
 
-			result := element.ProcessContext.Process.Credentials.EUser
+	case "load_module.file.inode":
+		return "load_module", nil
 
-			values = append(values, result)
+	case "load_module.file.mode":
+		return "load_module", nil
 
-			ptr = iterator.Next()
-		}
+	case "load_module.file.modification_time":
+		return "load_module", nil
 
-		return values, nil
+	case "load_module.file.mount_id":
+		return "load_module", nil
 
-	case "process.ancestors.file.change_time":
+	case "load_module.file.name":
+		return "load_module", nil
 
-		var values []int
+	case "load_module.file.path":
+		return "load_module", nil
 
-		ctx := eval.NewContext(unsafe.Pointer(e))
+	case "load_module.file.rights":
+		return "load_module", nil
 
-		iterator := &model.ProcessAncestorsIterator{}
-		ptr := iterator.Front(ctx)
+	case "load_module.file.uid":
+		return "load_module", nil
 
-		for ptr != nil {
+	case "load_module.file.user":
+		return "load_module", nil
 
-			element := (*model.ProcessCacheEntry)(ptr)
+	case "load_module.loaded_from_memory":
+		return "load_module", nil
 
-			result := int(element.ProcessContext.Process.FileFields.CTime)
+	case "load_module.name":
+		return "load_module", nil
 
-			values = append(values, result)
+	case "load_module.retval":
+		return "load_module", nil
 
-			ptr = iterator.Next()
-		}
+	case "mkdir.file.change_time":
+		return "mkdir", nil
 
-		return values, nil
+	case "mkdir.file.destination.mode":
+		return "mkdir", nil
 
-	case "process.ancestors.file.filesystem":
+	case "mkdir.file.destination.rights":
+		return "mkdir", nil
 
-		var values []string
+	case "mkdir.file.filesystem":
+		return "mkdir", nil
 
-		ctx := eval.NewContext(unsafe.Pointer(e))
+	case "mkdir.file.gid":
+		return "mkdir", nil
 
-		iterator := &model.ProcessAncestorsIterator{}
-		ptr := iterator.Front(ctx)
+	case "mkdir.file.group":
+		return "mkdir", nil
 
-		for ptr != nil {
+	case "mkdir.file.in_upper_layer":
+		return "mkdir", nil
 
-			element := (*model.ProcessCacheEntry)(ptr)
+	case "mkdir.file.inode":
+		return "mkdir", nil
 
-			result := element.ProcessContext.Process.Filesystem
+	case "mkdir.file.mode":
+		return "mkdir", nil
 
-			values = append(values, result)
+	case "mkdir.file.modification_time":
+		return "mkdir", nil
 
-			ptr = iterator.Next()
-		}
+	case "mkdir.file.mount_id":
+		return "mkdir", nil
 
-		return values, nil
+	case "mkdir.file.name":
+		return "mkdir", nil
 
-	case "process.ancestors.file.gid":
+	case "mkdir.file.path":
+		return "mkdir", nil
 
-		var values []int
+	case "mkdir.file.rights":
+		return "mkdir", nil
 
-		ctx := eval.NewContext(unsafe.Pointer(e))
+	case "mkdir.file.uid":
+		return "mkdir", nil
 
-		iterator := &model.ProcessAncestorsIterator{}
-		ptr := iterator.Front(ctx)
+	case "mkdir.file.user":
+		return "mkdir", nil
 
-		for ptr != nil {
+	case "mkdir.retval":
+		return "mkdir", nil
 
-			element := (*model.ProcessCacheEntry)(ptr)
+	case "mmap.file.change_time":
+		return "mmap", nil
 
-			result := int(element.ProcessContext.Process.FileFields.GID)
+	case "mmap.file.filesystem":
+		return "mmap", nil
 
-			values = append(values, result)
+	case "mmap.file.gid":
+		return "mmap", nil
 
-			ptr = iterator.Next()
-		}
+	case "mmap.file.group":
+		return "mmap", nil
 
-		return values, nil
+	case "mmap.file.in_upper_layer":
+		return "mmap", nil
 
-	case "process.ancestors.file.group":
+	case "mmap.file.inode":
+		return "mmap", nil
 
-		var values []string
+	case "mmap.file.mode":
+		return "mmap", nil
 
-		ctx := eval.NewContext(unsafe.Pointer(e))
+	case "mmap.file.modification_time":
+		return "mmap", nil
 
-		iterator := &model.ProcessAncestorsIterator{}
-		ptr := iterator.Front(ctx)
+	case "mmap.file.mount_id":
+		return "mmap", nil
 
-		for ptr != nil {
+	case "mmap.file.name":
+		return "mmap", nil
 
-			element := (*model.ProcessCacheEntry)(ptr)
+	case "mmap.file.path":
+		return "mmap", nil
 
-			result := (*Event)(ctx.Object).ResolveFileFieldsGroup(&element.FileFields)
+	case "mmap.file.rights":
+		return "mmap", nil
 
-			values = append(values, result)
+	case "mmap.file.uid":
+		return "mmap", nil
 
-			ptr = iterator.Next()
-		}
+	case "mmap.file.user":
+		return "mmap", nil
 
-		return values, nil
+	case "mmap.flags":
+		return "mmap", nil
 
-	case "process.ancestors.file.in_upper_layer":
+	case "mmap.protection":
+		return "mmap", nil
 
-		var values []bool
+	case "mmap.retval":
+		return "mmap", nil
 
-		ctx := eval.NewContext(unsafe.Pointer(e))
+	case "mprotect.req_protection":
+		return "mprotect", nil
 
-		iterator := &model.ProcessAncestorsIterator{}
-		ptr := iterator.Front(ctx)
+	case "mprotect.retval":
+		return "mprotect", nil
 
-		for ptr != nil {
+	case "mprotect.vm_protection":
+		return "mprotect", nil
 
-			element := (*model.ProcessCacheEntry)(ptr)
+	case "open.file.change_time":
+		return "open", nil
 
-			result := (*Event)(ctx.Object).ResolveFileFieldsInUpperLayer(&element.FileFields)
+	case "open.file.destination.mode":
+		return "open", nil
 
-			values = append(values, result)
+	case "open.file.filesystem":
+		return "open", nil
 
-			ptr = iterator.Next()
-		}
+	case "open.file.gid":
+		return "open", nil
 
-		return values, nil
+	case "open.file.group":
+		return "open", nil
 
-	case "process.ancestors.file.inode":
+	case "open.file.in_upper_layer":
+		return "open", nil
 
-		var values []int
+	case "open.file.inode":
+		return "open", nil
 
-		ctx := eval.NewContext(unsafe.Pointer(e))
+	case "open.file.mode":
+		return "open", nil
 
-		iterator := &model.ProcessAncestorsIterator{}
-		ptr := iterator.Front(ctx)
+	case "open.file.modification_time":
+		return "open", nil
 
-		for ptr != nil {
+	case "open.file.mount_id":
+		return "open", nil
 
-			element := (*model.ProcessCacheEntry)(ptr)
+	case "open.file.name":
+		return "open", nil
 
-			result := int(element.ProcessContext.Process.FileFields.Inode)
+	case "open.file.path":
+		return "open", nil
 
-			values = append(values, result)
+	case "open.file.rights":
+		return "open", nil
 
-			ptr = iterator.Next()
-		}
+	case "open.file.uid":
+		return "open", nil
 
-		return values, nil
+	case "open.file.user":
+		return "open", nil
 
-	case "process.ancestors.file.mode":
+	case "open.flags":
+		return "open", nil
 
-		var values []int
+	case "open.retval":
+		return "open", nil
 
-		ctx := eval.NewContext(unsafe.Pointer(e))
+	case "process.ancestors.cap_effective":
+		return "*", nil
 
-		iterator := &model.ProcessAncestorsIterator{}
-		ptr := iterator.Front(ctx)
+	case "process.ancestors.cap_permitted":
+		return "*", nil
 
-		for ptr != nil {
+	case "process.ancestors.comm":
+		return "*", nil
 
-			element := (*model.ProcessCacheEntry)(ptr)
+	case "process.ancestors.container.id":
+		return "*", nil
 
-			result := int(element.ProcessContext.Process.FileFields.Mode)
+	case "process.ancestors.cookie":
+		return "*", nil
 
-			values = append(values, result)
+	case "process.ancestors.created_at":
+		return "*", nil
 
-			ptr = iterator.Next()
-		}
+	case "process.ancestors.egid":
+		return "*", nil
 
-		return values, nil
+	case "process.ancestors.egroup":
+		return "*", nil
 
-	case "process.ancestors.file.modification_time":
+	case "process.ancestors.euid":
+		return "*", nil
 
-		var values []int
+	case "process.ancestors.euser":
+		return "*", nil
 
-		ctx := eval.NewContext(unsafe.Pointer(e))
+	case "process.ancestors.file.change_time":
+		return "*", nil
 
-		iterator := &model.ProcessAncestorsIterator{}
-		ptr := iterator.Front(ctx)
+	case "process.ancestors.file.filesystem":
+		return "*", nil
 
-		for ptr != nil {
+	case "process.ancestors.file.gid":
+		return "*", nil
 
-			element := (*model.ProcessCacheEntry)(ptr)
+	case "process.ancestors.file.group":
+		return "*", nil
 
-			result := int(element.ProcessContext.Process.FileFields.MTime)
+	case "process.ancestors.file.in_upper_layer":
+		return "*", nil
 
-			values = append(values, result)
+	case "process.ancestors.file.inode":
+		return "*", nil
 
-			ptr = iterator.Next()
-		}
+	case "process.ancestors.file.mode":
+		return "*", nil
 
-		return values, nil
+	case "process.ancestors.file.modification_time":
+		return "*", nil
 
 	case "process.ancestors.file.mount_id":
+		return "*", nil
 
-		var values []int
+	case "process.ancestors.file.name":
+		return "*", nil
 
-		ctx := eval.NewContext(unsafe.Pointer(e))
+	case "process.ancestors.file.path":
+		return "*", nil
 
-		iterator := &model.ProcessAncestorsIterator{}
-		ptr := iterator.Front(ctx)
+	case "process.ancestors.file.rights":
+		return "*", nil
 
-		for ptr != nil {
+	case "process.ancestors.file.uid":
+		return "*", nil
 
-			element := (*model.ProcessCacheEntry)(ptr)
+	case "process.ancestors.file.user":
+		return "*", nil
 
-			result := int(element.ProcessContext.Process.FileFields.MountID)
+	case "process.ancestors.fsgid":
+		return "*", nil
 
-			values = append(values, result)
+	case "process.ancestors.fsgroup":
+		return "*", nil
 
-			ptr = iterator.Next()
-		}
+	case "process.ancestors.fsuid":
+		return "*", nil
 
-		return values, nil
+	case "process.ancestors.fsuser":
+		return "*", nil
 
-	case "process.ancestors.file.name":
+	case "process.ancestors.gid":
+		return "*", nil
 
-		var values []string
+	case "process.ancestors.group":
+		return "*", nil
 
-		ctx := eval.NewContext(unsafe.Pointer(e))
+	case "process.ancestors.pid":
+		return "*", nil
 
-		iterator := &model.ProcessAncestorsIterator{}
-		ptr := iterator.Front(ctx)
+	case "process.ancestors.ppid":
+		return "*", nil
 
-		for ptr != nil {
+	case "process.ancestors.tid":
+		return "*", nil
 
-			element := (*model.ProcessCacheEntry)(ptr)
+	case "process.ancestors.tty_name":
+		return "*", nil
 
-			result := element.ProcessContext.Process.BasenameStr
+	case "process.ancestors.uid":
+		return "*", nil
 
-			values = append(values, result)
+	case "process.ancestors.user":
+		return "*", nil
 
-			ptr = iterator.Next()
-		}
+	case "process.cap_effective":
+		return "*", nil
 
-		return values, nil
+	case "process.cap_permitted":
+		return "*", nil
 
-	case "process.ancestors.file.path":
+	case "process.comm":
+		return "*", nil
 
-		var values []string
+	case "process.container.id":
+		return "*", nil
 
-		ctx := eval.NewContext(unsafe.Pointer(e))
+	case "process.cookie":
+		return "*", nil
 
-		iterator := &model.ProcessAncestorsIterator{}
-		ptr := iterator.Front(ctx)
+	case "process.created_at":
+		return "*", nil
 
-		for ptr != nil {
+	case "process.egid":
+		return "*", nil
 
-			element := (*model.ProcessCacheEntry)(ptr)
+	case "process.egroup":
+		return "*", nil
 
-			result := element.ProcessContext.Process.PathnameStr
+	case "process.euid":
+		return "*", nil
+
+	case "process.euser":
+		return "*", nil
 
-			values = append(values, result)
+	case "process.file.change_time":
+		return "*", nil
 
-			ptr = iterator.Next()
-		}
+	case "process.file.filesystem":
+		return "*", nil
 
-		return values, nil
+	case "process.file.gid":
+		return "*", nil
 
-	case "process.ancestors.file.rights":
+	case "process.file.group":
+		return "*", nil
 
-		var values []int
+	case "process.file.in_upper_layer":
+		return "*", nil
 
-		ctx := eval.NewContext(unsafe.Pointer(e))
+	case "process.file.inode":
+		return "*", nil
 
-		iterator := &model.ProcessAncestorsIterator{}
-		ptr := iterator.Front(ctx)
+	case "process.file.mode":
+		return "*", nil
 
-		for ptr != nil {
+	case "process.file.modification_time":
+		return "*", nil
 
-			element := (*model.ProcessCacheEntry)(ptr)
+	case "process.file.mount_id":
+		return "*", nil
 
-			result := int((*Event)(ctx.Object).ResolveRights(&element.FileFields))
+	case "process.file.name":
+		return "*", nil
 
-			values = append(values, result)
+	case "process.file.path":
+		return "*", nil
 
-			ptr = iterator.Next()
-		}
+	case "process.file.rights":
+		return "*", nil
 
-		return values, nil
+	case "process.file.uid":
+		return "*", nil
 
-	case "process.ancestors.file.uid":
+	case "process.file.user":
+		return "*", nil
 
-		var values []int
+	case "process.fsgid":
+		return "*", nil
 
-		ctx := eval.NewContext(unsafe.Pointer(e))
+	case "process.fsgroup":
+		return "*", nil
 
-		iterator := &model.ProcessAncestorsIterator{}
-		ptr := iterator.Front(ctx)
+	case "process.fsuid":
+		return "*", nil
 
-		for ptr != nil {
+	case "process.fsuser":
+		return "*", nil
 
-			element := (*model.ProcessCacheEntry)(ptr)
+	case "process.gid":
+		return "*", nil
 
-			result := int(element.ProcessContext.Process.FileFields.UID)
+	case "process.group":
+		return "*", nil
 
-			values = append(values, result)
+	case "process.pid":
+		return "*", nil
 
-			ptr = iterator.Next()
-		}
+	case "process.ppid":
+		return "*", nil
 
-		return values, nil
+	case "process.tid":
+		return "*", nil
 
-	case "process.ancestors.file.user":
+	case "process.tty_name":
+		return "*", nil
 
-		var values []string
+	case "process.uid":
+		return "*", nil
 
-		ctx := eval.NewContext(unsafe.Pointer(e))
+	case "process.user":
+		return "*", nil
 
-		iterator := &model.ProcessAncestorsIterator{}
-		ptr := iterator.Front(ctx)
+	case "ptrace.request":
+		return "ptrace", nil
 
-		for ptr != nil {
+	case "ptrace.retval":
+		return "ptrace", nil
 
-			element := (*model.ProcessCacheEntry)(ptr)
+	case "ptrace.tracee.cap_effective":
+		return "ptrace", nil
 
-			result := (*Event)(ctx.Object).ResolveFileFieldsUser(&element.FileFields)
+	case "ptrace.tracee.cap_permitted":
+		return "ptrace", nil
 
-			values = append(values, result)
+	case "ptrace.tracee.comm":
+		return "ptrace", nil
 
-			ptr = iterator.Next()
-		}
+	case "ptrace.tracee.container.id":
+		return "ptrace", nil
 
-		return values, nil
+	case "ptrace.tracee.cookie":
+		return "ptrace", nil
 
-	case "process.ancestors.fsgid":
+	case "ptrace.tracee.created_at":
+		return "ptrace", nil
 
-		var values []int
+	case "ptrace.tracee.egid":
+		return "ptrace", nil
 
-		ctx := eval.NewContext(unsafe.Pointer(e))
+	case "ptrace.tracee.egroup":
+		return "ptrace", nil
 
-		iterator := &model.ProcessAncestorsIterator{}
-		ptr := iterator.Front(ctx)
+	case "ptrace.tracee.euid":
+		return "ptrace", nil
 
-		for ptr != nil {
+	case "ptrace.tracee.euser":
+		return "ptrace", nil
 
-			element := (*model.ProcessCacheEntry)(ptr)
+	case "ptrace.tracee.file.change_time":
+		return "ptrace", nil
 
-			result := int(element.ProcessContext.Process.Credentials.FSGID)
+	case "ptrace.tracee.file.filesystem":
+		return "ptrace", nil
 
-			values = append(values, result)
+	case "ptrace.tracee.file.gid":
+		return "ptrace", nil
 
-			ptr = iterator.Next()
-		}
+	case "ptrace.tracee.file.group":
+		return "ptrace", nil
 
-		return values, nil
+	case "ptrace.tracee.file.in_upper_layer":
+		return "ptrace", nil
 
-	case "process.ancestors.fsgroup":
+	case "ptrace.tracee.file.inode":
+		return "ptrace", nil
 
-		var values []string
+	case "ptrace.tracee.file.mode":
+		return "ptrace", nil
 
-		ctx := eval.NewContext(unsafe.Pointer(e))
+	case "ptrace.tracee.file.modification_time":
+		return "ptrace", nil
 
-		iterator := &model.ProcessAncestorsIterator{}
-		ptr := iterator.Front(ctx)
+	case "ptrace.tracee.file.mount_id":
+		return "ptrace", nil
 
-		for ptr != nil {
+	case "ptrace.tracee.file.name":
+		return "ptrace", nil
 
-			element := (*model.ProcessCacheEntry)(ptr)
+	case "ptrace.tracee.file.path":
+		return "ptrace", nil
 
-			result := element.ProcessContext.Process.Credentials.FSGroup
+	case "ptrace.tracee.file.rights":
+		return "ptrace", nil
 
-			values = append(values, result)
+	case "ptrace.tracee.file.uid":
+		return "ptrace", nil
 
-			ptr = iterator.Next()
-		}
+	case "ptrace.tracee.file.user":
+		return "ptrace", nil
 
-		return values, nil
+	case "ptrace.tracee.fsgid":
+		return "ptrace", nil
 
-	case "process.ancestors.fsuid":
+	case "ptrace.tracee.fsgroup":
+		return "ptrace", nil
 
-		var values []int
+	case "ptrace.tracee.fsuid":
+		return "ptrace", nil
 
-		ctx := eval.NewContext(unsafe.Pointer(e))
+	case "ptrace.tracee.fsuser":
+		return "ptrace", nil
 
-		iterator := &model.ProcessAncestorsIterator{}
-		ptr := iterator.Front(ctx)
+	case "ptrace.tracee.gid":
+		return "ptrace", nil
 
-		for ptr != nil {
+	case "ptrace.tracee.group":
+		return "ptrace", nil
 
-			element := (*model.ProcessCacheEntry)(ptr)
+	case "ptrace.tracee.pid":
+		return "ptrace", nil
 
-			result := int(element.ProcessContext.Process.Credentials.FSUID)
+	case "ptrace.tracee.ppid":
+		return "ptrace", nil
 
-			values = append(values, result)
+	case "ptrace.tracee.tid":
+		return "ptrace", nil
 
-			ptr = iterator.Next()
-		}
+	case "ptrace.tracee.tty_name":
+		return "ptrace", nil
 
-		return values, nil
+	case "ptrace.tracee.uid":
+		return "ptrace", nil
 
-	case "process.ancestors.fsuser":
+	case "ptrace.tracee.user":
+		return "ptrace", nil
 
-		var values []string
+	case "removexattr.file.change_time":
+		return "removexattr", nil
 
-		ctx := eval.NewContext(unsafe.Pointer(e))
+	case "removexattr.file.destination.name":
+		return "removexattr", nil
 
-		iterator := &model.ProcessAncestorsIterator{}
-		ptr := iterator.Front(ctx)
+	case "removexattr.file.destination.namespace":
+		return "removexattr", nil
 
-		for ptr != nil {
+	case "removexattr.file.filesystem":
+		return "removexattr", nil
 
-			element := (*model.ProcessCacheEntry)(ptr)
+	case "removexattr.file.gid":
+		return "removexattr", nil
 
-			result := element.ProcessContext.Process.Credentials.FSUser
+	case "removexattr.file.group":
+		return "removexattr", nil
 
-			values = append(values, result)
+	case "removexattr.file.in_upper_layer":
+		return "removexattr", nil
 
-			ptr = iterator.Next()
-		}
+	case "removexattr.file.inode":
+		return "removexattr", nil
 
-		return values, nil
+	case "removexattr.file.mode":
+		return "removexattr", nil
 
-	case "process.ancestors.gid":
+	case "removexattr.file.modification_time":
+		return "removexattr", nil
 
-		var values []int
+	case "removexattr.file.mount_id":
+		return "removexattr", nil
 
-		ctx := eval.NewContext(unsafe.Pointer(e))
+	case "removexattr.file.name":
+		return "removexattr", nil
 
-		iterator := &model.ProcessAncestorsIterator{}
-		ptr := iterator.Front(ctx)
+	case "removexattr.file.path":
+		return "removexattr", nil
 
-		for ptr != nil {
+	case "removexattr.file.rights":
+		return "removexattr", nil
 
-			element := (*model.ProcessCacheEntry)(ptr)
+	case "removexattr.file.uid":
+		return "removexattr", nil
 
-			result := int(element.ProcessContext.Process.Credentials.GID)
+	case "removexattr.file.user":
+		return "removexattr", nil
 
-			values = append(values, result)
+	case "removexattr.retval":
+		return "removexattr", nil
 
-			ptr = iterator.Next()
-		}
+	case "rename.file.change_time":
+		return "rename", nil
 
-		return values, nil
+	case "rename.file.destination.change_time":
+		return "rename", nil
 
-	case "process.ancestors.group":
+	case "rename.file.destination.filesystem":
+		return "rename", nil
 
-		var values []string
+	case "rename.file.destination.gid":
+		return "rename", nil
 
-		ctx := eval.NewContext(unsafe.Pointer(e))
+	case "rename.file.destination.group":
+		return "rename", nil
 
-		iterator := &model.ProcessAncestorsIterator{}
-		ptr := iterator.Front(ctx)
+	case "rename.file.destination.in_upper_layer":
+		return "rename", nil
 
-		for ptr != nil {
+	case "rename.file.destination.inode":
+		return "rename", nil
 
-			element := (*model.ProcessCacheEntry)(ptr)
+	case "rename.file.destination.mode":
+		return "rename", nil
 
-			result := element.ProcessContext.Process.Credentials.Group
+	case "rename.file.destination.modification_time":
+		return "rename", nil
 
-			values = append(values, result)
+	case "rename.file.destination.mount_id":
+		return "rename", nil
 
-			ptr = iterator.Next()
-		}
+	case "rename.file.destination.name":
+		return "rename", nil
 
-		return values, nil
+	case "rename.file.destination.path":
+		return "rename", nil
 
-	case "process.ancestors.pid":
+	case "rename.file.destination.rights":
+		return "rename", nil
 
-		var values []int
+	case "rename.file.destination.uid":
+		return "rename", nil
 
-		ctx := eval.NewContext(unsafe.Pointer(e))
+	case "rename.file.destination.user":
+		return "rename", nil
 
-		iterator := &model.ProcessAncestorsIterator{}
-		ptr := iterator.Front(ctx)
+	case "rename.file.filesystem":
+		return "rename", nil
 
-		for ptr != nil {
+	case "rename.file.gid":
+		return "rename", nil
 
-			element := (*model.ProcessCacheEntry)(ptr)
+	case "rename.file.group":
+		return "rename", nil
 
-			result := int(element.ProcessContext.Process.Pid)
+	case "rename.file.in_upper_layer":
+		return "rename", nil
 
-			values = append(values, result)
+	case "rename.file.inode":
+		return "rename", nil
 
-			ptr = iterator.Next()
-		}
+	case "rename.file.mode":
+		return "rename", nil
 
-		return values, nil
+	case "rename.file.modification_time":
+		return "rename", nil
 
-	case "process.ancestors.ppid":
+	case "rename.file.mount_id":
+		return "rename", nil
 
-		var values []int
+	case "rename.file.name":
+		return "rename", nil
 
-		ctx := eval.NewContext(unsafe.Pointer(e))
+	case "rename.file.path":
+		return "rename", nil
 
-		iterator := &model.ProcessAncestorsIterator{}
-		ptr := iterator.Front(ctx)
+	case "rename.file.rights":
+		return "rename", nil
 
-		for ptr != nil {
+	case "rename.file.uid":
+		return "rename", nil
 
-			element := (*model.ProcessCacheEntry)(ptr)
+	case "rename.file.user":
+		return "rename", nil
 
-			result := int(element.ProcessContext.Process.PPid)
+	case "rename.retval":
+		return "rename", nil
 
-			values = append(values, result)
+	case "rmdir.file.change_time":
+		return "rmdir", nil
 
-			ptr = iterator.Next()
-		}
+	case "rmdir.file.filesystem":
+		return "rmdir", nil
 
-		return values, nil
+	case "rmdir.file.gid":
+		return "rmdir", nil
 
-	case "process.ancestors.tid":
+	case "rmdir.file.group":
+		return "rmdir", nil
 
-		var values []int
+	case "rmdir.file.in_upper_layer":
+		return "rmdir", nil
 
-		ctx := eval.NewContext(unsafe.Pointer(e))
+	case "rmdir.file.inode":
+		return "rmdir", nil
 
-		iterator := &model.ProcessAncestorsIterator{}
-		ptr := iterator.Front(ctx)
+	case "rmdir.file.mode":
+		return "rmdir", nil
 
-		for ptr != nil {
+	case "rmdir.file.modification_time":
+		return "rmdir", nil
 
-			element := (*model.ProcessCacheEntry)(ptr)
+	case "rmdir.file.mount_id":
+		return "rmdir", nil
 
-			result := int(element.ProcessContext.Process.Tid)
+	case "rmdir.file.name":
+		return "rmdir", nil
 
-			values = append(values, result)
+	case "rmdir.file.path":
+		return "rmdir", nil
 
-			ptr = iterator.Next()
-		}
+	case "rmdir.file.rights":
+		return "rmdir", nil
 
-		return values, nil
+	case "rmdir.file.uid":
+		return "rmdir", nil
 
-	case "process.ancestors.tty_name":
+	case "rmdir.file.user":
+		return "rmdir", nil
 
-		var values []string
+	case "rmdir.retval":
+		return "rmdir", nil
 
-		ctx := eval.NewContext(unsafe.Pointer(e))
+	case "selinux.bool.name":
+		return "selinux", nil
 
-		iterator := &model.ProcessAncestorsIterator{}
-		ptr := iterator.Front(ctx)
+	case "selinux.bool.state":
+		return "selinux", nil
 
-		for ptr != nil {
+	case "selinux.bool_commit.state":
+		return "selinux", nil
 
-			element := (*model.ProcessCacheEntry)(ptr)
+	case "selinux.enforce.status":
+		return "selinux", nil
 
-			result := element.ProcessContext.Process.TTYName
+	case "setgid.egid":
+		return "setgid", nil
 
-			values = append(values, result)
+	case "setgid.egroup":
+		return "setgid", nil
 
-			ptr = iterator.Next()
-		}
+	case "setgid.fsgid":
+		return "setgid", nil
 
-		return values, nil
+	case "setgid.fsgroup":
+		return "setgid", nil
 
-	case "process.ancestors.uid":
+	case "setgid.gid":
+		return "setgid", nil
 
-		var values []int
+	case "setgid.group":
+		return "setgid", nil
 
-		ctx := eval.NewContext(unsafe.Pointer(e))
+	case "setuid.euid":
+		return "setuid", nil
 
-		iterator := &model.ProcessAncestorsIterator{}
-		ptr := iterator.Front(ctx)
+	case "setuid.euser":
+		return "setuid", nil
 
-		for ptr != nil {
+	case "setuid.fsuid":
+		return "setuid", nil
 
-			element := (*model.ProcessCacheEntry)(ptr)
+	case "setuid.fsuser":
+		return "setuid", nil
 
-			result := int(element.ProcessContext.Process.Credentials.UID)
+	case "setuid.uid":
+		return "setuid", nil
 
-			values = append(values, result)
+	case "setuid.user":
+		return "setuid", nil
 
-			ptr = iterator.Next()
-		}
+	case "setxattr.file.change_time":
+		return "setxattr", nil
 
-		return values, nil
+	case "setxattr.file.destination.name":
+		return "setxattr", nil
 
-	case "process.ancestors.user":
+	case "setxattr.file.destination.namespace":
+		return "setxattr", nil
 
-		var values []string
+	case "setxattr.file.filesystem":
+		return "setxattr", nil
 
-		ctx := eval.NewContext(unsafe.Pointer(e))
+	case "setxattr.file.gid":
+		return "setxattr", nil
 
-		iterator := &model.ProcessAncestorsIterator{}
-		ptr := iterator.Front(ctx)
+	case "setxattr.file.group":
+		return "setxattr", nil
 
-		for ptr != nil {
+	case "setxattr.file.in_upper_layer":
+		return "setxattr", nil
 
-			element := (*model.ProcessCacheEntry)(ptr)
+	case "setxattr.file.inode":
+		return "setxattr", nil
 
-			result := element.ProcessContext.Process.Credentials.User
+	case "setxattr.file.mode":
+		return "setxattr", nil
 
-			values = append(values, result)
+	case "setxattr.file.modification_time":
+		return "setxattr", nil
 
-			ptr = iterator.Next()
-		}
+	case "setxattr.file.mount_id":
+		return "setxattr", nil
 
-		return values, nil
+	case "setxattr.file.name":
+		return "setxattr", nil
 
-	case "process.cap_effective":
+	case "setxattr.file.path":
+		return "setxattr", nil
 
-		return int(e.ProcessContext.Process.Credentials.CapEffective), nil
+	case "setxattr.file.rights":
+		return "setxattr", nil
 
-	case "process.cap_permitted":
+	case "setxattr.file.uid":
+		return "setxattr", nil
 
-		return int(e.ProcessContext.Process.Credentials.CapPermitted), nil
+	case "setxattr.file.user":
+		return "setxattr", nil
 
-	case "process.comm":
+	case "setxattr.retval":
+		return "setxattr", nil
 
-		return e.ProcessContext.Process.Comm, nil
+	case "signal.pid":
+		return "signal", nil
 
-	case "process.container.id":
+	case "signal.retval":
+		return "signal", nil
 
-		return e.ProcessContext.Process.ContainerID, nil
+	case "signal.target.cap_effective":
+		return "signal", nil
 
-	case "process.cookie":
+	case "signal.target.cap_permitted":
+		return "signal", nil
 
-		return int(e.ProcessContext.Process.Cookie), nil
+	case "signal.target.comm":
+		return "signal", nil
 
-	case "process.created_at":
+	case "signal.target.container.id":
+		return "signal", nil
 
-		return int(e.ResolveProcessCreatedAt(&e.ProcessContext.Process)), nil
+	case "signal.target.cookie":
+		return "signal", nil
 
-	case "process.egid":
+	case "signal.target.created_at":
+		return "signal", nil
 
-		return int(e.ProcessContext.Process.Credentials.EGID), nil
+	case "signal.target.egid":
+		return "signal", nil
 
-	case "process.egroup":
+	case "signal.target.egroup":
+		return "signal", nil
 
-		return e.ProcessContext.Process.Credentials.EGroup, nil
+	case "signal.target.euid":
+		return "signal", nil
 
-	case "process.euid":
+	case "signal.target.euser":
+		return "signal", nil
 
-		return int(e.ProcessContext.Process.Credentials.EUID), nil
+	case "signal.target.file.change_time":
+		return "signal", nil
 
-	case "process.euser":
+	case "signal.target.file.filesystem":
+		return "signal", nil
 
-		return e.ProcessContext.Process.Credentials.EUser, nil
+	case "signal.target.file.gid":
+		return "signal", nil
 
-	case "process.file.change_time":
+	case "signal.target.file.group":
+		return "signal", nil
 
-		return int(e.ProcessContext.Process.FileFields.CTime), nil
+	case "signal.target.file.in_upper_layer":
+		return "signal", nil
 
-	case "process.file.filesystem":
+	case "signal.target.file.inode":
+		return "signal", nil
 
-		return e.ProcessContext.Process.Filesystem, nil
+	case "signal.target.file.mode":
+		return "signal", nil
 
-	case "process.file.gid":
+	case "signal.target.file.modification_time":
+		return "signal", nil
 
-		return int(e.ProcessContext.Process.FileFields.GID), nil
+	case "signal.target.file.mount_id":
+		return "signal", nil
 
-	case "process.file.group":
+	case "signal.target.file.name":
+		return "signal", nil
 
-		return e.ResolveFileFieldsGroup(&e.ProcessContext.Process.FileFields), nil
+	case "signal.target.file.path":
+		return "signal", nil
 
-	case "process.file.in_upper_layer":
+	case "signal.target.file.rights":
+		return "signal", nil
 
-		return e.ResolveFileFieldsInUpperLayer(&e.ProcessContext.Process.FileFields), nil
+	case "signal.target.file.uid":
+		return "signal", nil
 
-	case "process.file.inode":
+	case "signal.target.file.user":
+		return "signal", nil
 
-		return int(e.ProcessContext.Process.FileFields.Inode), nil
+	case "signal.target.fsgid":
+		return "signal", nil
 
-	case "process.file.mode":
+	case "signal.target.fsgroup":
+		return "signal", nil
 
-		return int(e.ProcessContext.Process.FileFields.Mode), nil
+	case "signal.target.fsuid":
+		return "signal", nil
 
-	case "process.file.modification_time":
+	case "signal.target.fsuser":
+		return "signal", nil
 
-		return int(e.ProcessContext.Process.FileFields.MTime), nil
+	case "signal.target.gid":
+		return "signal", nil
 
-	case "process.file.mount_id":
+	case "signal.target.group":
+		return "signal", nil
 
-		return int(e.ProcessContext.Process.FileFields.MountID), nil
+	case "signal.target.pid":
+		return "signal", nil
 
-	case "process.file.name":
+	case "signal.target.ppid":
+		return "signal", nil
 
-		return e.ProcessContext.Process.BasenameStr, nil
+	case "signal.target.tid":
+		return "signal", nil
 
-	case "process.file.path":
+	case "signal.target.tty_name":
+		return "signal", nil
 
-		return e.ProcessContext.Process.PathnameStr, nil
+	case "signal.target.uid":
+		return "signal", nil
 
-	case "process.file.rights":
+	case "signal.target.user":
+		return "signal", nil
 
-		return int(e.ResolveRights(&e.ProcessContext.Process.FileFields)), nil
+	case "signal.type":
+		return "signal", nil
 
-	case "process.file.uid":
+	case "unlink.file.change_time":
+		return "unlink", nil
 
-		return int(e.ProcessContext.Process.FileFields.UID), nil
+	case "unlink.file.filesystem":
+		return "unlink", nil
 
-	case "process.file.user":
+	case "unlink.file.gid":
+		return "unlink", nil
 
-		return e.ResolveFileFieldsUser(&e.ProcessContext.Process.FileFields), nil
+	case "unlink.file.group":
+		return "unlink", nil
 
-	case "process.fsgid":
+	case "unlink.file.in_upper_layer":
+		return "unlink", nil
 
-		return int(e.ProcessContext.Process.Credentials.FSGID), nil
+	case "unlink.file.inode":
+		return "unlink", nil
 
-	case "process.fsgroup":
+	case "unlink.file.mode":
+		return "unlink", nil
 
-		return e.ProcessContext.Process.Credentials.FSGroup, nil
+	case "unlink.file.modification_time":
+		return "unlink", nil
 
-	case "process.fsuid":
+	case "unlink.file.mount_id":
+		return "unlink", nil
 
-		return int(e.ProcessContext.Process.Credentials.FSUID), nil
+	case "unlink.file.name":
+		return "unlink", nil
 
-	case "process.fsuser":
+	case "unlink.file.path":
+		return "unlink", nil
 
-		return e.ProcessContext.Process.Credentials.FSUser, nil
+	case "unlink.file.rights":
+		return "unlink", nil
 
-	case "process.gid":
+	case "unlink.file.uid":
+		return "unlink", nil
 
-		return int(e.ProcessContext.Process.Credentials.GID), nil
+	case "unlink.file.user":
+		return "unlink", nil
 
-	case "process.group":
+	case "unlink.retval":
+		return "unlink", nil
 
-		return e.ProcessContext.Process.Credentials.Group, nil
+	case "unload_module.name":
+		return "unload_module", nil
 
-	case "process.pid":
+	case "unload_module.retval":
+		return "unload_module", nil
 
-		return int(e.ProcessContext.Process.Pid), nil
+	case "utimes.file.change_time":
+		return "utimes", nil
 
-	case "process.ppid":
+	case "utimes.file.filesystem":
+		return "utimes", nil
 
-		return int(e.ProcessContext.Process.PPid), nil
+	case "utimes.file.gid":
+		return "utimes", nil
 
-	case "process.tid":
+	case "utimes.file.group":
+		return "utimes", nil
 
-		return int(e.ProcessContext.Process.Tid), nil
+	case "utimes.file.in_upper_layer":
+		return "utimes", nil
 
-	case "process.tty_name":
+	case "utimes.file.inode":
+		return "utimes", nil
 
-		return e.ProcessContext.Process.TTYName, nil
+	case "utimes.file.mode":
+		return "utimes", nil
 
-	case "process.uid":
+	case "utimes.file.modification_time":
+		return "utimes", nil
 
-		return int(e.ProcessContext.Process.Credentials.UID), nil
+	case "utimes.file.mount_id":
+		return "utimes", nil
 
-	case "process.user":
+	case "utimes.file.name":
+		return "utimes", nil
 
-		return e.ProcessContext.Process.Credentials.User, nil
+	case "utimes.file.path":
+		return "utimes", nil
 
-	case "removexattr.file.change_time":
+	case "utimes.file.rights":
+		return "utimes", nil
 
-		return int(e.RemoveXAttr.File.FileFields.CTime), nil
+	case "utimes.file.uid":
+		return "utimes", nil
 
-	case "removexattr.file.destination.name":
+	case "utimes.file.user":
+		return "utimes", nil
 
-		return e.ResolveXAttrName(&e.RemoveXAttr), nil
+	case "utimes.retval":
+		return "utimes", nil
 
-	case "removexattr.file.destination.namespace":
+	}
 
-		return e.ResolveXAttrNamespace(&e.RemoveXAttr), nil
+	return "", &eval.ErrFieldNotFound{Field: field}
+}
 
-	case "removexattr.file.filesystem":
+func (e *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
+	switch field {
 
-		return e.ResolveFileFilesystem(&e.RemoveXAttr.File), nil
+	case "accept.addr.family":
 
-	case "removexattr.file.gid":
+		return reflect.Int, nil
 
-		return int(e.RemoveXAttr.File.FileFields.GID), nil
+	case "accept.addr.ip":
 
-	case "removexattr.file.group":
+		return reflect.Struct, nil
 
-		return e.ResolveFileFieldsGroup(&e.RemoveXAttr.File.FileFields), nil
+	case "accept.addr.port":
 
-	case "removexattr.file.in_upper_layer":
+		return reflect.Int, nil
 
-		return e.ResolveFileFieldsInUpperLayer(&e.RemoveXAttr.File.FileFields), nil
+	case "accept.retval":
 
-	case "removexattr.file.inode":
+		return reflect.Int, nil
 
-		return int(e.RemoveXAttr.File.FileFields.Inode), nil
+	case "bind.addr.family":
 
-	case "removexattr.file.mode":
+		return reflect.Int, nil
 
-		return int(e.RemoveXAttr.File.FileFields.Mode), nil
+	case "bind.addr.ip":
 
-	case "removexattr.file.modification_time":
+		return reflect.Struct, nil
 
-		return int(e.RemoveXAttr.File.FileFields.MTime), nil
+	case "bind.addr.port":
 
-	case "removexattr.file.mount_id":
+		return reflect.Int, nil
 
-		return int(e.RemoveXAttr.File.FileFields.MountID), nil
+	case "bind.retval":
 
-	case "removexattr.file.name":
+		return reflect.Int, nil
 
-		return e.ResolveFileBasename(&e.RemoveXAttr.File), nil
+	case "bpf.cmd":
 
-	case "removexattr.file.path":
+		return reflect.Int, nil
 
-		return e.ResolveFilePath(&e.RemoveXAttr.File), nil
+	case "bpf.map.type":
 
-	case "removexattr.file.rights":
+		return reflect.Int, nil
 
-		return int(e.ResolveRights(&e.RemoveXAttr.File.FileFields)), nil
+	case "bpf.prog.attach_type":
 
-	case "removexattr.file.uid":
+		return reflect.Int, nil
 
-		return int(e.RemoveXAttr.File.FileFields.UID), nil
+	case "bpf.prog.type":
 
-	case "removexattr.file.user":
+		return reflect.Int, nil
 
-		return e.ResolveFileFieldsUser(&e.RemoveXAttr.File.FileFields), nil
+	case "bpf.retval":
 
-	case "removexattr.retval":
+		return reflect.Int, nil
 
-		return int(e.RemoveXAttr.SyscallEvent.Retval), nil
+	case "capset.cap_effective":
 
-	case "rename.file.change_time":
+		return reflect.Int, nil
 
-		return int(e.Rename.Old.FileFields.CTime), nil
+	case "capset.cap_permitted":
 
-	case "rename.file.destination.change_time":
+		return reflect.Int, nil
 
-		return int(e.Rename.New.FileFields.CTime), nil
+	case "chmod.file.change_time":
 
-	case "rename.file.destination.filesystem":
+		return reflect.Int, nil
 
-		return e.ResolveFileFilesystem(&e.Rename.New), nil
+	case "chmod.file.destination.mode":
 
-	case "rename.file.destination.gid":
+		return reflect.Int, nil
 
-		return int(e.Rename.New.FileFields.GID), nil
+	case "chmod.file.destination.rights":
 
-	case "rename.file.destination.group":
+		return reflect.Int, nil
 
-		return e.ResolveFileFieldsGroup(&e.Rename.New.FileFields), nil
+	case "chmod.file.filesystem":
 
-	case "rename.file.destination.in_upper_layer":
+		return reflect.String, nil
 
-		return e.ResolveFileFieldsInUpperLayer(&e.Rename.New.FileFields), nil
+	case "chmod.file.gid":
 
-	case "rename.file.destination.inode":
+		return reflect.Int, nil
 
-		return int(e.Rename.New.FileFields.Inode), nil
+	case "chmod.file.group":
 
-	case "rename.file.destination.mode":
+		return reflect.String, nil
 
-		return int(e.Rename.New.FileFields.Mode), nil
+	case "chmod.file.in_upper_layer":
 
-	case "rename.file.destination.modification_time":
+		return reflect.Bool, nil
 
-		return int(e.Rename.New.FileFields.MTime), nil
+	case "chmod.file.inode":
 
-	case "rename.file.destination.mount_id":
+		return reflect.Int, nil
 
-		return int(e.Rename.New.FileFields.MountID), nil
+	case "chmod.file.mode":
 
-	case "rename.file.destination.name":
+		return reflect.Int, nil
 
-		return e.ResolveFileBasename(&e.Rename.New), nil
+	case "chmod.file.modification_time":
 
-	case "rename.file.destination.path":
+		return reflect.Int, nil
 
-		return e.ResolveFilePath(&e.Rename.New), nil
+	case "chmod.file.mount_id":
 
-	case "rename.file.destination.rights":
+		return reflect.Int, nil
 
-		return int(e.ResolveRights(&e.Rename.New.FileFields)), nil
+	case "chmod.file.name":
 
-	case "rename.file.destination.uid":
+		return reflect.String, nil
 
-		return int(e.Rename.New.FileFields.UID), nil
+	case "chmod.file.path":
 
-	case "rename.file.destination.user":
+		return reflect.String, nil
 
-		return e.ResolveFileFieldsUser(&e.Rename.New.FileFields), nil
+	case "chmod.file.rights":
 
-	case "rename.file.filesystem":
+		return reflect.Int, nil
 
-		return e.ResolveFileFilesystem(&e.Rename.Old), nil
+	case "chmod.file.uid":
 
-	case "rename.file.gid":
+		return reflect.Int, nil
 
-		return int(e.Rename.Old.FileFields.GID), nil
+	case "chmod.file.user":
 
-	case "rename.file.group":
+		return reflect.String, nil
 
-		return e.ResolveFileFieldsGroup(&e.Rename.Old.FileFields), nil
+	case "chmod.retval":
 
-	case "rename.file.in_upper_layer":
+		return reflect.Int, nil
 
-		return e.ResolveFileFieldsInUpperLayer(&e.Rename.Old.FileFields), nil
+	case "chown.file.change_time":
 
-	case "rename.file.inode":
+		return reflect.Int, nil
 
-		return int(e.Rename.Old.FileFields.Inode), nil
+	case "chown.file.destination.gid":
 
-	case "rename.file.mode":
+		return reflect.Int, nil
 
-		return int(e.Rename.Old.FileFields.Mode), nil
+	case "chown.file.destination.group":
 
-	case "rename.file.modification_time":
+		return reflect.String, nil
 
-		return int(e.Rename.Old.FileFields.MTime), nil
+	case "chown.file.destination.uid":
 
-	case "rename.file.mount_id":
+		return reflect.Int, nil
 
-		return int(e.Rename.Old.FileFields.MountID), nil
+	case "chown.file.destination.user":
 
-	case "rename.file.name":
+		return reflect.String, nil
 
-		return e.ResolveFileBasename(&e.Rename.Old), nil
+	case "chown.file.filesystem":
 
-	case "rename.file.path":
+		return reflect.String, nil
 
-		return e.ResolveFilePath(&e.Rename.Old), nil
+	case "chown.file.gid":
 
-	case "rename.file.rights":
+		return reflect.Int, nil
 
-		return int(e.ResolveRights(&e.Rename.Old.FileFields)), nil
+	case "chown.file.group":
 
-	case "rename.file.uid":
+		return reflect.String, nil
 
-		return int(e.Rename.Old.FileFields.UID), nil
+	case "chown.file.in_upper_layer":
 
-	case "rename.file.user":
+		return reflect.Bool, nil
 
-		return e.ResolveFileFieldsUser(&e.Rename.Old.FileFields), nil
+	case "chown.file.inode":
 
-	case "rename.retval":
+		return reflect.Int, nil
 
-		return int(e.Rename.SyscallEvent.Retval), nil
+	case "chown.file.mode":
 
-	case "rmdir.file.change_time":
+		return reflect.Int, nil
 
-		return int(e.Rmdir.File.FileFields.CTime), nil
+	case "chown.file.modification_time":
 
-	case "rmdir.file.filesystem":
+		return reflect.Int, nil
 
-		return e.ResolveFileFilesystem(&e.Rmdir.File), nil
+	case "chown.file.mount_id":
 
-	case "rmdir.file.gid":
+		return reflect.Int, nil
 
-		return int(e.Rmdir.File.FileFields.GID), nil
+	case "chown.file.name":
 
-	case "rmdir.file.group":
+		return reflect.String, nil
 
-		return e.ResolveFileFieldsGroup(&e.Rmdir.File.FileFields), nil
+	case "chown.file.path":
 
-	case "rmdir.file.in_upper_layer":
+		return reflect.String, nil
 
-		return e.ResolveFileFieldsInUpperLayer(&e.Rmdir.File.FileFields), nil
+	case "chown.file.rights":
 
-	case "rmdir.file.inode":
+		return reflect.Int, nil
 
-		return int(e.Rmdir.File.FileFields.Inode), nil
+	case "chown.file.uid":
 
-	case "rmdir.file.mode":
+		return reflect.Int, nil
 
-		return int(e.Rmdir.File.FileFields.Mode), nil
+	case "chown.file.user":
 
-	case "rmdir.file.modification_time":
+		return reflect.String, nil
 
-		return int(e.Rmdir.File.FileFields.MTime), nil
+	case "chown.retval":
 
-	case "rmdir.file.mount_id":
+		return reflect.Int, nil
 
-		return int(e.Rmdir.File.FileFields.MountID), nil
+	case "connect.addr.family":
 
-	case "rmdir.file.name":
+		return reflect.Int, nil
 
-		return e.ResolveFileBasename(&e.Rmdir.File), nil
+	case "connect.addr.ip":
 
-	case "rmdir.file.path":
+		return reflect.Struct, nil
 
-		return e.ResolveFilePath(&e.Rmdir.File), nil
+	case "connect.addr.port":
 
-	case "rmdir.file.rights":
+		return reflect.Int, nil
 
-		return int(e.ResolveRights(&e.Rmdir.File.FileFields)), nil
+	case "connect.retval":
 
-	case "rmdir.file.uid":
+		return reflect.Int, nil
 
-		return int(e.Rmdir.File.FileFields.UID), nil
+	case "container.id":
 
-	case "rmdir.file.user":
+		return reflect.String, nil
 
-		return e.ResolveFileFieldsUser(&e.Rmdir.File.FileFields), nil
+	case "container.tags":
 
-	case "rmdir.retval":
+		return reflect.String, nil
 
-		return int(e.Rmdir.SyscallEvent.Retval), nil
+	case "dns.id":
 
-	case "selinux.bool.name":
+		return reflect.Int, nil
 
-		return e.ResolveSELinuxBoolName(&e.SELinux), nil
+	case "dns.question.class":
 
-	case "selinux.bool.state":
+		return reflect.Int, nil
 
-		return e.SELinux.BoolChangeValue, nil
+	case "dns.question.count":
 
-	case "selinux.bool_commit.state":
+		return reflect.Int, nil
 
-		return e.SELinux.BoolCommitValue, nil
+	case "dns.question.name":
 
-	case "selinux.enforce.status":
+		return reflect.String, nil
 
-		return e.SELinux.EnforceStatus, nil
+	case "dns.question.size":
 
-	case "setgid.egid":
+		return reflect.Int, nil
 
-		return int(e.SetGID.EGID), nil
+	case "dns.question.type":
 
-	case "setgid.egroup":
+		return reflect.Int, nil
 
-		return e.ResolveSetgidEGroup(&e.SetGID), nil
+	case "exec.args":
 
-	case "setgid.fsgid":
+		return reflect.String, nil
 
-		return int(e.SetGID.FSGID), nil
+	case "exec.args_flags":
 
-	case "setgid.fsgroup":
+		return reflect.String, nil
 
-		return e.ResolveSetgidFSGroup(&e.SetGID), nil
+	case "exec.args_options":
 
-	case "setgid.gid":
+		return reflect.String, nil
 
-		return int(e.SetGID.GID), nil
+	case "exec.args_truncated":
 
-	case "setgid.group":
+		return reflect.Bool, nil
 
-		return e.ResolveSetgidGroup(&e.SetGID), nil
+	case "exec.argv":
 
-	case "setuid.euid":
+		return reflect.String, nil
 
-		return int(e.SetUID.EUID), nil
+	case "exec.cap_effective":
 
-	case "setuid.euser":
+		return reflect.Int, nil
 
-		return e.ResolveSetuidEUser(&e.SetUID), nil
+	case "exec.cap_permitted":
 
-	case "setuid.fsuid":
+		return reflect.Int, nil
 
-		return int(e.SetUID.FSUID), nil
+	case "exec.comm":
 
-	case "setuid.fsuser":
+		return reflect.String, nil
 
-		return e.ResolveSetuidFSUser(&e.SetUID), nil
+	case "exec.container.id":
 
-	case "setuid.uid":
+		return reflect.String, nil
 
-		return int(e.SetUID.UID), nil
+	case "exec.cookie":
 
-	case "setuid.user":
+		return reflect.Int, nil
 
-		return e.ResolveSetuidUser(&e.SetUID), nil
+	case "exec.created_at":
 
-	case "setxattr.file.change_time":
+		return reflect.Int, nil
 
-		return int(e.SetXAttr.File.FileFields.CTime), nil
+	case "exec.egid":
 
-	case "setxattr.file.destination.name":
+		return reflect.Int, nil
 
-		return e.ResolveXAttrName(&e.SetXAttr), nil
+	case "exec.egroup":
 
-	case "setxattr.file.destination.namespace":
+		return reflect.String, nil
 
-		return e.ResolveXAttrNamespace(&e.SetXAttr), nil
+	case "exec.envs":
 
-	case "setxattr.file.filesystem":
+		return reflect.String, nil
 
-		return e.ResolveFileFilesystem(&e.SetXAttr.File), nil
+	case "exec.envs_truncated":
 
-	case "setxattr.file.gid":
+		return reflect.Bool, nil
 
-		return int(e.SetXAttr.File.FileFields.GID), nil
+	case "exec.euid":
 
-	case "setxattr.file.group":
+		return reflect.Int, nil
 
-		return e.ResolveFileFieldsGroup(&e.SetXAttr.File.FileFields), nil
+	case "exec.euser":
 
-	case "setxattr.file.in_upper_layer":
+		return reflect.String, nil
 
-		return e.ResolveFileFieldsInUpperLayer(&e.SetXAttr.File.FileFields), nil
+	case "exec.file.change_time":
 
-	case "setxattr.file.inode":
+		return reflect.Int, nil
 
-		return int(e.SetXAttr.File.FileFields.Inode), nil
+	case "exec.file.filesystem":
 
-	case "setxattr.file.mode":
+		return reflect.String, nil
 
-		return int(e.SetXAttr.File.FileFields.Mode), nil
+	case "exec.file.gid":
 
-	case "setxattr.file.modification_time":
+		return reflect.Int, nil
 
-		return int(e.SetXAttr.File.FileFields.MTime), nil
+	case "exec.file.group":
 
-	case "setxattr.file.mount_id":
+		return reflect.String, nil
 
-		return int(e.SetXAttr.File.FileFields.MountID), nil
+	case "exec.file.in_upper_layer":
 
-	case "setxattr.file.name":
+		return reflect.Bool, nil
 
-		return e.ResolveFileBasename(&e.SetXAttr.File), nil
+	case "exec.file.inode":
 
-	case "setxattr.file.path":
+		return reflect.Int, nil
 
-		return e.ResolveFilePath(&e.SetXAttr.File), nil
+	case "exec.file.mode":
 
-	case "setxattr.file.rights":
+		return reflect.Int, nil
 
-		return int(e.ResolveRights(&e.SetXAttr.File.FileFields)), nil
+	case "exec.file.modification_time":
 
-	case "setxattr.file.uid":
+		return reflect.Int, nil
 
-		return int(e.SetXAttr.File.FileFields.UID), nil
+	case "exec.file.mount_id":
 
-	case "setxattr.file.user":
+		return reflect.Int, nil
 
-		return e.ResolveFileFieldsUser(&e.SetXAttr.File.FileFields), nil
+	case "exec.file.name":
 
-	case "setxattr.retval":
+		return reflect.String, nil
 
-		return int(e.SetXAttr.SyscallEvent.Retval), nil
+	case "exec.file.path":
 
-	case "unlink.file.change_time":
+		return reflect.String, nil
 
-		return int(e.Unlink.File.FileFields.CTime), nil
+	case "exec.file.rights":
 
-	case "unlink.file.filesystem":
+		return reflect.Int, nil
 
-		return e.ResolveFileFilesystem(&e.Unlink.File), nil
+	case "exec.file.uid":
 
-	case "unlink.file.gid":
+		return reflect.Int, nil
 
-		return int(e.Unlink.File.FileFields.GID), nil
+	case "exec.file.user":
 
-	case "unlink.file.group":
+		return reflect.String, nil
 
-		return e.ResolveFileFieldsGroup(&e.Unlink.File.FileFields), nil
+	case "exec.fsgid":
 
-	case "unlink.file.in_upper_layer":
+		return reflect.Int, nil
 
-		return e.ResolveFileFieldsInUpperLayer(&e.Unlink.File.FileFields), nil
+	case "exec.fsgroup":
 
-	case "unlink.file.inode":
+		return reflect.String, nil
 
-		return int(e.Unlink.File.FileFields.Inode), nil
+	case "exec.fsuid":
 
-	case "unlink.file.mode":
+		return reflect.Int, nil
 
-		return int(e.Unlink.File.FileFields.Mode), nil
+	case "exec.fsuser":
 
-	case "unlink.file.modification_time":
+		return reflect.String, nil
 
-		return int(e.Unlink.File.FileFields.MTime), nil
+	case "exec.gid":
 
-	case "unlink.file.mount_id":
+		return reflect.Int, nil
 
-		return int(e.Unlink.File.FileFields.MountID), nil
+	case "exec.group":
 
-	case "unlink.file.name":
+		return reflect.String, nil
 
-		return e.ResolveFileBasename(&e.Unlink.File), nil
+	case "exec.pid":
 
-	case "unlink.file.path":
+		return reflect.Int, nil
 
-		return e.ResolveFilePath(&e.Unlink.File), nil
+	case "exec.ppid":
 
-	case "unlink.file.rights":
+		return reflect.Int, nil
 
-		return int(e.ResolveRights(&e.Unlink.File.FileFields)), nil
+	case "exec.tid":
 
-	case "unlink.file.uid":
+		return reflect.Int, nil
 
-		return int(e.Unlink.File.FileFields.UID), nil
+	case "exec.tty_name":
 
-	case "unlink.file.user":
+		return reflect.String, nil
 
-		return e.ResolveFileFieldsUser(&e.Unlink.File.FileFields), nil
+	case "exec.uid":
 
-	case "unlink.retval":
+		return reflect.Int, nil
 
-		return int(e.Unlink.SyscallEvent.Retval), nil
+	case "exec.user":
 
-	case "utimes.file.change_time":
+		return reflect.String, nil
 
-		return int(e.Utimes.File.FileFields.CTime), nil
+	case "link.file.change_time":
 
-	case "utimes.file.filesystem":
+		return reflect.Int, nil
 
-		return e.ResolveFileFilesystem(&e.Utimes.File), nil
+	case "link.file.destination.change_time":
 
-	case "utimes.file.gid":
+		return reflect.Int, nil
 
-		return int(e.Utimes.File.FileFields.GID), nil
+	case "link.file.destination.filesystem":
 
-	case "utimes.file.group":
+		return reflect.String, nil
 
-		return e.ResolveFileFieldsGroup(&e.Utimes.File.FileFields), nil
+	case "link.file.destination.gid":
 
-	case "utimes.file.in_upper_layer":
+		return reflect.Int, nil
 
-		return e.ResolveFileFieldsInUpperLayer(&e.Utimes.File.FileFields), nil
+	case "link.file.destination.group":
 
-	case "utimes.file.inode":
+		return reflect.String, nil
 
-		return int(e.Utimes.File.FileFields.Inode), nil
+	case "link.file.destination.in_upper_layer":
 
-	case "utimes.file.mode":
+		return reflect.Bool, nil
 
-		return int(e.Utimes.File.FileFields.Mode), nil
+	case "link.file.destination.inode":
 
-	case "utimes.file.modification_time":
+		return reflect.Int, nil
 
-		return int(e.Utimes.File.FileFields.MTime), nil
+	case "link.file.destination.mode":
 
-	case "utimes.file.mount_id":
+		return reflect.Int, nil
 
-		return int(e.Utimes.File.FileFields.MountID), nil
+	case "link.file.destination.modification_time":
 
-	case "utimes.file.name":
+		return reflect.Int, nil
 
-		return e.ResolveFileBasename(&e.Utimes.File), nil
+	case "link.file.destination.mount_id":
 
-	case "utimes.file.path":
+		return reflect.Int, nil
 
-		return e.ResolveFilePath(&e.Utimes.File), nil
+	case "link.file.destination.name":
 
-	case "utimes.file.rights":
+		return reflect.String, nil
 
-		return int(e.ResolveRights(&e.Utimes.File.FileFields)), nil
+	case "link.file.destination.path":
 
-	case "utimes.file.uid":
+		return reflect.String, nil
 
-		return int(e.Utimes.File.FileFields.UID), nil
+	case "link.file.destination.rights":
 
-	case "utimes.file.user":
+		return reflect.Int, nil
 
-		return e.ResolveFileFieldsUser(&e.Utimes.File.FileFields), nil
+	case "link.file.destination.uid":
 
-	case "utimes.retval":
+		return reflect.Int, nil
 
-		return int(e.Utimes.SyscallEvent.Retval), nil
+	case "link.file.destination.user":
 
-	}
+		return reflect.String, nil
 
-	return nil, &eval.ErrFieldNotFound{Field: field}
-}
+	case "link.file.filesystem":
 
-func (e *Event) GetFieldEventType(field eval.Field) (eval.EventType, error) {
-	switch field {
+		return reflect.String, nil
 
-	case "bpf.cmd":
-		return "bpf", nil
+	case "link.file.gid":
 
-	case "bpf.map.type":
-		return "bpf", nil
+		return reflect.Int, nil
 
-	case "bpf.prog.attach_type":
-		return "bpf", nil
+	case "link.file.group":
 
-	case "bpf.prog.type":
-		return "bpf", nil
+		return reflect.String, nil
 
-	case "bpf.retval":
-		return "bpf", nil
+	case "link.file.in_upper_layer":
 
-	case "capset.cap_effective":
-		return "capset", nil
+		return reflect.Bool, nil
 
-	case "capset.cap_permitted":
-		return "capset", nil
+	case "link.file.inode":
 
-	case "chmod.file.change_time":
-		return "chmod", nil
+		return reflect.Int, nil
 
-	case "chmod.file.destination.mode":
-		return "chmod", nil
+	case "link.file.mode":
 
-	case "chmod.file.destination.rights":
-		return "chmod", nil
+		return reflect.Int, nil
 
-	case "chmod.file.filesystem":
-		return "chmod", nil
+	case "link.file.modification_time":
 
-	case "chmod.file.gid":
-		return "chmod", nil
+		return reflect.Int, nil
 
-	case "chmod.file.group":
-		return "chmod", nil
+	case "link.file.mount_id":
 
-	case "chmod.file.in_upper_layer":
-		return "chmod", nil
+		return reflect.Int, nil
 
-	case "chmod.file.inode":
-		return "chmod", nil
+	case "link.file.name":
 
-	case "chmod.file.mode":
-		return "chmod", nil
+		return reflect.String, nil
 
-	case "chmod.file.modification_time":
-		return "chmod", nil
+	case "link.file.path":
 
-	case "chmod.file.mount_id":
-		return "chmod", nil
+		return reflect.String, nil
 
-	case "chmod.file.name":
-		return "chmod", nil
+	case "link.file.rights":
 
-	case "chmod.file.path":
-		return "chmod", nil
+		return reflect.Int, nil
 
-	case "chmod.file.rights":
-		return "chmod", nil
+	case "link.file.uid":
 
-	case "chmod.file.uid":
-		return "chmod", nil
+		return reflect.Int, nil
 
-	case "chmod.file.user":
-		return "chmod", nil
+	case "link.file.user":
 
-	case "chmod.retval":
-		return "chmod", nil
+		return reflect.String, nil
 
-	case "chown.file.change_time":
-		return "chown", nil
+	case "link.retval":
 
-	case "chown.file.destination.gid":
-		return "chown", nil
+		return reflect.Int, nil
 
-	case "chown.file.destination.group":
-		return "chown", nil
+	case "load_module.file.change_time":
 
-	case "chown.file.destination.uid":
-		return "chown", nil
+		return reflect.Int, nil
 
-	case "chown.file.destination.user":
-		return "chown", nil
+	case "load_module.file.filesystem":
 
-	case "chown.file.filesystem":
-		return "chown", nil
+		return reflect.String, nil
 
-	case "chown.file.gid":
-		return "chown", nil
+	case "load_module.file.gid":
 
-	case "chown.file.group":
-		return "chown", nil
+		return reflect.Int, nil
 
-	case "chown.file.in_upper_layer":
-		return "chown", nil
+	case "load_module.file.group":
 
-	case "chown.file.inode":
-		return "chown", nil
+		return reflect.String, nil
 
-	case "chown.file.mode":
-		return "chown", nil
+	case "load_module.file.in_upper_layer":
 
-	case "chown.file.modification_time":
-		return "chown", nil
+		return reflect.Bool, nil
 
-	case "chown.file.mount_id":
-		return "chown", nil
+	case "load_module.file.inode":
 
-	case "chown.file.name":
-		return "chown", nil
+		return reflect.Int, nil
 
-	case "chown.file.path":
-		return "chown", nil
+	case "load_module.file.mode":
 
-	case "chown.file.rights":
-		return "chown", nil
+		return reflect.Int, nil
 
-	case "chown.file.uid":
-		return "chown", nil
+	case "load_module.file.modification_time":
 
-	case "chown.file.user":
-		return "chown", nil
+		return reflect.Int, nil
 
-	case "chown.retval":
-		return "chown", nil
+	case "load_module.file.mount_id":
 
-	case "container.id":
-		return "*", nil
+		return reflect.Int, nil
 
-	case "container.tags":
-		return "*", nil
+	case "load_module.file.name":
 
-	case "exec.args":
-		return "exec", nil
+		return reflect.String, nil
 
-	case "exec.args_flags":
-		return "exec", nil
+	case "load_module.file.path":
 
-	case "exec.args_options":
-		return "exec", nil
+		return reflect.String, nil
 
-	case "exec.args_truncated":
-		return "exec", nil
+	case "load_module.file.rights":
 
-	case "exec.argv":
-		return "exec", nil
+		return reflect.Int, nil
 
-	case "exec.cap_effective":
-		return "exec", nil
+	case "load_module.file.uid":
 
-	case "exec.cap_permitted":
-		return "exec", nil
+		return reflect.Int, nil
 
-	case "exec.comm":
-		return "exec", nil
+	case "load_module.file.user":
 
-	case "exec.container.id":
-		return "exec", nil
+		return reflect.String, nil
 
-	case "exec.cookie":
-		return "exec", nil
+	case "load_module.loaded_from_memory":
 
-	case "exec.created_at":
-		return "exec", nil
+		return reflect.Bool, nil
 
-	case "exec.egid":
-		return "exec", nil
+	case "load_module.name":
 
-	case "exec.egroup":
-		return "exec", nil
+		return reflect.String, nil
 
-	case "exec.envs":
-		return "exec", nil
+	case "load_module.retval":
 
-	case "exec.envs_truncated":
-		return "exec", nil
+		return reflect.Int, nil
 
-	case "exec.euid":
-		return "exec", nil
+	case "mkdir.file.change_time":
 
-	case "exec.euser":
-		return "exec", nil
+		return reflect.Int, nil
 
-	case "exec.file.change_time":
-		return "exec", nil
+	case "mkdir.file.destination.mode":
 
-	case "exec.file.filesystem":
-		return "exec", nil
+		return reflect.Int, nil
 
-	case "exec.file.gid":
-		return "exec", nil
+	case "mkdir.file.destination.rights":
 
-	case "exec.file.group":
-		return "exec", nil
+		return reflect.Int, nil
 
-	case "exec.file.in_upper_layer":
-		return "exec", nil
+	case "mkdir.file.filesystem":
 
-	case "exec.file.inode":
-		return "exec", nil
+		return reflect.String, nil
 
-	case "exec.file.mode":
-		return "exec", nil
+	case "mkdir.file.gid":
 
-	case "exec.file.modification_time":
-		return "exec", nil
+		return reflect.Int, nil
 
-	case "exec.file.mount_id":
-		return "exec", nil
+	case "mkdir.file.group":
 
-	case "exec.file.name":
-		return "exec", nil
+		return reflect.String, nil
 
-	case "exec.file.path":
-		return "exec", nil
+	case "mkdir.file.in_upper_layer":
 
-	case "exec.file.rights":
-		return "exec", nil
+		return reflect.Bool, nil
 
-	case "exec.file.uid":
-		return "exec", nil
+	case "mkdir.file.inode":
 
-	case "exec.file.user":
-		return "exec", nil
+		return reflect.Int, nil
 
-	case "exec.fsgid":
-		return "exec", nil
+	case "mkdir.file.mode":
 
-	case "exec.fsgroup":
-		return "exec", nil
+		return reflect.Int, nil
 
-	case "exec.fsuid":
-		return "exec", nil
+	case "mkdir.file.modification_time":
 
-	case "exec.fsuser":
-		return "exec", nil
+		return reflect.Int, nil
 
-	case "exec.gid":
-		return "exec", nil
+	case "mkdir.file.mount_id":
 
-	case "exec.group":
-		return "exec", nil
+		return reflect.Int, nil
 
-	case "exec.pid":
-		return "exec", nil
+	case "mkdir.file.name":
 
-	case "exec.ppid":
-		return "exec", nil
+		return reflect.String, nil
 
-	case "exec.tid":
-		return "exec", nil
+	case "mkdir.file.path":
 
-	case "exec.tty_name":
-		return "exec", nil
+		return reflect.String, nil
 
-	case "exec.uid":
-		return "exec", nil
+	case "mkdir.file.rights":
 
-	case "exec.user":
-		return "exec", nil
+		return reflect.Int, nil
 
-	case "link.file.change_time":
-		return "link", nil
+	case "mkdir.file.uid":
 
-	case "link.file.destination.change_time":
-		return "link", nil
+		return reflect.Int, nil
 
-	case "link.file.destination.filesystem":
-		return "link", nil
+	case "mkdir.file.user":
 
-	case "link.file.destination.gid":
-		return "link", nil
+		return reflect.String, nil
 
-	case "link.file.destination.group":
-		return "link", nil
+	case "mkdir.retval":
 
-	case "link.file.destination.in_upper_layer":
-		return "link", nil
+		return reflect.Int, nil
 
-	case "link.file.destination.inode":
-		return "link", nil
+	case "mmap.file.change_time":
 
-	case "link.file.destination.mode":
-		return "link", nil
+		return reflect.Int, nil
 
-	case "link.file.destination.modification_time":
-		return "link", nil
+	case "mmap.file.filesystem":
 
-	case "link.file.destination.mount_id":
-		return "link", nil
+		return reflect.String, nil
 
-	case "link.file.destination.name":
-		return "link", nil
+	case "mmap.file.gid":
 
-	case "link.file.destination.path":
-		return "link", nil
+		return reflect.Int, nil
 
-	case "link.file.destination.rights":
-		return "link", nil
+	case "mmap.file.group":
 
-	case "link.file.destination.uid":
-		return "link", nil
+		return reflect.String, nil
 
-	case "link.file.destination.user":
-		return "link", nil
+	case "mmap.file.in_upper_layer":
 
-	case "link.file.filesystem":
-		return "link", nil
+		return reflect.Bool, nil
 
-	case "link.file.gid":
-		return "link", nil
+	case "mmap.file.inode":
 
-	case "link.file.group":
-		return "link", nil
+		return reflect.Int, nil
 
-	case "link.file.in_upper_layer":
-		return "link", nil
+	case "mmap.file.mode":
 
-	case "link.file.inode":
-		return "link", nil
+		return reflect.Int, nil
 
-	case "link.file.mode":
-		return "link", nil
+	case "mmap.file.modification_time":
 
-	case "link.file.modification_time":
-		return "link", nil
+		return reflect.Int, nil
 
-	case "link.file.mount_id":
-		return "link", nil
+	case "mmap.file.mount_id":
 
-	case "link.file.name":
-		return "link", nil
+		return reflect.Int, nil
 
-	case "link.file.path":
-		return "link", nil
+	case "mmap.file.name":
 
-	case "link.file.rights":
-		return "link", nil
+		return reflect.String, nil
 
-	case "link.file.uid":
-		return "link", nil
+	case "mmap.file.path":
 
-	case "link.file.user":
-		return "link", nil
+		return reflect.String, nil
 
-	case "link.retval":
-		return "link", nil
+	case "mmap.file.rights":
 
-	case "mkdir.file.change_time":
-		return "mkdir", nil
+		return reflect.Int, nil
 
-	case "mkdir.file.destination.mode":
-		return "mkdir", nil
+	case "mmap.file.uid":
 
-	case "mkdir.file.destination.rights":
-		return "mkdir", nil
+		return reflect.Int, nil
 
-	case "mkdir.file.filesystem":
-		return "mkdir", nil
+	case "mmap.file.user":
 
-	case "mkdir.file.gid":
-		return "mkdir", nil
+		return reflect.String, nil
 
-	case "mkdir.file.group":
-		return "mkdir", nil
+	case "mmap.flags":
 
-	case "mkdir.file.in_upper_layer":
-		return "mkdir", nil
+		return reflect.Int, nil
 
-	case "mkdir.file.inode":
-		return "mkdir", nil
+	case "mmap.protection":
 
-	case "mkdir.file.mode":
-		return "mkdir", nil
+		return reflect.Int, nil
 
-	case "mkdir.file.modification_time":
-		return "mkdir", nil
+	case "mmap.retval":
 
-	case "mkdir.file.mount_id":
-		return "mkdir", nil
+		return reflect.Int, nil
 
-	case "mkdir.file.name":
-		return "mkdir", nil
+	case "mprotect.req_protection":
 
-	case "mkdir.file.path":
-		return "mkdir", nil
+		return reflect.Int, nil
 
-	case "mkdir.file.rights":
-		return "mkdir", nil
+	case "mprotect.retval":
 
-	case "mkdir.file.uid":
-		return "mkdir", nil
+		return reflect.Int, nil
 
-	case "mkdir.file.user":
-		return "mkdir", nil
+	case "mprotect.vm_protection":
 
-	case "mkdir.retval":
-		return "mkdir", nil
+		return reflect.Int, nil
 
 	case "open.file.change_time":
-		return "open", nil
+
+		return reflect.Int, nil
 
 	case "open.file.destination.mode":
-		return "open", nil
+
+		return reflect.Int, nil
 
 	case "open.file.filesystem":
-		return "open", nil
+
+		return reflect.String, nil
 
 	case "open.file.gid":
-		return "open", nil
+
+		return reflect.Int, nil
 
 	case "open.file.group":
-		return "open", nil
+
+		return reflect.String, nil
 
 	case "open.file.in_upper_layer":
-		return "open", nil
+
+		return reflect.Bool, nil
 
 	case "open.file.inode":
-		return "open", nil
+
+		return reflect.Int, nil
 
 	case "open.file.mode":
-		return "open", nil
+
+		return reflect.Int, nil
 
 	case "open.file.modification_time":
-		return "open", nil
+
+		return reflect.Int, nil
 
 	case "open.file.mount_id":
-		return "open", nil
+
+		return reflect.Int, nil
 
 	case "open.file.name":
-		return "open", nil
+
+		return reflect.String, nil
 
 	case "open.file.path":
-		return "open", nil
+
+		return reflect.String, nil
 
 	case "open.file.rights":
-		return "open", nil
+
+		return reflect.Int, nil
 
 	case "open.file.uid":
-		return "open", nil
+
+		return reflect.Int, nil
 
 	case "open.file.user":
-		return "open", nil
+
+		return reflect.String, nil
 
 	case "open.flags":
-		return "open", nil
+
+		return reflect.Int, nil
 
 	case "open.retval":
-		return "open", nil
+
+		return reflect.Int, nil
 
 	case "process.ancestors.cap_effective":
-		return "*", nil
+
+		return reflect.Int, nil
 
 	case "process.ancestors.cap_permitted":
-		return "*", nil
+
+		return reflect.Int, nil
 
 	case "process.ancestors.comm":
-		return "*", nil
+
+		return reflect.String, nil
 
 	case "process.ancestors.container.id":
-		return "*", nil
+
+		return reflect.String, nil
 
 	case "process.ancestors.cookie":
-		return "*", nil
 
-	case "process.ancestors.created_at":
-		return "*", nil
+		return reflect.Int, nil
 
-	case "process.ancestors.egid":
-		return "*", nil
+	case "process.ancestors.created_at":
 
-	case "process.ancestors.egroup":
-		return "*", nil
+		return reflect.Int, nil
 
-	case "process.ancestors.euid":
-		return "*", nil
+	case "process.ancestors.egid":
 
-	case "process.ancestors.euser":
-		return "*", nil
+		return reflect.Int, nil
 
-	case "process.ancestors.file.change_time":
-		return "*", nil
+	case "process.ancestors.egroup":
 
-	case "process.ancestors.file.filesystem":
-		return "*", nil
+		return reflect.String, nil
 
-	case "process.ancestors.file.gid":
-		return "*", nil
+	case "process.ancestors.euid":
 
-	case "process.ancestors.file.group":
-		return "*", nil
+		return reflect.Int, nil
 
-	case "process.ancestors.file.in_upper_layer":
-		return "*", nil
+	case "process.ancestors.euser":
 
-	case "process.ancestors.file.inode":
-		return "*", nil
+		return reflect.String, nil
 
-	case "process.ancestors.file.mode":
-		return "*", nil
+	case "process.ancestors.file.change_time":
 
-	case "process.ancestors.file.modification_time":
-		return "*", nil
+		return reflect.Int, nil
 
-	case "process.ancestors.file.mount_id":
-		return "*", nil
+	case "process.ancestors.file.filesystem":
 
-	case "process.ancestors.file.name":
-		return "*", nil
+		return reflect.String, nil
 
-	case "process.ancestors.file.path":
-		return "*", nil
+	case "process.ancestors.file.gid":
 
-	case "process.ancestors.file.rights":
-		return "*", nil
+		return reflect.Int, nil
 
-	case "process.ancestors.file.uid":
-		return "*", nil
+	case "process.ancestors.file.group":
 
-	case "process.ancestors.file.user":
-		return "*", nil
+		return reflect.String, nil
 
-	case "process.ancestors.fsgid":
-		return "*", nil
+	case "process.ancestors.file.in_upper_layer":
 
-	case "process.ancestors.fsgroup":
-		return "*", nil
+		return reflect.Bool, nil
 
-	case "process.ancestors.fsuid":
-		return "*", nil
+	case "process.ancestors.file.inode":
 
-	case "process.ancestors.fsuser":
-		return "*", nil
+		return reflect.Int, nil
 
-	case "process.ancestors.gid":
-		return "*", nil
+	case "process.ancestors.file.mode":
 
-	case "process.ancestors.group":
-		return "*", nil
+		return reflect.Int, nil
 
-	case "process.ancestors.pid":
-		return "*", nil
+	case "process.ancestors.file.modification_time":
 
-	case "process.ancestors.ppid":
-		return "*", nil
+		return reflect.Int, nil
 
-	case "process.ancestors.tid":
-		return "*", nil
+	case "process.ancestors.file.mount_id":
 
-	case "process.ancestors.tty_name":
-		return "*", nil
+		return reflect.Int, nil
 
-	case "process.ancestors.uid":
-		return "*", nil
+	case "process.ancestors.file.name":
 
-	case "process.ancestors.user":
-		return "*", nil
+		return reflect.String, nil
 
-	case "process.cap_effective":
-		return "*", nil
+	case "process.ancestors.file.path":
 
-	case "process.cap_permitted":
-		return "*", nil
+		return reflect.String, nil
 
-	case "process.comm":
-		return "*", nil
+	case "process.ancestors.file.rights":
 
-	case "process.container.id":
-		return "*", nil
+		return reflect.Int, nil
 
-	case "process.cookie":
-		return "*", nil
+	case "process.ancestors.file.uid":
 
-	case "process.created_at":
-		return "*", nil
+		return reflect.Int, nil
 
-	case "process.egid":
-		return "*", nil
+	case "process.ancestors.file.user":
 
-	case "process.egroup":
-		return "*", nil
+		return reflect.String, nil
 
-	case "process.euid":
-		return "*", nil
+	case "process.ancestors.fsgid":
 
-	case "process.euser":
-		return "*", nil
+		return reflect.Int, nil
 
-	case "process.file.change_time":
-		return "*", nil
+	case "process.ancestors.fsgroup":
 
-	case "process.file.filesystem":
-		return "*", nil
+		return reflect.String, nil
 
-	case "process.file.gid":
-		return "*", nil
+	case "process.ancestors.fsuid":
 
-	case "process.file.group":
-		return "*", nil
+		return reflect.Int, nil
 
-	case "process.file.in_upper_layer":
-		return "*", nil
+	case "process.ancestors.fsuser":
 
-	case "process.file.inode":
-		return "*", nil
+		return reflect.String, nil
 
-	case "process.file.mode":
-		return "*", nil
+	case "process.ancestors.gid":
 
-	case "process.file.modification_time":
-		return "*", nil
+		return reflect.Int, nil
 
-	case "process.file.mount_id":
-		return "*", nil
+	case "process.ancestors.group":
 
-	case "process.file.name":
-		return "*", nil
+		return reflect.String, nil
 
-	case "process.file.path":
-		return "*", nil
+	case "process.ancestors.pid":
 
-	case "process.file.rights":
-		return "*", nil
+		return reflect.Int, nil
 
-	case "process.file.uid":
-		return "*", nil
+	case "process.ancestors.ppid":
 
-	case "process.file.user":
-		return "*", nil
+		return reflect.Int, nil
 
-	case "process.fsgid":
-		return "*", nil
+	case "process.ancestors.tid":
 
-	case "process.fsgroup":
-		return "*", nil
+		return reflect.Int, nil
 
-	case "process.fsuid":
-		return "*", nil
+	case "process.ancestors.tty_name":
 
-	case "process.fsuser":
-		return "*", nil
+		return reflect.String, nil
 
-	case "process.gid":
-		return "*", nil
+	case "process.ancestors.uid":
 
-	case "process.group":
-		return "*", nil
+		return reflect.Int, nil
 
-	case "process.pid":
-		return "*", nil
+	case "process.ancestors.user":
 
-	case "process.ppid":
-		return "*", nil
+		return reflect.String, nil
 
-	case "process.tid":
-		return "*", nil
+	case "process.cap_effective":
 
-	case "process.tty_name":
-		return "*", nil
+		return reflect.Int, nil
 
-	case "process.uid":
-		return "*", nil
+	case "process.cap_permitted":
 
-	case "process.user":
-		return "*", nil
+		return reflect.Int, nil
 
-	case "removexattr.file.change_time":
-		return "removexattr", nil
+	case "process.comm":
 
-	case "removexattr.file.destination.name":
-		return "removexattr", nil
+		return reflect.String, nil
 
-	case "removexattr.file.destination.namespace":
-		return "removexattr", nil
+	case "process.container.id":
 
-	case "removexattr.file.filesystem":
-		return "removexattr", nil
+		return reflect.String, nil
 
-	case "removexattr.file.gid":
-		return "removexattr", nil
+	case "process.cookie":
 
-	case "removexattr.file.group":
-		return "removexattr", nil
+		return reflect.Int, nil
 
-	case "removexattr.file.in_upper_layer":
-		return "removexattr", nil
+	case "process.created_at":
 
-	case "removexattr.file.inode":
-		return "removexattr", nil
+		return reflect.Int, nil
 
-	case "removexattr.file.mode":
-		return "removexattr", nil
+	case "process.egid":
 
-	case "removexattr.file.modification_time":
-		return "removexattr", nil
+		return reflect.Int, nil
 
-	case "removexattr.file.mount_id":
-		return "removexattr", nil
+	case "process.egroup":
 
-	case "removexattr.file.name":
-		return "removexattr", nil
+		return reflect.String, nil
 
-	case "removexattr.file.path":
-		return "removexattr", nil
+	case "process.euid":
 
-	case "removexattr.file.rights":
-		return "removexattr", nil
+		return reflect.Int, nil
 
-	case "removexattr.file.uid":
-		return "removexattr", nil
+	case "process.euser":
 
-	case "removexattr.file.user":
-		return "removexattr", nil
+		return reflect.String, nil
 
-	case "removexattr.retval":
-		return "removexattr", nil
+	case "process.file.change_time":
 
-	case "rename.file.change_time":
-		return "rename", nil
+		return reflect.Int, nil
 
-	case "rename.file.destination.change_time":
-		return "rename", nil
+	case "process.file.filesystem":
 
-	case "rename.file.destination.filesystem":
-		return "rename", nil
+		return reflect.String, nil
 
-	case "rename.file.destination.gid":
-		return "rename", nil
+	case "process.file.gid":
 
-	case "rename.file.destination.group":
-		return "rename", nil
+		return reflect.Int, nil
 
-	case "rename.file.destination.in_upper_layer":
-		return "rename", nil
+	case "process.file.group":
 
-	case "rename.file.destination.inode":
-		return "rename", nil
+		return reflect.String, nil
 
-	case "rename.file.destination.mode":
-		return "rename", nil
+	case "process.file.in_upper_layer":
 
-	case "rename.file.destination.modification_time":
-		return "rename", nil
+		return reflect.Bool, nil
 
-	case "rename.file.destination.mount_id":
-		return "rename", nil
+	case "process.file.inode":
 
-	case "rename.file.destination.name":
-		return "rename", nil
+		return reflect.Int, nil
 
-	case "rename.file.destination.path":
-		return "rename", nil
+	case "process.file.mode":
 
-	case "rename.file.destination.rights":
-		return "rename", nil
+		return reflect.Int, nil
 
-	case "rename.file.destination.uid":
-		return "rename", nil
+	case "process.file.modification_time":
 
-	case "rename.file.destination.user":
-		return "rename", nil
+		return reflect.Int, nil
 
-	case "rename.file.filesystem":
-		return "rename", nil
+	case "process.file.mount_id":
 
-	case "rename.file.gid":
-		return "rename", nil
+		return reflect.Int, nil
 
-	case "rename.file.group":
-		return "rename", nil
+	case "process.file.name":
 
-	case "rename.file.in_upper_layer":
-		return "rename", nil
+		return reflect.String, nil
 
-	case "rename.file.inode":
-		return "rename", nil
+	case "process.file.path":
 
-	case "rename.file.mode":
-		return "rename", nil
+		return reflect.String, nil
 
-	case "rename.file.modification_time":
-		return "rename", nil
+	case "process.file.rights":
 
-	case "rename.file.mount_id":
-		return "rename", nil
+		return reflect.Int, nil
 
-	case "rename.file.name":
-		return "rename", nil
+	case "process.file.uid":
 
-	case "rename.file.path":
-		return "rename", nil
+		return reflect.Int, nil
 
-	case "rename.file.rights":
-		return "rename", nil
+	case "process.file.user":
 
-	case "rename.file.uid":
-		return "rename", nil
+		return reflect.String, nil
 
-	case "rename.file.user":
-		return "rename", nil
+	case "process.fsgid":
 
-	case "rename.retval":
-		return "rename", nil
+		return reflect.Int, nil
 
-	case "rmdir.file.change_time":
-		return "rmdir", nil
+	case "process.fsgroup":
 
-	case "rmdir.file.filesystem":
-		return "rmdir", nil
+		return reflect.String, nil
 
-	case "rmdir.file.gid":
-		return "rmdir", nil
+	case "process.fsuid":
 
-	case "rmdir.file.group":
-		return "rmdir", nil
+		return reflect.Int, nil
 
-	case "rmdir.file.in_upper_layer":
-		return "rmdir", nil
+	case "process.fsuser":
 
-	case "rmdir.file.inode":
-		return "rmdir", nil
+		return reflect.String, nil
 
-	case "rmdir.file.mode":
-		return "rmdir", nil
+	case "process.gid":
 
-	case "rmdir.file.modification_time":
-		return "rmdir", nil
+		return reflect.Int, nil
 
-	case "rmdir.file.mount_id":
-		return "rmdir", nil
+	case "process.group":
 
-	case "rmdir.file.name":
-		return "rmdir", nil
+		return reflect.String, nil
 
-	case "rmdir.file.path":
-		return "rmdir", nil
+	case "process.pid":
 
-	case "rmdir.file.rights":
-		return "rmdir", nil
+		return reflect.Int, nil
 
-	case "rmdir.file.uid":
-		return "rmdir", nil
+	case "process.ppid":
 
-	case "rmdir.file.user":
-		return "rmdir", nil
+		return reflect.Int, nil
 
-	case "rmdir.retval":
-		return "rmdir", nil
+	case "process.tid":
 
-	case "selinux.bool.name":
-		return "selinux", nil
+		return reflect.Int, nil
 
-	case "selinux.bool.state":
-		return "selinux", nil
+	case "process.tty_name":
 
-	case "selinux.bool_commit.state":
-		return "selinux", nil
+		return reflect.String, nil
 
-	case "selinux.enforce.status":
-		return "selinux", nil
+	case "process.uid":
 
-	case "setgid.egid":
-		return "setgid", nil
+		return reflect.Int, nil
 
-	case "setgid.egroup":
-		return "setgid", nil
+	case "process.user":
 
-	case "setgid.fsgid":
-		return "setgid", nil
+		return reflect.String, nil
 
-	case "setgid.fsgroup":
-		return "setgid", nil
+	case "ptrace.request":
 
-	case "setgid.gid":
-		return "setgid", nil
+		return reflect.Int, nil
 
-	case "setgid.group":
-		return "setgid", nil
+	case "ptrace.retval":
 
-	case "setuid.euid":
-		return "setuid", nil
+		return reflect.Int, nil
 
-	case "setuid.euser":
-		return "setuid", nil
+	case "ptrace.tracee.cap_effective":
 
-	case "setuid.fsuid":
-		return "setuid", nil
+		return reflect.Int, nil
 
-	case "setuid.fsuser":
-		return "setuid", nil
+	case "ptrace.tracee.cap_permitted":
 
-	case "setuid.uid":
-		return "setuid", nil
+		return reflect.Int, nil
 
-	case "setuid.user":
-		return "setuid", nil
+	case "ptrace.tracee.comm":
 
-	case "setxattr.file.change_time":
-		return "setxattr", nil
+		return reflect.String, nil
 
-	case "setxattr.file.destination.name":
-		return "setxattr", nil
+	case "ptrace.tracee.container.id":
 
-	case "setxattr.file.destination.namespace":
-		return "setxattr", nil
+		return reflect.String, nil
 
-	case "setxattr.file.filesystem":
-		return "setxattr", nil
+	case "ptrace.tracee.cookie":
 
-	case "setxattr.file.gid":
-		return "setxattr", nil
+		return reflect.Int, nil
 
-	case "setxattr.file.group":
-		return "setxattr", nil
+	case "ptrace.tracee.created_at":
 
-	case "setxattr.file.in_upper_layer":
-		return "setxattr", nil
+		return reflect.Int, nil
 
-	case "setxattr.file.inode":
-		return "setxattr", nil
+	case "ptrace.tracee.egid":
 
-	case "setxattr.file.mode":
-		return "setxattr", nil
+		return reflect.Int, nil
 
-	case "setxattr.file.modification_time":
-		return "setxattr", nil
+	case "ptrace.tracee.egroup":
 
-	case "setxattr.file.mount_id":
-		return "setxattr", nil
+		return reflect.String, nil
 
-	case "setxattr.file.name":
-		return "setxattr", nil
+	case "ptrace.tracee.euid":
 
-	case "setxattr.file.path":
-		return "setxattr", nil
+		return reflect.Int, nil
 
-	case "setxattr.file.rights":
-		return "setxattr", nil
+	case "ptrace.tracee.euser":
 
-	case "setxattr.file.uid":
-		return "setxattr", nil
+		return reflect.String, nil
 
-	case "setxattr.file.user":
-		return "setxattr", nil
+	case "ptrace.tracee.file.change_time":
 
-	case "setxattr.retval":
-		return "setxattr", nil
+		return reflect.Int, nil
 
-	case "unlink.file.change_time":
-		return "unlink", nil
+	case "ptrace.tracee.file.filesystem":
 
-	case "unlink.file.filesystem":
-		return "unlink", nil
+		return reflect.String, nil
 
-	case "unlink.file.gid":
-		return "unlink", nil
+	case "ptrace.tracee.file.gid":
 
-	case "unlink.file.group":
-		return "unlink", nil
+		return reflect.Int, nil
 
-	case "unlink.file.in_upper_layer":
-		return "unlink", nil
+	case "ptrace.tracee.file.group":
 
-	case "unlink.file.inode":
-		return "unlink", nil
+		return reflect.String, nil
 
-	case "unlink.file.mode":
-		return "unlink", nil
+	case "ptrace.tracee.file.in_upper_layer":
 
-	case "unlink.file.modification_time":
-		return "unlink", nil
+		return reflect.Bool, nil
 
-	case "unlink.file.mount_id":
-		return "unlink", nil
+	case "ptrace.tracee.file.inode":
 
-	case "unlink.file.name":
-		return "unlink", nil
+		return reflect.Int, nil
 
-	case "unlink.file.path":
-		return "unlink", nil
+	case "ptrace.tracee.file.mode":
 
-	case "unlink.file.rights":
-		return "unlink", nil
+		return reflect.Int, nil
 
-	case "unlink.file.uid":
-		return "unlink", nil
+	case "ptrace.tracee.file.modification_time":
 
-	case "unlink.file.user":
-		return "unlink", nil
+		return reflect.Int, nil
 
-	case "unlink.retval":
-		return "unlink", nil
+	case "ptrace.tracee.file.mount_id":
 
-	case "utimes.file.change_time":
-		return "utimes", nil
+		return reflect.Int, nil
 
-	case "utimes.file.filesystem":
-		return "utimes", nil
+	case "ptrace.tracee.file.name":
 
-	case "utimes.file.gid":
-		return "utimes", nil
+		return reflect.String, nil
 
-	case "utimes.file.group":
-		return "utimes", nil
+	case "ptrace.tracee.file.path":
 
-	case "utimes.file.in_upper_layer":
-		return "utimes", nil
+		return reflect.String, nil
 
-	case "utimes.file.inode":
-		return "utimes", nil
+	case "ptrace.tracee.file.rights":
 
-	case "utimes.file.mode":
-		return "utimes", nil
+		return reflect.Int, nil
 
-	case "utimes.file.modification_time":
-		return "utimes", nil
+	case "ptrace.tracee.file.uid":
 
-	case "utimes.file.mount_id":
-		return "utimes", nil
+		return reflect.Int, nil
 
-	case "utimes.file.name":
-		return "utimes", nil
+	case "ptrace.tracee.file.user":
 
-	case "utimes.file.path":
-		return "utimes", nil
+		return reflect.String, nil
 
-	case "utimes.file.rights":
-		return "utimes", nil
+	case "ptrace.tracee.fsgid":
 
-	case "utimes.file.uid":
-		return "utimes", nil
+		return reflect.Int, nil
 
-	case "utimes.file.user":
-		return "utimes", nil
+	case "ptrace.tracee.fsgroup":
 
-	case "utimes.retval":
-		return "utimes", nil
+		return reflect.String, nil
 
-	}
+	case "ptrace.tracee.fsuid":
 
-	return "", &eval.ErrFieldNotFound{Field: field}
-}
+		return reflect.Int, nil
 
-func (e *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
-	switch field {
+	case "ptrace.tracee.fsuser":
 
-	case "bpf.cmd":
+		return reflect.String, nil
+
+	case "ptrace.tracee.gid":
 
 		return reflect.Int, nil
 
-	case "bpf.map.type":
+	case "ptrace.tracee.group":
 
-		return reflect.Int, nil
+		return reflect.String, nil
 
-	case "bpf.prog.attach_type":
+	case "ptrace.tracee.pid":
 
 		return reflect.Int, nil
 
-	case "bpf.prog.type":
+	case "ptrace.tracee.ppid":
 
 		return reflect.Int, nil
 
-	case "bpf.retval":
+	case "ptrace.tracee.tid":
 
 		return reflect.Int, nil
 
-	case "capset.cap_effective":
+	case "ptrace.tracee.tty_name":
 
-		return reflect.Int, nil
+		return reflect.String, nil
 
-	case "capset.cap_permitted":
+	case "ptrace.tracee.uid":
 
 		return reflect.Int, nil
 
-	case "chmod.file.change_time":
+	case "ptrace.tracee.user":
 
-		return reflect.Int, nil
+		return reflect.String, nil
 
-	case "chmod.file.destination.mode":
+	case "removexattr.file.change_time":
 
 		return reflect.Int, nil
 
-	case "chmod.file.destination.rights":
+	case "removexattr.file.destination.name":
 
-		return reflect.Int, nil
+		return reflect.String, nil
 
-	case "chmod.file.filesystem":
+	case "removexattr.file.destination.namespace":
 
 		return reflect.String, nil
 
-	case "chmod.file.gid":
+	case "removexattr.file.filesystem":
+
+		return reflect.String, nil
+
+	case "removexattr.file.gid":
 
 		return reflect.Int, nil
 
-	case "chmod.file.group":
+	case "removexattr.file.group":
 
 		return reflect.String, nil
 
-	case "chmod.file.in_upper_layer":
+	case "removexattr.file.in_upper_layer":
 
 		return reflect.Bool, nil
 
-	case "chmod.file.inode":
+	case "removexattr.file.inode":
 
 		return reflect.Int, nil
 
-	case "chmod.file.mode":
+	case "removexattr.file.mode":
 
 		return reflect.Int, nil
 
-	case "chmod.file.modification_time":
+	case "removexattr.file.modification_time":
 
 		return reflect.Int, nil
 
-	case "chmod.file.mount_id":
+	case "removexattr.file.mount_id":
 
 		return reflect.Int, nil
 
-	case "chmod.file.name":
+	case "removexattr.file.name":
 
 		return reflect.String, nil
 
-	case "chmod.file.path":
+	case "removexattr.file.path":
 
 		return reflect.String, nil
 
-	case "chmod.file.rights":
+	case "removexattr.file.rights":
 
 		return reflect.Int, nil
 
-	case "chmod.file.uid":
+	case "removexattr.file.uid":
 
 		return reflect.Int, nil
 
-	case "chmod.file.user":
+	case "removexattr.file.user":
 
 		return reflect.String, nil
 
-	case "chmod.retval":
-
-		return reflect.Int, nil
-
-	case "chown.file.change_time":
+	case "removexattr.retval":
 
 		return reflect.Int, nil
 
-	case "chown.file.destination.gid":
+	case "rename.file.change_time":
 
 		return reflect.Int, nil
 
-	case "chown.file.destination.group":
-
-		return reflect.String, nil
-
-	case "chown.file.destination.uid":
+	case "rename.file.destination.change_time":
 
 		return reflect.Int, nil
 
-	case "chown.file.destination.user":
-
-		return reflect.String, nil
-
-	case "chown.file.filesystem":
+	case "rename.file.destination.filesystem":
 
 		return reflect.String, nil
 
-	case "chown.file.gid":
+	case "rename.file.destination.gid":
 
 		return reflect.Int, nil
 
-	case "chown.file.group":
+	case "rename.file.destination.group":
 
 		return reflect.String, nil
 
-	case "chown.file.in_upper_layer":
+	case "rename.file.destination.in_upper_layer":
 
 		return reflect.Bool, nil
 
-	case "chown.file.inode":
+	case "rename.file.destination.inode":
 
 		return reflect.Int, nil
 
-	case "chown.file.mode":
+	case "rename.file.destination.mode":
 
 		return reflect.Int, nil
 
-	case "chown.file.modification_time":
+	case "rename.file.destination.modification_time":
 
 		return reflect.Int, nil
 
-	case "chown.file.mount_id":
+	case "rename.file.destination.mount_id":
 
 		return reflect.Int, nil
 
-	case "chown.file.name":
+	case "rename.file.destination.name":
 
 		return reflect.String, nil
 
-	case "chown.file.path":
+	case "rename.file.destination.path":
 
 		return reflect.String, nil
 
-	case "chown.file.rights":
+	case "rename.file.destination.rights":
 
 		return reflect.Int, nil
 
-	case "chown.file.uid":
+	case "rename.file.destination.uid":
 
 		return reflect.Int, nil
 
-	case "chown.file.user":
+	case "rename.file.destination.user":
 
 		return reflect.String, nil
 
-	case "chown.retval":
+	case "rename.file.filesystem":
+
+		return reflect.String, nil
+
+	case "rename.file.gid":
 
 		return reflect.Int, nil
 
-	case "container.id":
+	case "rename.file.group":
 
 		return reflect.String, nil
 
-	case "container.tags":
+	case "rename.file.in_upper_layer":
 
-		return reflect.String, nil
+		return reflect.Bool, nil
 
-	case "exec.args":
+	case "rename.file.inode":
 
-		return reflect.String, nil
+		return reflect.Int, nil
 
-	case "exec.args_flags":
+	case "rename.file.mode":
 
-		return reflect.String, nil
+		return reflect.Int, nil
 
-	case "exec.args_options":
+	case "rename.file.modification_time":
 
-		return reflect.String, nil
+		return reflect.Int, nil
 
-	case "exec.args_truncated":
+	case "rename.file.mount_id":
 
-		return reflect.Bool, nil
+		return reflect.Int, nil
 
-	case "exec.argv":
+	case "rename.file.name":
 
 		return reflect.String, nil
 
-	case "exec.cap_effective":
+	case "rename.file.path":
 
-		return reflect.Int, nil
+		return reflect.String, nil
 
-	case "exec.cap_permitted":
+	case "rename.file.rights":
 
 		return reflect.Int, nil
 
-	case "exec.comm":
+	case "rename.file.uid":
 
-		return reflect.String, nil
+		return reflect.Int, nil
 
-	case "exec.container.id":
+	case "rename.file.user":
 
 		return reflect.String, nil
 
-	case "exec.cookie":
+	case "rename.retval":
 
 		return reflect.Int, nil
 
-	case "exec.created_at":
+	case "rmdir.file.change_time":
 
 		return reflect.Int, nil
 
-	case "exec.egid":
+	case "rmdir.file.filesystem":
 
-		return reflect.Int, nil
+		return reflect.String, nil
 
-	case "exec.egroup":
+	case "rmdir.file.gid":
 
-		return reflect.String, nil
+		return reflect.Int, nil
 
-	case "exec.envs":
+	case "rmdir.file.group":
 
 		return reflect.String, nil
 
-	case "exec.envs_truncated":
+	case "rmdir.file.in_upper_layer":
 
 		return reflect.Bool, nil
 
-	case "exec.euid":
+	case "rmdir.file.inode":
 
 		return reflect.Int, nil
 
-	case "exec.euser":
-
-		return reflect.String, nil
-
-	case "exec.file.change_time":
+	case "rmdir.file.mode":
 
 		return reflect.Int, nil
 
-	case "exec.file.filesystem":
+	case "rmdir.file.modification_time":
 
-		return reflect.String, nil
+		return reflect.Int, nil
 
-	case "exec.file.gid":
+	case "rmdir.file.mount_id":
 
 		return reflect.Int, nil
 
-	case "exec.file.group":
+	case "rmdir.file.name":
 
 		return reflect.String, nil
 
-	case "exec.file.in_upper_layer":
+	case "rmdir.file.path":
 
-		return reflect.Bool, nil
+		return reflect.String, nil
 
-	case "exec.file.inode":
+	case "rmdir.file.rights":
 
 		return reflect.Int, nil
 
-	case "exec.file.mode":
+	case "rmdir.file.uid":
 
 		return reflect.Int, nil
 
-	case "exec.file.modification_time":
+	case "rmdir.file.user":
 
-		return reflect.Int, nil
+		return reflect.String, nil
 
-	case "exec.file.mount_id":
+	case "rmdir.retval":
 
 		return reflect.Int, nil
 
-	case "exec.file.name":
+	case "selinux.bool.name":
 
 		return reflect.String, nil
 
-	case "exec.file.path":
+	case "selinux.bool.state":
 
 		return reflect.String, nil
 
-	case "exec.file.rights":
-
-		return reflect.Int, nil
-
-	case "exec.file.uid":
+	case "selinux.bool_commit.state":
 
-		return reflect.Int, nil
+		return reflect.Bool, nil
 
-	case "exec.file.user":
+	case "selinux.enforce.status":
 
 		return reflect.String, nil
 
-	case "exec.fsgid":
+	case "setgid.egid":
 
 		return reflect.Int, nil
 
-	case "exec.fsgroup":
+	case "setgid.egroup":
 
 		return reflect.String, nil
 
-	case "exec.fsuid":
+	case "setgid.fsgid":
 
 		return reflect.Int, nil
 
-	case "exec.fsuser":
+	case "setgid.fsgroup":
 
 		return reflect.String, nil
 
-	case "exec.gid":
+	case "setgid.gid":
 
 		return reflect.Int, nil
 
-	case "exec.group":
+	case "setgid.group":
 
 		return reflect.String, nil
 
-	case "exec.pid":
+	case "setuid.euid":
 
 		return reflect.Int, nil
 
-	case "exec.ppid":
+	case "setuid.euser":
 
-		return reflect.Int, nil
+		return reflect.String, nil
 
-	case "exec.tid":
+	case "setuid.fsuid":
 
 		return reflect.Int, nil
 
-	case "exec.tty_name":
+	case "setuid.fsuser":
 
 		return reflect.String, nil
 
-	case "exec.uid":
+	case "setuid.uid":
 
 		return reflect.Int, nil
 
-	case "exec.user":
+	case "setuid.user":
 
 		return reflect.String, nil
 
-	case "link.file.change_time":
+	case "setxattr.file.change_time":
 
 		return reflect.Int, nil
 
-	case "link.file.destination.change_time":
+	case "setxattr.file.destination.name":
 
-		return reflect.Int, nil
+		return reflect.String, nil
 
-	case "link.file.destination.filesystem":
+	case "setxattr.file.destination.namespace":
 
 		return reflect.String, nil
 
-	case "link.file.destination.gid":
+	case "setxattr.file.filesystem":
+
+		return reflect.String, nil
+
+	case "setxattr.file.gid":
 
 		return reflect.Int, nil
 
-	case "link.file.destination.group":
+	case "setxattr.file.group":
 
 		return reflect.String, nil
 
-	case "link.file.destination.in_upper_layer":
+	case "setxattr.file.in_upper_layer":
 
 		return reflect.Bool, nil
 
-	case "link.file.destination.inode":
+	case "setxattr.file.inode":
 
 		return reflect.Int, nil
 
-	case "link.file.destination.mode":
+	case "setxattr.file.mode":
 
 		return reflect.Int, nil
 
-	case "link.file.destination.modification_time":
+	case "setxattr.file.modification_time":
 
 		return reflect.Int, nil
 
-	case "link.file.destination.mount_id":
+	case "setxattr.file.mount_id":
 
 		return reflect.Int, nil
 
-	case "link.file.destination.name":
+	case "setxattr.file.name":
 
 		return reflect.String, nil
 
-	case "link.file.destination.path":
+	case "setxattr.file.path":
 
 		return reflect.String, nil
 
-	case "link.file.destination.rights":
+	case "setxattr.file.rights":
 
 		return reflect.Int, nil
 
-	case "link.file.destination.uid":
+	case "setxattr.file.uid":
 
 		return reflect.Int, nil
 
-	case "link.file.destination.user":
-
-		return reflect.String, nil
-
-	case "link.file.filesystem":
+	case "setxattr.file.user":
 
 		return reflect.String, nil
 
-	case "link.file.gid":
+	case "setxattr.retval":
 
 		return reflect.Int, nil
 
-	case "link.file.group":
-
-		return reflect.String, nil
-
-	case "link.file.in_upper_layer":
-
-		return reflect.Bool, nil
-
-	case "link.file.inode":
+	case "signal.pid":
 
 		return reflect.Int, nil
 
-	case "link.file.mode":
+	case "signal.retval":
 
 		return reflect.Int, nil
 
-	case "link.file.modification_time":
+	case "signal.target.cap_effective":
 
 		return reflect.Int, nil
 
-	case "link.file.mount_id":
+	case "signal.target.cap_permitted":
 
 		return reflect.Int, nil
 
-	case "link.file.name":
+	case "signal.target.comm":
 
 		return reflect.String, nil
 
-	case "link.file.path":
+	case "signal.target.container.id":
 
 		return reflect.String, nil
 
-	case "link.file.rights":
+	case "signal.target.cookie":
 
 		return reflect.Int, nil
 
-	case "link.file.uid":
+	case "signal.target.created_at":
+
+		return reflect.Int, nil
+
+	case "signal.target.egid":
 
 		return reflect.Int, nil
 
-	case "link.file.user":
+	case "signal.target.egroup":
 
 		return reflect.String, nil
 
-	case "link.retval":
-
-		return reflect.Int, nil
-
-	case "mkdir.file.change_time":
+	case "signal.target.euid":
 
 		return reflect.Int, nil
 
-	case "mkdir.file.destination.mode":
+	case "signal.target.euser":
 
-		return reflect.Int, nil
+		return reflect.String, nil
 
-	case "mkdir.file.destination.rights":
+	case "signal.target.file.change_time":
 
 		return reflect.Int, nil
 
-	case "mkdir.file.filesystem":
+	case "signal.target.file.filesystem":
 
 		return reflect.String, nil
 
-	case "mkdir.file.gid":
+	case "signal.target.file.gid":
 
 		return reflect.Int, nil
 
-	case "mkdir.file.group":
+	case "signal.target.file.group":
 
 		return reflect.String, nil
 
-	case "mkdir.file.in_upper_layer":
+	case "signal.target.file.in_upper_layer":
 
 		return reflect.Bool, nil
 
-	case "mkdir.file.inode":
+	case "signal.target.file.inode":
 
 		return reflect.Int, nil
 
-	case "mkdir.file.mode":
+	case "signal.target.file.mode":
 
 		return reflect.Int, nil
 
-	case "mkdir.file.modification_time":
+	case "signal.target.file.modification_time":
 
 		return reflect.Int, nil
 
-	case "mkdir.file.mount_id":
+	case "signal.target.file.mount_id":
 
 		return reflect.Int, nil
 
-	case "mkdir.file.name":
+	case "signal.target.file.name":
 
 		return reflect.String, nil
 
-	case "mkdir.file.path":
+	case "signal.target.file.path":
 
 		return reflect.String, nil
 
-	case "mkdir.file.rights":
+	case "signal.target.file.rights":
 
 		return reflect.Int, nil
 
-	case "mkdir.file.uid":
+	case "signal.target.file.uid":
 
 		return reflect.Int, nil
 
-	case "mkdir.file.user":
+	case "signal.target.file.user":
 
 		return reflect.String, nil
 
-	case "mkdir.retval":
+	case "signal.target.fsgid":
 
 		return reflect.Int, nil
 
-	case "open.file.change_time":
+	case "signal.target.fsgroup":
 
-		return reflect.Int, nil
+		return reflect.String, nil
 
-	case "open.file.destination.mode":
+	case "signal.target.fsuid":
 
 		return reflect.Int, nil
 
-	case "open.file.filesystem":
+	case "signal.target.fsuser":
 
 		return reflect.String, nil
 
-	case "open.file.gid":
+	case "signal.target.gid":
 
 		return reflect.Int, nil
 
-	case "open.file.group":
+	case "signal.target.group":
 
 		return reflect.String, nil
 
-	case "open.file.in_upper_layer":
+	case "signal.target.pid":
 
-		return reflect.Bool, nil
+		return reflect.Int, nil
 
-	case "open.file.inode":
+	case "signal.target.ppid":
 
 		return reflect.Int, nil
 
-	case "open.file.mode":
+	case "signal.target.tid":
 
 		return reflect.Int, nil
 
-	case "open.file.modification_time":
+	case "signal.target.tty_name":
 
-		return reflect.Int, nil
+		return reflect.String, nil
 
-	case "open.file.mount_id":
+	case "signal.target.uid":
 
 		return reflect.Int, nil
 
-	case "open.file.name":
+	case "signal.target.user":
 
 		return reflect.String, nil
 
-	case "open.file.path":
+	case "signal.type":
 
-		return reflect.String, nil
+		return reflect.Int, nil
 
-	case "open.file.rights":
+	case "unlink.file.change_time":
 
 		return reflect.Int, nil
 
-	case "open.file.uid":
+	case "unlink.file.filesystem":
+
+		return reflect.String, nil
+
+	case "unlink.file.gid":
 
 		return reflect.Int, nil
 
-	case "open.file.user":
+	case "unlink.file.group":
 
 		return reflect.String, nil
 
-	case "open.flags":
+	case "unlink.file.in_upper_layer":
 
-		return reflect.Int, nil
+		return reflect.Bool, nil
 
-	case "open.retval":
+	case "unlink.file.inode":
 
 		return reflect.Int, nil
 
-	case "process.ancestors.cap_effective":
+	case "unlink.file.mode":
 
 		return reflect.Int, nil
 
-	case "process.ancestors.cap_permitted":
+	case "unlink.file.modification_time":
 
 		return reflect.Int, nil
 
-	case "process.ancestors.comm":
+	case "unlink.file.mount_id":
 
-		return reflect.String, nil
+		return reflect.Int, nil
 
-	case "process.ancestors.container.id":
+	case "unlink.file.name":
 
 		return reflect.String, nil
 
-	case "process.ancestors.cookie":
+	case "unlink.file.path":
 
-		return reflect.Int, nil
+		return reflect.String, nil
 
-	case "process.ancestors.created_at":
+	case "unlink.file.rights":
 
 		return reflect.Int, nil
 
-	case "process.ancestors.egid":
+	case "unlink.file.uid":
 
 		return reflect.Int, nil
 
-	case "process.ancestors.egroup":
+	case "unlink.file.user":
 
 		return reflect.String, nil
 
-	case "process.ancestors.euid":
+	case "unlink.retval":
 
 		return reflect.Int, nil
 
-	case "process.ancestors.euser":
+	case "unload_module.name":
 
 		return reflect.String, nil
 
-	case "process.ancestors.file.change_time":
+	case "unload_module.retval":
 
 		return reflect.Int, nil
 
-	case "process.ancestors.file.filesystem":
+	case "utimes.file.change_time":
+
+		return reflect.Int, nil
+
+	case "utimes.file.filesystem":
 
 		return reflect.String, nil
 
-	case "process.ancestors.file.gid":
+	case "utimes.file.gid":
 
 		return reflect.Int, nil
 
-	case "process.ancestors.file.group":
+	case "utimes.file.group":
 
 		return reflect.String, nil
 
-	case "process.ancestors.file.in_upper_layer":
+	case "utimes.file.in_upper_layer":
 
 		return reflect.Bool, nil
 
-	case "process.ancestors.file.inode":
+	case "utimes.file.inode":
 
 		return reflect.Int, nil
 
-	case "process.ancestors.file.mode":
+	case "utimes.file.mode":
 
 		return reflect.Int, nil
 
-	case "process.ancestors.file.modification_time":
+	case "utimes.file.modification_time":
 
 		return reflect.Int, nil
 
-	case "process.ancestors.file.mount_id":
+	case "utimes.file.mount_id":
 
 		return reflect.Int, nil
 
-	case "process.ancestors.file.name":
+	case "utimes.file.name":
 
 		return reflect.String, nil
 
-	case "process.ancestors.file.path":
+	case "utimes.file.path":
 
 		return reflect.String, nil
 
-	case "process.ancestors.file.rights":
+	case "utimes.file.rights":
 
 		return reflect.Int, nil
 
-	case "process.ancestors.file.uid":
+	case "utimes.file.uid":
 
 		return reflect.Int, nil
 
-	case "process.ancestors.file.user":
+	case "utimes.file.user":
 
 		return reflect.String, nil
 
-	case "process.ancestors.fsgid":
+	case "utimes.retval":
 
 		return reflect.Int, nil
 
-	case "process.ancestors.fsgroup":
+	}
 
-		return reflect.String, nil
+	return reflect.Invalid, &eval.ErrFieldNotFound{Field: field}
+}
 
-	case "process.ancestors.fsuid":
+func (e *Event) SetFieldValue(field eval.Field, value interface{}) error {
+	switch field {
 
-		return reflect.Int, nil
+	case "accept.addr.family":
 
-	case "process.ancestors.fsuser":
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Accept.AddrFamily"}
+		}
+		e.Accept.AddrFamily = uint16(v)
 
-		return reflect.String, nil
+		return nil
 
-	case "process.ancestors.gid":
+	case "accept.addr.ip":
 
-		return reflect.Int, nil
+		var ok bool
+		v, ok := value.(net.IPNet)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Accept.Addr.IPNet"}
+		}
+		e.Accept.Addr.IPNet = v
 
-	case "process.ancestors.group":
+		return nil
 
-		return reflect.String, nil
+	case "accept.addr.port":
 
-	case "process.ancestors.pid":
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Accept.Addr.Port"}
+		}
+		e.Accept.Addr.Port = uint16(v)
 
-		return reflect.Int, nil
+		return nil
 
-	case "process.ancestors.ppid":
+	case "accept.retval":
 
-		return reflect.Int, nil
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Accept.SyscallEvent.Retval"}
+		}
+		e.Accept.SyscallEvent.Retval = int64(v)
 
-	case "process.ancestors.tid":
+		return nil
 
-		return reflect.Int, nil
+	case "bind.addr.family":
 
-	case "process.ancestors.tty_name":
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Bind.AddrFamily"}
+		}
+		e.Bind.AddrFamily = uint16(v)
 
-		return reflect.String, nil
+		return nil
+
+	case "bind.addr.ip":
+
+		var ok bool
+		v, ok := value.(net.IPNet)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Bind.Addr.IPNet"}
+		}
+		e.Bind.Addr.IPNet = v
+
+		return nil
+
+	case "bind.addr.port":
+
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Bind.Addr.Port"}
+		}
+		e.Bind.Addr.Port = uint16(v)
+
+		return nil
+
+	case "bind.retval":
+
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Bind.SyscallEvent.Retval"}
+		}
+		e.Bind.SyscallEvent.Retval = int64(v)
+
+		return nil
+
+	case "bpf.cmd":
+
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BPF.Cmd"}
+		}
+		e.BPF.Cmd = uint32(v)
+
+		return nil
+
+	case "bpf.map.type":
+
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BPF.Map.Type"}
+		}
+		e.BPF.Map.Type = uint32(v)
+
+		return nil
+
+	case "bpf.prog.attach_type":
+
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BPF.Program.AttachType"}
+		}
+		e.BPF.Program.AttachType = uint32(v)
+
+		return nil
+
+	case "bpf.prog.type":
+
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BPF.Program.Type"}
+		}
+		e.BPF.Program.Type = uint32(v)
+
+		return nil
+
+	case "bpf.retval":
+
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "BPF.SyscallEvent.Retval"}
+		}
+		e.BPF.SyscallEvent.Retval = int64(v)
+
+		return nil
+
+	case "capset.cap_effective":
+
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Capset.CapEffective"}
+		}
+		e.Capset.CapEffective = uint64(v)
+
+		return nil
+
+	case "capset.cap_permitted":
+
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Capset.CapPermitted"}
+		}
+		e.Capset.CapPermitted = uint64(v)
 
-	case "process.ancestors.uid":
+		return nil
 
-		return reflect.Int, nil
+	case "chmod.file.change_time":
 
-	case "process.ancestors.user":
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chmod.File.FileFields.CTime"}
+		}
+		e.Chmod.File.FileFields.CTime = uint64(v)
 
-		return reflect.String, nil
+		return nil
 
-	case "process.cap_effective":
+	case "chmod.file.destination.mode":
 
-		return reflect.Int, nil
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chmod.Mode"}
+		}
+		e.Chmod.Mode = uint32(v)
 
-	case "process.cap_permitted":
+		return nil
 
-		return reflect.Int, nil
+	case "chmod.file.destination.rights":
 
-	case "process.comm":
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chmod.Mode"}
+		}
+		e.Chmod.Mode = uint32(v)
 
-		return reflect.String, nil
+		return nil
 
-	case "process.container.id":
+	case "chmod.file.filesystem":
 
-		return reflect.String, nil
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chmod.File.Filesytem"}
+		}
+		e.Chmod.File.Filesytem = str
 
-	case "process.cookie":
+		return nil
 
-		return reflect.Int, nil
+	case "chmod.file.gid":
 
-	case "process.created_at":
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chmod.File.FileFields.GID"}
+		}
+		e.Chmod.File.FileFields.GID = uint32(v)
 
-		return reflect.Int, nil
+		return nil
 
-	case "process.egid":
+	case "chmod.file.group":
 
-		return reflect.Int, nil
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chmod.File.FileFields.Group"}
+		}
+		e.Chmod.File.FileFields.Group = str
 
-	case "process.egroup":
+		return nil
 
-		return reflect.String, nil
+	case "chmod.file.in_upper_layer":
 
-	case "process.euid":
+		var ok bool
+		if e.Chmod.File.FileFields.InUpperLayer, ok = value.(bool); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chmod.File.FileFields.InUpperLayer"}
+		}
+		return nil
 
-		return reflect.Int, nil
+	case "chmod.file.inode":
 
-	case "process.euser":
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chmod.File.FileFields.Inode"}
+		}
+		e.Chmod.File.FileFields.Inode = uint64(v)
 
-		return reflect.String, nil
+		return nil
 
-	case "process.file.change_time":
+	case "chmod.file.mode":
 
-		return reflect.Int, nil
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chmod.File.FileFields.Mode"}
+		}
+		e.Chmod.File.FileFields.Mode = uint16(v)
 
-	case "process.file.filesystem":
+		return nil
 
-		return reflect.String, nil
+	case "chmod.file.modification_time":
 
-	case "process.file.gid":
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chmod.File.FileFields.MTime"}
+		}
+		e.Chmod.File.FileFields.MTime = uint64(v)
 
-		return reflect.Int, nil
+		return nil
 
-	case "process.file.group":
+	case "chmod.file.mount_id":
 
-		return reflect.String, nil
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chmod.File.FileFields.MountID"}
+		}
+		e.Chmod.File.FileFields.MountID = uint32(v)
 
-	case "process.file.in_upper_layer":
+		return nil
 
-		return reflect.Bool, nil
+	case "chmod.file.name":
 
-	case "process.file.inode":
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chmod.File.BasenameStr"}
+		}
+		e.Chmod.File.BasenameStr = str
 
-		return reflect.Int, nil
+		return nil
 
-	case "process.file.mode":
+	case "chmod.file.path":
 
-		return reflect.Int, nil
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chmod.File.PathnameStr"}
+		}
+		e.Chmod.File.PathnameStr = str
 
-	case "process.file.modification_time":
+		return nil
 
-		return reflect.Int, nil
+	case "chmod.file.rights":
 
-	case "process.file.mount_id":
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chmod.File.FileFields.Mode"}
+		}
+		e.Chmod.File.FileFields.Mode = uint16(v)
 
-		return reflect.Int, nil
+		return nil
 
-	case "process.file.name":
+	case "chmod.file.uid":
 
-		return reflect.String, nil
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chmod.File.FileFields.UID"}
+		}
+		e.Chmod.File.FileFields.UID = uint32(v)
 
-	case "process.file.path":
+		return nil
 
-		return reflect.String, nil
+	case "chmod.file.user":
 
-	case "process.file.rights":
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chmod.File.FileFields.User"}
+		}
+		e.Chmod.File.FileFields.User = str
 
-		return reflect.Int, nil
+		return nil
 
-	case "process.file.uid":
+	case "chmod.retval":
 
-		return reflect.Int, nil
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chmod.SyscallEvent.Retval"}
+		}
+		e.Chmod.SyscallEvent.Retval = int64(v)
 
-	case "process.file.user":
+		return nil
 
-		return reflect.String, nil
+	case "chown.file.change_time":
 
-	case "process.fsgid":
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.File.FileFields.CTime"}
+		}
+		e.Chown.File.FileFields.CTime = uint64(v)
 
-		return reflect.Int, nil
+		return nil
 
-	case "process.fsgroup":
+	case "chown.file.destination.gid":
 
-		return reflect.String, nil
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.GID"}
+		}
+		e.Chown.GID = uint32(v)
 
-	case "process.fsuid":
+		return nil
 
-		return reflect.Int, nil
+	case "chown.file.destination.group":
 
-	case "process.fsuser":
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.Group"}
+		}
+		e.Chown.Group = str
 
-		return reflect.String, nil
+		return nil
 
-	case "process.gid":
+	case "chown.file.destination.uid":
 
-		return reflect.Int, nil
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.UID"}
+		}
+		e.Chown.UID = uint32(v)
 
-	case "process.group":
+		return nil
 
-		return reflect.String, nil
+	case "chown.file.destination.user":
 
-	case "process.pid":
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.User"}
+		}
+		e.Chown.User = str
 
-		return reflect.Int, nil
+		return nil
 
-	case "process.ppid":
+	case "chown.file.filesystem":
 
-		return reflect.Int, nil
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.File.Filesytem"}
+		}
+		e.Chown.File.Filesytem = str
 
-	case "process.tid":
+		return nil
 
-		return reflect.Int, nil
+	case "chown.file.gid":
 
-	case "process.tty_name":
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.File.FileFields.GID"}
+		}
+		e.Chown.File.FileFields.GID = uint32(v)
 
-		return reflect.String, nil
+		return nil
 
-	case "process.uid":
+	case "chown.file.group":
 
-		return reflect.Int, nil
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.File.FileFields.Group"}
+		}
+		e.Chown.File.FileFields.Group = str
 
-	case "process.user":
+		return nil
 
-		return reflect.String, nil
+	case "chown.file.in_upper_layer":
 
-	case "removexattr.file.change_time":
+		var ok bool
+		if e.Chown.File.FileFields.InUpperLayer, ok = value.(bool); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.File.FileFields.InUpperLayer"}
+		}
+		return nil
 
-		return reflect.Int, nil
+	case "chown.file.inode":
 
-	case "removexattr.file.destination.name":
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.File.FileFields.Inode"}
+		}
+		e.Chown.File.FileFields.Inode = uint64(v)
 
-		return reflect.String, nil
+		return nil
 
-	case "removexattr.file.destination.namespace":
+	case "chown.file.mode":
 
-		return reflect.String, nil
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.File.FileFields.Mode"}
+		}
+		e.Chown.File.FileFields.Mode = uint16(v)
 
-	case "removexattr.file.filesystem":
+		return nil
 
-		return reflect.String, nil
+	case "chown.file.modification_time":
 
-	case "removexattr.file.gid":
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.File.FileFields.MTime"}
+		}
+		e.Chown.File.FileFields.MTime = uint64(v)
 
-		return reflect.Int, nil
+		return nil
 
-	case "removexattr.file.group":
+	case "chown.file.mount_id":
 
-		return reflect.String, nil
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.File.FileFields.MountID"}
+		}
+		e.Chown.File.FileFields.MountID = uint32(v)
 
-	case "removexattr.file.in_upper_layer":
+		return nil
 
-		return reflect.Bool, nil
+	case "chown.file.name":
 
-	case "removexattr.file.inode":
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.File.BasenameStr"}
+		}
+		e.Chown.File.BasenameStr = str
 
-		return reflect.Int, nil
+		return nil
 
-	case "removexattr.file.mode":
+	case "chown.file.path":
 
-		return reflect.Int, nil
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.File.PathnameStr"}
+		}
+		e.Chown.File.PathnameStr = str
 
-	case "removexattr.file.modification_time":
+		return nil
 
-		return reflect.Int, nil
+	case "chown.file.rights":
 
-	case "removexattr.file.mount_id":
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.File.FileFields.Mode"}
+		}
+		e.Chown.File.FileFields.Mode = uint16(v)
 
-		return reflect.Int, nil
+		return nil
 
-	case "removexattr.file.name":
+	case "chown.file.uid":
 
-		return reflect.String, nil
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.File.FileFields.UID"}
+		}
+		e.Chown.File.FileFields.UID = uint32(v)
 
-	case "removexattr.file.path":
+		return nil
 
-		return reflect.String, nil
+	case "chown.file.user":
 
-	case "removexattr.file.rights":
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.File.FileFields.User"}
+		}
+		e.Chown.File.FileFields.User = str
 
-		return reflect.Int, nil
+		return nil
 
-	case "removexattr.file.uid":
+	case "chown.retval":
 
-		return reflect.Int, nil
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Chown.SyscallEvent.Retval"}
+		}
+		e.Chown.SyscallEvent.Retval = int64(v)
 
-	case "removexattr.file.user":
+		return nil
 
-		return reflect.String, nil
+	case "connect.addr.family":
 
-	case "removexattr.retval":
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Connect.AddrFamily"}
+		}
+		e.Connect.AddrFamily = uint16(v)
 
-		return reflect.Int, nil
+		return nil
 
-	case "rename.file.change_time":
+	case "connect.addr.ip":
 
-		return reflect.Int, nil
+		var ok bool
+		v, ok := value.(net.IPNet)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Connect.Addr.IPNet"}
+		}
+		e.Connect.Addr.IPNet = v
 
-	case "rename.file.destination.change_time":
+		return nil
 
-		return reflect.Int, nil
+	case "connect.addr.port":
 
-	case "rename.file.destination.filesystem":
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Connect.Addr.Port"}
+		}
+		e.Connect.Addr.Port = uint16(v)
 
-		return reflect.String, nil
+		return nil
 
-	case "rename.file.destination.gid":
+	case "connect.retval":
 
-		return reflect.Int, nil
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Connect.SyscallEvent.Retval"}
+		}
+		e.Connect.SyscallEvent.Retval = int64(v)
 
-	case "rename.file.destination.group":
+		return nil
 
-		return reflect.String, nil
+	case "container.id":
 
-	case "rename.file.destination.in_upper_layer":
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "ContainerContext.ID"}
+		}
+		e.ContainerContext.ID = str
 
-		return reflect.Bool, nil
+		return nil
 
-	case "rename.file.destination.inode":
+	case "container.tags":
 
-		return reflect.Int, nil
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "ContainerContext.Tags"}
+		}
+		e.ContainerContext.Tags = append(e.ContainerContext.Tags, str)
 
-	case "rename.file.destination.mode":
+		return nil
 
-		return reflect.Int, nil
+	case "dns.id":
 
-	case "rename.file.destination.modification_time":
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "DNS.ID"}
+		}
+		e.DNS.ID = uint16(v)
 
-		return reflect.Int, nil
+		return nil
 
-	case "rename.file.destination.mount_id":
+	case "dns.question.class":
 
-		return reflect.Int, nil
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "DNS.Class"}
+		}
+		e.DNS.Class = uint16(v)
 
-	case "rename.file.destination.name":
+		return nil
 
-		return reflect.String, nil
+	case "dns.question.count":
 
-	case "rename.file.destination.path":
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "DNS.Count"}
+		}
+		e.DNS.Count = uint16(v)
 
-		return reflect.String, nil
+		return nil
 
-	case "rename.file.destination.rights":
+	case "dns.question.name":
 
-		return reflect.Int, nil
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "DNS.Name"}
+		}
+		e.DNS.Name = str
 
-	case "rename.file.destination.uid":
+		return nil
 
-		return reflect.Int, nil
+	case "dns.question.size":
 
-	case "rename.file.destination.user":
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "DNS.Size"}
+		}
+		e.DNS.Size = uint16(v)
 
-		return reflect.String, nil
+		return nil
 
-	case "rename.file.filesystem":
+	case "dns.question.type":
 
-		return reflect.String, nil
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "DNS.Type"}
+		}
+		e.DNS.Type = uint16(v)
 
-	case "rename.file.gid":
+		return nil
 
-		return reflect.Int, nil
+	case "exec.args":
 
-	case "rename.file.group":
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Args"}
+		}
+		e.Exec.Args = str
 
-		return reflect.String, nil
+		return nil
 
-	case "rename.file.in_upper_layer":
+	case "exec.args_flags":
 
-		return reflect.Bool, nil
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Argv"}
+		}
+		e.Exec.Argv = append(e.Exec.Argv, str)
 
-	case "rename.file.inode":
+		return nil
 
-		return reflect.Int, nil
+	case "exec.args_options":
 
-	case "rename.file.mode":
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Argv"}
+		}
+		e.Exec.Argv = append(e.Exec.Argv, str)
 
-		return reflect.Int, nil
+		return nil
 
-	case "rename.file.modification_time":
+	case "exec.args_truncated":
 
-		return reflect.Int, nil
+		var ok bool
+		if e.Exec.ArgsTruncated, ok = value.(bool); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.ArgsTruncated"}
+		}
+		return nil
 
-	case "rename.file.mount_id":
+	case "exec.argv":
 
-		return reflect.Int, nil
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Argv"}
+		}
+		e.Exec.Argv = append(e.Exec.Argv, str)
 
-	case "rename.file.name":
+		return nil
 
-		return reflect.String, nil
+	case "exec.cap_effective":
 
-	case "rename.file.path":
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Credentials.CapEffective"}
+		}
+		e.Exec.Process.Credentials.CapEffective = uint64(v)
 
-		return reflect.String, nil
+		return nil
 
-	case "rename.file.rights":
+	case "exec.cap_permitted":
 
-		return reflect.Int, nil
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Credentials.CapPermitted"}
+		}
+		e.Exec.Process.Credentials.CapPermitted = uint64(v)
 
-	case "rename.file.uid":
+		return nil
 
-		return reflect.Int, nil
+	case "exec.comm":
 
-	case "rename.file.user":
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Comm"}
+		}
+		e.Exec.Process.Comm = str
 
-		return reflect.String, nil
+		return nil
 
-	case "rename.retval":
+	case "exec.container.id":
 
-		return reflect.Int, nil
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.ContainerID"}
+		}
+		e.Exec.Process.ContainerID = str
 
-	case "rmdir.file.change_time":
+		return nil
 
-		return reflect.Int, nil
+	case "exec.cookie":
 
-	case "rmdir.file.filesystem":
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Cookie"}
+		}
+		e.Exec.Process.Cookie = uint32(v)
 
-		return reflect.String, nil
+		return nil
 
-	case "rmdir.file.gid":
+	case "exec.created_at":
 
-		return reflect.Int, nil
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.CreatedAt"}
+		}
+		e.Exec.Process.CreatedAt = uint64(v)
 
-	case "rmdir.file.group":
+		return nil
 
-		return reflect.String, nil
+	case "exec.egid":
 
-	case "rmdir.file.in_upper_layer":
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Credentials.EGID"}
+		}
+		e.Exec.Process.Credentials.EGID = uint32(v)
 
-		return reflect.Bool, nil
+		return nil
 
-	case "rmdir.file.inode":
+	case "exec.egroup":
 
-		return reflect.Int, nil
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Credentials.EGroup"}
+		}
+		e.Exec.Process.Credentials.EGroup = str
 
-	case "rmdir.file.mode":
+		return nil
 
-		return reflect.Int, nil
+	case "exec.envs":
 
-	case "rmdir.file.modification_time":
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Envs"}
+		}
+		e.Exec.Envs = append(e.Exec.Envs, str)
 
-		return reflect.Int, nil
+		return nil
 
-	case "rmdir.file.mount_id":
+	case "exec.envs_truncated":
 
-		return reflect.Int, nil
+		var ok bool
+		if e.Exec.EnvsTruncated, ok = value.(bool); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.EnvsTruncated"}
+		}
+		return nil
 
-	case "rmdir.file.name":
+	case "exec.euid":
 
-		return reflect.String, nil
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Credentials.EUID"}
+		}
+		e.Exec.Process.Credentials.EUID = uint32(v)
 
-	case "rmdir.file.path":
+		return nil
 
-		return reflect.String, nil
+	case "exec.euser":
 
-	case "rmdir.file.rights":
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Credentials.EUser"}
+		}
+		e.Exec.Process.Credentials.EUser = str
 
-		return reflect.Int, nil
+		return nil
 
-	case "rmdir.file.uid":
+	case "exec.file.change_time":
 
-		return reflect.Int, nil
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.FileFields.CTime"}
+		}
+		e.Exec.Process.FileFields.CTime = uint64(v)
 
-	case "rmdir.file.user":
+		return nil
 
-		return reflect.String, nil
+	case "exec.file.filesystem":
 
-	case "rmdir.retval":
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Filesystem"}
+		}
+		e.Exec.Process.Filesystem = str
 
-		return reflect.Int, nil
+		return nil
 
-	case "selinux.bool.name":
+	case "exec.file.gid":
 
-		return reflect.String, nil
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.FileFields.GID"}
+		}
+		e.Exec.Process.FileFields.GID = uint32(v)
 
-	case "selinux.bool.state":
+		return nil
 
-		return reflect.String, nil
+	case "exec.file.group":
 
-	case "selinux.bool_commit.state":
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.FileFields.Group"}
+		}
+		e.Exec.Process.FileFields.Group = str
 
-		return reflect.Bool, nil
+		return nil
 
-	case "selinux.enforce.status":
+	case "exec.file.in_upper_layer":
 
-		return reflect.String, nil
+		var ok bool
+		if e.Exec.Process.FileFields.InUpperLayer, ok = value.(bool); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.FileFields.InUpperLayer"}
+		}
+		return nil
 
-	case "setgid.egid":
+	case "exec.file.inode":
 
-		return reflect.Int, nil
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.FileFields.Inode"}
+		}
+		e.Exec.Process.FileFields.Inode = uint64(v)
 
-	case "setgid.egroup":
+		return nil
 
-		return reflect.String, nil
+	case "exec.file.mode":
 
-	case "setgid.fsgid":
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.FileFields.Mode"}
+		}
+		e.Exec.Process.FileFields.Mode = uint16(v)
 
-		return reflect.Int, nil
+		return nil
 
-	case "setgid.fsgroup":
+	case "exec.file.modification_time":
 
-		return reflect.String, nil
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.FileFields.MTime"}
+		}
+		e.Exec.Process.FileFields.MTime = uint64(v)
 
-	case "setgid.gid":
+		return nil
 
-		return reflect.Int, nil
+	case "exec.file.mount_id":
 
-	case "setgid.group":
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.FileFields.MountID"}
+		}
+		e.Exec.Process.FileFields.MountID = uint32(v)
 
-		return reflect.String, nil
+		return nil
 
-	case "setuid.euid":
+	case "exec.file.name":
 
-		return reflect.Int, nil
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.BasenameStr"}
+		}
+		e.Exec.Process.BasenameStr = str
 
-	case "setuid.euser":
+		return nil
 
-		return reflect.String, nil
+	case "exec.file.path":
 
-	case "setuid.fsuid":
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.PathnameStr"}
+		}
+		e.Exec.Process.PathnameStr = str
 
-		return reflect.Int, nil
+		return nil
 
-	case "setuid.fsuser":
+	case "exec.file.rights":
 
-		return reflect.String, nil
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.FileFields.Mode"}
+		}
+		e.Exec.Process.FileFields.Mode = uint16(v)
 
-	case "setuid.uid":
+		return nil
 
-		return reflect.Int, nil
+	case "exec.file.uid":
 
-	case "setuid.user":
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.FileFields.UID"}
+		}
+		e.Exec.Process.FileFields.UID = uint32(v)
 
-		return reflect.String, nil
+		return nil
 
-	case "setxattr.file.change_time":
+	case "exec.file.user":
 
-		return reflect.Int, nil
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.FileFields.User"}
+		}
+		e.Exec.Process.FileFields.User = str
 
-	case "setxattr.file.destination.name":
+		return nil
 
-		return reflect.String, nil
+	case "exec.fsgid":
 
-	case "setxattr.file.destination.namespace":
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Credentials.FSGID"}
+		}
+		e.Exec.Process.Credentials.FSGID = uint32(v)
 
-		return reflect.String, nil
+		return nil
 
-	case "setxattr.file.filesystem":
+	case "exec.fsgroup":
 
-		return reflect.String, nil
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Credentials.FSGroup"}
+		}
+		e.Exec.Process.Credentials.FSGroup = str
 
-	case "setxattr.file.gid":
+		return nil
 
-		return reflect.Int, nil
+	case "exec.fsuid":
 
-	case "setxattr.file.group":
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Credentials.FSUID"}
+		}
+		e.Exec.Process.Credentials.FSUID = uint32(v)
 
-		return reflect.String, nil
+		return nil
 
-	case "setxattr.file.in_upper_layer":
+	case "exec.fsuser":
 
-		return reflect.Bool, nil
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Credentials.FSUser"}
+		}
+		e.Exec.Process.Credentials.FSUser = str
 
-	case "setxattr.file.inode":
+		return nil
 
-		return reflect.Int, nil
+	case "exec.gid":
 
-	case "setxattr.file.mode":
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Credentials.GID"}
+		}
+		e.Exec.Process.Credentials.GID = uint32(v)
 
-		return reflect.Int, nil
+		return nil
 
-	case "setxattr.file.modification_time":
+	case "exec.group":
 
-		return reflect.Int, nil
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Credentials.Group"}
+		}
+		e.Exec.Process.Credentials.Group = str
 
-	case "setxattr.file.mount_id":
+		return nil
 
-		return reflect.Int, nil
+	case "exec.pid":
 
-	case "setxattr.file.name":
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Pid"}
+		}
+		e.Exec.Process.Pid = uint32(v)
 
-		return reflect.String, nil
+		return nil
 
-	case "setxattr.file.path":
+	case "exec.ppid":
 
-		return reflect.String, nil
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.PPid"}
+		}
+		e.Exec.Process.PPid = uint32(v)
 
-	case "setxattr.file.rights":
+		return nil
 
-		return reflect.Int, nil
+	case "exec.tid":
 
-	case "setxattr.file.uid":
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Tid"}
+		}
+		e.Exec.Process.Tid = uint32(v)
 
-		return reflect.Int, nil
+		return nil
 
-	case "setxattr.file.user":
+	case "exec.tty_name":
 
-		return reflect.String, nil
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.TTYName"}
+		}
+		e.Exec.Process.TTYName = str
 
-	case "setxattr.retval":
+		return nil
 
-		return reflect.Int, nil
+	case "exec.uid":
 
-	case "unlink.file.change_time":
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Credentials.UID"}
+		}
+		e.Exec.Process.Credentials.UID = uint32(v)
 
-		return reflect.Int, nil
+		return nil
 
-	case "unlink.file.filesystem":
+	case "exec.user":
 
-		return reflect.String, nil
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Credentials.User"}
+		}
+		e.Exec.Process.Credentials.User = str
 
-	case "unlink.file.gid":
+		return nil
 
-		return reflect.Int, nil
+	case "link.file.change_time":
 
-	case "unlink.file.group":
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Link.Source.FileFields.CTime"}
+		}
+		e.Link.Source.FileFields.CTime = uint64(v)
 
-		return reflect.String, nil
+		return nil
 
-	case "unlink.file.in_upper_layer":
+	case "link.file.destination.change_time":
 
-		return reflect.Bool, nil
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Link.Target.FileFields.CTime"}
+		}
+		e.Link.Target.FileFields.CTime = uint64(v)
 
-	case "unlink.file.inode":
+		return nil
 
-		return reflect.Int, nil
+	case "link.file.destination.filesystem":
 
-	case "unlink.file.mode":
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Link.Target.Filesytem"}
+		}
+		e.Link.Target.Filesytem = str
 
-		return reflect.Int, nil
+		return nil
 
-	case "unlink.file.modification_time":
+	case "link.file.destination.gid":
 
-		return reflect.Int, nil
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Link.Target.FileFields.GID"}
+		}
+		e.Link.Target.FileFields.GID = uint32(v)
 
-	case "unlink.file.mount_id":
+		return nil
 
-		return reflect.Int, nil
+	case "link.file.destination.group":
 
-	case "unlink.file.name":
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Link.Target.FileFields.Group"}
+		}
+		e.Link.Target.FileFields.Group = str
 
-		return reflect.String, nil
+		return nil
 
-	case "unlink.file.path":
+	case "link.file.destination.in_upper_layer":
 
-		return reflect.String, nil
+		var ok bool
+		if e.Link.Target.FileFields.InUpperLayer, ok = value.(bool); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Link.Target.FileFields.InUpperLayer"}
+		}
+		return nil
 
-	case "unlink.file.rights":
+	case "link.file.destination.inode":
 
-		return reflect.Int, nil
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Link.Target.FileFields.Inode"}
+		}
+		e.Link.Target.FileFields.Inode = uint64(v)
 
-	case "unlink.file.uid":
+		return nil
 
-		return reflect.Int, nil
+	case "link.file.destination.mode":
 
-	case "unlink.file.user":
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Link.Target.FileFields.Mode"}
+		}
+		e.Link.Target.FileFields.Mode = uint16(v)
 
-		return reflect.String, nil
+		return nil
 
-	case "unlink.retval":
+	case "link.file.destination.modification_time":
 
-		return reflect.Int, nil
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Link.Target.FileFields.MTime"}
+		}
+		e.Link.Target.FileFields.MTime = uint64(v)
 
-	case "utimes.file.change_time":
+		return nil
 
-		return reflect.Int, nil
+	case "link.file.destination.mount_id":
 
-	case "utimes.file.filesystem":
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Link.Target.FileFields.MountID"}
+		}
+		e.Link.Target.FileFields.MountID = uint32(v)
 
-		return reflect.String, nil
+		return nil
 
-	case "utimes.file.gid":
+	case "link.file.destination.name":
 
-		return reflect.Int, nil
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Link.Target.BasenameStr"}
+		}
+		e.Link.Target.BasenameStr = str
 
-	case "utimes.file.group":
+		return nil
 
-		return reflect.String, nil
+	case "link.file.destination.path":
 
-	case "utimes.file.in_upper_layer":
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Link.Target.PathnameStr"}
+		}
+		e.Link.Target.PathnameStr = str
 
-		return reflect.Bool, nil
+		return nil
 
-	case "utimes.file.inode":
+	case "link.file.destination.rights":
 
-		return reflect.Int, nil
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Link.Target.FileFields.Mode"}
+		}
+		e.Link.Target.FileFields.Mode = uint16(v)
 
-	case "utimes.file.mode":
+		return nil
 
-		return reflect.Int, nil
+	case "link.file.destination.uid":
 
-	case "utimes.file.modification_time":
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Link.Target.FileFields.UID"}
+		}
+		e.Link.Target.FileFields.UID = uint32(v)
 
-		return reflect.Int, nil
+		return nil
 
-	case "utimes.file.mount_id":
+	case "link.file.destination.user":
 
-		return reflect.Int, nil
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Link.Target.FileFields.User"}
+		}
+		e.Link.Target.FileFields.User = str
 
-	case "utimes.file.name":
+		return nil
 
-		return reflect.String, nil
+	case "link.file.filesystem":
 
-	case "utimes.file.path":
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Link.Source.Filesytem"}
+		}
+		e.Link.Source.Filesytem = str
 
-		return reflect.String, nil
+		return nil
 
-	case "utimes.file.rights":
+	case "link.file.gid":
 
-		return reflect.Int, nil
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Link.Source.FileFields.GID"}
+		}
+		e.Link.Source.FileFields.GID = uint32(v)
 
-	case "utimes.file.uid":
+		return nil
 
-		return reflect.Int, nil
+	case "link.file.group":
 
-	case "utimes.file.user":
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Link.Source.FileFields.Group"}
+		}
+		e.Link.Source.FileFields.Group = str
 
-		return reflect.String, nil
+		return nil
 
-	case "utimes.retval":
+	case "link.file.in_upper_layer":
 
-		return reflect.Int, nil
+		var ok bool
+		if e.Link.Source.FileFields.InUpperLayer, ok = value.(bool); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Link.Source.FileFields.InUpperLayer"}
+		}
+		return nil
 
-	}
+	case "link.file.inode":
 
-	return reflect.Invalid, &eval.ErrFieldNotFound{Field: field}
-}
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Link.Source.FileFields.Inode"}
+		}
+		e.Link.Source.FileFields.Inode = uint64(v)
 
-func (e *Event) SetFieldValue(field eval.Field, value interface{}) error {
-	switch field {
+		return nil
 
-	case "bpf.cmd":
+	case "link.file.mode":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "BPF.Cmd"}
+			return &eval.ErrValueTypeMismatch{Field: "Link.Source.FileFields.Mode"}
 		}
-		e.BPF.Cmd = uint32(v)
+		e.Link.Source.FileFields.Mode = uint16(v)
 
 		return nil
 
-	case "bpf.map.type":
+	case "link.file.modification_time":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "BPF.Map.Type"}
+			return &eval.ErrValueTypeMismatch{Field: "Link.Source.FileFields.MTime"}
 		}
-		e.BPF.Map.Type = uint32(v)
+		e.Link.Source.FileFields.MTime = uint64(v)
 
 		return nil
 
-	case "bpf.prog.attach_type":
+	case "link.file.mount_id":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "BPF.Program.AttachType"}
+			return &eval.ErrValueTypeMismatch{Field: "Link.Source.FileFields.MountID"}
 		}
-		e.BPF.Program.AttachType = uint32(v)
+		e.Link.Source.FileFields.MountID = uint32(v)
 
 		return nil
 
-	case "bpf.prog.type":
+	case "link.file.name":
 
 		var ok bool
-		v, ok := value.(int)
+		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "BPF.Program.Type"}
+			return &eval.ErrValueTypeMismatch{Field: "Link.Source.BasenameStr"}
 		}
-		e.BPF.Program.Type = uint32(v)
+		e.Link.Source.BasenameStr = str
 
 		return nil
 
-	case "bpf.retval":
+	case "link.file.path":
 
 		var ok bool
-		v, ok := value.(int)
+		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "BPF.SyscallEvent.Retval"}
+			return &eval.ErrValueTypeMismatch{Field: "Link.Source.PathnameStr"}
 		}
-		e.BPF.SyscallEvent.Retval = int64(v)
+		e.Link.Source.PathnameStr = str
 
 		return nil
 
-	case "capset.cap_effective":
+	case "link.file.rights":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Capset.CapEffective"}
+			return &eval.ErrValueTypeMismatch{Field: "Link.Source.FileFields.Mode"}
 		}
-		e.Capset.CapEffective = uint64(v)
+		e.Link.Source.FileFields.Mode = uint16(v)
 
 		return nil
 
-	case "capset.cap_permitted":
+	case "link.file.uid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Capset.CapPermitted"}
+			return &eval.ErrValueTypeMismatch{Field: "Link.Source.FileFields.UID"}
 		}
-		e.Capset.CapPermitted = uint64(v)
+		e.Link.Source.FileFields.UID = uint32(v)
 
 		return nil
 
-	case "chmod.file.change_time":
+	case "link.file.user":
 
 		var ok bool
-		v, ok := value.(int)
+		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chmod.File.FileFields.CTime"}
+			return &eval.ErrValueTypeMismatch{Field: "Link.Source.FileFields.User"}
 		}
-		e.Chmod.File.FileFields.CTime = uint64(v)
+		e.Link.Source.FileFields.User = str
 
 		return nil
 
-	case "chmod.file.destination.mode":
+	case "link.retval":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chmod.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "Link.SyscallEvent.Retval"}
 		}
-		e.Chmod.Mode = uint32(v)
+		e.Link.SyscallEvent.Retval = int64(v)
 
 		return nil
 
-	case "chmod.file.destination.rights":
+	case "load_module.file.change_time":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chmod.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "LoadModule.File.FileFields.CTime"}
 		}
-		e.Chmod.Mode = uint32(v)
+		e.LoadModule.File.FileFields.CTime = uint64(v)
 
 		return nil
 
-	case "chmod.file.filesystem":
+	case "load_module.file.filesystem":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chmod.File.Filesytem"}
+			return &eval.ErrValueTypeMismatch{Field: "LoadModule.File.Filesytem"}
 		}
-		e.Chmod.File.Filesytem = str
+		e.LoadModule.File.Filesytem = str
 
 		return nil
 
-	case "chmod.file.gid":
+	case "load_module.file.gid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chmod.File.FileFields.GID"}
+			return &eval.ErrValueTypeMismatch{Field: "LoadModule.File.FileFields.GID"}
 		}
-		e.Chmod.File.FileFields.GID = uint32(v)
+		e.LoadModule.File.FileFields.GID = uint32(v)
 
 		return nil
 
-	case "chmod.file.group":
+	case "load_module.file.group":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chmod.File.FileFields.Group"}
+			return &eval.ErrValueTypeMismatch{Field: "LoadModule.File.FileFields.Group"}
 		}
-		e.Chmod.File.FileFields.Group = str
+		e.LoadModule.File.FileFields.Group = str
 
 		return nil
 
-	case "chmod.file.in_upper_layer":
+	case "load_module.file.in_upper_layer":
 
 		var ok bool
-		if e.Chmod.File.FileFields.InUpperLayer, ok = value.(bool); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chmod.File.FileFields.InUpperLayer"}
+		if e.LoadModule.File.FileFields.InUpperLayer, ok = value.(bool); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "LoadModule.File.FileFields.InUpperLayer"}
 		}
 		return nil
 
-	case "chmod.file.inode":
+	case "load_module.file.inode":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chmod.File.FileFields.Inode"}
+			return &eval.ErrValueTypeMismatch{Field: "LoadModule.File.FileFields.Inode"}
 		}
-		e.Chmod.File.FileFields.Inode = uint64(v)
+		e.LoadModule.File.FileFields.Inode = uint64(v)
 
 		return nil
 
-	case "chmod.file.mode":
+	case "load_module.file.mode":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chmod.File.FileFields.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "LoadModule.File.FileFields.Mode"}
 		}
-		e.Chmod.File.FileFields.Mode = uint16(v)
+		e.LoadModule.File.FileFields.Mode = uint16(v)
 
 		return nil
 
-	case "chmod.file.modification_time":
+	case "load_module.file.modification_time":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chmod.File.FileFields.MTime"}
+			return &eval.ErrValueTypeMismatch{Field: "LoadModule.File.FileFields.MTime"}
 		}
-		e.Chmod.File.FileFields.MTime = uint64(v)
+		e.LoadModule.File.FileFields.MTime = uint64(v)
 
 		return nil
 
-	case "chmod.file.mount_id":
+	case "load_module.file.mount_id":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chmod.File.FileFields.MountID"}
+			return &eval.ErrValueTypeMismatch{Field: "LoadModule.File.FileFields.MountID"}
 		}
-		e.Chmod.File.FileFields.MountID = uint32(v)
+		e.LoadModule.File.FileFields.MountID = uint32(v)
 
 		return nil
 
-	case "chmod.file.name":
+	case "load_module.file.name":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chmod.File.BasenameStr"}
+			return &eval.ErrValueTypeMismatch{Field: "LoadModule.File.BasenameStr"}
 		}
-		e.Chmod.File.BasenameStr = str
+		e.LoadModule.File.BasenameStr = str
 
 		return nil
 
-	case "chmod.file.path":
+	case "load_module.file.path":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chmod.File.PathnameStr"}
+			return &eval.ErrValueTypeMismatch{Field: "LoadModule.File.PathnameStr"}
 		}
-		e.Chmod.File.PathnameStr = str
+		e.LoadModule.File.PathnameStr = str
 
 		return nil
 
-	case "chmod.file.rights":
+	case "load_module.file.rights":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chmod.File.FileFields.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "LoadModule.File.FileFields.Mode"}
 		}
-		e.Chmod.File.FileFields.Mode = uint16(v)
+		e.LoadModule.File.FileFields.Mode = uint16(v)
 
 		return nil
 
-	case "chmod.file.uid":
+	case "load_module.file.uid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chmod.File.FileFields.UID"}
+			return &eval.ErrValueTypeMismatch{Field: "LoadModule.File.FileFields.UID"}
 		}
-		e.Chmod.File.FileFields.UID = uint32(v)
+		e.LoadModule.File.FileFields.UID = uint32(v)
 
 		return nil
 
-	case "chmod.file.user":
+	case "load_module.file.user":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chmod.File.FileFields.User"}
+			return &eval.ErrValueTypeMismatch{Field: "LoadModule.File.FileFields.User"}
 		}
-		e.Chmod.File.FileFields.User = str
+		e.LoadModule.File.FileFields.User = str
 
 		return nil
 
-	case "chmod.retval":
+	case "load_module.loaded_from_memory":
 
 		var ok bool
-		v, ok := value.(int)
-		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chmod.SyscallEvent.Retval"}
+		if e.LoadModule.LoadedFromMemory, ok = value.(bool); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "LoadModule.LoadedFromMemory"}
 		}
-		e.Chmod.SyscallEvent.Retval = int64(v)
-
 		return nil
 
-	case "chown.file.change_time":
+	case "load_module.name":
 
 		var ok bool
-		v, ok := value.(int)
+		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chown.File.FileFields.CTime"}
+			return &eval.ErrValueTypeMismatch{Field: "LoadModule.Name"}
 		}
-		e.Chown.File.FileFields.CTime = uint64(v)
+		e.LoadModule.Name = str
 
 		return nil
 
-	case "chown.file.destination.gid":
+	case "load_module.retval":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chown.GID"}
+			return &eval.ErrValueTypeMismatch{Field: "LoadModule.SyscallEvent.Retval"}
 		}
-		e.Chown.GID = uint32(v)
+		e.LoadModule.SyscallEvent.Retval = int64(v)
 
 		return nil
 
-	case "chown.file.destination.group":
+	case "mkdir.file.change_time":
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chown.Group"}
+			return &eval.ErrValueTypeMismatch{Field: "Mkdir.File.FileFields.CTime"}
 		}
-		e.Chown.Group = str
+		e.Mkdir.File.FileFields.CTime = uint64(v)
 
 		return nil
 
-	case "chown.file.destination.uid":
+	case "mkdir.file.destination.mode":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chown.UID"}
+			return &eval.ErrValueTypeMismatch{Field: "Mkdir.Mode"}
 		}
-		e.Chown.UID = uint32(v)
+		e.Mkdir.Mode = uint32(v)
 
 		return nil
 
-	case "chown.file.destination.user":
+	case "mkdir.file.destination.rights":
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chown.User"}
+			return &eval.ErrValueTypeMismatch{Field: "Mkdir.Mode"}
 		}
-		e.Chown.User = str
+		e.Mkdir.Mode = uint32(v)
 
 		return nil
 
-	case "chown.file.filesystem":
+	case "mkdir.file.filesystem":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chown.File.Filesytem"}
+			return &eval.ErrValueTypeMismatch{Field: "Mkdir.File.Filesytem"}
 		}
-		e.Chown.File.Filesytem = str
+		e.Mkdir.File.Filesytem = str
 
 		return nil
 
-	case "chown.file.gid":
+	case "mkdir.file.gid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chown.File.FileFields.GID"}
+			return &eval.ErrValueTypeMismatch{Field: "Mkdir.File.FileFields.GID"}
 		}
-		e.Chown.File.FileFields.GID = uint32(v)
+		e.Mkdir.File.FileFields.GID = uint32(v)
 
 		return nil
 
-	case "chown.file.group":
+	case "mkdir.file.group":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chown.File.FileFields.Group"}
+			return &eval.ErrValueTypeMismatch{Field: "Mkdir.File.FileFields.Group"}
 		}
-		e.Chown.File.FileFields.Group = str
+		e.Mkdir.File.FileFields.Group = str
 
 		return nil
 
-	case "chown.file.in_upper_layer":
+	case "mkdir.file.in_upper_layer":
 
 		var ok bool
-		if e.Chown.File.FileFields.InUpperLayer, ok = value.(bool); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chown.File.FileFields.InUpperLayer"}
+		if e.Mkdir.File.FileFields.InUpperLayer, ok = value.(bool); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Mkdir.File.FileFields.InUpperLayer"}
 		}
 		return nil
 
-	case "chown.file.inode":
+	case "mkdir.file.inode":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chown.File.FileFields.Inode"}
+			return &eval.ErrValueTypeMismatch{Field: "Mkdir.File.FileFields.Inode"}
 		}
-		e.Chown.File.FileFields.Inode = uint64(v)
+		e.Mkdir.File.FileFields.Inode = uint64(v)
 
 		return nil
 
-	case "chown.file.mode":
+	case "mkdir.file.mode":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chown.File.FileFields.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "Mkdir.File.FileFields.Mode"}
 		}
-		e.Chown.File.FileFields.Mode = uint16(v)
+		e.Mkdir.File.FileFields.Mode = uint16(v)
 
 		return nil
 
-	case "chown.file.modification_time":
+	case "mkdir.file.modification_time":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chown.File.FileFields.MTime"}
+			return &eval.ErrValueTypeMismatch{Field: "Mkdir.File.FileFields.MTime"}
 		}
-		e.Chown.File.FileFields.MTime = uint64(v)
+		e.Mkdir.File.FileFields.MTime = uint64(v)
 
 		return nil
 
-	case "chown.file.mount_id":
+	case "mkdir.file.mount_id":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chown.File.FileFields.MountID"}
+			return &eval.ErrValueTypeMismatch{Field: "Mkdir.File.FileFields.MountID"}
 		}
-		e.Chown.File.FileFields.MountID = uint32(v)
+		e.Mkdir.File.FileFields.MountID = uint32(v)
 
 		return nil
 
-	case "chown.file.name":
+	case "mkdir.file.name":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chown.File.BasenameStr"}
+			return &eval.ErrValueTypeMismatch{Field: "Mkdir.File.BasenameStr"}
 		}
-		e.Chown.File.BasenameStr = str
+		e.Mkdir.File.BasenameStr = str
 
 		return nil
 
-	case "chown.file.path":
+	case "mkdir.file.path":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chown.File.PathnameStr"}
+			return &eval.ErrValueTypeMismatch{Field: "Mkdir.File.PathnameStr"}
 		}
-		e.Chown.File.PathnameStr = str
+		e.Mkdir.File.PathnameStr = str
 
 		return nil
 
-	case "chown.file.rights":
+	case "mkdir.file.rights":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chown.File.FileFields.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "Mkdir.File.FileFields.Mode"}
 		}
-		e.Chown.File.FileFields.Mode = uint16(v)
+		e.Mkdir.File.FileFields.Mode = uint16(v)
 
 		return nil
 
-	case "chown.file.uid":
+	case "mkdir.file.uid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chown.File.FileFields.UID"}
+			return &eval.ErrValueTypeMismatch{Field: "Mkdir.File.FileFields.UID"}
 		}
-		e.Chown.File.FileFields.UID = uint32(v)
+		e.Mkdir.File.FileFields.UID = uint32(v)
 
 		return nil
 
-	case "chown.file.user":
+	case "mkdir.file.user":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chown.File.FileFields.User"}
+			return &eval.ErrValueTypeMismatch{Field: "Mkdir.File.FileFields.User"}
 		}
-		e.Chown.File.FileFields.User = str
+		e.Mkdir.File.FileFields.User = str
 
 		return nil
 
-	case "chown.retval":
+	case "mkdir.retval":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Chown.SyscallEvent.Retval"}
+			return &eval.ErrValueTypeMismatch{Field: "Mkdir.SyscallEvent.Retval"}
 		}
-		e.Chown.SyscallEvent.Retval = int64(v)
+		e.Mkdir.SyscallEvent.Retval = int64(v)
 
 		return nil
 
-	case "container.id":
+	case "mmap.file.change_time":
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ContainerContext.ID"}
+			return &eval.ErrValueTypeMismatch{Field: "MMap.File.FileFields.CTime"}
 		}
-		e.ContainerContext.ID = str
+		e.MMap.File.FileFields.CTime = uint64(v)
 
 		return nil
 
-	case "container.tags":
+	case "mmap.file.filesystem":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ContainerContext.Tags"}
+			return &eval.ErrValueTypeMismatch{Field: "MMap.File.Filesytem"}
 		}
-		e.ContainerContext.Tags = append(e.ContainerContext.Tags, str)
+		e.MMap.File.Filesytem = str
 
 		return nil
 
-	case "exec.args":
+	case "mmap.file.gid":
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Args"}
+			return &eval.ErrValueTypeMismatch{Field: "MMap.File.FileFields.GID"}
 		}
-		e.Exec.Args = str
+		e.MMap.File.FileFields.GID = uint32(v)
 
 		return nil
 
-	case "exec.args_flags":
+	case "mmap.file.group":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Argv"}
+			return &eval.ErrValueTypeMismatch{Field: "MMap.File.FileFields.Group"}
 		}
-		e.Exec.Argv = append(e.Exec.Argv, str)
+		e.MMap.File.FileFields.Group = str
 
 		return nil
 
-	case "exec.args_options":
+	case "mmap.file.in_upper_layer":
 
 		var ok bool
-		str, ok := value.(string)
-		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Argv"}
+		if e.MMap.File.FileFields.InUpperLayer, ok = value.(bool); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "MMap.File.FileFields.InUpperLayer"}
 		}
-		e.Exec.Argv = append(e.Exec.Argv, str)
-
 		return nil
 
-	case "exec.args_truncated":
+	case "mmap.file.inode":
 
 		var ok bool
-		if e.Exec.ArgsTruncated, ok = value.(bool); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.ArgsTruncated"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "MMap.File.FileFields.Inode"}
 		}
+		e.MMap.File.FileFields.Inode = uint64(v)
+
 		return nil
 
-	case "exec.argv":
+	case "mmap.file.mode":
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Argv"}
+			return &eval.ErrValueTypeMismatch{Field: "MMap.File.FileFields.Mode"}
 		}
-		e.Exec.Argv = append(e.Exec.Argv, str)
+		e.MMap.File.FileFields.Mode = uint16(v)
 
 		return nil
 
-	case "exec.cap_effective":
+	case "mmap.file.modification_time":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Credentials.CapEffective"}
+			return &eval.ErrValueTypeMismatch{Field: "MMap.File.FileFields.MTime"}
 		}
-		e.Exec.Process.Credentials.CapEffective = uint64(v)
+		e.MMap.File.FileFields.MTime = uint64(v)
 
 		return nil
 
-	case "exec.cap_permitted":
+	case "mmap.file.mount_id":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Credentials.CapPermitted"}
+			return &eval.ErrValueTypeMismatch{Field: "MMap.File.FileFields.MountID"}
 		}
-		e.Exec.Process.Credentials.CapPermitted = uint64(v)
+		e.MMap.File.FileFields.MountID = uint32(v)
 
 		return nil
 
-	case "exec.comm":
+	case "mmap.file.name":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Comm"}
+			return &eval.ErrValueTypeMismatch{Field: "MMap.File.BasenameStr"}
 		}
-		e.Exec.Process.Comm = str
+		e.MMap.File.BasenameStr = str
 
 		return nil
 
-	case "exec.container.id":
+	case "mmap.file.path":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.ContainerID"}
+			return &eval.ErrValueTypeMismatch{Field: "MMap.File.PathnameStr"}
 		}
-		e.Exec.Process.ContainerID = str
+		e.MMap.File.PathnameStr = str
 
 		return nil
 
-	case "exec.cookie":
+	case "mmap.file.rights":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Cookie"}
+			return &eval.ErrValueTypeMismatch{Field: "MMap.File.FileFields.Mode"}
 		}
-		e.Exec.Process.Cookie = uint32(v)
+		e.MMap.File.FileFields.Mode = uint16(v)
 
 		return nil
 
-	case "exec.created_at":
+	case "mmap.file.uid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.CreatedAt"}
+			return &eval.ErrValueTypeMismatch{Field: "MMap.File.FileFields.UID"}
 		}
-		e.Exec.Process.CreatedAt = uint64(v)
+		e.MMap.File.FileFields.UID = uint32(v)
 
 		return nil
 
-	case "exec.egid":
+	case "mmap.file.user":
+
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "MMap.File.FileFields.User"}
+		}
+		e.MMap.File.FileFields.User = str
+
+		return nil
+
+	case "mmap.flags":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Credentials.EGID"}
+			return &eval.ErrValueTypeMismatch{Field: "MMap.Flags"}
 		}
-		e.Exec.Process.Credentials.EGID = uint32(v)
+		e.MMap.Flags = int(v)
 
 		return nil
 
-	case "exec.egroup":
+	case "mmap.protection":
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Credentials.EGroup"}
+			return &eval.ErrValueTypeMismatch{Field: "MMap.Protection"}
 		}
-		e.Exec.Process.Credentials.EGroup = str
+		e.MMap.Protection = int(v)
 
 		return nil
 
-	case "exec.envs":
+	case "mmap.retval":
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Envs"}
+			return &eval.ErrValueTypeMismatch{Field: "MMap.SyscallEvent.Retval"}
 		}
-		e.Exec.Envs = append(e.Exec.Envs, str)
+		e.MMap.SyscallEvent.Retval = int64(v)
 
 		return nil
 
-	case "exec.envs_truncated":
+	case "mprotect.req_protection":
 
 		var ok bool
-		if e.Exec.EnvsTruncated, ok = value.(bool); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.EnvsTruncated"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "MProtect.ReqProtection"}
 		}
+		e.MProtect.ReqProtection = int(v)
+
 		return nil
 
-	case "exec.euid":
+	case "mprotect.retval":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Credentials.EUID"}
+			return &eval.ErrValueTypeMismatch{Field: "MProtect.SyscallEvent.Retval"}
 		}
-		e.Exec.Process.Credentials.EUID = uint32(v)
+		e.MProtect.SyscallEvent.Retval = int64(v)
 
 		return nil
 
-	case "exec.euser":
+	case "mprotect.vm_protection":
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Credentials.EUser"}
+			return &eval.ErrValueTypeMismatch{Field: "MProtect.VMProtection"}
 		}
-		e.Exec.Process.Credentials.EUser = str
+		e.MProtect.VMProtection = int(v)
 
 		return nil
 
-	case "exec.file.change_time":
+	case "open.file.change_time":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.FileFields.CTime"}
+			return &eval.ErrValueTypeMismatch{Field: "Open.File.FileFields.CTime"}
 		}
-		e.Exec.Process.FileFields.CTime = uint64(v)
+		e.Open.File.FileFields.CTime = uint64(v)
 
 		return nil
 
-	case "exec.file.filesystem":
+	case "open.file.destination.mode":
+
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Open.Mode"}
+		}
+		e.Open.Mode = uint32(v)
+
+		return nil
+
+	case "open.file.filesystem":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Filesystem"}
+			return &eval.ErrValueTypeMismatch{Field: "Open.File.Filesytem"}
 		}
-		e.Exec.Process.Filesystem = str
+		e.Open.File.Filesytem = str
 
 		return nil
 
-	case "exec.file.gid":
+	case "open.file.gid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.FileFields.GID"}
+			return &eval.ErrValueTypeMismatch{Field: "Open.File.FileFields.GID"}
 		}
-		e.Exec.Process.FileFields.GID = uint32(v)
+		e.Open.File.FileFields.GID = uint32(v)
 
 		return nil
 
-	case "exec.file.group":
+	case "open.file.group":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.FileFields.Group"}
+			return &eval.ErrValueTypeMismatch{Field: "Open.File.FileFields.Group"}
 		}
-		e.Exec.Process.FileFields.Group = str
+		e.Open.File.FileFields.Group = str
 
 		return nil
 
-	case "exec.file.in_upper_layer":
+	case "open.file.in_upper_layer":
 
 		var ok bool
-		if e.Exec.Process.FileFields.InUpperLayer, ok = value.(bool); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.FileFields.InUpperLayer"}
+		if e.Open.File.FileFields.InUpperLayer, ok = value.(bool); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Open.File.FileFields.InUpperLayer"}
 		}
 		return nil
 
-	case "exec.file.inode":
+	case "open.file.inode":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.FileFields.Inode"}
+			return &eval.ErrValueTypeMismatch{Field: "Open.File.FileFields.Inode"}
 		}
-		e.Exec.Process.FileFields.Inode = uint64(v)
+		e.Open.File.FileFields.Inode = uint64(v)
 
 		return nil
 
-	case "exec.file.mode":
+	case "open.file.mode":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.FileFields.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "Open.File.FileFields.Mode"}
 		}
-		e.Exec.Process.FileFields.Mode = uint16(v)
+		e.Open.File.FileFields.Mode = uint16(v)
 
 		return nil
 
-	case "exec.file.modification_time":
+	case "open.file.modification_time":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.FileFields.MTime"}
+			return &eval.ErrValueTypeMismatch{Field: "Open.File.FileFields.MTime"}
 		}
-		e.Exec.Process.FileFields.MTime = uint64(v)
+		e.Open.File.FileFields.MTime = uint64(v)
 
 		return nil
 
-	case "exec.file.mount_id":
+	case "open.file.mount_id":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.FileFields.MountID"}
+			return &eval.ErrValueTypeMismatch{Field: "Open.File.FileFields.MountID"}
 		}
-		e.Exec.Process.FileFields.MountID = uint32(v)
+		e.Open.File.FileFields.MountID = uint32(v)
 
 		return nil
 
-	case "exec.file.name":
+	case "open.file.name":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.BasenameStr"}
+			return &eval.ErrValueTypeMismatch{Field: "Open.File.BasenameStr"}
 		}
-		e.Exec.Process.BasenameStr = str
+		e.Open.File.BasenameStr = str
 
 		return nil
 
-	case "exec.file.path":
+	case "open.file.path":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.PathnameStr"}
+			return &eval.ErrValueTypeMismatch{Field: "Open.File.PathnameStr"}
 		}
-		e.Exec.Process.PathnameStr = str
+		e.Open.File.PathnameStr = str
 
 		return nil
 
-	case "exec.file.rights":
+	case "open.file.rights":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.FileFields.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "Open.File.FileFields.Mode"}
 		}
-		e.Exec.Process.FileFields.Mode = uint16(v)
+		e.Open.File.FileFields.Mode = uint16(v)
 
 		return nil
 
-	case "exec.file.uid":
+	case "open.file.uid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.FileFields.UID"}
+			return &eval.ErrValueTypeMismatch{Field: "Open.File.FileFields.UID"}
 		}
-		e.Exec.Process.FileFields.UID = uint32(v)
+		e.Open.File.FileFields.UID = uint32(v)
 
 		return nil
 
-	case "exec.file.user":
+	case "open.file.user":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.FileFields.User"}
+			return &eval.ErrValueTypeMismatch{Field: "Open.File.FileFields.User"}
 		}
-		e.Exec.Process.FileFields.User = str
+		e.Open.File.FileFields.User = str
 
 		return nil
 
-	case "exec.fsgid":
+	case "open.flags":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Credentials.FSGID"}
+			return &eval.ErrValueTypeMismatch{Field: "Open.Flags"}
 		}
-		e.Exec.Process.Credentials.FSGID = uint32(v)
+		e.Open.Flags = uint32(v)
 
 		return nil
 
-	case "exec.fsgroup":
+	case "open.retval":
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Credentials.FSGroup"}
+			return &eval.ErrValueTypeMismatch{Field: "Open.SyscallEvent.Retval"}
 		}
-		e.Exec.Process.Credentials.FSGroup = str
+		e.Open.SyscallEvent.Retval = int64(v)
 
 		return nil
 
-	case "exec.fsuid":
+	case "process.ancestors.cap_effective":
+
+		if e.ProcessContext.Ancestor == nil {
+			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
+		}
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Credentials.FSUID"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Credentials.CapEffective"}
 		}
-		e.Exec.Process.Credentials.FSUID = uint32(v)
+		e.ProcessContext.Ancestor.ProcessContext.Process.Credentials.CapEffective = uint64(v)
 
 		return nil
 
-	case "exec.fsuser":
+	case "process.ancestors.cap_permitted":
+
+		if e.ProcessContext.Ancestor == nil {
+			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
+		}
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Credentials.FSUser"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Credentials.CapPermitted"}
 		}
-		e.Exec.Process.Credentials.FSUser = str
+		e.ProcessContext.Ancestor.ProcessContext.Process.Credentials.CapPermitted = uint64(v)
 
 		return nil
 
-	case "exec.gid":
+	case "process.ancestors.comm":
+
+		if e.ProcessContext.Ancestor == nil {
+			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
+		}
 
 		var ok bool
-		v, ok := value.(int)
+		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Credentials.GID"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Comm"}
 		}
-		e.Exec.Process.Credentials.GID = uint32(v)
+		e.ProcessContext.Ancestor.ProcessContext.Process.Comm = str
 
 		return nil
 
-	case "exec.group":
+	case "process.ancestors.container.id":
+
+		if e.ProcessContext.Ancestor == nil {
+			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
+		}
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Credentials.Group"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.ContainerID"}
 		}
-		e.Exec.Process.Credentials.Group = str
+		e.ProcessContext.Ancestor.ProcessContext.Process.ContainerID = str
 
 		return nil
 
-	case "exec.pid":
+	case "process.ancestors.cookie":
+
+		if e.ProcessContext.Ancestor == nil {
+			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
+		}
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Pid"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Cookie"}
 		}
-		e.Exec.Process.Pid = uint32(v)
+		e.ProcessContext.Ancestor.ProcessContext.Process.Cookie = uint32(v)
 
 		return nil
 
-	case "exec.ppid":
+	case "process.ancestors.created_at":
+
+		if e.ProcessContext.Ancestor == nil {
+			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
+		}
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.PPid"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.CreatedAt"}
 		}
-		e.Exec.Process.PPid = uint32(v)
+		e.ProcessContext.Ancestor.ProcessContext.Process.CreatedAt = uint64(v)
 
 		return nil
 
-	case "exec.tid":
+	case "process.ancestors.egid":
+
+		if e.ProcessContext.Ancestor == nil {
+			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
+		}
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Tid"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Credentials.EGID"}
 		}
-		e.Exec.Process.Tid = uint32(v)
+		e.ProcessContext.Ancestor.ProcessContext.Process.Credentials.EGID = uint32(v)
 
 		return nil
 
-	case "exec.tty_name":
+	case "process.ancestors.egroup":
+
+		if e.ProcessContext.Ancestor == nil {
+			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
+		}
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.TTYName"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Credentials.EGroup"}
 		}
-		e.Exec.Process.TTYName = str
+		e.ProcessContext.Ancestor.ProcessContext.Process.Credentials.EGroup = str
 
 		return nil
 
-	case "exec.uid":
+	case "process.ancestors.euid":
+
+		if e.ProcessContext.Ancestor == nil {
+			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
+		}
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Credentials.UID"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Credentials.EUID"}
 		}
-		e.Exec.Process.Credentials.UID = uint32(v)
+		e.ProcessContext.Ancestor.ProcessContext.Process.Credentials.EUID = uint32(v)
 
 		return nil
 
-	case "exec.user":
+	case "process.ancestors.euser":
+
+		if e.ProcessContext.Ancestor == nil {
+			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
+		}
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Exec.Process.Credentials.User"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Credentials.EUser"}
 		}
-		e.Exec.Process.Credentials.User = str
+		e.ProcessContext.Ancestor.ProcessContext.Process.Credentials.EUser = str
 
 		return nil
 
-	case "link.file.change_time":
+	case "process.ancestors.file.change_time":
+
+		if e.ProcessContext.Ancestor == nil {
+			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
+		}
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Source.FileFields.CTime"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.FileFields.CTime"}
 		}
-		e.Link.Source.FileFields.CTime = uint64(v)
+		e.ProcessContext.Ancestor.ProcessContext.Process.FileFields.CTime = uint64(v)
 
 		return nil
 
-	case "link.file.destination.change_time":
+	case "process.ancestors.file.filesystem":
+
+		if e.ProcessContext.Ancestor == nil {
+			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
+		}
+
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Filesystem"}
+		}
+		e.ProcessContext.Ancestor.ProcessContext.Process.Filesystem = str
+
+		return nil
+
+	case "process.ancestors.file.gid":
+
+		if e.ProcessContext.Ancestor == nil {
+			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
+		}
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Target.FileFields.CTime"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.FileFields.GID"}
 		}
-		e.Link.Target.FileFields.CTime = uint64(v)
+		e.ProcessContext.Ancestor.ProcessContext.Process.FileFields.GID = uint32(v)
 
 		return nil
 
-	case "link.file.destination.filesystem":
+	case "process.ancestors.file.group":
+
+		if e.ProcessContext.Ancestor == nil {
+			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
+		}
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Target.Filesytem"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.FileFields.Group"}
 		}
-		e.Link.Target.Filesytem = str
+		e.ProcessContext.Ancestor.ProcessContext.Process.FileFields.Group = str
 
 		return nil
 
-	case "link.file.destination.gid":
+	case "process.ancestors.file.in_upper_layer":
+
+		if e.ProcessContext.Ancestor == nil {
+			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
+		}
+
+		var ok bool
+		if e.ProcessContext.Ancestor.ProcessContext.Process.FileFields.InUpperLayer, ok = value.(bool); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.FileFields.InUpperLayer"}
+		}
+		return nil
+
+	case "process.ancestors.file.inode":
+
+		if e.ProcessContext.Ancestor == nil {
+			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
+		}
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Target.FileFields.GID"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.FileFields.Inode"}
 		}
-		e.Link.Target.FileFields.GID = uint32(v)
+		e.ProcessContext.Ancestor.ProcessContext.Process.FileFields.Inode = uint64(v)
 
 		return nil
 
-	case "link.file.destination.group":
+	case "process.ancestors.file.mode":
+
+		if e.ProcessContext.Ancestor == nil {
+			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
+		}
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Target.FileFields.Group"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.FileFields.Mode"}
 		}
-		e.Link.Target.FileFields.Group = str
+		e.ProcessContext.Ancestor.ProcessContext.Process.FileFields.Mode = uint16(v)
 
 		return nil
 
-	case "link.file.destination.in_upper_layer":
+	case "process.ancestors.file.modification_time":
+
+		if e.ProcessContext.Ancestor == nil {
+			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
+		}
 
 		var ok bool
-		if e.Link.Target.FileFields.InUpperLayer, ok = value.(bool); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Target.FileFields.InUpperLayer"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.FileFields.MTime"}
 		}
+		e.ProcessContext.Ancestor.ProcessContext.Process.FileFields.MTime = uint64(v)
+
 		return nil
 
-	case "link.file.destination.inode":
+	case "process.ancestors.file.mount_id":
+
+		if e.ProcessContext.Ancestor == nil {
+			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
+		}
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Target.FileFields.Inode"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.FileFields.MountID"}
 		}
-		e.Link.Target.FileFields.Inode = uint64(v)
+		e.ProcessContext.Ancestor.ProcessContext.Process.FileFields.MountID = uint32(v)
 
 		return nil
 
-	case "link.file.destination.mode":
+	case "process.ancestors.file.name":
+
+		if e.ProcessContext.Ancestor == nil {
+			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
+		}
 
 		var ok bool
-		v, ok := value.(int)
+		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Target.FileFields.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.BasenameStr"}
 		}
-		e.Link.Target.FileFields.Mode = uint16(v)
+		e.ProcessContext.Ancestor.ProcessContext.Process.BasenameStr = str
 
 		return nil
 
-	case "link.file.destination.modification_time":
+	case "process.ancestors.file.path":
+
+		if e.ProcessContext.Ancestor == nil {
+			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
+		}
 
 		var ok bool
-		v, ok := value.(int)
+		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Target.FileFields.MTime"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.PathnameStr"}
 		}
-		e.Link.Target.FileFields.MTime = uint64(v)
+		e.ProcessContext.Ancestor.ProcessContext.Process.PathnameStr = str
 
 		return nil
 
-	case "link.file.destination.mount_id":
+	case "process.ancestors.file.rights":
+
+		if e.ProcessContext.Ancestor == nil {
+			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
+		}
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Target.FileFields.MountID"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.FileFields.Mode"}
 		}
-		e.Link.Target.FileFields.MountID = uint32(v)
+		e.ProcessContext.Ancestor.ProcessContext.Process.FileFields.Mode = uint16(v)
 
 		return nil
 
-	case "link.file.destination.name":
+	case "process.ancestors.file.uid":
+
+		if e.ProcessContext.Ancestor == nil {
+			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
+		}
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Target.BasenameStr"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.FileFields.UID"}
 		}
-		e.Link.Target.BasenameStr = str
+		e.ProcessContext.Ancestor.ProcessContext.Process.FileFields.UID = uint32(v)
 
 		return nil
 
-	case "link.file.destination.path":
+	case "process.ancestors.file.user":
+
+		if e.ProcessContext.Ancestor == nil {
+			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
+		}
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Target.PathnameStr"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.FileFields.User"}
 		}
-		e.Link.Target.PathnameStr = str
+		e.ProcessContext.Ancestor.ProcessContext.Process.FileFields.User = str
 
 		return nil
 
-	case "link.file.destination.rights":
+	case "process.ancestors.fsgid":
+
+		if e.ProcessContext.Ancestor == nil {
+			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
+		}
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Target.FileFields.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Credentials.FSGID"}
 		}
-		e.Link.Target.FileFields.Mode = uint16(v)
+		e.ProcessContext.Ancestor.ProcessContext.Process.Credentials.FSGID = uint32(v)
 
 		return nil
 
-	case "link.file.destination.uid":
+	case "process.ancestors.fsgroup":
+
+		if e.ProcessContext.Ancestor == nil {
+			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
+		}
 
 		var ok bool
-		v, ok := value.(int)
+		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Target.FileFields.UID"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Credentials.FSGroup"}
 		}
-		e.Link.Target.FileFields.UID = uint32(v)
+		e.ProcessContext.Ancestor.ProcessContext.Process.Credentials.FSGroup = str
 
 		return nil
 
-	case "link.file.destination.user":
+	case "process.ancestors.fsuid":
+
+		if e.ProcessContext.Ancestor == nil {
+			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
+		}
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Target.FileFields.User"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Credentials.FSUID"}
 		}
-		e.Link.Target.FileFields.User = str
+		e.ProcessContext.Ancestor.ProcessContext.Process.Credentials.FSUID = uint32(v)
 
 		return nil
 
-	case "link.file.filesystem":
+	case "process.ancestors.fsuser":
+
+		if e.ProcessContext.Ancestor == nil {
+			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
+		}
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Source.Filesytem"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Credentials.FSUser"}
 		}
-		e.Link.Source.Filesytem = str
+		e.ProcessContext.Ancestor.ProcessContext.Process.Credentials.FSUser = str
 
 		return nil
 
-	case "link.file.gid":
+	case "process.ancestors.gid":
+
+		if e.ProcessContext.Ancestor == nil {
+			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
+		}
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Source.FileFields.GID"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Credentials.GID"}
 		}
-		e.Link.Source.FileFields.GID = uint32(v)
+		e.ProcessContext.Ancestor.ProcessContext.Process.Credentials.GID = uint32(v)
 
 		return nil
 
-	case "link.file.group":
+	case "process.ancestors.group":
+
+		if e.ProcessContext.Ancestor == nil {
+			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
+		}
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Source.FileFields.Group"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Credentials.Group"}
 		}
-		e.Link.Source.FileFields.Group = str
+		e.ProcessContext.Ancestor.ProcessContext.Process.Credentials.Group = str
 
 		return nil
 
-	case "link.file.in_upper_layer":
+	case "process.ancestors.pid":
 
-		var ok bool
-		if e.Link.Source.FileFields.InUpperLayer, ok = value.(bool); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Source.FileFields.InUpperLayer"}
+		if e.ProcessContext.Ancestor == nil {
+			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
 		}
-		return nil
-
-	case "link.file.inode":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Source.FileFields.Inode"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Pid"}
 		}
-		e.Link.Source.FileFields.Inode = uint64(v)
+		e.ProcessContext.Ancestor.ProcessContext.Process.Pid = uint32(v)
 
 		return nil
 
-	case "link.file.mode":
+	case "process.ancestors.ppid":
+
+		if e.ProcessContext.Ancestor == nil {
+			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
+		}
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Source.FileFields.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.PPid"}
 		}
-		e.Link.Source.FileFields.Mode = uint16(v)
+		e.ProcessContext.Ancestor.ProcessContext.Process.PPid = uint32(v)
 
 		return nil
 
-	case "link.file.modification_time":
+	case "process.ancestors.tid":
+
+		if e.ProcessContext.Ancestor == nil {
+			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
+		}
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Source.FileFields.MTime"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Tid"}
 		}
-		e.Link.Source.FileFields.MTime = uint64(v)
+		e.ProcessContext.Ancestor.ProcessContext.Process.Tid = uint32(v)
 
 		return nil
 
-	case "link.file.mount_id":
+	case "process.ancestors.tty_name":
+
+		if e.ProcessContext.Ancestor == nil {
+			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
+		}
 
 		var ok bool
-		v, ok := value.(int)
+		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Source.FileFields.MountID"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.TTYName"}
 		}
-		e.Link.Source.FileFields.MountID = uint32(v)
+		e.ProcessContext.Ancestor.ProcessContext.Process.TTYName = str
 
 		return nil
 
-	case "link.file.name":
+	case "process.ancestors.uid":
+
+		if e.ProcessContext.Ancestor == nil {
+			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
+		}
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Source.BasenameStr"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Credentials.UID"}
 		}
-		e.Link.Source.BasenameStr = str
+		e.ProcessContext.Ancestor.ProcessContext.Process.Credentials.UID = uint32(v)
 
 		return nil
 
-	case "link.file.path":
+	case "process.ancestors.user":
+
+		if e.ProcessContext.Ancestor == nil {
+			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
+		}
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Source.PathnameStr"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Credentials.User"}
 		}
-		e.Link.Source.PathnameStr = str
+		e.ProcessContext.Ancestor.ProcessContext.Process.Credentials.User = str
 
 		return nil
 
-	case "link.file.rights":
+	case "process.cap_effective":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Source.FileFields.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Credentials.CapEffective"}
 		}
-		e.Link.Source.FileFields.Mode = uint16(v)
+		e.ProcessContext.Process.Credentials.CapEffective = uint64(v)
 
 		return nil
 
-	case "link.file.uid":
+	case "process.cap_permitted":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Source.FileFields.UID"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Credentials.CapPermitted"}
 		}
-		e.Link.Source.FileFields.UID = uint32(v)
+		e.ProcessContext.Process.Credentials.CapPermitted = uint64(v)
 
 		return nil
 
-	case "link.file.user":
+	case "process.comm":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.Source.FileFields.User"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Comm"}
 		}
-		e.Link.Source.FileFields.User = str
+		e.ProcessContext.Process.Comm = str
 
 		return nil
 
-	case "link.retval":
+	case "process.container.id":
 
 		var ok bool
-		v, ok := value.(int)
+		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Link.SyscallEvent.Retval"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.ContainerID"}
 		}
-		e.Link.SyscallEvent.Retval = int64(v)
+		e.ProcessContext.Process.ContainerID = str
 
 		return nil
 
-	case "mkdir.file.change_time":
+	case "process.cookie":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Mkdir.File.FileFields.CTime"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Cookie"}
 		}
-		e.Mkdir.File.FileFields.CTime = uint64(v)
+		e.ProcessContext.Process.Cookie = uint32(v)
 
 		return nil
 
-	case "mkdir.file.destination.mode":
+	case "process.created_at":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Mkdir.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.CreatedAt"}
 		}
-		e.Mkdir.Mode = uint32(v)
+		e.ProcessContext.Process.CreatedAt = uint64(v)
 
 		return nil
 
-	case "mkdir.file.destination.rights":
+	case "process.egid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Mkdir.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Credentials.EGID"}
 		}
-		e.Mkdir.Mode = uint32(v)
+		e.ProcessContext.Process.Credentials.EGID = uint32(v)
 
 		return nil
 
-	case "mkdir.file.filesystem":
+	case "process.egroup":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Mkdir.File.Filesytem"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Credentials.EGroup"}
 		}
-		e.Mkdir.File.Filesytem = str
+		e.ProcessContext.Process.Credentials.EGroup = str
 
 		return nil
 
-	case "mkdir.file.gid":
+	case "process.euid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Mkdir.File.FileFields.GID"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Credentials.EUID"}
 		}
-		e.Mkdir.File.FileFields.GID = uint32(v)
+		e.ProcessContext.Process.Credentials.EUID = uint32(v)
 
 		return nil
 
-	case "mkdir.file.group":
+	case "process.euser":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Mkdir.File.FileFields.Group"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Credentials.EUser"}
 		}
-		e.Mkdir.File.FileFields.Group = str
+		e.ProcessContext.Process.Credentials.EUser = str
 
 		return nil
 
-	case "mkdir.file.in_upper_layer":
+	case "process.file.change_time":
 
 		var ok bool
-		if e.Mkdir.File.FileFields.InUpperLayer, ok = value.(bool); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Mkdir.File.FileFields.InUpperLayer"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.FileFields.CTime"}
 		}
+		e.ProcessContext.Process.FileFields.CTime = uint64(v)
+
 		return nil
 
-	case "mkdir.file.inode":
+	case "process.file.filesystem":
 
 		var ok bool
-		v, ok := value.(int)
+		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Mkdir.File.FileFields.Inode"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Filesystem"}
 		}
-		e.Mkdir.File.FileFields.Inode = uint64(v)
+		e.ProcessContext.Process.Filesystem = str
 
 		return nil
 
-	case "mkdir.file.mode":
+	case "process.file.gid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Mkdir.File.FileFields.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.FileFields.GID"}
 		}
-		e.Mkdir.File.FileFields.Mode = uint16(v)
+		e.ProcessContext.Process.FileFields.GID = uint32(v)
 
 		return nil
 
-	case "mkdir.file.modification_time":
+	case "process.file.group":
 
 		var ok bool
-		v, ok := value.(int)
+		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Mkdir.File.FileFields.MTime"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.FileFields.Group"}
 		}
-		e.Mkdir.File.FileFields.MTime = uint64(v)
+		e.ProcessContext.Process.FileFields.Group = str
 
 		return nil
 
-	case "mkdir.file.mount_id":
+	case "process.file.in_upper_layer":
 
 		var ok bool
-		v, ok := value.(int)
-		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Mkdir.File.FileFields.MountID"}
+		if e.ProcessContext.Process.FileFields.InUpperLayer, ok = value.(bool); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.FileFields.InUpperLayer"}
 		}
-		e.Mkdir.File.FileFields.MountID = uint32(v)
-
 		return nil
 
-	case "mkdir.file.name":
+	case "process.file.inode":
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Mkdir.File.BasenameStr"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.FileFields.Inode"}
 		}
-		e.Mkdir.File.BasenameStr = str
+		e.ProcessContext.Process.FileFields.Inode = uint64(v)
 
 		return nil
 
-	case "mkdir.file.path":
+	case "process.file.mode":
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Mkdir.File.PathnameStr"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.FileFields.Mode"}
 		}
-		e.Mkdir.File.PathnameStr = str
+		e.ProcessContext.Process.FileFields.Mode = uint16(v)
 
 		return nil
 
-	case "mkdir.file.rights":
+	case "process.file.modification_time":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Mkdir.File.FileFields.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.FileFields.MTime"}
 		}
-		e.Mkdir.File.FileFields.Mode = uint16(v)
+		e.ProcessContext.Process.FileFields.MTime = uint64(v)
 
 		return nil
 
-	case "mkdir.file.uid":
+	case "process.file.mount_id":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Mkdir.File.FileFields.UID"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.FileFields.MountID"}
 		}
-		e.Mkdir.File.FileFields.UID = uint32(v)
+		e.ProcessContext.Process.FileFields.MountID = uint32(v)
 
 		return nil
 
-	case "mkdir.file.user":
+	case "process.file.name":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Mkdir.File.FileFields.User"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.BasenameStr"}
 		}
-		e.Mkdir.File.FileFields.User = str
+		e.ProcessContext.Process.BasenameStr = str
 
 		return nil
 
-	case "mkdir.retval":
+	case "process.file.path":
 
 		var ok bool
-		v, ok := value.(int)
+		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Mkdir.SyscallEvent.Retval"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.PathnameStr"}
 		}
-		e.Mkdir.SyscallEvent.Retval = int64(v)
+		e.ProcessContext.Process.PathnameStr = str
 
 		return nil
 
-	case "open.file.change_time":
+	case "process.file.rights":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Open.File.FileFields.CTime"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.FileFields.Mode"}
 		}
-		e.Open.File.FileFields.CTime = uint64(v)
+		e.ProcessContext.Process.FileFields.Mode = uint16(v)
 
 		return nil
 
-	case "open.file.destination.mode":
+	case "process.file.uid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Open.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.FileFields.UID"}
 		}
-		e.Open.Mode = uint32(v)
+		e.ProcessContext.Process.FileFields.UID = uint32(v)
 
 		return nil
 
-	case "open.file.filesystem":
+	case "process.file.user":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Open.File.Filesytem"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.FileFields.User"}
 		}
-		e.Open.File.Filesytem = str
+		e.ProcessContext.Process.FileFields.User = str
 
 		return nil
 
-	case "open.file.gid":
+	case "process.fsgid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Open.File.FileFields.GID"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Credentials.FSGID"}
 		}
-		e.Open.File.FileFields.GID = uint32(v)
+		e.ProcessContext.Process.Credentials.FSGID = uint32(v)
 
 		return nil
 
-	case "open.file.group":
+	case "process.fsgroup":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Open.File.FileFields.Group"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Credentials.FSGroup"}
 		}
-		e.Open.File.FileFields.Group = str
+		e.ProcessContext.Process.Credentials.FSGroup = str
 
 		return nil
 
-	case "open.file.in_upper_layer":
+	case "process.fsuid":
 
 		var ok bool
-		if e.Open.File.FileFields.InUpperLayer, ok = value.(bool); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Open.File.FileFields.InUpperLayer"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Credentials.FSUID"}
 		}
+		e.ProcessContext.Process.Credentials.FSUID = uint32(v)
+
 		return nil
 
-	case "open.file.inode":
+	case "process.fsuser":
 
 		var ok bool
-		v, ok := value.(int)
+		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Open.File.FileFields.Inode"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Credentials.FSUser"}
 		}
-		e.Open.File.FileFields.Inode = uint64(v)
+		e.ProcessContext.Process.Credentials.FSUser = str
 
 		return nil
 
-	case "open.file.mode":
+	case "process.gid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Open.File.FileFields.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Credentials.GID"}
 		}
-		e.Open.File.FileFields.Mode = uint16(v)
+		e.ProcessContext.Process.Credentials.GID = uint32(v)
 
 		return nil
 
-	case "open.file.modification_time":
+	case "process.group":
 
 		var ok bool
-		v, ok := value.(int)
+		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Open.File.FileFields.MTime"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Credentials.Group"}
 		}
-		e.Open.File.FileFields.MTime = uint64(v)
+		e.ProcessContext.Process.Credentials.Group = str
 
 		return nil
 
-	case "open.file.mount_id":
+	case "process.pid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Open.File.FileFields.MountID"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Pid"}
 		}
-		e.Open.File.FileFields.MountID = uint32(v)
+		e.ProcessContext.Process.Pid = uint32(v)
 
 		return nil
 
-	case "open.file.name":
+	case "process.ppid":
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Open.File.BasenameStr"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.PPid"}
 		}
-		e.Open.File.BasenameStr = str
+		e.ProcessContext.Process.PPid = uint32(v)
 
 		return nil
 
-	case "open.file.path":
+	case "process.tid":
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Open.File.PathnameStr"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Tid"}
 		}
-		e.Open.File.PathnameStr = str
+		e.ProcessContext.Process.Tid = uint32(v)
 
 		return nil
 
-	case "open.file.rights":
+	case "process.tty_name":
 
 		var ok bool
-		v, ok := value.(int)
+		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Open.File.FileFields.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.TTYName"}
 		}
-		e.Open.File.FileFields.Mode = uint16(v)
+		e.ProcessContext.Process.TTYName = str
 
 		return nil
 
-	case "open.file.uid":
+	case "process.uid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Open.File.FileFields.UID"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Credentials.UID"}
 		}
-		e.Open.File.FileFields.UID = uint32(v)
+		e.ProcessContext.Process.Credentials.UID = uint32(v)
 
 		return nil
 
-	case "open.file.user":
+	case "process.user":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Open.File.FileFields.User"}
+			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Credentials.User"}
 		}
-		e.Open.File.FileFields.User = str
+		e.ProcessContext.Process.Credentials.User = str
 
 		return nil
 
-	case "open.flags":
+	case "ptrace.request":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Open.Flags"}
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Request"}
 		}
-		e.Open.Flags = uint32(v)
+		e.PTrace.Request = uint32(v)
 
 		return nil
 
-	case "open.retval":
+	case "ptrace.retval":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Open.SyscallEvent.Retval"}
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.SyscallEvent.Retval"}
 		}
-		e.Open.SyscallEvent.Retval = int64(v)
+		e.PTrace.SyscallEvent.Retval = int64(v)
 
 		return nil
 
-	case "process.ancestors.cap_effective":
-
-		if e.ProcessContext.Ancestor == nil {
-			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
-		}
+	case "ptrace.tracee.cap_effective":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Credentials.CapEffective"}
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Credentials.CapEffective"}
 		}
-		e.ProcessContext.Ancestor.ProcessContext.Process.Credentials.CapEffective = uint64(v)
+		e.PTrace.Tracee.Credentials.CapEffective = uint64(v)
 
 		return nil
 
-	case "process.ancestors.cap_permitted":
-
-		if e.ProcessContext.Ancestor == nil {
-			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
-		}
+	case "ptrace.tracee.cap_permitted":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Credentials.CapPermitted"}
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Credentials.CapPermitted"}
 		}
-		e.ProcessContext.Ancestor.ProcessContext.Process.Credentials.CapPermitted = uint64(v)
+		e.PTrace.Tracee.Credentials.CapPermitted = uint64(v)
 
 		return nil
 
-	case "process.ancestors.comm":
-
-		if e.ProcessContext.Ancestor == nil {
-			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
-		}
+	case "ptrace.tracee.comm":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Comm"}
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Comm"}
 		}
-		e.ProcessContext.Ancestor.ProcessContext.Process.Comm = str
+		e.PTrace.Tracee.Comm = str
 
 		return nil
 
-	case "process.ancestors.container.id":
-
-		if e.ProcessContext.Ancestor == nil {
-			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
-		}
+	case "ptrace.tracee.container.id":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.ContainerID"}
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.ContainerID"}
 		}
-		e.ProcessContext.Ancestor.ProcessContext.Process.ContainerID = str
+		e.PTrace.Tracee.ContainerID = str
 
 		return nil
 
-	case "process.ancestors.cookie":
-
-		if e.ProcessContext.Ancestor == nil {
-			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
-		}
+	case "ptrace.tracee.cookie":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Cookie"}
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Cookie"}
 		}
-		e.ProcessContext.Ancestor.ProcessContext.Process.Cookie = uint32(v)
+		e.PTrace.Tracee.Cookie = uint32(v)
 
 		return nil
 
-	case "process.ancestors.created_at":
-
-		if e.ProcessContext.Ancestor == nil {
-			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
-		}
+	case "ptrace.tracee.created_at":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.CreatedAt"}
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.CreatedAt"}
 		}
-		e.ProcessContext.Ancestor.ProcessContext.Process.CreatedAt = uint64(v)
+		e.PTrace.Tracee.CreatedAt = uint64(v)
 
 		return nil
 
-	case "process.ancestors.egid":
-
-		if e.ProcessContext.Ancestor == nil {
-			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
-		}
+	case "ptrace.tracee.egid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Credentials.EGID"}
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Credentials.EGID"}
 		}
-		e.ProcessContext.Ancestor.ProcessContext.Process.Credentials.EGID = uint32(v)
+		e.PTrace.Tracee.Credentials.EGID = uint32(v)
 
 		return nil
 
-	case "process.ancestors.egroup":
-
-		if e.ProcessContext.Ancestor == nil {
-			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
-		}
+	case "ptrace.tracee.egroup":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Credentials.EGroup"}
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Credentials.EGroup"}
 		}
-		e.ProcessContext.Ancestor.ProcessContext.Process.Credentials.EGroup = str
+		e.PTrace.Tracee.Credentials.EGroup = str
 
 		return nil
 
-	case "process.ancestors.euid":
-
-		if e.ProcessContext.Ancestor == nil {
-			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
-		}
-
+	case "ptrace.tracee.euid":
+
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Credentials.EUID"}
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Credentials.EUID"}
 		}
-		e.ProcessContext.Ancestor.ProcessContext.Process.Credentials.EUID = uint32(v)
+		e.PTrace.Tracee.Credentials.EUID = uint32(v)
 
 		return nil
 
-	case "process.ancestors.euser":
-
-		if e.ProcessContext.Ancestor == nil {
-			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
-		}
+	case "ptrace.tracee.euser":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Credentials.EUser"}
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Credentials.EUser"}
 		}
-		e.ProcessContext.Ancestor.ProcessContext.Process.Credentials.EUser = str
+		e.PTrace.Tracee.Credentials.EUser = str
 
 		return nil
 
-	case "process.ancestors.file.change_time":
-
-		if e.ProcessContext.Ancestor == nil {
-			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
-		}
+	case "ptrace.tracee.file.change_time":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.FileFields.CTime"}
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.FileFields.CTime"}
 		}
-		e.ProcessContext.Ancestor.ProcessContext.Process.FileFields.CTime = uint64(v)
+		e.PTrace.Tracee.FileFields.CTime = uint64(v)
 
 		return nil
 
-	case "process.ancestors.file.filesystem":
-
-		if e.ProcessContext.Ancestor == nil {
-			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
-		}
+	case "ptrace.tracee.file.filesystem":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Filesystem"}
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Filesystem"}
 		}
-		e.ProcessContext.Ancestor.ProcessContext.Process.Filesystem = str
+		e.PTrace.Tracee.Filesystem = str
 
 		return nil
 
-	case "process.ancestors.file.gid":
-
-		if e.ProcessContext.Ancestor == nil {
-			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
-		}
+	case "ptrace.tracee.file.gid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.FileFields.GID"}
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.FileFields.GID"}
 		}
-		e.ProcessContext.Ancestor.ProcessContext.Process.FileFields.GID = uint32(v)
+		e.PTrace.Tracee.FileFields.GID = uint32(v)
 
 		return nil
 
-	case "process.ancestors.file.group":
-
-		if e.ProcessContext.Ancestor == nil {
-			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
-		}
+	case "ptrace.tracee.file.group":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.FileFields.Group"}
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.FileFields.Group"}
 		}
-		e.ProcessContext.Ancestor.ProcessContext.Process.FileFields.Group = str
+		e.PTrace.Tracee.FileFields.Group = str
 
 		return nil
 
-	case "process.ancestors.file.in_upper_layer":
-
-		if e.ProcessContext.Ancestor == nil {
-			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
-		}
+	case "ptrace.tracee.file.in_upper_layer":
 
 		var ok bool
-		if e.ProcessContext.Ancestor.ProcessContext.Process.FileFields.InUpperLayer, ok = value.(bool); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.FileFields.InUpperLayer"}
+		if e.PTrace.Tracee.FileFields.InUpperLayer, ok = value.(bool); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.FileFields.InUpperLayer"}
 		}
 		return nil
 
-	case "process.ancestors.file.inode":
-
-		if e.ProcessContext.Ancestor == nil {
-			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
-		}
+	case "ptrace.tracee.file.inode":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.FileFields.Inode"}
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.FileFields.Inode"}
 		}
-		e.ProcessContext.Ancestor.ProcessContext.Process.FileFields.Inode = uint64(v)
+		e.PTrace.Tracee.FileFields.Inode = uint64(v)
 
 		return nil
 
-	case "process.ancestors.file.mode":
-
-		if e.ProcessContext.Ancestor == nil {
-			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
-		}
+	case "ptrace.tracee.file.mode":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.FileFields.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.FileFields.Mode"}
 		}
-		e.ProcessContext.Ancestor.ProcessContext.Process.FileFields.Mode = uint16(v)
+		e.PTrace.Tracee.FileFields.Mode = uint16(v)
 
 		return nil
 
-	case "process.ancestors.file.modification_time":
-
-		if e.ProcessContext.Ancestor == nil {
-			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
-		}
+	case "ptrace.tracee.file.modification_time":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.FileFields.MTime"}
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.FileFields.MTime"}
 		}
-		e.ProcessContext.Ancestor.ProcessContext.Process.FileFields.MTime = uint64(v)
+		e.PTrace.Tracee.FileFields.MTime = uint64(v)
 
 		return nil
 
-	case "process.ancestors.file.mount_id":
-
-		if e.ProcessContext.Ancestor == nil {
-			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
-		}
+	case "ptrace.tracee.file.mount_id":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.FileFields.MountID"}
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.FileFields.MountID"}
 		}
-		e.ProcessContext.Ancestor.ProcessContext.Process.FileFields.MountID = uint32(v)
+		e.PTrace.Tracee.FileFields.MountID = uint32(v)
 
 		return nil
 
-	case "process.ancestors.file.name":
-
-		if e.ProcessContext.Ancestor == nil {
-			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
-		}
+	case "ptrace.tracee.file.name":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.BasenameStr"}
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.BasenameStr"}
 		}
-		e.ProcessContext.Ancestor.ProcessContext.Process.BasenameStr = str
+		e.PTrace.Tracee.BasenameStr = str
 
 		return nil
 
-	case "process.ancestors.file.path":
-
-		if e.ProcessContext.Ancestor == nil {
-			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
-		}
+	case "ptrace.tracee.file.path":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.PathnameStr"}
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.PathnameStr"}
 		}
-		e.ProcessContext.Ancestor.ProcessContext.Process.PathnameStr = str
+		e.PTrace.Tracee.PathnameStr = str
 
 		return nil
 
-	case "process.ancestors.file.rights":
-
-		if e.ProcessContext.Ancestor == nil {
-			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
-		}
+	case "ptrace.tracee.file.rights":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.FileFields.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.FileFields.Mode"}
 		}
-		e.ProcessContext.Ancestor.ProcessContext.Process.FileFields.Mode = uint16(v)
+		e.PTrace.Tracee.FileFields.Mode = uint16(v)
 
 		return nil
 
-	case "process.ancestors.file.uid":
-
-		if e.ProcessContext.Ancestor == nil {
-			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
-		}
+	case "ptrace.tracee.file.uid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.FileFields.UID"}
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.FileFields.UID"}
 		}
-		e.ProcessContext.Ancestor.ProcessContext.Process.FileFields.UID = uint32(v)
+		e.PTrace.Tracee.FileFields.UID = uint32(v)
 
 		return nil
 
-	case "process.ancestors.file.user":
-
-		if e.ProcessContext.Ancestor == nil {
-			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
-		}
+	case "ptrace.tracee.file.user":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.FileFields.User"}
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.FileFields.User"}
 		}
-		e.ProcessContext.Ancestor.ProcessContext.Process.FileFields.User = str
+		e.PTrace.Tracee.FileFields.User = str
 
 		return nil
 
-	case "process.ancestors.fsgid":
-
-		if e.ProcessContext.Ancestor == nil {
-			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
-		}
+	case "ptrace.tracee.fsgid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Credentials.FSGID"}
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Credentials.FSGID"}
 		}
-		e.ProcessContext.Ancestor.ProcessContext.Process.Credentials.FSGID = uint32(v)
+		e.PTrace.Tracee.Credentials.FSGID = uint32(v)
 
 		return nil
 
-	case "process.ancestors.fsgroup":
-
-		if e.ProcessContext.Ancestor == nil {
-			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
-		}
+	case "ptrace.tracee.fsgroup":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Credentials.FSGroup"}
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Credentials.FSGroup"}
 		}
-		e.ProcessContext.Ancestor.ProcessContext.Process.Credentials.FSGroup = str
+		e.PTrace.Tracee.Credentials.FSGroup = str
 
 		return nil
 
-	case "process.ancestors.fsuid":
-
-		if e.ProcessContext.Ancestor == nil {
-			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
-		}
+	case "ptrace.tracee.fsuid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Credentials.FSUID"}
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Credentials.FSUID"}
 		}
-		e.ProcessContext.Ancestor.ProcessContext.Process.Credentials.FSUID = uint32(v)
+		e.PTrace.Tracee.Credentials.FSUID = uint32(v)
 
 		return nil
 
-	case "process.ancestors.fsuser":
-
-		if e.ProcessContext.Ancestor == nil {
-			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
-		}
+	case "ptrace.tracee.fsuser":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Credentials.FSUser"}
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Credentials.FSUser"}
 		}
-		e.ProcessContext.Ancestor.ProcessContext.Process.Credentials.FSUser = str
+		e.PTrace.Tracee.Credentials.FSUser = str
 
 		return nil
 
-	case "process.ancestors.gid":
-
-		if e.ProcessContext.Ancestor == nil {
-			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
-		}
+	case "ptrace.tracee.gid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Credentials.GID"}
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Credentials.GID"}
 		}
-		e.ProcessContext.Ancestor.ProcessContext.Process.Credentials.GID = uint32(v)
+		e.PTrace.Tracee.Credentials.GID = uint32(v)
 
 		return nil
 
-	case "process.ancestors.group":
-
-		if e.ProcessContext.Ancestor == nil {
-			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
-		}
+	case "ptrace.tracee.group":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Credentials.Group"}
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Credentials.Group"}
 		}
-		e.ProcessContext.Ancestor.ProcessContext.Process.Credentials.Group = str
+		e.PTrace.Tracee.Credentials.Group = str
 
 		return nil
 
-	case "process.ancestors.pid":
-
-		if e.ProcessContext.Ancestor == nil {
-			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
-		}
+	case "ptrace.tracee.pid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Pid"}
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Pid"}
 		}
-		e.ProcessContext.Ancestor.ProcessContext.Process.Pid = uint32(v)
+		e.PTrace.Tracee.Pid = uint32(v)
 
 		return nil
 
-	case "process.ancestors.ppid":
-
-		if e.ProcessContext.Ancestor == nil {
-			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
-		}
+	case "ptrace.tracee.ppid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.PPid"}
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.PPid"}
 		}
-		e.ProcessContext.Ancestor.ProcessContext.Process.PPid = uint32(v)
+		e.PTrace.Tracee.PPid = uint32(v)
 
 		return nil
 
-	case "process.ancestors.tid":
-
-		if e.ProcessContext.Ancestor == nil {
-			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
-		}
+	case "ptrace.tracee.tid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Tid"}
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Tid"}
 		}
-		e.ProcessContext.Ancestor.ProcessContext.Process.Tid = uint32(v)
+		e.PTrace.Tracee.Tid = uint32(v)
 
 		return nil
 
-	case "process.ancestors.tty_name":
-
-		if e.ProcessContext.Ancestor == nil {
-			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
-		}
+	case "ptrace.tracee.tty_name":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.TTYName"}
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.TTYName"}
 		}
-		e.ProcessContext.Ancestor.ProcessContext.Process.TTYName = str
+		e.PTrace.Tracee.TTYName = str
 
 		return nil
 
-	case "process.ancestors.uid":
-
-		if e.ProcessContext.Ancestor == nil {
-			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
-		}
+	case "ptrace.tracee.uid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Credentials.UID"}
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Credentials.UID"}
 		}
-		e.ProcessContext.Ancestor.ProcessContext.Process.Credentials.UID = uint32(v)
+		e.PTrace.Tracee.Credentials.UID = uint32(v)
 
 		return nil
 
-	case "process.ancestors.user":
-
-		if e.ProcessContext.Ancestor == nil {
-			e.ProcessContext.Ancestor = &model.ProcessCacheEntry{}
-		}
+	case "ptrace.tracee.user":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Ancestor.ProcessContext.Process.Credentials.User"}
+			return &eval.ErrValueTypeMismatch{Field: "PTrace.Tracee.Credentials.User"}
 		}
-		e.ProcessContext.Ancestor.ProcessContext.Process.Credentials.User = str
+		e.PTrace.Tracee.Credentials.User = str
 
 		return nil
 
-	case "process.cap_effective":
+	case "removexattr.file.change_time":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Credentials.CapEffective"}
+			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.File.FileFields.CTime"}
 		}
-		e.ProcessContext.Process.Credentials.CapEffective = uint64(v)
+		e.RemoveXAttr.File.FileFields.CTime = uint64(v)
 
 		return nil
 
-	case "process.cap_permitted":
+	case "removexattr.file.destination.name":
 
 		var ok bool
-		v, ok := value.(int)
+		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Credentials.CapPermitted"}
+			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.Name"}
 		}
-		e.ProcessContext.Process.Credentials.CapPermitted = uint64(v)
+		e.RemoveXAttr.Name = str
 
 		return nil
 
-	case "process.comm":
+	case "removexattr.file.destination.namespace":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Comm"}
+			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.Namespace"}
 		}
-		e.ProcessContext.Process.Comm = str
+		e.RemoveXAttr.Namespace = str
 
 		return nil
 
-	case "process.container.id":
+	case "removexattr.file.filesystem":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.ContainerID"}
+			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.File.Filesytem"}
 		}
-		e.ProcessContext.Process.ContainerID = str
+		e.RemoveXAttr.File.Filesytem = str
 
 		return nil
 
-	case "process.cookie":
+	case "removexattr.file.gid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Cookie"}
+			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.File.FileFields.GID"}
 		}
-		e.ProcessContext.Process.Cookie = uint32(v)
+		e.RemoveXAttr.File.FileFields.GID = uint32(v)
 
 		return nil
 
-	case "process.created_at":
+	case "removexattr.file.group":
+
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.File.FileFields.Group"}
+		}
+		e.RemoveXAttr.File.FileFields.Group = str
+
+		return nil
+
+	case "removexattr.file.in_upper_layer":
+
+		var ok bool
+		if e.RemoveXAttr.File.FileFields.InUpperLayer, ok = value.(bool); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.File.FileFields.InUpperLayer"}
+		}
+		return nil
+
+	case "removexattr.file.inode":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.CreatedAt"}
+			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.File.FileFields.Inode"}
 		}
-		e.ProcessContext.Process.CreatedAt = uint64(v)
+		e.RemoveXAttr.File.FileFields.Inode = uint64(v)
 
 		return nil
 
-	case "process.egid":
+	case "removexattr.file.mode":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Credentials.EGID"}
+			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.File.FileFields.Mode"}
 		}
-		e.ProcessContext.Process.Credentials.EGID = uint32(v)
+		e.RemoveXAttr.File.FileFields.Mode = uint16(v)
 
 		return nil
 
-	case "process.egroup":
+	case "removexattr.file.modification_time":
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Credentials.EGroup"}
+			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.File.FileFields.MTime"}
 		}
-		e.ProcessContext.Process.Credentials.EGroup = str
+		e.RemoveXAttr.File.FileFields.MTime = uint64(v)
 
 		return nil
 
-	case "process.euid":
+	case "removexattr.file.mount_id":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Credentials.EUID"}
+			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.File.FileFields.MountID"}
 		}
-		e.ProcessContext.Process.Credentials.EUID = uint32(v)
+		e.RemoveXAttr.File.FileFields.MountID = uint32(v)
 
 		return nil
 
-	case "process.euser":
+	case "removexattr.file.name":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Credentials.EUser"}
+			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.File.BasenameStr"}
 		}
-		e.ProcessContext.Process.Credentials.EUser = str
+		e.RemoveXAttr.File.BasenameStr = str
 
 		return nil
 
-	case "process.file.change_time":
+	case "removexattr.file.path":
 
 		var ok bool
-		v, ok := value.(int)
+		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.FileFields.CTime"}
+			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.File.PathnameStr"}
 		}
-		e.ProcessContext.Process.FileFields.CTime = uint64(v)
+		e.RemoveXAttr.File.PathnameStr = str
 
 		return nil
 
-	case "process.file.filesystem":
+	case "removexattr.file.rights":
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Filesystem"}
+			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.File.FileFields.Mode"}
 		}
-		e.ProcessContext.Process.Filesystem = str
+		e.RemoveXAttr.File.FileFields.Mode = uint16(v)
 
 		return nil
 
-	case "process.file.gid":
+	case "removexattr.file.uid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.FileFields.GID"}
+			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.File.FileFields.UID"}
 		}
-		e.ProcessContext.Process.FileFields.GID = uint32(v)
+		e.RemoveXAttr.File.FileFields.UID = uint32(v)
 
 		return nil
 
-	case "process.file.group":
+	case "removexattr.file.user":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.FileFields.Group"}
+			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.File.FileFields.User"}
 		}
-		e.ProcessContext.Process.FileFields.Group = str
+		e.RemoveXAttr.File.FileFields.User = str
 
 		return nil
 
-	case "process.file.in_upper_layer":
+	case "removexattr.retval":
 
 		var ok bool
-		if e.ProcessContext.Process.FileFields.InUpperLayer, ok = value.(bool); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.FileFields.InUpperLayer"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.SyscallEvent.Retval"}
 		}
+		e.RemoveXAttr.SyscallEvent.Retval = int64(v)
+
 		return nil
 
-	case "process.file.inode":
+	case "rename.file.change_time":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.FileFields.Inode"}
+			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.FileFields.CTime"}
 		}
-		e.ProcessContext.Process.FileFields.Inode = uint64(v)
+		e.Rename.Old.FileFields.CTime = uint64(v)
 
 		return nil
 
-	case "process.file.mode":
+	case "rename.file.destination.change_time":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.FileFields.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "Rename.New.FileFields.CTime"}
 		}
-		e.ProcessContext.Process.FileFields.Mode = uint16(v)
+		e.Rename.New.FileFields.CTime = uint64(v)
 
 		return nil
 
-	case "process.file.modification_time":
+	case "rename.file.destination.filesystem":
 
 		var ok bool
-		v, ok := value.(int)
+		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.FileFields.MTime"}
+			return &eval.ErrValueTypeMismatch{Field: "Rename.New.Filesytem"}
 		}
-		e.ProcessContext.Process.FileFields.MTime = uint64(v)
+		e.Rename.New.Filesytem = str
 
 		return nil
 
-	case "process.file.mount_id":
+	case "rename.file.destination.gid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.FileFields.MountID"}
+			return &eval.ErrValueTypeMismatch{Field: "Rename.New.FileFields.GID"}
 		}
-		e.ProcessContext.Process.FileFields.MountID = uint32(v)
+		e.Rename.New.FileFields.GID = uint32(v)
 
 		return nil
 
-	case "process.file.name":
+	case "rename.file.destination.group":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.BasenameStr"}
+			return &eval.ErrValueTypeMismatch{Field: "Rename.New.FileFields.Group"}
 		}
-		e.ProcessContext.Process.BasenameStr = str
+		e.Rename.New.FileFields.Group = str
 
 		return nil
 
-	case "process.file.path":
+	case "rename.file.destination.in_upper_layer":
 
 		var ok bool
-		str, ok := value.(string)
-		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.PathnameStr"}
+		if e.Rename.New.FileFields.InUpperLayer, ok = value.(bool); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Rename.New.FileFields.InUpperLayer"}
 		}
-		e.ProcessContext.Process.PathnameStr = str
-
 		return nil
 
-	case "process.file.rights":
+	case "rename.file.destination.inode":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.FileFields.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "Rename.New.FileFields.Inode"}
 		}
-		e.ProcessContext.Process.FileFields.Mode = uint16(v)
+		e.Rename.New.FileFields.Inode = uint64(v)
 
 		return nil
 
-	case "process.file.uid":
+	case "rename.file.destination.mode":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.FileFields.UID"}
+			return &eval.ErrValueTypeMismatch{Field: "Rename.New.FileFields.Mode"}
 		}
-		e.ProcessContext.Process.FileFields.UID = uint32(v)
+		e.Rename.New.FileFields.Mode = uint16(v)
 
 		return nil
 
-	case "process.file.user":
+	case "rename.file.destination.modification_time":
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.FileFields.User"}
+			return &eval.ErrValueTypeMismatch{Field: "Rename.New.FileFields.MTime"}
 		}
-		e.ProcessContext.Process.FileFields.User = str
+		e.Rename.New.FileFields.MTime = uint64(v)
 
 		return nil
 
-	case "process.fsgid":
+	case "rename.file.destination.mount_id":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Credentials.FSGID"}
+			return &eval.ErrValueTypeMismatch{Field: "Rename.New.FileFields.MountID"}
 		}
-		e.ProcessContext.Process.Credentials.FSGID = uint32(v)
+		e.Rename.New.FileFields.MountID = uint32(v)
 
 		return nil
 
-	case "process.fsgroup":
+	case "rename.file.destination.name":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Credentials.FSGroup"}
+			return &eval.ErrValueTypeMismatch{Field: "Rename.New.BasenameStr"}
 		}
-		e.ProcessContext.Process.Credentials.FSGroup = str
+		e.Rename.New.BasenameStr = str
 
 		return nil
 
-	case "process.fsuid":
+	case "rename.file.destination.path":
 
 		var ok bool
-		v, ok := value.(int)
+		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Credentials.FSUID"}
+			return &eval.ErrValueTypeMismatch{Field: "Rename.New.PathnameStr"}
 		}
-		e.ProcessContext.Process.Credentials.FSUID = uint32(v)
+		e.Rename.New.PathnameStr = str
 
 		return nil
 
-	case "process.fsuser":
+	case "rename.file.destination.rights":
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Credentials.FSUser"}
+			return &eval.ErrValueTypeMismatch{Field: "Rename.New.FileFields.Mode"}
 		}
-		e.ProcessContext.Process.Credentials.FSUser = str
+		e.Rename.New.FileFields.Mode = uint16(v)
 
 		return nil
 
-	case "process.gid":
+	case "rename.file.destination.uid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Credentials.GID"}
+			return &eval.ErrValueTypeMismatch{Field: "Rename.New.FileFields.UID"}
 		}
-		e.ProcessContext.Process.Credentials.GID = uint32(v)
+		e.Rename.New.FileFields.UID = uint32(v)
 
 		return nil
 
-	case "process.group":
+	case "rename.file.destination.user":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Credentials.Group"}
+			return &eval.ErrValueTypeMismatch{Field: "Rename.New.FileFields.User"}
 		}
-		e.ProcessContext.Process.Credentials.Group = str
+		e.Rename.New.FileFields.User = str
 
 		return nil
 
-	case "process.pid":
+	case "rename.file.filesystem":
 
 		var ok bool
-		v, ok := value.(int)
+		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Pid"}
+			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.Filesytem"}
 		}
-		e.ProcessContext.Process.Pid = uint32(v)
+		e.Rename.Old.Filesytem = str
 
 		return nil
 
-	case "process.ppid":
+	case "rename.file.gid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.PPid"}
+			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.FileFields.GID"}
 		}
-		e.ProcessContext.Process.PPid = uint32(v)
+		e.Rename.Old.FileFields.GID = uint32(v)
 
 		return nil
 
-	case "process.tid":
+	case "rename.file.group":
 
 		var ok bool
-		v, ok := value.(int)
+		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Tid"}
+			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.FileFields.Group"}
 		}
-		e.ProcessContext.Process.Tid = uint32(v)
+		e.Rename.Old.FileFields.Group = str
 
 		return nil
 
-	case "process.tty_name":
+	case "rename.file.in_upper_layer":
 
 		var ok bool
-		str, ok := value.(string)
+		if e.Rename.Old.FileFields.InUpperLayer, ok = value.(bool); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.FileFields.InUpperLayer"}
+		}
+		return nil
+
+	case "rename.file.inode":
+
+		var ok bool
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.TTYName"}
+			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.FileFields.Inode"}
 		}
-		e.ProcessContext.Process.TTYName = str
+		e.Rename.Old.FileFields.Inode = uint64(v)
 
 		return nil
 
-	case "process.uid":
+	case "rename.file.mode":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Credentials.UID"}
+			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.FileFields.Mode"}
 		}
-		e.ProcessContext.Process.Credentials.UID = uint32(v)
+		e.Rename.Old.FileFields.Mode = uint16(v)
 
 		return nil
 
-	case "process.user":
+	case "rename.file.modification_time":
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "ProcessContext.Process.Credentials.User"}
+			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.FileFields.MTime"}
 		}
-		e.ProcessContext.Process.Credentials.User = str
+		e.Rename.Old.FileFields.MTime = uint64(v)
 
 		return nil
 
-	case "removexattr.file.change_time":
+	case "rename.file.mount_id":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.File.FileFields.CTime"}
+			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.FileFields.MountID"}
 		}
-		e.RemoveXAttr.File.FileFields.CTime = uint64(v)
+		e.Rename.Old.FileFields.MountID = uint32(v)
 
 		return nil
 
-	case "removexattr.file.destination.name":
+	case "rename.file.name":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.Name"}
+			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.BasenameStr"}
 		}
-		e.RemoveXAttr.Name = str
+		e.Rename.Old.BasenameStr = str
 
 		return nil
 
-	case "removexattr.file.destination.namespace":
+	case "rename.file.path":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.Namespace"}
+			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.PathnameStr"}
 		}
-		e.RemoveXAttr.Namespace = str
+		e.Rename.Old.PathnameStr = str
 
 		return nil
 
-	case "removexattr.file.filesystem":
+	case "rename.file.rights":
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.File.Filesytem"}
+			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.FileFields.Mode"}
 		}
-		e.RemoveXAttr.File.Filesytem = str
+		e.Rename.Old.FileFields.Mode = uint16(v)
 
 		return nil
 
-	case "removexattr.file.gid":
+	case "rename.file.uid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.File.FileFields.GID"}
+			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.FileFields.UID"}
 		}
-		e.RemoveXAttr.File.FileFields.GID = uint32(v)
+		e.Rename.Old.FileFields.UID = uint32(v)
 
 		return nil
 
-	case "removexattr.file.group":
+	case "rename.file.user":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.File.FileFields.Group"}
+			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.FileFields.User"}
 		}
-		e.RemoveXAttr.File.FileFields.Group = str
+		e.Rename.Old.FileFields.User = str
 
 		return nil
 
-	case "removexattr.file.in_upper_layer":
+	case "rename.retval":
 
 		var ok bool
-		if e.RemoveXAttr.File.FileFields.InUpperLayer, ok = value.(bool); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.File.FileFields.InUpperLayer"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Rename.SyscallEvent.Retval"}
 		}
+		e.Rename.SyscallEvent.Retval = int64(v)
+
 		return nil
 
-	case "removexattr.file.inode":
+	case "rmdir.file.change_time":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.File.FileFields.Inode"}
+			return &eval.ErrValueTypeMismatch{Field: "Rmdir.File.FileFields.CTime"}
 		}
-		e.RemoveXAttr.File.FileFields.Inode = uint64(v)
+		e.Rmdir.File.FileFields.CTime = uint64(v)
 
 		return nil
 
-	case "removexattr.file.mode":
+	case "rmdir.file.filesystem":
 
 		var ok bool
-		v, ok := value.(int)
+		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.File.FileFields.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "Rmdir.File.Filesytem"}
 		}
-		e.RemoveXAttr.File.FileFields.Mode = uint16(v)
+		e.Rmdir.File.Filesytem = str
 
 		return nil
 
-	case "removexattr.file.modification_time":
+	case "rmdir.file.gid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.File.FileFields.MTime"}
+			return &eval.ErrValueTypeMismatch{Field: "Rmdir.File.FileFields.GID"}
 		}
-		e.RemoveXAttr.File.FileFields.MTime = uint64(v)
+		e.Rmdir.File.FileFields.GID = uint32(v)
 
 		return nil
 
-	case "removexattr.file.mount_id":
+	case "rmdir.file.group":
 
 		var ok bool
-		v, ok := value.(int)
+		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.File.FileFields.MountID"}
+			return &eval.ErrValueTypeMismatch{Field: "Rmdir.File.FileFields.Group"}
 		}
-		e.RemoveXAttr.File.FileFields.MountID = uint32(v)
+		e.Rmdir.File.FileFields.Group = str
 
 		return nil
 
-	case "removexattr.file.name":
+	case "rmdir.file.in_upper_layer":
 
 		var ok bool
-		str, ok := value.(string)
+		if e.Rmdir.File.FileFields.InUpperLayer, ok = value.(bool); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Rmdir.File.FileFields.InUpperLayer"}
+		}
+		return nil
+
+	case "rmdir.file.inode":
+
+		var ok bool
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.File.BasenameStr"}
+			return &eval.ErrValueTypeMismatch{Field: "Rmdir.File.FileFields.Inode"}
 		}
-		e.RemoveXAttr.File.BasenameStr = str
+		e.Rmdir.File.FileFields.Inode = uint64(v)
 
 		return nil
 
-	case "removexattr.file.path":
+	case "rmdir.file.mode":
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.File.PathnameStr"}
+			return &eval.ErrValueTypeMismatch{Field: "Rmdir.File.FileFields.Mode"}
 		}
-		e.RemoveXAttr.File.PathnameStr = str
+		e.Rmdir.File.FileFields.Mode = uint16(v)
 
 		return nil
 
-	case "removexattr.file.rights":
+	case "rmdir.file.modification_time":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.File.FileFields.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "Rmdir.File.FileFields.MTime"}
 		}
-		e.RemoveXAttr.File.FileFields.Mode = uint16(v)
+		e.Rmdir.File.FileFields.MTime = uint64(v)
 
 		return nil
 
-	case "removexattr.file.uid":
+	case "rmdir.file.mount_id":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.File.FileFields.UID"}
+			return &eval.ErrValueTypeMismatch{Field: "Rmdir.File.FileFields.MountID"}
 		}
-		e.RemoveXAttr.File.FileFields.UID = uint32(v)
+		e.Rmdir.File.FileFields.MountID = uint32(v)
 
 		return nil
 
-	case "removexattr.file.user":
+	case "rmdir.file.name":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.File.FileFields.User"}
+			return &eval.ErrValueTypeMismatch{Field: "Rmdir.File.BasenameStr"}
 		}
-		e.RemoveXAttr.File.FileFields.User = str
+		e.Rmdir.File.BasenameStr = str
 
 		return nil
 
-	case "removexattr.retval":
+	case "rmdir.file.path":
 
 		var ok bool
-		v, ok := value.(int)
+		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "RemoveXAttr.SyscallEvent.Retval"}
+			return &eval.ErrValueTypeMismatch{Field: "Rmdir.File.PathnameStr"}
 		}
-		e.RemoveXAttr.SyscallEvent.Retval = int64(v)
+		e.Rmdir.File.PathnameStr = str
 
 		return nil
 
-	case "rename.file.change_time":
+	case "rmdir.file.rights":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.FileFields.CTime"}
+			return &eval.ErrValueTypeMismatch{Field: "Rmdir.File.FileFields.Mode"}
 		}
-		e.Rename.Old.FileFields.CTime = uint64(v)
+		e.Rmdir.File.FileFields.Mode = uint16(v)
 
 		return nil
 
-	case "rename.file.destination.change_time":
+	case "rmdir.file.uid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.New.FileFields.CTime"}
+			return &eval.ErrValueTypeMismatch{Field: "Rmdir.File.FileFields.UID"}
 		}
-		e.Rename.New.FileFields.CTime = uint64(v)
+		e.Rmdir.File.FileFields.UID = uint32(v)
 
 		return nil
 
-	case "rename.file.destination.filesystem":
+	case "rmdir.file.user":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.New.Filesytem"}
+			return &eval.ErrValueTypeMismatch{Field: "Rmdir.File.FileFields.User"}
 		}
-		e.Rename.New.Filesytem = str
+		e.Rmdir.File.FileFields.User = str
 
 		return nil
 
-	case "rename.file.destination.gid":
+	case "rmdir.retval":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.New.FileFields.GID"}
+			return &eval.ErrValueTypeMismatch{Field: "Rmdir.SyscallEvent.Retval"}
 		}
-		e.Rename.New.FileFields.GID = uint32(v)
+		e.Rmdir.SyscallEvent.Retval = int64(v)
 
 		return nil
 
-	case "rename.file.destination.group":
+	case "selinux.bool.name":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.New.FileFields.Group"}
+			return &eval.ErrValueTypeMismatch{Field: "SELinux.BoolName"}
 		}
-		e.Rename.New.FileFields.Group = str
+		e.SELinux.BoolName = str
 
 		return nil
 
-	case "rename.file.destination.in_upper_layer":
+	case "selinux.bool.state":
 
 		var ok bool
-		if e.Rename.New.FileFields.InUpperLayer, ok = value.(bool); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.New.FileFields.InUpperLayer"}
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "SELinux.BoolChangeValue"}
 		}
+		e.SELinux.BoolChangeValue = str
+
 		return nil
 
-	case "rename.file.destination.inode":
+	case "selinux.bool_commit.state":
 
 		var ok bool
-		v, ok := value.(int)
-		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.New.FileFields.Inode"}
+		if e.SELinux.BoolCommitValue, ok = value.(bool); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "SELinux.BoolCommitValue"}
 		}
-		e.Rename.New.FileFields.Inode = uint64(v)
-
 		return nil
 
-	case "rename.file.destination.mode":
+	case "selinux.enforce.status":
 
 		var ok bool
-		v, ok := value.(int)
+		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.New.FileFields.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "SELinux.EnforceStatus"}
 		}
-		e.Rename.New.FileFields.Mode = uint16(v)
+		e.SELinux.EnforceStatus = str
 
 		return nil
 
-	case "rename.file.destination.modification_time":
+	case "setgid.egid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.New.FileFields.MTime"}
+			return &eval.ErrValueTypeMismatch{Field: "SetGID.EGID"}
 		}
-		e.Rename.New.FileFields.MTime = uint64(v)
+		e.SetGID.EGID = uint32(v)
 
 		return nil
 
-	case "rename.file.destination.mount_id":
+	case "setgid.egroup":
 
 		var ok bool
-		v, ok := value.(int)
+		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.New.FileFields.MountID"}
+			return &eval.ErrValueTypeMismatch{Field: "SetGID.EGroup"}
 		}
-		e.Rename.New.FileFields.MountID = uint32(v)
+		e.SetGID.EGroup = str
 
 		return nil
 
-	case "rename.file.destination.name":
+	case "setgid.fsgid":
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.New.BasenameStr"}
+			return &eval.ErrValueTypeMismatch{Field: "SetGID.FSGID"}
 		}
-		e.Rename.New.BasenameStr = str
+		e.SetGID.FSGID = uint32(v)
 
 		return nil
 
-	case "rename.file.destination.path":
+	case "setgid.fsgroup":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.New.PathnameStr"}
+			return &eval.ErrValueTypeMismatch{Field: "SetGID.FSGroup"}
 		}
-		e.Rename.New.PathnameStr = str
+		e.SetGID.FSGroup = str
 
 		return nil
 
-	case "rename.file.destination.rights":
+	case "setgid.gid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.New.FileFields.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "SetGID.GID"}
 		}
-		e.Rename.New.FileFields.Mode = uint16(v)
+		e.SetGID.GID = uint32(v)
 
 		return nil
 
-	case "rename.file.destination.uid":
+	case "setgid.group":
 
 		var ok bool
-		v, ok := value.(int)
+		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.New.FileFields.UID"}
+			return &eval.ErrValueTypeMismatch{Field: "SetGID.Group"}
 		}
-		e.Rename.New.FileFields.UID = uint32(v)
+		e.SetGID.Group = str
 
 		return nil
 
-	case "rename.file.destination.user":
+	case "setuid.euid":
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.New.FileFields.User"}
+			return &eval.ErrValueTypeMismatch{Field: "SetUID.EUID"}
 		}
-		e.Rename.New.FileFields.User = str
+		e.SetUID.EUID = uint32(v)
 
 		return nil
 
-	case "rename.file.filesystem":
+	case "setuid.euser":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.Filesytem"}
+			return &eval.ErrValueTypeMismatch{Field: "SetUID.EUser"}
 		}
-		e.Rename.Old.Filesytem = str
+		e.SetUID.EUser = str
 
 		return nil
 
-	case "rename.file.gid":
+	case "setuid.fsuid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.FileFields.GID"}
+			return &eval.ErrValueTypeMismatch{Field: "SetUID.FSUID"}
 		}
-		e.Rename.Old.FileFields.GID = uint32(v)
+		e.SetUID.FSUID = uint32(v)
 
 		return nil
 
-	case "rename.file.group":
+	case "setuid.fsuser":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.FileFields.Group"}
+			return &eval.ErrValueTypeMismatch{Field: "SetUID.FSUser"}
 		}
-		e.Rename.Old.FileFields.Group = str
-
-		return nil
-
-	case "rename.file.in_upper_layer":
+		e.SetUID.FSUser = str
 
-		var ok bool
-		if e.Rename.Old.FileFields.InUpperLayer, ok = value.(bool); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.FileFields.InUpperLayer"}
-		}
 		return nil
 
-	case "rename.file.inode":
+	case "setuid.uid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.FileFields.Inode"}
+			return &eval.ErrValueTypeMismatch{Field: "SetUID.UID"}
 		}
-		e.Rename.Old.FileFields.Inode = uint64(v)
+		e.SetUID.UID = uint32(v)
 
 		return nil
 
-	case "rename.file.mode":
+	case "setuid.user":
 
 		var ok bool
-		v, ok := value.(int)
+		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.FileFields.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "SetUID.User"}
 		}
-		e.Rename.Old.FileFields.Mode = uint16(v)
+		e.SetUID.User = str
 
 		return nil
 
-	case "rename.file.modification_time":
+	case "setxattr.file.change_time":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.FileFields.MTime"}
+			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.File.FileFields.CTime"}
 		}
-		e.Rename.Old.FileFields.MTime = uint64(v)
+		e.SetXAttr.File.FileFields.CTime = uint64(v)
 
 		return nil
 
-	case "rename.file.mount_id":
+	case "setxattr.file.destination.name":
 
 		var ok bool
-		v, ok := value.(int)
+		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.FileFields.MountID"}
+			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.Name"}
 		}
-		e.Rename.Old.FileFields.MountID = uint32(v)
+		e.SetXAttr.Name = str
 
 		return nil
 
-	case "rename.file.name":
+	case "setxattr.file.destination.namespace":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.BasenameStr"}
+			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.Namespace"}
 		}
-		e.Rename.Old.BasenameStr = str
+		e.SetXAttr.Namespace = str
 
 		return nil
 
-	case "rename.file.path":
+	case "setxattr.file.filesystem":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.PathnameStr"}
+			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.File.Filesytem"}
 		}
-		e.Rename.Old.PathnameStr = str
+		e.SetXAttr.File.Filesytem = str
 
 		return nil
 
-	case "rename.file.rights":
+	case "setxattr.file.gid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.FileFields.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.File.FileFields.GID"}
 		}
-		e.Rename.Old.FileFields.Mode = uint16(v)
+		e.SetXAttr.File.FileFields.GID = uint32(v)
 
 		return nil
 
-	case "rename.file.uid":
+	case "setxattr.file.group":
 
 		var ok bool
-		v, ok := value.(int)
+		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.FileFields.UID"}
+			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.File.FileFields.Group"}
 		}
-		e.Rename.Old.FileFields.UID = uint32(v)
+		e.SetXAttr.File.FileFields.Group = str
 
 		return nil
 
-	case "rename.file.user":
+	case "setxattr.file.in_upper_layer":
 
 		var ok bool
-		str, ok := value.(string)
-		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.Old.FileFields.User"}
+		if e.SetXAttr.File.FileFields.InUpperLayer, ok = value.(bool); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.File.FileFields.InUpperLayer"}
 		}
-		e.Rename.Old.FileFields.User = str
-
 		return nil
 
-	case "rename.retval":
+	case "setxattr.file.inode":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rename.SyscallEvent.Retval"}
+			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.File.FileFields.Inode"}
 		}
-		e.Rename.SyscallEvent.Retval = int64(v)
+		e.SetXAttr.File.FileFields.Inode = uint64(v)
 
 		return nil
 
-	case "rmdir.file.change_time":
+	case "setxattr.file.mode":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rmdir.File.FileFields.CTime"}
+			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.File.FileFields.Mode"}
 		}
-		e.Rmdir.File.FileFields.CTime = uint64(v)
+		e.SetXAttr.File.FileFields.Mode = uint16(v)
 
 		return nil
 
-	case "rmdir.file.filesystem":
+	case "setxattr.file.modification_time":
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rmdir.File.Filesytem"}
+			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.File.FileFields.MTime"}
 		}
-		e.Rmdir.File.Filesytem = str
+		e.SetXAttr.File.FileFields.MTime = uint64(v)
 
 		return nil
 
-	case "rmdir.file.gid":
+	case "setxattr.file.mount_id":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rmdir.File.FileFields.GID"}
+			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.File.FileFields.MountID"}
 		}
-		e.Rmdir.File.FileFields.GID = uint32(v)
+		e.SetXAttr.File.FileFields.MountID = uint32(v)
 
 		return nil
 
-	case "rmdir.file.group":
+	case "setxattr.file.name":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rmdir.File.FileFields.Group"}
+			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.File.BasenameStr"}
 		}
-		e.Rmdir.File.FileFields.Group = str
+		e.SetXAttr.File.BasenameStr = str
 
 		return nil
 
-	case "rmdir.file.in_upper_layer":
+	case "setxattr.file.path":
 
 		var ok bool
-		if e.Rmdir.File.FileFields.InUpperLayer, ok = value.(bool); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rmdir.File.FileFields.InUpperLayer"}
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.File.PathnameStr"}
 		}
+		e.SetXAttr.File.PathnameStr = str
+
 		return nil
 
-	case "rmdir.file.inode":
+	case "setxattr.file.rights":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rmdir.File.FileFields.Inode"}
+			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.File.FileFields.Mode"}
 		}
-		e.Rmdir.File.FileFields.Inode = uint64(v)
+		e.SetXAttr.File.FileFields.Mode = uint16(v)
 
 		return nil
 
-	case "rmdir.file.mode":
+	case "setxattr.file.uid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rmdir.File.FileFields.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.File.FileFields.UID"}
 		}
-		e.Rmdir.File.FileFields.Mode = uint16(v)
+		e.SetXAttr.File.FileFields.UID = uint32(v)
 
 		return nil
 
-	case "rmdir.file.modification_time":
+	case "setxattr.file.user":
 
 		var ok bool
-		v, ok := value.(int)
+		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rmdir.File.FileFields.MTime"}
+			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.File.FileFields.User"}
 		}
-		e.Rmdir.File.FileFields.MTime = uint64(v)
+		e.SetXAttr.File.FileFields.User = str
 
 		return nil
 
-	case "rmdir.file.mount_id":
+	case "setxattr.retval":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rmdir.File.FileFields.MountID"}
+			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.SyscallEvent.Retval"}
 		}
-		e.Rmdir.File.FileFields.MountID = uint32(v)
+		e.SetXAttr.SyscallEvent.Retval = int64(v)
 
 		return nil
 
-	case "rmdir.file.name":
+	case "signal.pid":
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rmdir.File.BasenameStr"}
+			return &eval.ErrValueTypeMismatch{Field: "Signal.PID"}
 		}
-		e.Rmdir.File.BasenameStr = str
+		e.Signal.PID = uint32(v)
 
 		return nil
 
-	case "rmdir.file.path":
+	case "signal.retval":
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rmdir.File.PathnameStr"}
+			return &eval.ErrValueTypeMismatch{Field: "Signal.SyscallEvent.Retval"}
 		}
-		e.Rmdir.File.PathnameStr = str
+		e.Signal.SyscallEvent.Retval = int64(v)
 
 		return nil
 
-	case "rmdir.file.rights":
+	case "signal.target.cap_effective":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rmdir.File.FileFields.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Credentials.CapEffective"}
 		}
-		e.Rmdir.File.FileFields.Mode = uint16(v)
+		e.Signal.Target.Credentials.CapEffective = uint64(v)
 
 		return nil
 
-	case "rmdir.file.uid":
+	case "signal.target.cap_permitted":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rmdir.File.FileFields.UID"}
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Credentials.CapPermitted"}
 		}
-		e.Rmdir.File.FileFields.UID = uint32(v)
+		e.Signal.Target.Credentials.CapPermitted = uint64(v)
 
 		return nil
 
-	case "rmdir.file.user":
+	case "signal.target.comm":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rmdir.File.FileFields.User"}
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Comm"}
 		}
-		e.Rmdir.File.FileFields.User = str
+		e.Signal.Target.Comm = str
 
 		return nil
 
-	case "rmdir.retval":
+	case "signal.target.container.id":
 
 		var ok bool
-		v, ok := value.(int)
+		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "Rmdir.SyscallEvent.Retval"}
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.ContainerID"}
 		}
-		e.Rmdir.SyscallEvent.Retval = int64(v)
+		e.Signal.Target.ContainerID = str
 
 		return nil
 
-	case "selinux.bool.name":
+	case "signal.target.cookie":
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SELinux.BoolName"}
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Cookie"}
 		}
-		e.SELinux.BoolName = str
+		e.Signal.Target.Cookie = uint32(v)
 
 		return nil
 
-	case "selinux.bool.state":
+	case "signal.target.created_at":
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SELinux.BoolChangeValue"}
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.CreatedAt"}
 		}
-		e.SELinux.BoolChangeValue = str
+		e.Signal.Target.CreatedAt = uint64(v)
 
 		return nil
 
-	case "selinux.bool_commit.state":
+	case "signal.target.egid":
 
 		var ok bool
-		if e.SELinux.BoolCommitValue, ok = value.(bool); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SELinux.BoolCommitValue"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Credentials.EGID"}
 		}
+		e.Signal.Target.Credentials.EGID = uint32(v)
+
 		return nil
 
-	case "selinux.enforce.status":
+	case "signal.target.egroup":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SELinux.EnforceStatus"}
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Credentials.EGroup"}
 		}
-		e.SELinux.EnforceStatus = str
+		e.Signal.Target.Credentials.EGroup = str
 
 		return nil
 
-	case "setgid.egid":
+	case "signal.target.euid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SetGID.EGID"}
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Credentials.EUID"}
 		}
-		e.SetGID.EGID = uint32(v)
+		e.Signal.Target.Credentials.EUID = uint32(v)
 
 		return nil
 
-	case "setgid.egroup":
+	case "signal.target.euser":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SetGID.EGroup"}
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Credentials.EUser"}
 		}
-		e.SetGID.EGroup = str
+		e.Signal.Target.Credentials.EUser = str
 
 		return nil
 
-	case "setgid.fsgid":
+	case "signal.target.file.change_time":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SetGID.FSGID"}
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.FileFields.CTime"}
 		}
-		e.SetGID.FSGID = uint32(v)
+		e.Signal.Target.FileFields.CTime = uint64(v)
 
 		return nil
 
-	case "setgid.fsgroup":
+	case "signal.target.file.filesystem":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SetGID.FSGroup"}
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Filesystem"}
 		}
-		e.SetGID.FSGroup = str
+		e.Signal.Target.Filesystem = str
 
 		return nil
 
-	case "setgid.gid":
+	case "signal.target.file.gid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SetGID.GID"}
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.FileFields.GID"}
 		}
-		e.SetGID.GID = uint32(v)
+		e.Signal.Target.FileFields.GID = uint32(v)
 
 		return nil
 
-	case "setgid.group":
+	case "signal.target.file.group":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SetGID.Group"}
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.FileFields.Group"}
 		}
-		e.SetGID.Group = str
+		e.Signal.Target.FileFields.Group = str
 
 		return nil
 
-	case "setuid.euid":
+	case "signal.target.file.in_upper_layer":
 
 		var ok bool
-		v, ok := value.(int)
-		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SetUID.EUID"}
+		if e.Signal.Target.FileFields.InUpperLayer, ok = value.(bool); !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.FileFields.InUpperLayer"}
 		}
-		e.SetUID.EUID = uint32(v)
-
 		return nil
 
-	case "setuid.euser":
+	case "signal.target.file.inode":
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SetUID.EUser"}
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.FileFields.Inode"}
 		}
-		e.SetUID.EUser = str
+		e.Signal.Target.FileFields.Inode = uint64(v)
 
 		return nil
 
-	case "setuid.fsuid":
+	case "signal.target.file.mode":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SetUID.FSUID"}
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.FileFields.Mode"}
 		}
-		e.SetUID.FSUID = uint32(v)
+		e.Signal.Target.FileFields.Mode = uint16(v)
 
 		return nil
 
-	case "setuid.fsuser":
+	case "signal.target.file.modification_time":
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SetUID.FSUser"}
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.FileFields.MTime"}
 		}
-		e.SetUID.FSUser = str
+		e.Signal.Target.FileFields.MTime = uint64(v)
 
 		return nil
 
-	case "setuid.uid":
+	case "signal.target.file.mount_id":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SetUID.UID"}
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.FileFields.MountID"}
 		}
-		e.SetUID.UID = uint32(v)
+		e.Signal.Target.FileFields.MountID = uint32(v)
 
 		return nil
 
-	case "setuid.user":
+	case "signal.target.file.name":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SetUID.User"}
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.BasenameStr"}
 		}
-		e.SetUID.User = str
+		e.Signal.Target.BasenameStr = str
 
 		return nil
 
-	case "setxattr.file.change_time":
+	case "signal.target.file.path":
 
 		var ok bool
-		v, ok := value.(int)
+		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.File.FileFields.CTime"}
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.PathnameStr"}
 		}
-		e.SetXAttr.File.FileFields.CTime = uint64(v)
+		e.Signal.Target.PathnameStr = str
 
 		return nil
 
-	case "setxattr.file.destination.name":
+	case "signal.target.file.rights":
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.Name"}
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.FileFields.Mode"}
 		}
-		e.SetXAttr.Name = str
+		e.Signal.Target.FileFields.Mode = uint16(v)
 
 		return nil
 
-	case "setxattr.file.destination.namespace":
+	case "signal.target.file.uid":
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.Namespace"}
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.FileFields.UID"}
 		}
-		e.SetXAttr.Namespace = str
+		e.Signal.Target.FileFields.UID = uint32(v)
 
 		return nil
 
-	case "setxattr.file.filesystem":
+	case "signal.target.file.user":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.File.Filesytem"}
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.FileFields.User"}
 		}
-		e.SetXAttr.File.Filesytem = str
+		e.Signal.Target.FileFields.User = str
 
 		return nil
 
-	case "setxattr.file.gid":
+	case "signal.target.fsgid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.File.FileFields.GID"}
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Credentials.FSGID"}
 		}
-		e.SetXAttr.File.FileFields.GID = uint32(v)
+		e.Signal.Target.Credentials.FSGID = uint32(v)
 
 		return nil
 
-	case "setxattr.file.group":
+	case "signal.target.fsgroup":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.File.FileFields.Group"}
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Credentials.FSGroup"}
 		}
-		e.SetXAttr.File.FileFields.Group = str
+		e.Signal.Target.Credentials.FSGroup = str
 
 		return nil
 
-	case "setxattr.file.in_upper_layer":
+	case "signal.target.fsuid":
 
 		var ok bool
-		if e.SetXAttr.File.FileFields.InUpperLayer, ok = value.(bool); !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.File.FileFields.InUpperLayer"}
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Credentials.FSUID"}
 		}
+		e.Signal.Target.Credentials.FSUID = uint32(v)
+
 		return nil
 
-	case "setxattr.file.inode":
+	case "signal.target.fsuser":
 
 		var ok bool
-		v, ok := value.(int)
+		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.File.FileFields.Inode"}
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Credentials.FSUser"}
 		}
-		e.SetXAttr.File.FileFields.Inode = uint64(v)
+		e.Signal.Target.Credentials.FSUser = str
 
 		return nil
 
-	case "setxattr.file.mode":
+	case "signal.target.gid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.File.FileFields.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Credentials.GID"}
 		}
-		e.SetXAttr.File.FileFields.Mode = uint16(v)
+		e.Signal.Target.Credentials.GID = uint32(v)
 
 		return nil
 
-	case "setxattr.file.modification_time":
+	case "signal.target.group":
 
 		var ok bool
-		v, ok := value.(int)
+		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.File.FileFields.MTime"}
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Credentials.Group"}
 		}
-		e.SetXAttr.File.FileFields.MTime = uint64(v)
+		e.Signal.Target.Credentials.Group = str
 
 		return nil
 
-	case "setxattr.file.mount_id":
+	case "signal.target.pid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.File.FileFields.MountID"}
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Pid"}
 		}
-		e.SetXAttr.File.FileFields.MountID = uint32(v)
+		e.Signal.Target.Pid = uint32(v)
 
 		return nil
 
-	case "setxattr.file.name":
+	case "signal.target.ppid":
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.File.BasenameStr"}
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.PPid"}
 		}
-		e.SetXAttr.File.BasenameStr = str
+		e.Signal.Target.PPid = uint32(v)
 
 		return nil
 
-	case "setxattr.file.path":
+	case "signal.target.tid":
 
 		var ok bool
-		str, ok := value.(string)
+		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.File.PathnameStr"}
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Tid"}
 		}
-		e.SetXAttr.File.PathnameStr = str
+		e.Signal.Target.Tid = uint32(v)
 
 		return nil
 
-	case "setxattr.file.rights":
+	case "signal.target.tty_name":
 
 		var ok bool
-		v, ok := value.(int)
+		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.File.FileFields.Mode"}
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.TTYName"}
 		}
-		e.SetXAttr.File.FileFields.Mode = uint16(v)
+		e.Signal.Target.TTYName = str
 
 		return nil
 
-	case "setxattr.file.uid":
+	case "signal.target.uid":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.File.FileFields.UID"}
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Credentials.UID"}
 		}
-		e.SetXAttr.File.FileFields.UID = uint32(v)
+		e.Signal.Target.Credentials.UID = uint32(v)
 
 		return nil
 
-	case "setxattr.file.user":
+	case "signal.target.user":
 
 		var ok bool
 		str, ok := value.(string)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.File.FileFields.User"}
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Target.Credentials.User"}
 		}
-		e.SetXAttr.File.FileFields.User = str
+		e.Signal.Target.Credentials.User = str
 
 		return nil
 
-	case "setxattr.retval":
+	case "signal.type":
 
 		var ok bool
 		v, ok := value.(int)
 		if !ok {
-			return &eval.ErrValueTypeMismatch{Field: "SetXAttr.SyscallEvent.Retval"}
+			return &eval.ErrValueTypeMismatch{Field: "Signal.Type"}
 		}
-		e.SetXAttr.SyscallEvent.Retval = int64(v)
+		e.Signal.Type = uint32(v)
 
 		return nil
 
@@ -13239,6 +17781,28 @@ func (e *Event) SetFieldValue(field eval.Field, value interface{}) error {
 
 		return nil
 
+	case "unload_module.name":
+
+		var ok bool
+		str, ok := value.(string)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "UnloadModule.Name"}
+		}
+		e.UnloadModule.Name = str
+
+		return nil
+
+	case "unload_module.retval":
+
+		var ok bool
+		v, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "UnloadModule.SyscallEvent.Retval"}
+		}
+		e.UnloadModule.SyscallEvent.Retval = int64(v)
+
+		return nil
+
 	case "utimes.file.change_time":
 
 		var ok bool
@@ -0,0 +1,445 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// +build linux
+
+package probe
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+)
+
+// activityDumpEventTypes is the list of the event types recorded by the activity dumps
+var activityDumpEventTypes = []model.EventType{
+	model.ExecEventType,
+	model.FileOpenEventType,
+	model.FileMkdirEventType,
+	model.FileLinkEventType,
+	model.FileRenameEventType,
+	model.FileUnlinkEventType,
+	model.FileRmdirEventType,
+	model.FileChmodEventType,
+	model.FileChownEventType,
+	model.FileUtimesEventType,
+	model.FileSetXAttrEventType,
+	model.FileRemoveXAttrEventType,
+}
+
+func isActivityDumpEventType(eventType model.EventType) bool {
+	for _, et := range activityDumpEventTypes {
+		if et == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// getActivityDumpEventFile returns the file of the events recorded by the activity dumps
+func getActivityDumpEventFile(event *Event) *model.FileEvent {
+	switch event.GetEventType() {
+	case model.FileOpenEventType:
+		return &event.Open.File
+	case model.FileMkdirEventType:
+		return &event.Mkdir.File
+	case model.FileLinkEventType:
+		return &event.Link.Source
+	case model.FileRenameEventType:
+		return &event.Rename.Old
+	case model.FileUnlinkEventType:
+		return &event.Unlink.File
+	case model.FileRmdirEventType:
+		return &event.Rmdir.File
+	case model.FileChmodEventType:
+		return &event.Chmod.File
+	case model.FileChownEventType:
+		return &event.Chown.File
+	case model.FileUtimesEventType:
+		return &event.Utimes.File
+	case model.FileSetXAttrEventType:
+		return &event.SetXAttr.File
+	case model.FileRemoveXAttrEventType:
+		return &event.RemoveXAttr.File
+	}
+	return nil
+}
+
+// processLineage returns the paths of the executables of a process and of its ancestors running in the same
+// container, from the oldest ancestor to the process itself. Forks sharing the executable of their parent are merged.
+func processLineage(entry *model.ProcessCacheEntry) []string {
+	var lineage []string
+
+	for ancestor := entry; ancestor != nil && ancestor.ContainerID == entry.ContainerID; ancestor = ancestor.Ancestor {
+		if ancestor.PathnameStr == "" {
+			continue
+		}
+		if len(lineage) > 0 && lineage[len(lineage)-1] == ancestor.PathnameStr {
+			continue
+		}
+		lineage = append(lineage, ancestor.PathnameStr)
+	}
+
+	for i, j := 0, len(lineage)-1; i < j; i, j = i+1, j-1 {
+		lineage[i], lineage[j] = lineage[j], lineage[i]
+	}
+
+	return lineage
+}
+
+// ActivityDumpSelector defines the workload traced by an activity dump, either a container or all the
+// containers of an image
+type ActivityDumpSelector struct {
+	ContainerID string `json:"container_id,omitempty"`
+	Image       string `json:"image,omitempty"`
+}
+
+// Validate checks that exactly one workload is selected
+func (s ActivityDumpSelector) Validate() error {
+	if (s.ContainerID == "") == (s.Image == "") {
+		return errors.New("either a container ID or an image is required")
+	}
+	return nil
+}
+
+// matches returns whether the container is selected, the image being resolved only when needed
+func (s ActivityDumpSelector) matches(containerID string, resolveImage func() string) bool {
+	if s.ContainerID != "" {
+		return s.ContainerID == containerID
+	}
+	return s.Image != "" && s.Image == resolveImage()
+}
+
+// String returns a short description of the selected workload
+func (s ActivityDumpSelector) String() string {
+	if s.ContainerID != "" {
+		return "container_id:" + s.ContainerID
+	}
+	return "image:" + s.Image
+}
+
+// expression returns the SECL expression matching the selected workload
+func (s ActivityDumpSelector) expression() string {
+	if s.ContainerID != "" {
+		return fmt.Sprintf("container.id == %q", s.ContainerID)
+	}
+	return fmt.Sprintf("container.tags == %q", "image_name:"+s.Image)
+}
+
+// FileActivityNode holds the events recorded on a file
+type FileActivityNode struct {
+	Events map[string]uint64 `json:"events"`
+}
+
+// ProcessActivityNode holds the activity recorded for an executable: the files it accessed, the count of its
+// events per type and the executables it started
+type ProcessActivityNode struct {
+	Path     string                       `json:"path"`
+	Files    map[string]*FileActivityNode `json:"files,omitempty"`
+	Syscalls map[string]uint64            `json:"syscalls,omitempty"`
+	Children []*ProcessActivityNode       `json:"children,omitempty"`
+}
+
+func getOrCreateProcessNode(nodes *[]*ProcessActivityNode, path string) *ProcessActivityNode {
+	for _, node := range *nodes {
+		if node.Path == path {
+			return node
+		}
+	}
+
+	node := &ProcessActivityNode{
+		Path:     path,
+		Files:    make(map[string]*FileActivityNode),
+		Syscalls: make(map[string]uint64),
+	}
+	*nodes = append(*nodes, node)
+
+	return node
+}
+
+func (n *ProcessActivityNode) addEvent(eventType string, filePath string) {
+	n.Syscalls[eventType]++
+
+	if filePath == "" {
+		return
+	}
+
+	file, exists := n.Files[filePath]
+	if !exists {
+		file = &FileActivityNode{Events: make(map[string]uint64)}
+		n.Files[filePath] = file
+	}
+	file.Events[eventType]++
+}
+
+// ActivityDump holds the activity recorded for a workload during a time window
+type ActivityDump struct {
+	Name     string                 `json:"name"`
+	Selector ActivityDumpSelector   `json:"selector"`
+	Start    time.Time              `json:"start"`
+	End      time.Time              `json:"end"`
+	Tree     []*ProcessActivityNode `json:"tree"`
+
+	Timeout        time.Duration `json:"-"`
+	OutputFilename string        `json:"-"`
+	PolicyFilename string        `json:"-"`
+}
+
+var nonRuleIDChars = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+
+// NewActivityDump returns a new activity dump for the provided workload
+func NewActivityDump(selector ActivityDumpSelector, timeout time.Duration, now time.Time) *ActivityDump {
+	// the name is used as a prefix of the IDs of the generated rules
+	name := selector.Image
+	if selector.ContainerID != "" {
+		name = selector.ContainerID
+		if len(name) > 12 {
+			name = name[:12]
+		}
+	}
+	name = strings.Trim(nonRuleIDChars.ReplaceAllString(name, "_"), "_")
+
+	return &ActivityDump{
+		Name:     fmt.Sprintf("activity_dump_%s_%d", name, now.Unix()),
+		Selector: selector,
+		Start:    now,
+		Timeout:  timeout,
+	}
+}
+
+// IsExpired returns whether the time window of the dump is over
+func (ad *ActivityDump) IsExpired(now time.Time) bool {
+	return now.After(ad.Start.Add(ad.Timeout))
+}
+
+// AddEvent records an event of the process whose lineage is provided
+func (ad *ActivityDump) AddEvent(lineage []string, eventType string, filePath string) {
+	if len(lineage) == 0 {
+		return
+	}
+
+	var node *ProcessActivityNode
+	nodes := &ad.Tree
+	for _, path := range lineage {
+		node = getOrCreateProcessNode(nodes, path)
+		nodes = &node.Children
+	}
+
+	node.addEvent(eventType, filePath)
+}
+
+// Export writes the profile of the dump and its generated policy in the output directory
+func (ad *ActivityDump) Export(outputDir string) error {
+	if err := os.MkdirAll(outputDir, 0750); err != nil {
+		return errors.Wrap(err, "couldn't create the activity dump directory")
+	}
+
+	profile, err := json.MarshalIndent(ad, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "couldn't encode the activity dump")
+	}
+
+	outputFilename := filepath.Join(outputDir, ad.Name+".json")
+	if err := ioutil.WriteFile(outputFilename, profile, 0640); err != nil {
+		return errors.Wrap(err, "couldn't write the activity dump")
+	}
+	ad.OutputFilename = outputFilename
+
+	policy, err := yaml.Marshal(ad.GeneratePolicy())
+	if err != nil {
+		return errors.Wrap(err, "couldn't encode the activity dump policy")
+	}
+
+	policyFilename := filepath.Join(outputDir, ad.Name+".policy")
+	if err := ioutil.WriteFile(policyFilename, policy, 0640); err != nil {
+		return errors.Wrap(err, "couldn't write the activity dump policy")
+	}
+	ad.PolicyFilename = policyFilename
+
+	return nil
+}
+
+// ActivityDumpPolicy is the SECL policy generated from an activity dump
+type ActivityDumpPolicy struct {
+	Rules []*ActivityDumpRule `yaml:"rules"`
+}
+
+// ActivityDumpRule is a rule of the policy generated from an activity dump
+type ActivityDumpRule struct {
+	ID          string `yaml:"id"`
+	Description string `yaml:"description"`
+	Expression  string `yaml:"expression"`
+}
+
+func quotedList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = fmt.Sprintf("%q", value)
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
+
+// GeneratePolicy returns a policy whose rules match the executions and the opened files of the workload that
+// weren't recorded by the dump
+func (ad *ActivityDump) GeneratePolicy() *ActivityDumpPolicy {
+	profile := NewActivityProfile(ad)
+	selector := ad.Selector.expression()
+
+	processes := profile.processPaths()
+	policy := &ActivityDumpPolicy{
+		Rules: []*ActivityDumpRule{
+			{
+				ID:          ad.Name + "_process",
+				Description: fmt.Sprintf("Process outside of the activity dump of %s", ad.Selector),
+				Expression:  fmt.Sprintf("exec.file.path not in %s && %s", quotedList(processes), selector),
+			},
+		},
+	}
+
+	for i, process := range processes {
+		files := profile.filePaths(process)
+		if len(files) == 0 {
+			continue
+		}
+
+		policy.Rules = append(policy.Rules, &ActivityDumpRule{
+			ID:          fmt.Sprintf("%s_files_%d", ad.Name, i),
+			Description: fmt.Sprintf("File opened by %s outside of the activity dump of %s", process, ad.Selector),
+			Expression:  fmt.Sprintf("open.file.path not in %s && process.file.path == %q && %s", quotedList(files), process, selector),
+		})
+	}
+
+	return policy
+}
+
+const (
+	// AnomalyUnknownProcess is the reason of the anomalies reported for an executable missing from a profile
+	AnomalyUnknownProcess = "unknown_process"
+	// AnomalyUnknownFile is the reason of the anomalies reported for a file missing from a profile
+	AnomalyUnknownFile = "unknown_file"
+
+	// maxReportedAnomalies is the number of anomalies remembered per profile to report them only once, the
+	// least recently seen ones being reported again once forgotten
+	maxReportedAnomalies = 1024
+)
+
+// ActivityProfile indexes the executables of an activity dump and the files they opened, in order to detect the
+// activity of a workload outside of its profile
+type ActivityProfile struct {
+	Name     string
+	Selector ActivityDumpSelector
+
+	processes map[string]map[string]bool
+	reported  *lru.Cache
+}
+
+// NewActivityProfile returns the profile of an activity dump
+func NewActivityProfile(ad *ActivityDump) *ActivityProfile {
+	// the creation of the cache only fails for a non-positive size
+	reported, _ := lru.New(maxReportedAnomalies)
+
+	profile := &ActivityProfile{
+		Name:      ad.Name,
+		Selector:  ad.Selector,
+		processes: make(map[string]map[string]bool),
+		reported:  reported,
+	}
+
+	var index func(nodes []*ProcessActivityNode)
+	index = func(nodes []*ProcessActivityNode) {
+		for _, node := range nodes {
+			files, exists := profile.processes[node.Path]
+			if !exists {
+				files = make(map[string]bool)
+				profile.processes[node.Path] = files
+			}
+
+			for path, file := range node.Files {
+				if file.Events[model.FileOpenEventType.String()] > 0 {
+					files[path] = true
+				}
+			}
+
+			index(node.Children)
+		}
+	}
+	index(ad.Tree)
+
+	return profile
+}
+
+// LoadActivityProfile loads the profile of an activity dump exported in a file
+func LoadActivityProfile(filename string) (*ActivityProfile, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var ad ActivityDump
+	if err := json.Unmarshal(data, &ad); err != nil {
+		return nil, errors.Wrapf(err, "couldn't decode the activity dump %s", filename)
+	}
+
+	if err := ad.Selector.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid selector in the activity dump %s", filename)
+	}
+
+	return NewActivityProfile(&ad), nil
+}
+
+func (p *ActivityProfile) processPaths() []string {
+	paths := make([]string, 0, len(p.processes))
+	for path := range p.processes {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func (p *ActivityProfile) filePaths(processPath string) []string {
+	var paths []string
+	for path := range p.processes[processPath] {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// Check returns the reason of the anomaly if the event falls outside of the profile. The opened files are only
+// checked for the executables of the profile, the execution of the others being reported already.
+func (p *ActivityProfile) Check(eventType model.EventType, processPath string, filePath string) string {
+	files, knownProcess := p.processes[processPath]
+
+	switch eventType {
+	case model.ExecEventType:
+		if !knownProcess {
+			return AnomalyUnknownProcess
+		}
+	case model.FileOpenEventType:
+		if knownProcess && filePath != "" && !files[filePath] {
+			return AnomalyUnknownFile
+		}
+	}
+
+	return ""
+}
+
+// shouldReport returns whether an anomaly wasn't reported yet for this profile. It is safe for concurrent use.
+func (p *ActivityProfile) shouldReport(reason string, processPath string, filePath string) bool {
+	found, _ := p.reported.ContainsOrAdd(reason+":"+processPath+":"+filePath, struct{}{})
+	return !found
+}
@@ -0,0 +1,275 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// +build linux
+
+package probe
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/DataDog/datadog-agent/pkg/security/config"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+// ActivityDumpManager records the activity dumps of the workloads and enforces the profiles loaded from the
+// profiles directory
+type ActivityDumpManager struct {
+	sync.RWMutex
+	probe          *Probe
+	config         *config.Config
+	dumps          []*ActivityDump
+	profiles       []*ActivityProfile
+	onDumpsChanged func()
+}
+
+// SetDumpsChangedHandler sets the function called when a dump is started or stopped, so that the event types
+// recorded by the dumps can be enabled or disabled
+func (adm *ActivityDumpManager) SetDumpsChangedHandler(cb func()) {
+	adm.onDumpsChanged = cb
+}
+
+func (adm *ActivityDumpManager) dumpsChanged() {
+	if adm.onDumpsChanged != nil {
+		adm.onDumpsChanged()
+	}
+}
+
+// Start stops the dumps once their time window is over
+func (adm *ActivityDumpManager) Start(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(10 * time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case now := <-ticker.C:
+				if adm.stopExpiredDumps(now) {
+					adm.dumpsChanged()
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (adm *ActivityDumpManager) stopExpiredDumps(now time.Time) bool {
+	adm.Lock()
+	defer adm.Unlock()
+
+	var dumps []*ActivityDump
+	for _, ad := range adm.dumps {
+		if !ad.IsExpired(now) {
+			dumps = append(dumps, ad)
+			continue
+		}
+
+		ad.End = now
+		if err := ad.Export(adm.config.ActivityDumpOutputDir); err != nil {
+			log.Errorf("failed to export the activity dump %s: %s", ad.Name, err)
+		} else {
+			log.Infof("activity dump %s written: %s", ad.Name, ad.OutputFilename)
+		}
+	}
+
+	stopped := len(dumps) != len(adm.dumps)
+	adm.dumps = dumps
+
+	return stopped
+}
+
+// StartDump starts recording the activity of a workload
+func (adm *ActivityDumpManager) StartDump(selector ActivityDumpSelector, timeout time.Duration) (*ActivityDump, error) {
+	if err := selector.Validate(); err != nil {
+		return nil, err
+	}
+
+	if timeout <= 0 {
+		timeout = adm.config.ActivityDumpDefaultTimeout
+	}
+
+	adm.Lock()
+	for _, ad := range adm.dumps {
+		if ad.Selector == selector {
+			adm.Unlock()
+			return nil, fmt.Errorf("an activity dump of %s is already running: %s", selector, ad.Name)
+		}
+	}
+
+	ad := NewActivityDump(selector, timeout, time.Now())
+	adm.dumps = append(adm.dumps, ad)
+	adm.Unlock()
+
+	adm.dumpsChanged()
+
+	return ad, nil
+}
+
+// StopDump stops an activity dump and exports it
+func (adm *ActivityDumpManager) StopDump(name string) (*ActivityDump, error) {
+	adm.Lock()
+
+	var stopped *ActivityDump
+	for i, ad := range adm.dumps {
+		if ad.Name == name {
+			stopped = ad
+			adm.dumps = append(adm.dumps[:i], adm.dumps[i+1:]...)
+			break
+		}
+	}
+	adm.Unlock()
+
+	if stopped == nil {
+		return nil, fmt.Errorf("activity dump %s not found", name)
+	}
+
+	adm.dumpsChanged()
+
+	stopped.End = time.Now()
+	if err := stopped.Export(adm.config.ActivityDumpOutputDir); err != nil {
+		return nil, err
+	}
+
+	return stopped, nil
+}
+
+// ListDumps returns the running activity dumps
+func (adm *ActivityDumpManager) ListDumps() []*ActivityDump {
+	adm.RLock()
+	defer adm.RUnlock()
+
+	dumps := make([]*ActivityDump, len(adm.dumps))
+	copy(dumps, adm.dumps)
+	return dumps
+}
+
+// LoadProfiles loads the profiles to enforce from the profiles directory
+func (adm *ActivityDumpManager) LoadProfiles() error {
+	var profiles []*ActivityProfile
+
+	if adm.config.ActivityDumpProfilesDir != "" {
+		filenames, err := filepath.Glob(filepath.Join(adm.config.ActivityDumpProfilesDir, "*.json"))
+		if err != nil {
+			return err
+		}
+
+		for _, filename := range filenames {
+			profile, err := LoadActivityProfile(filename)
+			if err != nil {
+				log.Errorf("failed to load the activity profile %s: %s", filename, err)
+				continue
+			}
+			profiles = append(profiles, profile)
+		}
+	}
+
+	adm.Lock()
+	adm.profiles = profiles
+	adm.Unlock()
+
+	return nil
+}
+
+// GetEventTypes returns the event types that have to be sent by the kernel for the running dumps and the
+// loaded profiles
+func (adm *ActivityDumpManager) GetEventTypes() []eval.EventType {
+	adm.RLock()
+	defer adm.RUnlock()
+
+	if len(adm.dumps) == 0 && len(adm.profiles) == 0 {
+		return nil
+	}
+
+	eventTypes := make([]eval.EventType, len(activityDumpEventTypes))
+	for i, eventType := range activityDumpEventTypes {
+		eventTypes[i] = eventType.String()
+	}
+	return eventTypes
+}
+
+// ProcessEvent records the event in the dumps of its workload and reports the anomalies against the profiles
+func (adm *ActivityDumpManager) ProcessEvent(event *Event) {
+	eventType := event.GetEventType()
+	if !isActivityDumpEventType(eventType) {
+		return
+	}
+
+	adm.RLock()
+	defer adm.RUnlock()
+
+	if len(adm.dumps) == 0 && len(adm.profiles) == 0 {
+		return
+	}
+
+	// only the containers are traced
+	containerID := event.ResolveContainerID(&event.ContainerContext)
+	if containerID == "" {
+		return
+	}
+
+	var image string
+	resolveImage := func() string {
+		if image == "" {
+			image = adm.probe.resolvers.TagsResolver.GetValue(containerID, "image_name")
+		}
+		return image
+	}
+
+	lineage := processLineage(event.ResolveProcessCacheEntry())
+	if len(lineage) == 0 {
+		return
+	}
+
+	var filePath string
+	if file := getActivityDumpEventFile(event); file != nil {
+		filePath = event.ResolveFilePath(file)
+	}
+
+	for _, ad := range adm.dumps {
+		if ad.Selector.matches(containerID, resolveImage) {
+			ad.AddEvent(lineage, eventType.String(), filePath)
+		}
+	}
+
+	processPath := lineage[len(lineage)-1]
+	for _, profile := range adm.profiles {
+		if !profile.Selector.matches(containerID, resolveImage) {
+			continue
+		}
+
+		reason := profile.Check(eventType, processPath, filePath)
+		if reason == "" || !profile.shouldReport(reason, processPath, filePath) {
+			continue
+		}
+
+		if adm.probe.handler != nil {
+			rule, customEvent := NewAnomalyDetectionEvent(event, profile.Name, reason)
+			adm.probe.handler.HandleCustomEvent(rule, customEvent)
+		}
+	}
+}
+
+// NewActivityDumpManager returns a new activity dump manager
+func NewActivityDumpManager(p *Probe) (*ActivityDumpManager, error) {
+	if p.config.ActivityDumpOutputDir == "" {
+		return nil, errors.New("the activity dump output directory is required")
+	}
+
+	return &ActivityDumpManager{
+		probe:  p,
+		config: p.config,
+	}, nil
+}
@@ -0,0 +1,146 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// +build linux
+
+package probe
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	seclog "github.com/DataDog/datadog-agent/pkg/security/log"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/rules"
+)
+
+func newTestActivityDump() *ActivityDump {
+	ad := NewActivityDump(ActivityDumpSelector{Image: "library/nginx"}, time.Minute, time.Unix(1000, 0))
+
+	ad.AddEvent([]string{"/usr/sbin/nginx"}, "exec", "")
+	ad.AddEvent([]string{"/usr/sbin/nginx"}, "open", "/etc/nginx/nginx.conf")
+	ad.AddEvent([]string{"/usr/sbin/nginx"}, "open", "/etc/nginx/nginx.conf")
+	ad.AddEvent([]string{"/usr/sbin/nginx", "/bin/sh"}, "exec", "")
+	ad.AddEvent([]string{"/usr/sbin/nginx", "/bin/sh"}, "chmod", "/tmp/cache")
+
+	return ad
+}
+
+func TestProcessLineage(t *testing.T) {
+	root := &model.ProcessCacheEntry{}
+	root.PathnameStr = "/usr/bin/containerd-shim"
+
+	nginx := &model.ProcessCacheEntry{}
+	nginx.PathnameStr = "/usr/sbin/nginx"
+	nginx.ContainerID = "abc"
+	nginx.Ancestor = root
+
+	worker := &model.ProcessCacheEntry{}
+	worker.PathnameStr = "/usr/sbin/nginx"
+	worker.ContainerID = "abc"
+	worker.Ancestor = nginx
+
+	sh := &model.ProcessCacheEntry{}
+	sh.PathnameStr = "/bin/sh"
+	sh.ContainerID = "abc"
+	sh.Ancestor = worker
+
+	assert.Equal(t, []string{"/usr/sbin/nginx", "/bin/sh"}, processLineage(sh))
+	assert.Equal(t, []string{"/usr/bin/containerd-shim"}, processLineage(root))
+}
+
+func TestActivityDumpTree(t *testing.T) {
+	ad := newTestActivityDump()
+
+	assert.Equal(t, "activity_dump_library_nginx_1000", ad.Name)
+	assert.Equal(t, 1, len(ad.Tree))
+
+	nginx := ad.Tree[0]
+	assert.Equal(t, "/usr/sbin/nginx", nginx.Path)
+	assert.Equal(t, uint64(2), nginx.Syscalls["open"])
+	assert.Equal(t, uint64(2), nginx.Files["/etc/nginx/nginx.conf"].Events["open"])
+
+	assert.Equal(t, 1, len(nginx.Children))
+	assert.Equal(t, "/bin/sh", nginx.Children[0].Path)
+	assert.Equal(t, uint64(1), nginx.Children[0].Files["/tmp/cache"].Events["chmod"])
+
+	assert.False(t, ad.IsExpired(time.Unix(1030, 0)))
+	assert.True(t, ad.IsExpired(time.Unix(1061, 0)))
+}
+
+func TestActivityDumpSelector(t *testing.T) {
+	assert.NotNil(t, ActivityDumpSelector{}.Validate())
+	assert.NotNil(t, ActivityDumpSelector{ContainerID: "abc", Image: "nginx"}.Validate())
+	assert.Nil(t, ActivityDumpSelector{Image: "nginx"}.Validate())
+
+	noImage := func() string { return "" }
+	nginx := func() string { return "nginx" }
+
+	assert.True(t, ActivityDumpSelector{ContainerID: "abc"}.matches("abc", noImage))
+	assert.False(t, ActivityDumpSelector{ContainerID: "abc"}.matches("def", nginx))
+	assert.True(t, ActivityDumpSelector{Image: "nginx"}.matches("def", nginx))
+	assert.False(t, ActivityDumpSelector{Image: "nginx"}.matches("def", noImage))
+}
+
+func TestActivityProfileCheck(t *testing.T) {
+	profile := NewActivityProfile(newTestActivityDump())
+
+	assert.Equal(t, "", profile.Check(model.ExecEventType, "/bin/sh", ""))
+	assert.Equal(t, AnomalyUnknownProcess, profile.Check(model.ExecEventType, "/usr/bin/curl", ""))
+
+	assert.Equal(t, "", profile.Check(model.FileOpenEventType, "/usr/sbin/nginx", "/etc/nginx/nginx.conf"))
+	assert.Equal(t, AnomalyUnknownFile, profile.Check(model.FileOpenEventType, "/usr/sbin/nginx", "/etc/shadow"))
+	// only the files opened are part of the profile
+	assert.Equal(t, AnomalyUnknownFile, profile.Check(model.FileOpenEventType, "/bin/sh", "/tmp/cache"))
+	// the files of the unknown processes aren't reported
+	assert.Equal(t, "", profile.Check(model.FileOpenEventType, "/usr/bin/curl", "/etc/shadow"))
+
+	assert.True(t, profile.shouldReport(AnomalyUnknownFile, "/usr/sbin/nginx", "/etc/shadow"))
+	assert.False(t, profile.shouldReport(AnomalyUnknownFile, "/usr/sbin/nginx", "/etc/shadow"))
+
+	// the anomalies reported are bounded, the oldest being reported again
+	for i := 0; i < maxReportedAnomalies; i++ {
+		assert.True(t, profile.shouldReport(AnomalyUnknownFile, "/usr/sbin/nginx", fmt.Sprintf("/tmp/%d", i)))
+	}
+	assert.Equal(t, maxReportedAnomalies, profile.reported.Len())
+	assert.True(t, profile.shouldReport(AnomalyUnknownFile, "/usr/sbin/nginx", "/etc/shadow"))
+}
+
+func TestActivityDumpExport(t *testing.T) {
+	dir, err := ioutil.TempDir("", "activity-dump")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ad := newTestActivityDump()
+	if err := ad.Export(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	profile, err := LoadActivityProfile(ad.OutputFilename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, ad.Name, profile.Name)
+	assert.Equal(t, ad.Selector, profile.Selector)
+	assert.Equal(t, []string{"/bin/sh", "/usr/sbin/nginx"}, profile.processPaths())
+
+	// the generated policy has to be a valid one
+	opts := rules.NewOptsWithParams(model.SECLConstants, SECLVariables, SupportedDiscarders, map[eval.EventType]bool{"*": true}, nil, model.SECLLegacyAttributes, &seclog.PatternLogger{})
+	rs := rules.NewRuleSet(&model.Model{}, (&model.Model{}).NewEvent, opts)
+	if err := rules.LoadPolicies(dir, rs); err.ErrorOrNil() != nil {
+		t.Fatal(err)
+	}
+
+	assert.ElementsMatch(t, []string{ad.Name + "_process", ad.Name + "_files_1"}, rs.ListRuleIDs())
+	assert.Equal(t, `open.file.path not in ["/etc/nginx/nginx.conf"] && process.file.path == "/usr/sbin/nginx" && container.tags == "image_name:library/nginx"`, rs.GetRules()[ad.Name+"_files_1"].Definition.Expression)
+}
@@ -111,6 +111,15 @@ func (rsa *RuleSetApplier) Apply(rs *rules.RuleSet, approvers map[eval.EventType
 		}
 	}
 
+	// the activity dumps need all the events of the event types they record, the approvers are then ignored
+	if rsa.probe != nil && rsa.config.EnableKernelFilters {
+		for _, eventType := range rsa.probe.getActivityDumpEventTypes() {
+			if err := rsa.applyFilterPolicy(eventType, PolicyModeAccept, math.MaxUint8); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	return rsa.reporter.GetReport(), nil
 }
 
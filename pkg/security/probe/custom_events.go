@@ -29,6 +29,8 @@ const (
 	NoisyProcessRuleID = "noisy_process"
 	// AbnormalPathRuleID is the rule ID for the abnormal_path events
 	AbnormalPathRuleID = "abnormal_path"
+	// AnomalyDetectionRuleID is the rule ID for the anomaly_detection events
+	AnomalyDetectionRuleID = "anomaly_detection"
 )
 
 // AllCustomRuleIDs returns the list of custom rule IDs
@@ -38,6 +40,7 @@ func AllCustomRuleIDs() []string {
 		RulesetLoadedRuleID,
 		NoisyProcessRuleID,
 		AbnormalPathRuleID,
+		AnomalyDetectionRuleID,
 	}
 }
 
@@ -323,3 +326,27 @@ func NewAbnormalPathEvent(event *Event, pathResolutionError error) (*rules.Rule,
 			PathResolutionError: pathResolutionError.Error(),
 		})
 }
+
+// AnomalyDetectionEvent is used to report an activity of a workload outside of its profile
+// easyjson:json
+type AnomalyDetectionEvent struct {
+	Timestamp time.Time        `json:"date"`
+	Profile   string           `json:"profile"`
+	Reason    string           `json:"reason"`
+	Event     *EventSerializer `json:"triggering_event"`
+}
+
+// NewAnomalyDetectionEvent returns the rule and a populated custom event for an anomaly_detection event
+func NewAnomalyDetectionEvent(event *Event, profile string, reason string) (*rules.Rule, *CustomEvent) {
+	customEvent := newCustomEvent(model.CustomAnomalyDetectionEventType, AnomalyDetectionEvent{
+		Timestamp: event.ResolveEventTimestamp(),
+		Profile:   profile,
+		Reason:    reason,
+		Event:     NewEventSerializer(event),
+	})
+	customEvent.tags = append(event.GetTags(), "profile:"+profile)
+
+	return newRule(&rules.RuleDefinition{
+		ID: AnomalyDetectionRuleID,
+	}), customEvent
+}
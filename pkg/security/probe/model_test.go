@@ -8,6 +8,7 @@
 package probe
 
 import (
+	"net"
 	"reflect"
 	"sort"
 	"testing"
@@ -37,6 +38,10 @@ func TestSetFieldValue(t *testing.T) {
 			if err = event.SetFieldValue(field, true); err != nil {
 				t.Error(err)
 			}
+		case reflect.Struct:
+			if err = event.SetFieldValue(field, net.IPNet{IP: net.IPv4(127, 0, 0, 1), Mask: net.CIDRMask(32, 32)}); err != nil {
+				t.Error(err)
+			}
 		default:
 			t.Errorf("type unknown: %v", kind)
 		}
@@ -0,0 +1,33 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// +build linux
+
+package probe
+
+import (
+	"github.com/DataDog/datadog-agent/pkg/security/ebpf/kernel"
+)
+
+func getSocketTypeOffset(probe *Probe) uint64 {
+	// offsetof(struct socket, type)
+	return uint64(4)
+}
+
+func getSocketSKOffset(probe *Probe) uint64 {
+	offset := uint64(24)
+
+	// the socket_wq pointer was moved after the sk field in 5.3
+	if probe.kernelVersion.Code != 0 && probe.kernelVersion.Code < kernel.Kernel5_3 {
+		offset = 32
+	}
+
+	return offset
+}
+
+func getSockCommonDPortOffset(probe *Probe) uint64 {
+	// offsetof(struct sock_common, skc_dport)
+	return uint64(12)
+}
@@ -75,6 +75,9 @@ type Probe struct {
 	approvers          map[eval.EventType]activeApprovers
 
 	inodeDiscardersCounters map[model.EventType]*int64
+
+	// Activity dumps section
+	activityDumpManager *ActivityDumpManager
 }
 
 // GetResolvers returns the resolvers of Probe
@@ -218,6 +221,10 @@ func (p *Probe) Start() error {
 		return err
 	}
 
+	if p.activityDumpManager != nil {
+		p.activityDumpManager.Start(p.ctx, &p.wg)
+	}
+
 	return p.monitor.Start(p.ctx, &p.wg)
 }
 
@@ -237,6 +244,10 @@ func (p *Probe) DispatchEvent(event *Event, size uint64, CPU int, perfMap *manag
 		p.handler.HandleEvent(event)
 	}
 
+	if p.activityDumpManager != nil {
+		p.activityDumpManager.ProcessEvent(event)
+	}
+
 	// Process after evaluation because some monitors need the DentryResolver to have been called first.
 	p.monitor.ProcessEvent(event, size, CPU, perfMap)
 }
@@ -279,6 +290,19 @@ func (p *Probe) GetMonitor() *Monitor {
 	return p.monitor
 }
 
+// GetActivityDumpManager returns the activity dump manager of the probe, nil if the activity dumps are disabled
+func (p *Probe) GetActivityDumpManager() *ActivityDumpManager {
+	return p.activityDumpManager
+}
+
+// getActivityDumpEventTypes returns the event types recorded by the running activity dumps and the loaded profiles
+func (p *Probe) getActivityDumpEventTypes() []eval.EventType {
+	if p.activityDumpManager == nil {
+		return nil
+	}
+	return p.activityDumpManager.GetEventTypes()
+}
+
 func (p *Probe) handleLostEvents(CPU int, count uint64, perfMap *manager.PerfMap, manager *manager.Manager) {
 	seclog.Tracef("lost %d events", count)
 	p.monitor.perfBufferMonitor.CountLostEvent(count, perfMap, CPU)
@@ -540,6 +564,65 @@ func (p *Probe) handleEvent(CPU uint64, data []byte) {
 			log.Errorf("failed to decode bpf event: %s (offset %d, len %d)", err, offset, len(data))
 			return
 		}
+	case model.ConnectEventType:
+		if _, err = event.Connect.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode connect event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case model.BindEventType:
+		if _, err = event.Bind.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode bind event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case model.AcceptEventType:
+		if _, err = event.Accept.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode accept event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case model.DNSEventType:
+		if _, err = event.DNS.UnmarshalBinary(data[offset:]); err != nil {
+			// malformatted or truncated requests aren't worth an error
+			log.Debugf("failed to decode DNS event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case model.PTraceEventType:
+		if _, err = event.PTrace.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode ptrace event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+		// resolve the tracee process context
+		if pce := p.resolveTargetProcess(event.PTrace.PID); pce != nil {
+			event.PTrace.Tracee = pce.Process
+		}
+	case model.MMapEventType:
+		if _, err = event.MMap.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode mmap event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case model.MProtectEventType:
+		if _, err = event.MProtect.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode mprotect event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case model.LoadModuleEventType:
+		if _, err = event.LoadModule.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode load_module event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case model.UnloadModuleEventType:
+		if _, err = event.UnloadModule.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode unload_module event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+	case model.SignalEventType:
+		if _, err = event.Signal.UnmarshalBinary(data[offset:]); err != nil {
+			log.Errorf("failed to decode signal event: %s (offset %d, len %d)", err, offset, len(data))
+			return
+		}
+		// resolve the target process context
+		if pce := p.resolveTargetProcess(event.Signal.PID); pce != nil {
+			event.Signal.Target = pce.Process
+		}
 	default:
 		log.Errorf("unsupported event type %d", eventType)
 		return
@@ -566,6 +649,15 @@ func (p *Probe) handleEvent(CPU uint64, data []byte) {
 	p.resolvers.ProcessResolver.DequeueExited()
 }
 
+// resolveTargetProcess returns the cache entry of the process targeted by a ptrace or a signal. Negative and null
+// pids designate process groups and aren't resolved.
+func (p *Probe) resolveTargetProcess(pid uint32) *model.ProcessCacheEntry {
+	if int32(pid) <= 0 {
+		return nil
+	}
+	return p.resolvers.ProcessResolver.Resolve(pid, pid)
+}
+
 // OnRuleMatch is called when a rule matches just before sending
 func (p *Probe) OnRuleMatch(rule *rules.Rule, event *Event) {
 	// ensure that all the fields are resolved before sending
@@ -584,6 +676,13 @@ func (p *Probe) OnNewDiscarder(rs *rules.RuleSet, event *Event, field eval.Field
 		return nil
 	}
 
+	// the activity dumps need all the events of the event types they record
+	for _, dumpEventType := range p.getActivityDumpEventTypes() {
+		if dumpEventType == eventType {
+			return nil
+		}
+	}
+
 	seclog.Tracef("New discarder of type %s for field %s", eventType, field)
 
 	if handler, ok := allDiscarderHandlers[eventType]; ok {
@@ -652,8 +751,15 @@ func (p *Probe) SetApprovers(eventType eval.EventType, approvers rules.Approvers
 func (p *Probe) SelectProbes(rs *rules.RuleSet) error {
 	var activatedProbes []manager.ProbesSelector
 
+	eventTypes := rs.GetEventTypes()
+	dumpEventTypes := make(map[eval.EventType]bool)
+	for _, eventType := range p.getActivityDumpEventTypes() {
+		dumpEventTypes[eventType] = true
+		eventTypes = append(eventTypes, eventType)
+	}
+
 	for eventType, selectors := range probes.SelectorsPerEventType {
-		if eventType == "*" || rs.HasRulesForEventType(eventType) {
+		if eventType == "*" || rs.HasRulesForEventType(eventType) || dumpEventTypes[eventType] {
 			activatedProbes = append(activatedProbes, selectors...)
 		}
 	}
@@ -686,7 +792,7 @@ func (p *Probe) SelectProbes(rs *rules.RuleSet) error {
 	}
 
 	enabledEvents := uint64(0)
-	for _, eventName := range rs.GetEventTypes() {
+	for _, eventName := range eventTypes {
 		if eventName != "*" {
 			eventType := model.ParseEvalEventType(eventName)
 			if eventType == model.UnknownEventType {
@@ -949,6 +1055,18 @@ func NewProbe(config *config.Config, client *statsd.Client) (*Probe, error) {
 			Name:  "check_helper_call_input",
 			Value: getCheckHelperCallInputType(p),
 		},
+		manager.ConstantEditor{
+			Name:  "socket_type_offset",
+			Value: getSocketTypeOffset(p),
+		},
+		manager.ConstantEditor{
+			Name:  "socket_sk_offset",
+			Value: getSocketSKOffset(p),
+		},
+		manager.ConstantEditor{
+			Name:  "sock_common_dport_offset",
+			Value: getSockCommonDPortOffset(p),
+		},
 	)
 	p.managerOptions.ConstantEditors = append(p.managerOptions.ConstantEditors, TTYConstants(p)...)
 	p.managerOptions.ConstantEditors = append(p.managerOptions.ConstantEditors, DiscarderConstants...)
@@ -1002,6 +1120,12 @@ func NewProbe(config *config.Config, client *statsd.Client) (*Probe, error) {
 
 	p.event = NewEvent(p.resolvers, p.scrubber)
 
+	if p.config.ActivityDumpEnabled {
+		if p.activityDumpManager, err = NewActivityDumpManager(p); err != nil {
+			return nil, err
+		}
+	}
+
 	eventZero.resolvers = p.resolvers
 	eventZero.scrubber = p.scrubber
 
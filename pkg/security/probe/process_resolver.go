@@ -734,6 +734,20 @@ func (p *ProcessResolver) Get(pid uint32) *model.ProcessCacheEntry {
 	return p.entryCache[pid]
 }
 
+// HasContainerProcess returns whether the cache holds a process of the given container,
+// other than the given pid
+func (p *ProcessResolver) HasContainerProcess(containerID string, exceptPid uint32) bool {
+	p.RLock()
+	defer p.RUnlock()
+
+	for pid, entry := range p.entryCache {
+		if pid != exceptPid && entry.ContainerID == containerID {
+			return true
+		}
+	}
+	return false
+}
+
 // UpdateUID updates the credentials of the provided pid
 func (p *ProcessResolver) UpdateUID(pid uint32, e *Event) {
 	if e.ProcessContext.Pid != e.ProcessContext.Tid {
@@ -0,0 +1,57 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// +build linux
+
+package probe
+
+import (
+	"github.com/DataDog/datadog-agent/pkg/security/ebpf/kernel"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/rules"
+	"github.com/DataDog/datadog-agent/pkg/version"
+)
+
+// KernelFilterFields returns the fields that the `filters` of the rules and macros can use
+// to describe the kernels they support
+func KernelFilterFields(kv *kernel.Version) map[eval.Field]interface{} {
+	return map[eval.Field]interface{}{
+		"kernel.version.major": int(kv.Code >> 16),
+		"kernel.version.minor": int(kv.Code >> 8 & 0xff),
+		"kernel.version.patch": int(kv.Code & 0xff),
+		"kernel.is_rh7":        kv.IsRH7Kernel(),
+		"kernel.is_rh8":        kv.IsRH8Kernel(),
+		"kernel.is_suse":       kv.IsSuseKernel(),
+		"kernel.is_sles12":     kv.IsSLES12Kernel(),
+		"kernel.is_sles15":     kv.IsSLES15Kernel(),
+		"kernel.is_oracle_uek": kv.IsOracleUEKKernel(),
+	}
+}
+
+// NewRuleFilters returns the filters of the rules and macros for the running agent and the
+// given kernel
+func NewRuleFilters(kv *kernel.Version) ([]rules.RuleFilter, error) {
+	agentVersion, err := version.Agent()
+	if err != nil {
+		return nil, err
+	}
+
+	versionFilter, err := rules.NewAgentVersionFilter(agentVersion.GetNumber())
+	if err != nil {
+		return nil, err
+	}
+
+	kernelFilter, err := rules.NewSECLRuleFilter(KernelFilterFields(kv))
+	if err != nil {
+		return nil, err
+	}
+
+	return []rules.RuleFilter{versionFilter, kernelFilter}, nil
+}
+
+// GetRuleFilters returns the filters of the rules and macros for the running agent and kernel
+func (p *Probe) GetRuleFilters() ([]rules.RuleFilter, error) {
+	return NewRuleFilters(p.kernelVersion)
+}
@@ -11,6 +11,7 @@
 package probe
 
 import (
+	"fmt"
 	"syscall"
 	"time"
 
@@ -23,6 +24,7 @@ const (
 	FIMCategory     = "File Activity"
 	ProcessActivity = "Process Activity"
 	KernelActivity  = "Kernel Activity"
+	NetworkActivity = "Network Activity"
 )
 
 // FileSerializer serializes a file to JSON
@@ -218,6 +220,79 @@ type BPFEventSerializer struct {
 	Program *BPFProgramSerializer `json:"program,omitempty" jsonschema_description:"BPF program"`
 }
 
+// IPPortSerializer serializes an IP address and a port to JSON
+// easyjson:json
+type IPPortSerializer struct {
+	IP   string `json:"ip" jsonschema_description:"IP address"`
+	Port uint16 `json:"port" jsonschema_description:"Port number"`
+}
+
+// NetworkEventSerializer serializes a connect, bind or accept event to JSON
+// easyjson:json
+type NetworkEventSerializer struct {
+	Addr   IPPortSerializer `json:"addr" jsonschema_description:"Address of the connection or of the bound socket"`
+	Family string           `json:"family" jsonschema_description:"Address family"`
+}
+
+// DNSQuestionSerializer serializes a DNS question to JSON
+// easyjson:json
+type DNSQuestionSerializer struct {
+	Name  string `json:"name" jsonschema_description:"Queried domain name"`
+	Type  string `json:"type" jsonschema_description:"Type of the question"`
+	Class string `json:"class" jsonschema_description:"Class of the question"`
+	Size  uint16 `json:"size" jsonschema_description:"Size of the DNS request"`
+	Count uint16 `json:"count" jsonschema_description:"Number of questions in the DNS request"`
+}
+
+// DNSEventSerializer serializes a DNS event to JSON
+// easyjson:json
+type DNSEventSerializer struct {
+	ID       uint16                `json:"id" jsonschema_description:"DNS request ID"`
+	Question DNSQuestionSerializer `json:"question" jsonschema_description:"DNS question"`
+}
+
+// PTraceEventSerializer serializes a ptrace event to JSON
+// easyjson:json
+type PTraceEventSerializer struct {
+	Request string                       `json:"request" jsonschema_description:"ptrace request"`
+	Address string                       `json:"address" jsonschema_description:"Address at which the ptrace request was executed"`
+	Tracee  *ProcessCacheEntrySerializer `json:"tracee,omitempty" jsonschema_description:"Process context of the tracee"`
+}
+
+// MMapEventSerializer serializes a mmap event to JSON
+// easyjson:json
+type MMapEventSerializer struct {
+	Address    string `json:"address" jsonschema_description:"memory segment address"`
+	Offset     uint64 `json:"offset" jsonschema_description:"file offset"`
+	Len        uint32 `json:"length" jsonschema_description:"memory segment length"`
+	Protection string `json:"protection" jsonschema_description:"memory segment protection"`
+	Flags      string `json:"flags" jsonschema_description:"memory segment flags"`
+}
+
+// MProtectEventSerializer serializes a mprotect event to JSON
+// easyjson:json
+type MProtectEventSerializer struct {
+	VMStart       string `json:"vm_start" jsonschema_description:"memory segment start address"`
+	VMEnd         string `json:"vm_end" jsonschema_description:"memory segment end address"`
+	VMProtection  string `json:"vm_protection" jsonschema_description:"initial memory segment protection"`
+	ReqProtection string `json:"req_protection" jsonschema_description:"new memory segment protection"`
+}
+
+// ModuleEventSerializer serializes a load_module or an unload_module event to JSON
+// easyjson:json
+type ModuleEventSerializer struct {
+	Name             string `json:"name" jsonschema_description:"module name"`
+	LoadedFromMemory *bool  `json:"loaded_from_memory,omitempty" jsonschema_description:"indicates if a module was loaded from memory, as opposed to a file"`
+}
+
+// SignalEventSerializer serializes a signal event to JSON
+// easyjson:json
+type SignalEventSerializer struct {
+	Type   string                       `json:"type" jsonschema_description:"signal type"`
+	PID    uint32                       `json:"pid" jsonschema_description:"signal target pid"`
+	Target *ProcessCacheEntrySerializer `json:"target,omitempty" jsonschema_description:"Process context of the signal target"`
+}
+
 // DDContextSerializer serializes a span context to JSON
 // easyjson:json
 type DDContextSerializer struct {
@@ -232,6 +307,13 @@ type EventSerializer struct {
 	*FileEventSerializer       `json:"file,omitempty"`
 	*SELinuxEventSerializer    `json:"selinux,omitempty"`
 	*BPFEventSerializer        `json:"bpf,omitempty"`
+	*NetworkEventSerializer    `json:"network,omitempty"`
+	*DNSEventSerializer        `json:"dns,omitempty"`
+	*PTraceEventSerializer     `json:"ptrace,omitempty"`
+	*MMapEventSerializer       `json:"mmap,omitempty"`
+	*MProtectEventSerializer   `json:"mprotect,omitempty"`
+	*ModuleEventSerializer     `json:"module,omitempty"`
+	*SignalEventSerializer     `json:"signal,omitempty"`
 	UserContextSerializer      UserContextSerializer       `json:"usr,omitempty"`
 	ProcessContextSerializer   ProcessContextSerializer    `json:"process,omitempty"`
 	DDContextSerializer        DDContextSerializer         `json:"dd,omitempty"`
@@ -516,6 +598,86 @@ func newBPFEventSerializer(e *Event) *BPFEventSerializer {
 	}
 }
 
+func newNetworkEventSerializer(addr *model.IPPortContext, family uint16) *NetworkEventSerializer {
+	return &NetworkEventSerializer{
+		Addr: IPPortSerializer{
+			IP:   addr.IPNet.IP.String(),
+			Port: addr.Port,
+		},
+		Family: model.AddressFamily(family).String(),
+	}
+}
+
+func newDNSEventSerializer(d *model.DNSEvent) *DNSEventSerializer {
+	return &DNSEventSerializer{
+		ID: d.ID,
+		Question: DNSQuestionSerializer{
+			Name:  d.Name,
+			Type:  model.QType(d.Type).String(),
+			Class: model.QClass(d.Class).String(),
+			Size:  d.Size,
+			Count: d.Count,
+		},
+	}
+}
+
+// newTargetProcessSerializer serializes the process targeted by a ptrace or a signal, if it was resolved
+func newTargetProcessSerializer(p *model.Process, e *Event) *ProcessCacheEntrySerializer {
+	if p.Pid == 0 {
+		return nil
+	}
+	return newProcessCacheEntrySerializer(&model.ProcessCacheEntry{ProcessContext: model.ProcessContext{Process: *p}}, e)
+}
+
+func newPTraceEventSerializer(e *Event) *PTraceEventSerializer {
+	return &PTraceEventSerializer{
+		Request: model.PTraceRequest(e.PTrace.Request).String(),
+		Address: fmt.Sprintf("0x%x", e.PTrace.Address),
+		Tracee:  newTargetProcessSerializer(&e.PTrace.Tracee, e),
+	}
+}
+
+func newMMapEventSerializer(e *Event) *MMapEventSerializer {
+	return &MMapEventSerializer{
+		Address:    fmt.Sprintf("0x%x", e.MMap.Addr),
+		Offset:     e.MMap.Offset,
+		Len:        e.MMap.Len,
+		Protection: model.Protection(e.MMap.Protection).String(),
+		Flags:      model.MMapFlag(e.MMap.Flags).String(),
+	}
+}
+
+func newMProtectEventSerializer(e *Event) *MProtectEventSerializer {
+	return &MProtectEventSerializer{
+		VMStart:       fmt.Sprintf("0x%x", e.MProtect.VMStart),
+		VMEnd:         fmt.Sprintf("0x%x", e.MProtect.VMEnd),
+		VMProtection:  model.Protection(e.MProtect.VMProtection).String(),
+		ReqProtection: model.Protection(e.MProtect.ReqProtection).String(),
+	}
+}
+
+func newLoadModuleEventSerializer(e *Event) *ModuleEventSerializer {
+	loadedFromMemory := e.LoadModule.LoadedFromMemory
+	return &ModuleEventSerializer{
+		Name:             e.LoadModule.Name,
+		LoadedFromMemory: &loadedFromMemory,
+	}
+}
+
+func newUnloadModuleEventSerializer(e *Event) *ModuleEventSerializer {
+	return &ModuleEventSerializer{
+		Name: e.UnloadModule.Name,
+	}
+}
+
+func newSignalEventSerializer(e *Event) *SignalEventSerializer {
+	return &SignalEventSerializer{
+		Type:   model.Signal(e.Signal.Type).String(),
+		PID:    e.Signal.PID,
+		Target: newTargetProcessSerializer(&e.Signal.Target, e),
+	}
+}
+
 func serializeSyscallRetval(retval int64) string {
 	switch {
 	case syscall.Errno(retval) == syscall.EACCES || syscall.Errno(retval) == syscall.EPERM:
@@ -716,6 +878,57 @@ func NewEventSerializer(event *Event) *EventSerializer {
 		s.EventContextSerializer.Outcome = serializeSyscallRetval(0)
 		s.BPFEventSerializer = newBPFEventSerializer(event)
 		s.Category = KernelActivity
+	case model.ConnectEventType:
+		s.EventContextSerializer.Outcome = serializeSyscallRetval(event.Connect.Retval)
+		s.NetworkEventSerializer = newNetworkEventSerializer(&event.Connect.Addr, event.Connect.AddrFamily)
+		s.Category = NetworkActivity
+	case model.BindEventType:
+		s.EventContextSerializer.Outcome = serializeSyscallRetval(event.Bind.Retval)
+		s.NetworkEventSerializer = newNetworkEventSerializer(&event.Bind.Addr, event.Bind.AddrFamily)
+		s.Category = NetworkActivity
+	case model.AcceptEventType:
+		s.EventContextSerializer.Outcome = serializeSyscallRetval(event.Accept.Retval)
+		s.NetworkEventSerializer = newNetworkEventSerializer(&event.Accept.Addr, event.Accept.AddrFamily)
+		s.Category = NetworkActivity
+	case model.DNSEventType:
+		s.EventContextSerializer.Outcome = serializeSyscallRetval(0)
+		s.DNSEventSerializer = newDNSEventSerializer(&event.DNS)
+		s.Category = NetworkActivity
+	case model.PTraceEventType:
+		s.EventContextSerializer.Outcome = serializeSyscallRetval(event.PTrace.Retval)
+		s.PTraceEventSerializer = newPTraceEventSerializer(event)
+		s.Category = ProcessActivity
+	case model.MMapEventType:
+		s.EventContextSerializer.Outcome = serializeSyscallRetval(event.MMap.Retval)
+		// anonymous mappings aren't backed by a file
+		if event.MMap.File.Inode != 0 {
+			s.FileEventSerializer = &FileEventSerializer{
+				FileSerializer: *newFileSerializer(&event.MMap.File, event),
+			}
+		}
+		s.MMapEventSerializer = newMMapEventSerializer(event)
+		s.Category = KernelActivity
+	case model.MProtectEventType:
+		s.EventContextSerializer.Outcome = serializeSyscallRetval(event.MProtect.Retval)
+		s.MProtectEventSerializer = newMProtectEventSerializer(event)
+		s.Category = KernelActivity
+	case model.LoadModuleEventType:
+		s.EventContextSerializer.Outcome = serializeSyscallRetval(event.LoadModule.Retval)
+		if !event.LoadModule.LoadedFromMemory {
+			s.FileEventSerializer = &FileEventSerializer{
+				FileSerializer: *newFileSerializer(&event.LoadModule.File, event),
+			}
+		}
+		s.ModuleEventSerializer = newLoadModuleEventSerializer(event)
+		s.Category = KernelActivity
+	case model.UnloadModuleEventType:
+		s.EventContextSerializer.Outcome = serializeSyscallRetval(event.UnloadModule.Retval)
+		s.ModuleEventSerializer = newUnloadModuleEventSerializer(event)
+		s.Category = KernelActivity
+	case model.SignalEventType:
+		s.EventContextSerializer.Outcome = serializeSyscallRetval(event.Signal.Retval)
+		s.SignalEventSerializer = newSignalEventSerializer(event)
+		s.Category = ProcessActivity
 	}
 
 	return s
@@ -8,7 +8,10 @@
 package probe
 
 import (
+	"strconv"
+
 	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/rules"
 )
 
 var (
@@ -20,4 +23,23 @@ var (
 			},
 		},
 	}
+
+	// SECLVariableScopes resolves the scopes of the variables set by the rule actions
+	SECLVariableScopes = map[rules.Scope]rules.VariableScope{
+		rules.ScopeProcess: func(ctx *eval.Context) string {
+			return ProcessScopeKey((*Event)(ctx.Object).ProcessContext.Process.Pid)
+		},
+		rules.ScopeContainer: func(ctx *eval.Context) string {
+			ev := (*Event)(ctx.Object)
+			return ev.ResolveContainerID(&ev.ContainerContext)
+		},
+	}
 )
+
+// ProcessScopeKey returns the key of the process scope of the variables set by the rule actions
+func ProcessScopeKey(pid uint32) string {
+	if pid == 0 {
+		return ""
+	}
+	return strconv.FormatUint(uint64(pid), 10)
+}
@@ -19,6 +19,8 @@ var (
 	seclLexer = lexer.Must(ebnf.New(`
 Comment = ("#" | "//") { "\u0000"…"\uffff"-"\n" } .
 IntVariable = "${" (alpha | "_") { "_" | alpha | digit | "." } "}" .
+IPv4 = digit { digit } "." digit { digit } "." digit { digit } "." digit { digit } [ "/" digit { digit } ] .
+IPv6 = { hex } ":" { hex | ":" | "." } [ "/" digit { digit } ] .
 Duration = digit { digit } ("ms" | "s" | "m" | "h" | "d") .
 Regexp = "r\"" { "\u0000"…"\uffff"-"\""-"\\" | "\\" any } "\"" .
 Ident = (alpha | "_") { "_" | alpha | digit | "." | "[" | "]" } .
@@ -29,6 +31,7 @@ Punct = "!"…"/" | ":"…"@" | "["…` + "\"`\"" + ` | "{"…"~" .
 Whitespace = ( " " | "\t" | "\n" ) { " " | "\t" | "\n" } .
 alpha = "a"…"z" | "A"…"Z" .
 digit = "0"…"9" .
+hex = "0"…"9" | "a"…"f" | "A"…"F" .
 any = "\u0000"…"\uffff" .
 `))
 )
@@ -187,6 +190,7 @@ type Primary struct {
 	Pattern        *string     `parser:"| @Pattern"`
 	Regexp         *string     `parser:"| @Regexp"`
 	Duration       *int        `parser:"| @Duration"`
+	IP             *string     `parser:"| @( IPv4 | IPv6 )"`
 	SubExpression  *Expression `parser:"| \"(\" @@ \")\""`
 }
 
@@ -205,5 +209,7 @@ type Array struct {
 
 	StringMembers []StringMember `parser:"\"[\" @@ { \",\" @@ } \"]\""`
 	Numbers       []int          `parser:"| \"[\" @Int { \",\" @Int } \"]\""`
+	IPs           []string       `parser:"| \"[\" @( IPv4 | IPv6 ) { \",\" @( IPv4 | IPv6 ) } \"]\""`
+	IP            *string        `parser:"| @( IPv4 | IPv6 )"`
 	Ident         *string        `parser:"| @Ident"`
 }
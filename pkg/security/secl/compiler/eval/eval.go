@@ -9,6 +9,7 @@ package eval
 
 import (
 	"fmt"
+	"net"
 	"reflect"
 	"regexp"
 	"strconv"
@@ -280,6 +281,66 @@ func (b *BoolArrayEvaluator) IsScalar() bool {
 	return b.EvalFnc == nil
 }
 
+// CIDREvaluator returns a network, an IP address being a network with a full mask
+type CIDREvaluator struct {
+	EvalFnc func(ctx *Context) net.IPNet
+	Field   Field
+	Value   net.IPNet
+	Weight  int
+
+	isPartial bool
+}
+
+// Eval returns the result of the evaluation
+func (c *CIDREvaluator) Eval(ctx *Context) interface{} {
+	return c.EvalFnc(ctx)
+}
+
+// IsPartial returns whether the evaluator is partial
+func (c *CIDREvaluator) IsPartial() bool {
+	return c.isPartial
+}
+
+// GetField returns field name used by this evaluator
+func (c *CIDREvaluator) GetField() string {
+	return c.Field
+}
+
+// IsScalar returns whether the evaluator is a scalar
+func (c *CIDREvaluator) IsScalar() bool {
+	return c.EvalFnc == nil
+}
+
+// CIDRArrayEvaluator returns an array of networks
+type CIDRArrayEvaluator struct {
+	EvalFnc func(ctx *Context) []net.IPNet
+	Field   Field
+	Values  []net.IPNet
+	Weight  int
+
+	isPartial bool
+}
+
+// Eval returns the result of the evaluation
+func (c *CIDRArrayEvaluator) Eval(ctx *Context) interface{} {
+	return c.EvalFnc(ctx)
+}
+
+// IsPartial returns whether the evaluator is partial
+func (c *CIDRArrayEvaluator) IsPartial() bool {
+	return c.isPartial
+}
+
+// GetField returns field name used by this evaluator
+func (c *CIDRArrayEvaluator) GetField() string {
+	return c.Field
+}
+
+// IsScalar returns whether the evaluator is a scalar
+func (c *CIDRArrayEvaluator) IsScalar() bool {
+	return c.EvalFnc == nil
+}
+
 func extractField(field string) (Field, Field, RegisterID, error) {
 	var regID RegisterID
 
@@ -441,6 +502,21 @@ func arrayToEvaluator(array *ast.Array, opts *Opts, state *state) (interface{},
 			}
 		}
 		return &se, array.Pos, nil
+	} else if len(array.IPs) != 0 || array.IP != nil {
+		values := array.IPs
+		if array.IP != nil {
+			values = []string{*array.IP}
+		}
+
+		var ce CIDRArrayEvaluator
+		for _, value := range values {
+			ipNet, err := ParseCIDR(value)
+			if err != nil {
+				return nil, array.Pos, NewError(array.Pos, fmt.Sprintf("invalid IP address or CIDR `%s`: %s", value, err))
+			}
+			ce.Values = append(ce.Values, *ipNet)
+		}
+		return &ce, array.Pos, nil
 	} else if array.Ident != nil {
 		if state.macros != nil {
 			if macro, ok := state.macros[*array.Ident]; ok {
@@ -715,6 +791,20 @@ func nodeToEvaluator(obj interface{}, opts *Opts, state *state) (interface{}, le
 				default:
 					return nil, pos, NewTypeError(pos, reflect.Array)
 				}
+			case *CIDREvaluator:
+				switch nextCIDR := next.(type) {
+				case *CIDRArrayEvaluator:
+					boolEvaluator, err := ArrayCIDRContains(unary, nextCIDR, opts, state)
+					if err != nil {
+						return nil, pos, err
+					}
+					if *obj.ArrayComparison.Op == "notin" {
+						return Not(boolEvaluator, opts, state), obj.Pos, nil
+					}
+					return boolEvaluator, obj.Pos, nil
+				default:
+					return nil, pos, NewTypeError(pos, reflect.Array)
+				}
 			default:
 				return nil, pos, NewTypeError(pos, reflect.Array)
 			}
@@ -864,6 +954,27 @@ func nodeToEvaluator(obj interface{}, opts *Opts, state *state) (interface{}, le
 					}
 					return boolEvaluator, obj.Pos, nil
 				}
+			case *CIDREvaluator:
+				nextCIDR, ok := next.(*CIDREvaluator)
+				if !ok {
+					return nil, pos, NewTypeError(pos, reflect.Struct)
+				}
+
+				switch *obj.ScalarComparison.Op {
+				case "!=":
+					boolEvaluator, err := CIDREquals(unary, nextCIDR, opts, state)
+					if err != nil {
+						return nil, obj.Pos, err
+					}
+					return Not(boolEvaluator, opts, state), obj.Pos, nil
+				case "==":
+					boolEvaluator, err := CIDREquals(unary, nextCIDR, opts, state)
+					if err != nil {
+						return nil, obj.Pos, err
+					}
+					return boolEvaluator, obj.Pos, nil
+				}
+				return nil, pos, NewOpUnknownError(obj.Pos, *obj.ScalarComparison.Op)
 			case *IntEvaluator:
 				switch nextInt := next.(type) {
 				case *IntEvaluator:
@@ -1126,6 +1237,15 @@ func nodeToEvaluator(obj interface{}, opts *Opts, state *state) (interface{}, le
 				regexp:    reg,
 				valueType: RegexpValueType,
 			}, obj.Pos, nil
+		case obj.IP != nil:
+			ipNet, err := ParseCIDR(*obj.IP)
+			if err != nil {
+				return nil, obj.Pos, NewError(obj.Pos, fmt.Sprintf("invalid IP address or CIDR '%s': %s", *obj.IP, err))
+			}
+
+			return &CIDREvaluator{
+				Value: *ipNet,
+			}, obj.Pos, nil
 		case obj.SubExpression != nil:
 			return nodeToEvaluator(obj.SubExpression, opts, state)
 		default:
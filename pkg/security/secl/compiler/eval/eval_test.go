@@ -8,6 +8,7 @@ package eval
 import (
 	"container/list"
 	"fmt"
+	"net"
 	"os"
 	"runtime"
 	"strings"
@@ -1018,6 +1019,95 @@ func TestDuration(t *testing.T) {
 	}
 }
 
+func TestCIDR(t *testing.T) {
+	ip4, _ := ParseCIDR("192.168.0.1")
+	ip6, _ := ParseCIDR("2001:db8::1")
+
+	tests := []struct {
+		IP       *net.IPNet
+		Expr     string
+		Expected bool
+	}{
+		{IP: ip4, Expr: `network.ip == 192.168.0.1`, Expected: true},
+		{IP: ip4, Expr: `network.ip != 192.168.0.1`, Expected: false},
+		{IP: ip4, Expr: `network.ip == 192.168.0.2`, Expected: false},
+		{IP: ip4, Expr: `network.ip == 192.168.0.0/16`, Expected: true},
+		{IP: ip4, Expr: `network.ip == 10.0.0.0/8`, Expected: false},
+		{IP: ip4, Expr: `network.ip in [ 10.0.0.0/8, 172.16.0.0/12, 192.168.0.0/16 ]`, Expected: true},
+		{IP: ip4, Expr: `network.ip not in [ 10.0.0.0/8, 172.16.0.0/12, 192.168.0.0/16 ]`, Expected: false},
+		{IP: ip4, Expr: `network.ip in 192.168.0.0/24`, Expected: true},
+		{IP: ip4, Expr: `network.ip in [ 127.0.0.1, ::1 ]`, Expected: false},
+		{IP: ip6, Expr: `network.ip == 2001:db8::1`, Expected: true},
+		{IP: ip6, Expr: `network.ip in [ 2001:db8::/32 ]`, Expected: true},
+		{IP: ip6, Expr: `network.ip in [ 10.0.0.0/8, fe80::/10 ]`, Expected: false},
+	}
+
+	for _, test := range tests {
+		event := &testEvent{
+			network: testNetwork{
+				ip: *test.IP,
+			},
+		}
+
+		result, _, err := eval(t, event, test.Expr)
+		if err != nil {
+			t.Fatalf("error while evaluating `%s`: %s", test.Expr, err)
+		}
+
+		if result != test.Expected {
+			t.Errorf("expected result `%t` not found, got `%t`\n%s", test.Expected, result, test.Expr)
+		}
+	}
+
+	if _, err := parseRule(`network.ip == 192.168.0.256`, &testModel{}, &Opts{}); err == nil {
+		t.Error("expected an error for an invalid IP address")
+	}
+
+	if _, err := parseRule(`network.ip == "192.168.0.1"`, &testModel{}, &Opts{}); err == nil {
+		t.Error("expected a type error")
+	}
+}
+
+func TestCIDRPartial(t *testing.T) {
+	ip, _ := ParseCIDR("192.168.0.1")
+	event := testEvent{
+		network: testNetwork{
+			ip: *ip,
+		},
+	}
+
+	tests := []struct {
+		Expr        string
+		IsDiscarder bool
+	}{
+		{Expr: `network.ip == 10.0.0.1`, IsDiscarder: true},
+		{Expr: `network.ip != 10.0.0.1`, IsDiscarder: false},
+		{Expr: `network.ip in [ 192.168.0.0/16 ]`, IsDiscarder: false},
+		{Expr: `network.ip not in [ 192.168.0.0/16 ]`, IsDiscarder: true},
+	}
+
+	ctx := NewContext(unsafe.Pointer(&event))
+
+	for _, test := range tests {
+		rule, err := parseRule(test.Expr, &testModel{}, &Opts{Constants: testConstants})
+		if err != nil {
+			t.Fatalf("error while evaluating `%s`: %s", test.Expr, err)
+		}
+		if err := rule.GenPartials(); err != nil {
+			t.Fatalf("error while evaluating `%s`: %s", test.Expr, err)
+		}
+
+		result, err := rule.PartialEval(ctx, "network.ip")
+		if err != nil {
+			t.Fatalf("error while partial evaluating `%s`: %s", test.Expr, err)
+		}
+
+		if !result != test.IsDiscarder {
+			t.Fatalf("expected result `%t`, got `%t`\n%s", test.IsDiscarder, result, test.Expr)
+		}
+	}
+}
+
 func BenchmarkArray(b *testing.B) {
 	event := &testEvent{
 		process: testProcess{
@@ -6,6 +6,7 @@
 package eval
 
 import (
+	"net"
 	"reflect"
 	"syscall"
 	"unsafe"
@@ -95,6 +96,10 @@ type testMkdir struct {
 	mode     int
 }
 
+type testNetwork struct {
+	ip net.IPNet
+}
+
 type testEvent struct {
 	id   string
 	kind string
@@ -102,6 +107,7 @@ type testEvent struct {
 	process testProcess
 	open    testOpen
 	mkdir   testMkdir
+	network testNetwork
 
 	listEvaluated bool
 	uidEvaluated  bool
@@ -364,6 +370,13 @@ func (m *testModel) GetEvaluator(field Field, regID RegisterID) (Evaluator, erro
 			EvalFnc: func(ctx *Context) int { return (*testEvent)(ctx.Object).mkdir.mode },
 			Field:   field,
 		}, nil
+
+	case "network.ip":
+
+		return &CIDREvaluator{
+			EvalFnc: func(ctx *Context) net.IPNet { return (*testEvent)(ctx.Object).network.ip },
+			Field:   field,
+		}, nil
 	}
 
 	return nil, &ErrFieldNotFound{Field: field}
@@ -416,6 +429,10 @@ func (e *testEvent) GetFieldValue(field Field) (interface{}, error) {
 
 		return e.mkdir.mode, nil
 
+	case "network.ip":
+
+		return e.network.ip, nil
+
 	}
 
 	return nil, &ErrFieldNotFound{Field: field}
@@ -492,6 +509,10 @@ func (e *testEvent) GetFieldEventType(field Field) (string, error) {
 
 		return "mkdir", nil
 
+	case "network.ip":
+
+		return "network", nil
+
 	}
 
 	return "", &ErrFieldNotFound{Field: field}
@@ -555,6 +576,11 @@ func (e *testEvent) SetFieldValue(field Field, value interface{}) error {
 		e.mkdir.mode = value.(int)
 		return nil
 
+	case "network.ip":
+
+		e.network.ip = value.(net.IPNet)
+		return nil
+
 	}
 
 	return &ErrFieldNotFound{Field: field}
@@ -621,6 +647,10 @@ func (e *testEvent) GetFieldType(field Field) (reflect.Kind, error) {
 
 		return reflect.Int, nil
 
+	case "network.ip":
+
+		return reflect.Struct, nil
+
 	}
 
 	return reflect.Invalid, &ErrFieldNotFound{Field: field}
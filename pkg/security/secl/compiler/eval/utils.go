@@ -6,6 +6,9 @@
 package eval
 
 import (
+	"net"
+	"strings"
+
 	"github.com/pkg/errors"
 )
 
@@ -18,7 +21,51 @@ func NotOfValue(value interface{}) (interface{}, error) {
 		return RandString(256), nil
 	case bool:
 		return !v, nil
+	case net.IPNet:
+		ip := make(net.IP, len(v.IP))
+		for i, b := range v.IP {
+			ip[i] = ^b
+		}
+		return net.IPNet{IP: ip, Mask: net.CIDRMask(len(ip)*8, len(ip)*8)}, nil
 	}
 
 	return nil, errors.New("value type unknown")
 }
+
+// ParseCIDR parses an IP address or a CIDR. An IP address is returned as a network with a
+// full mask, IPv4 addresses being stored on 4 bytes.
+func ParseCIDR(s string) (*net.IPNet, error) {
+	if strings.Contains(s, "/") {
+		_, ipNet, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, err
+		}
+		return ipNet, nil
+	}
+
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return nil, errors.New("invalid IP address")
+	}
+
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(len(ip)*8, len(ip)*8)}, nil
+}
+
+// IPNetMatches returns whether one of the networks contains the other one, two IP addresses
+// matching when they're equal
+func IPNetMatches(a net.IPNet, b net.IPNet) bool {
+	onesA, bitsA := a.Mask.Size()
+	onesB, bitsB := b.Mask.Size()
+	if bitsA != bitsB {
+		return false
+	}
+
+	if onesA <= onesB {
+		return a.Contains(b.IP)
+	}
+	return b.Contains(a.IP)
+}
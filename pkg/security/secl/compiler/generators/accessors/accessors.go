@@ -94,7 +94,7 @@ func handleField(astFile *ast.File, name, alias, prefix, aliasPrefix, pkgName st
 	fmt.Printf("handleField fieldName %s, alias %s, prefix %s, aliasPrefix %s, pkgName %s, fieldType, %s\n", name, alias, prefix, aliasPrefix, pkgName, fieldType)
 
 	switch fieldType.Name {
-	case "string", "bool", "int", "int8", "int16", "int32", "int64", "uint8", "uint16", "uint32", "uint64":
+	case "string", "bool", "int", "int8", "int16", "int32", "int64", "uint8", "uint16", "uint32", "uint64", "net.IPNet":
 		if prefix != "" {
 			name = prefix + "." + name
 			alias = aliasPrefix + "." + alias
@@ -128,6 +128,11 @@ func handleField(astFile *ast.File, name, alias, prefix, aliasPrefix, pkgName st
 func getFieldIdent(field *ast.Field) (ident *ast.Ident, isPointer, isArray bool) {
 	if fieldType, ok := field.Type.(*ast.Ident); ok {
 		return fieldType, false, false
+	} else if fieldType, ok := field.Type.(*ast.SelectorExpr); ok {
+		// qualified types, such as net.IPNet, are handled as basic types
+		if pkg, ok := fieldType.X.(*ast.Ident); ok {
+			return ast.NewIdent(pkg.Name + "." + fieldType.Sel.Name), false, false
+		}
 	} else if fieldType, ok := field.Type.(*ast.StarExpr); ok {
 		if ident, ok := fieldType.X.(*ast.Ident); ok {
 			return ident, true, false
@@ -423,6 +428,7 @@ func main() {
 package {{.Name}}
 
 import (
+	"net"
 	"reflect"
 	"unsafe"
 
@@ -433,6 +439,7 @@ import (
 // suppress unused package warning
 var (
 	_ *unsafe.Pointer
+	_ net.IPNet
 )
 
 func (m *Model) GetIterator(field eval.Field) (eval.Iterator, error) {
@@ -474,6 +481,11 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 		{{if or $Field.Iterator $Field.IsArray}}
 			{{$EvaluatorType = "eval.BoolArrayEvaluator"}}
 		{{end}}
+	{{else if eq $Field.ReturnType "net.IPNet"}}
+		{{$EvaluatorType = "eval.CIDREvaluator"}}
+		{{if or $Field.Iterator $Field.IsArray}}
+			{{$EvaluatorType = "eval.CIDRArrayEvaluator"}}
+		{{end}}
 	{{end}}
 
 	case "{{$Name}}":
@@ -655,6 +667,8 @@ func (e *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 				{{end -}}
 			{{else if eq $Field.ReturnType "bool"}}
 				return {{$Return}}, nil
+			{{else if eq $Field.ReturnType "net.IPNet"}}
+				return {{$Return}}, nil
 			{{end}}
 		{{end}}
 		{{end}}
@@ -685,6 +699,8 @@ func (e *Event) GetFieldType(field eval.Field) (reflect.Kind, error) {
 			return reflect.Int, nil
 		{{else if eq $Field.ReturnType "bool"}}
 			return reflect.Bool, nil
+		{{else if eq $Field.ReturnType "net.IPNet"}}
+			return reflect.Struct, nil
 		{{end}}
 		{{end}}
 		}
@@ -732,6 +748,17 @@ func (e *Event) SetFieldValue(field eval.Field, value interface{}) error {
 				return &eval.ErrValueTypeMismatch{Field: "{{$Field.Name}}"}
 			}
 			return nil
+		{{else if eq $Field.BasicType "net.IPNet"}}
+			v, ok := value.(net.IPNet)
+			if !ok {
+				return &eval.ErrValueTypeMismatch{Field: "{{$Field.Name}}"}
+			}
+			{{- if $Field.IsArray}}
+				{{$FieldName}} = append({{$FieldName}}, v)
+			{{else}}
+				{{$FieldName}} = v
+			{{end}}
+			return nil
 		{{end}}
 		{{end}}
 		}
@@ -18,12 +18,17 @@ const (
 	FIMCategory EventCategory = "fim"
 	// RuntimeCategory Process events
 	RuntimeCategory EventCategory = "runtime"
+	// NetworkCategory Network events
+	NetworkCategory EventCategory = "network"
 )
 
 // GetEventTypeCategory returns the category for the given event type
 func GetEventTypeCategory(eventType eval.EventType) EventCategory {
-	if eventType == "exec" {
+	switch eventType {
+	case "exec", "ptrace", "mmap", "mprotect", "load_module", "unload_module", "signal":
 		return RuntimeCategory
+	case "connect", "bind", "accept", "dns":
+		return NetworkCategory
 	}
 
 	return FIMCategory
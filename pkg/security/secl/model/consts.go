@@ -3,6 +3,7 @@
 // This product includes software developed at Datadog (https://www.datadoghq.com/).
 // Copyright 2016-present Datadog, Inc.
 
+//go:build linux
 // +build linux
 
 package model
@@ -32,6 +33,12 @@ const (
 
 	// NameSuffix defines the suffix used for name fields
 	NameSuffix = ".name"
+
+	// DNSMaxLength defines the maximum length of the DNS requests captured by the kernel
+	DNSMaxLength uint16 = 256
+
+	// ModuleNameLength defines the maximum length of the name of a kernel module
+	ModuleNameLength = 56
 )
 
 var (
@@ -598,6 +605,128 @@ var (
 		"AT_REMOVEDIR": unix.AT_REMOVEDIR,
 	}
 
+	// SignalConstants are the supported signals
+	SignalConstants = map[string]int{
+		"SIGHUP":    int(unix.SIGHUP),
+		"SIGINT":    int(unix.SIGINT),
+		"SIGQUIT":   int(unix.SIGQUIT),
+		"SIGILL":    int(unix.SIGILL),
+		"SIGTRAP":   int(unix.SIGTRAP),
+		"SIGABRT":   int(unix.SIGABRT),
+		"SIGBUS":    int(unix.SIGBUS),
+		"SIGFPE":    int(unix.SIGFPE),
+		"SIGKILL":   int(unix.SIGKILL),
+		"SIGUSR1":   int(unix.SIGUSR1),
+		"SIGSEGV":   int(unix.SIGSEGV),
+		"SIGUSR2":   int(unix.SIGUSR2),
+		"SIGPIPE":   int(unix.SIGPIPE),
+		"SIGALRM":   int(unix.SIGALRM),
+		"SIGTERM":   int(unix.SIGTERM),
+		"SIGSTKFLT": int(unix.SIGSTKFLT),
+		"SIGCHLD":   int(unix.SIGCHLD),
+		"SIGCONT":   int(unix.SIGCONT),
+		"SIGSTOP":   int(unix.SIGSTOP),
+		"SIGTSTP":   int(unix.SIGTSTP),
+		"SIGTTIN":   int(unix.SIGTTIN),
+		"SIGTTOU":   int(unix.SIGTTOU),
+		"SIGURG":    int(unix.SIGURG),
+		"SIGXCPU":   int(unix.SIGXCPU),
+		"SIGXFSZ":   int(unix.SIGXFSZ),
+		"SIGVTALRM": int(unix.SIGVTALRM),
+		"SIGPROF":   int(unix.SIGPROF),
+		"SIGWINCH":  int(unix.SIGWINCH),
+		"SIGIO":     int(unix.SIGIO),
+		"SIGPWR":    int(unix.SIGPWR),
+		"SIGSYS":    int(unix.SIGSYS),
+	}
+
+	// PTraceConstants are the supported ptrace commands
+	PTraceConstants = map[string]uint32{
+		"PTRACE_TRACEME":     unix.PTRACE_TRACEME,
+		"PTRACE_PEEKTEXT":    unix.PTRACE_PEEKTEXT,
+		"PTRACE_PEEKDATA":    unix.PTRACE_PEEKDATA,
+		"PTRACE_PEEKUSR":     unix.PTRACE_PEEKUSR,
+		"PTRACE_POKETEXT":    unix.PTRACE_POKETEXT,
+		"PTRACE_POKEDATA":    unix.PTRACE_POKEDATA,
+		"PTRACE_POKEUSR":     unix.PTRACE_POKEUSR,
+		"PTRACE_CONT":        unix.PTRACE_CONT,
+		"PTRACE_KILL":        unix.PTRACE_KILL,
+		"PTRACE_SINGLESTEP":  unix.PTRACE_SINGLESTEP,
+		"PTRACE_ATTACH":      unix.PTRACE_ATTACH,
+		"PTRACE_DETACH":      unix.PTRACE_DETACH,
+		"PTRACE_SYSCALL":     unix.PTRACE_SYSCALL,
+		"PTRACE_SETOPTIONS":  unix.PTRACE_SETOPTIONS,
+		"PTRACE_GETEVENTMSG": unix.PTRACE_GETEVENTMSG,
+		"PTRACE_GETSIGINFO":  unix.PTRACE_GETSIGINFO,
+		"PTRACE_SETSIGINFO":  unix.PTRACE_SETSIGINFO,
+		"PTRACE_GETREGSET":   unix.PTRACE_GETREGSET,
+		"PTRACE_SETREGSET":   unix.PTRACE_SETREGSET,
+		"PTRACE_SEIZE":       unix.PTRACE_SEIZE,
+		"PTRACE_INTERRUPT":   unix.PTRACE_INTERRUPT,
+		"PTRACE_LISTEN":      unix.PTRACE_LISTEN,
+		"PTRACE_PEEKSIGINFO": unix.PTRACE_PEEKSIGINFO,
+	}
+
+	// ProtConstants are the supported memory protection flags
+	ProtConstants = map[string]int{
+		"PROT_NONE":      unix.PROT_NONE,
+		"PROT_READ":      unix.PROT_READ,
+		"PROT_WRITE":     unix.PROT_WRITE,
+		"PROT_EXEC":      unix.PROT_EXEC,
+		"PROT_GROWSDOWN": unix.PROT_GROWSDOWN,
+		"PROT_GROWSUP":   unix.PROT_GROWSUP,
+	}
+
+	// MMapFlagConstants are the supported flags of the mmap syscall
+	MMapFlagConstants = map[string]int{
+		"MAP_SHARED":          unix.MAP_SHARED,
+		"MAP_PRIVATE":         unix.MAP_PRIVATE,
+		"MAP_SHARED_VALIDATE": 0x3,
+		"MAP_FIXED":           unix.MAP_FIXED,
+		"MAP_ANONYMOUS":       unix.MAP_ANONYMOUS,
+		"MAP_GROWSDOWN":       unix.MAP_GROWSDOWN,
+		"MAP_DENYWRITE":       unix.MAP_DENYWRITE,
+		"MAP_EXECUTABLE":      unix.MAP_EXECUTABLE,
+		"MAP_LOCKED":          unix.MAP_LOCKED,
+		"MAP_NORESERVE":       unix.MAP_NORESERVE,
+		"MAP_POPULATE":        unix.MAP_POPULATE,
+		"MAP_NONBLOCK":        unix.MAP_NONBLOCK,
+		"MAP_STACK":           unix.MAP_STACK,
+		"MAP_HUGETLB":         unix.MAP_HUGETLB,
+		"MAP_FIXED_NOREPLACE": 0x100000,
+	}
+
+	// AddressFamilyConstants are the supported network address families
+	AddressFamilyConstants = map[string]uint16{
+		"AF_UNIX":  unix.AF_UNIX,
+		"AF_INET":  unix.AF_INET,
+		"AF_INET6": unix.AF_INET6,
+	}
+
+	// DNSQTypeConstants are the supported DNS question types
+	DNSQTypeConstants = map[string]uint16{
+		"A":     1,
+		"NS":    2,
+		"CNAME": 5,
+		"SOA":   6,
+		"PTR":   12,
+		"MX":    15,
+		"TXT":   16,
+		"AAAA":  28,
+		"SRV":   33,
+		"ANY":   255,
+	}
+
+	// DNSQClassConstants are the supported DNS question classes
+	DNSQClassConstants = map[string]uint16{
+		"CLASS_INET":   1,
+		"CLASS_CSNET":  2,
+		"CLASS_CHAOS":  3,
+		"CLASS_HESIOD": 4,
+		"CLASS_NONE":   254,
+		"CLASS_ANY":    255,
+	}
+
 	// SECLConstants are constants available in runtime security agent rules
 	SECLConstants = map[string]interface{}{
 		// boolean
@@ -616,6 +745,13 @@ var (
 	bpfMapTypeStrings         = map[uint32]string{}
 	bpfProgramTypeStrings     = map[uint32]string{}
 	bpfAttachTypeStrings      = map[uint32]string{}
+	addressFamilyStrings      = map[uint16]string{}
+	dnsQTypeStrings           = map[uint16]string{}
+	dnsQClassStrings          = map[uint16]string{}
+	signalStrings             = map[int]string{}
+	ptraceStrings             = map[uint32]string{}
+	protStrings               = map[int]string{}
+	mmapFlagStrings           = map[int]string{}
 )
 
 // File flags
@@ -691,6 +827,34 @@ func initBPFProgramTypeConstants() {
 	}
 }
 
+func initSignalConstants() {
+	for k, v := range SignalConstants {
+		SECLConstants[k] = &eval.IntEvaluator{Value: v}
+		signalStrings[v] = k
+	}
+}
+
+func initPTraceConstants() {
+	for k, v := range PTraceConstants {
+		SECLConstants[k] = &eval.IntEvaluator{Value: int(v)}
+		ptraceStrings[v] = k
+	}
+}
+
+func initProtConstants() {
+	for k, v := range ProtConstants {
+		SECLConstants[k] = &eval.IntEvaluator{Value: v}
+		protStrings[v] = k
+	}
+}
+
+func initMMapFlagConstants() {
+	for k, v := range MMapFlagConstants {
+		SECLConstants[k] = &eval.IntEvaluator{Value: v}
+		mmapFlagStrings[v] = k
+	}
+}
+
 func initBPFAttachTypeConstants() {
 	for k, v := range BPFAttachTypeConstants {
 		SECLConstants[k] = &eval.IntEvaluator{Value: int(v)}
@@ -698,6 +862,25 @@ func initBPFAttachTypeConstants() {
 	}
 }
 
+func initAddressFamilyConstants() {
+	for k, v := range AddressFamilyConstants {
+		SECLConstants[k] = &eval.IntEvaluator{Value: int(v)}
+		addressFamilyStrings[v] = k
+	}
+}
+
+func initDNSConstants() {
+	for k, v := range DNSQTypeConstants {
+		SECLConstants[k] = &eval.IntEvaluator{Value: int(v)}
+		dnsQTypeStrings[v] = k
+	}
+
+	for k, v := range DNSQClassConstants {
+		SECLConstants[k] = &eval.IntEvaluator{Value: int(v)}
+		dnsQClassStrings[v] = k
+	}
+}
+
 func initConstants() {
 	initErrorConstants()
 	initOpenConstants()
@@ -709,6 +892,12 @@ func initConstants() {
 	initBPFMapTypeConstants()
 	initBPFProgramTypeConstants()
 	initBPFAttachTypeConstants()
+	initSignalConstants()
+	initPTraceConstants()
+	initProtConstants()
+	initMMapFlagConstants()
+	initAddressFamilyConstants()
+	initDNSConstants()
 }
 
 func bitmaskToStringArray(bitmask int, intToStrMap map[int]string) []string {
@@ -1491,3 +1680,75 @@ const (
 	// BpfSkSkbVerdict attach type
 	BpfSkSkbVerdict
 )
+
+// AddressFamily represents a network address family
+type AddressFamily uint16
+
+func (f AddressFamily) String() string {
+	if s, ok := addressFamilyStrings[uint16(f)]; ok {
+		return s
+	}
+	return fmt.Sprintf("%d", f)
+}
+
+// QType represents a DNS question type
+type QType uint16
+
+func (t QType) String() string {
+	if s, ok := dnsQTypeStrings[uint16(t)]; ok {
+		return s
+	}
+	return fmt.Sprintf("%d", t)
+}
+
+// QClass represents a DNS question class
+type QClass uint16
+
+func (c QClass) String() string {
+	if s, ok := dnsQClassStrings[uint16(c)]; ok {
+		return s
+	}
+	return fmt.Sprintf("%d", c)
+}
+
+// Signal represents a signal
+type Signal int
+
+func (s Signal) String() string {
+	if str, ok := signalStrings[int(s)]; ok {
+		return str
+	}
+	return fmt.Sprintf("%d", s)
+}
+
+// PTraceRequest represents a ptrace request
+type PTraceRequest uint32
+
+func (r PTraceRequest) String() string {
+	if s, ok := ptraceStrings[uint32(r)]; ok {
+		return s
+	}
+	return fmt.Sprintf("%d", r)
+}
+
+// Protection represents a memory protection bitmask
+type Protection int
+
+func (p Protection) String() string {
+	if p == unix.PROT_NONE {
+		return "PROT_NONE"
+	}
+	return bitmaskToString(int(p), protStrings)
+}
+
+// MMapFlag represents a bitmask of mmap flags
+type MMapFlag int
+
+func (f MMapFlag) String() string {
+	// MAP_SHARED_VALIDATE overlaps MAP_SHARED and MAP_PRIVATE, the sharing type is a value and not a bit
+	switch f & 0x3 {
+	case 0x3:
+		return strings.Join(append([]string{"MAP_SHARED_VALIDATE"}, bitmaskToStringArray(int(f&^0x3), mmapFlagStrings)...), " | ")
+	}
+	return bitmaskToString(int(f), mmapFlagStrings)
+}
@@ -33,4 +33,19 @@ func TestFlagsToString(t *testing.T) {
 	if str != "O_RDONLY" {
 		t.Errorf("expected flags not found, got: %s", str)
 	}
+
+	str = Protection(syscall.PROT_READ | syscall.PROT_EXEC).String()
+	if str != "PROT_EXEC | PROT_READ" {
+		t.Errorf("expected flags not found, got: %s", str)
+	}
+
+	str = MMapFlag(syscall.MAP_PRIVATE | syscall.MAP_ANONYMOUS).String()
+	if str != "MAP_ANONYMOUS | MAP_PRIVATE" {
+		t.Errorf("expected flags not found, got: %s", str)
+	}
+
+	str = MMapFlag(syscall.MAP_SHARED | syscall.MAP_PRIVATE | syscall.MAP_FIXED).String()
+	if str != "MAP_SHARED_VALIDATE | MAP_FIXED" {
+		t.Errorf("expected flags not found, got: %s", str)
+	}
 }
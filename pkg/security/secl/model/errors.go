@@ -18,4 +18,7 @@ var (
 
 	// ErrNonPrintable returned when a string contains non printable char
 	ErrNonPrintable = errors.New("non printable")
+
+	// ErrDNSNameMalformatted returned when a DNS name can't be decoded
+	ErrDNSNameMalformatted = errors.New("DNS name malformatted")
 )
@@ -61,6 +61,26 @@ const (
 	SELinuxEventType
 	// BPFEventType bpf event
 	BPFEventType
+	// ConnectEventType connect event
+	ConnectEventType
+	// BindEventType bind event
+	BindEventType
+	// AcceptEventType accept event
+	AcceptEventType
+	// DNSEventType DNS request event
+	DNSEventType
+	// PTraceEventType ptrace event
+	PTraceEventType
+	// MMapEventType mmap event
+	MMapEventType
+	// MProtectEventType mprotect event
+	MProtectEventType
+	// LoadModuleEventType load_module event
+	LoadModuleEventType
+	// UnloadModuleEventType unload_module event
+	UnloadModuleEventType
+	// SignalEventType signal event
+	SignalEventType
 	// MaxEventType is used internally to get the maximum number of kernel events.
 	MaxEventType
 
@@ -82,6 +102,8 @@ const (
 	CustomForkBombEventType
 	// CustomTruncatedParentsEventType is the custom event used to report that the parents of a path were truncated
 	CustomTruncatedParentsEventType
+	// CustomAnomalyDetectionEventType is the custom event used to report an activity outside of a learned profile
+	CustomAnomalyDetectionEventType
 )
 
 func (t EventType) String() string {
@@ -134,6 +156,26 @@ func (t EventType) String() string {
 		return "selinux"
 	case BPFEventType:
 		return "bpf"
+	case ConnectEventType:
+		return "connect"
+	case BindEventType:
+		return "bind"
+	case AcceptEventType:
+		return "accept"
+	case DNSEventType:
+		return "dns"
+	case PTraceEventType:
+		return "ptrace"
+	case MMapEventType:
+		return "mmap"
+	case MProtectEventType:
+		return "mprotect"
+	case LoadModuleEventType:
+		return "load_module"
+	case UnloadModuleEventType:
+		return "unload_module"
+	case SignalEventType:
+		return "signal"
 
 	case CustomLostReadEventType:
 		return "lost_events_read"
@@ -147,6 +189,8 @@ func (t EventType) String() string {
 		return "fork_bomb"
 	case CustomTruncatedParentsEventType:
 		return "truncated_parents"
+	case CustomAnomalyDetectionEventType:
+		return "anomaly_detection"
 	default:
 		return "unknown"
 	}
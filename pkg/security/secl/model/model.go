@@ -13,6 +13,7 @@ package model
 
 import (
 	"fmt"
+	"net"
 	"path"
 	"path/filepath"
 	"regexp"
@@ -145,6 +146,18 @@ type Event struct {
 	SELinux SELinuxEvent `field:"selinux" event:"selinux"` // [7.30] [Kernel] An SELinux operation was run
 	BPF     BPFEvent     `field:"bpf" event:"bpf"`         // [7.33] [Kernel] A BPF command was executed
 
+	Connect ConnectEvent `field:"connect" event:"connect"` // [7.34] [Network] A connection was initiated
+	Bind    BindEvent    `field:"bind" event:"bind"`       // [7.34] [Network] A socket was bound to a local address
+	Accept  AcceptEvent  `field:"accept" event:"accept"`   // [7.34] [Network] A connection was accepted
+	DNS     DNSEvent     `field:"dns" event:"dns"`         // [7.34] [Network] A DNS request was sent
+
+	PTrace       PTraceEvent       `field:"ptrace" event:"ptrace"`               // [7.34] [Kernel] A ptrace command was executed
+	MMap         MMapEvent         `field:"mmap" event:"mmap"`                   // [7.34] [Kernel] A mmap command was executed
+	MProtect     MProtectEvent     `field:"mprotect" event:"mprotect"`           // [7.34] [Kernel] A mprotect command was executed
+	LoadModule   LoadModuleEvent   `field:"load_module" event:"load_module"`     // [7.34] [Kernel] A new kernel module was loaded
+	UnloadModule UnloadModuleEvent `field:"unload_module" event:"unload_module"` // [7.34] [Kernel] A kernel module was deleted
+	Signal       SignalEvent       `field:"signal" event:"signal"`               // [7.34] [Process] A signal was sent
+
 	Mount            MountEvent            `field:"-"`
 	Umount           UmountEvent           `field:"-"`
 	InvalidateDentry InvalidateDentryEvent `field:"-"`
@@ -598,3 +611,100 @@ type BPFProgram struct {
 	Helpers    []uint32 `field:"-,ResolveHelpers"` // eBPF helpers used by the eBPF program
 	Name       string   `field:"-"`                // Name of the eBPF program
 }
+
+// IPPortContext holds an IP address and a port
+type IPPortContext struct {
+	IPNet net.IPNet `field:"ip"`   // IP address
+	Port  uint16    `field:"port"` // Port number
+}
+
+// ConnectEvent represents a connect event
+type ConnectEvent struct {
+	SyscallEvent
+
+	Addr       IPPortContext `field:"addr"`        // Address the connection was initiated to
+	AddrFamily uint16        `field:"addr.family"` // Address family
+}
+
+// BindEvent represents a bind event
+type BindEvent struct {
+	SyscallEvent
+
+	Addr       IPPortContext `field:"addr"`        // Local address the socket was bound to
+	AddrFamily uint16        `field:"addr.family"` // Address family
+}
+
+// AcceptEvent represents an accept event
+type AcceptEvent struct {
+	SyscallEvent
+
+	Addr       IPPortContext `field:"addr"`        // Address of the peer of the accepted connection
+	AddrFamily uint16        `field:"addr.family"` // Address family
+}
+
+// DNSEvent represents a DNS request event
+type DNSEvent struct {
+	ID    uint16 `field:"id"`             // DNS request ID
+	Name  string `field:"question.name"`  // Queried domain name
+	Type  uint16 `field:"question.type"`  // Type of the question (A, AAAA, MX, ...)
+	Class uint16 `field:"question.class"` // Class of the question
+	Size  uint16 `field:"question.size"`  // Size of the DNS request, in bytes
+	Count uint16 `field:"question.count"` // Number of questions in the DNS request
+}
+
+// PTraceEvent represents a ptrace event
+type PTraceEvent struct {
+	SyscallEvent
+
+	Request uint32  `field:"request"` // ptrace request
+	PID     uint32  `field:"-"`
+	Address uint64  `field:"-"`
+	Tracee  Process `field:"tracee"` // Process context of the tracee
+}
+
+// MMapEvent represents a mmap event
+type MMapEvent struct {
+	SyscallEvent
+
+	File       FileEvent `field:"file"`
+	Addr       uint64    `field:"-"`
+	Offset     uint64    `field:"-"`
+	Len        uint32    `field:"-"`
+	Protection int       `field:"protection"` // Memory segment protection
+	Flags      int       `field:"flags"`      // Memory segment flags
+}
+
+// MProtectEvent represents a mprotect event
+type MProtectEvent struct {
+	SyscallEvent
+
+	VMStart       uint64 `field:"-"`
+	VMEnd         uint64 `field:"-"`
+	VMProtection  int    `field:"vm_protection"`  // Initial memory segment protection
+	ReqProtection int    `field:"req_protection"` // New memory segment protection
+}
+
+// LoadModuleEvent represents a load_module event
+type LoadModuleEvent struct {
+	SyscallEvent
+
+	File             FileEvent `field:"file"`               // Path to the kernel module file
+	LoadedFromMemory bool      `field:"loaded_from_memory"` // Indicates if the kernel module was loaded from memory
+	Name             string    `field:"name"`               // Name of the new kernel module
+}
+
+// UnloadModuleEvent represents an unload_module event
+type UnloadModuleEvent struct {
+	SyscallEvent
+
+	Name string `field:"name"` // Name of the kernel module that was deleted
+}
+
+// SignalEvent represents a signal event
+type SignalEvent struct {
+	SyscallEvent
+
+	Type   uint32  `field:"type"`   // Signal type (ex: SIGHUP, SIGINT, SIGQUIT, etc)
+	PID    uint32  `field:"pid"`    // Target PID
+	Target Process `field:"target"` // Process context of the signal target
+}
@@ -8,8 +8,13 @@
 package model
 
 import (
+	"encoding/binary"
+	"net"
+	"strings"
 	"time"
 	"unsafe"
+
+	"golang.org/x/sys/unix"
 )
 
 // BinaryUnmarshaler interface implemented by every event type
@@ -602,3 +607,235 @@ func parseHelpers(helpers []uint64) []uint32 {
 	}
 	return rep
 }
+
+// UnmarshalBinary unmarshalls a binary representation of itself
+func (e *ConnectEvent) UnmarshalBinary(data []byte) (int, error) {
+	return unmarshalNetworkAddress(data, &e.SyscallEvent, &e.Addr, &e.AddrFamily)
+}
+
+// UnmarshalBinary unmarshalls a binary representation of itself
+func (e *BindEvent) UnmarshalBinary(data []byte) (int, error) {
+	return unmarshalNetworkAddress(data, &e.SyscallEvent, &e.Addr, &e.AddrFamily)
+}
+
+// UnmarshalBinary unmarshalls a binary representation of itself
+func (e *AcceptEvent) UnmarshalBinary(data []byte) (int, error) {
+	return unmarshalNetworkAddress(data, &e.SyscallEvent, &e.Addr, &e.AddrFamily)
+}
+
+func unmarshalNetworkAddress(data []byte, syscallEvent *SyscallEvent, addr *IPPortContext, family *uint16) (int, error) {
+	read, err := UnmarshalBinary(data, syscallEvent)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(data)-read < 24 {
+		return 0, ErrNotEnoughData
+	}
+	data = data[read:]
+
+	*family = ByteOrder.Uint16(data[16:18])
+	// the port is kept in network byte order by the kernel
+	addr.Port = binary.BigEndian.Uint16(data[18:20])
+
+	switch *family {
+	case unix.AF_INET:
+		addr.IPNet = net.IPNet{IP: net.IP(append([]byte{}, data[0:4]...)), Mask: net.CIDRMask(32, 32)}
+	case unix.AF_INET6:
+		addr.IPNet = net.IPNet{IP: net.IP(append([]byte{}, data[0:16]...)), Mask: net.CIDRMask(128, 128)}
+	default:
+		addr.IPNet = net.IPNet{}
+	}
+
+	return read + 24, nil
+}
+
+// DNSHeaderLength is the length of the header of a DNS request
+const DNSHeaderLength = 12
+
+// UnmarshalBinary unmarshalls a binary representation of itself, the DNS request being decoded from the
+// payload captured by the kernel
+func (e *DNSEvent) UnmarshalBinary(data []byte) (int, error) {
+	if len(data) < 8 {
+		return 0, ErrNotEnoughData
+	}
+
+	e.Size = uint16(ByteOrder.Uint32(data[0:4]))
+	read := 8
+
+	if len(data[read:]) < int(DNSMaxLength) {
+		return 0, ErrNotEnoughData
+	}
+	payload := data[read : read+int(DNSMaxLength)]
+	read += int(DNSMaxLength)
+
+	e.ID = binary.BigEndian.Uint16(payload[0:2])
+	e.Count = binary.BigEndian.Uint16(payload[4:6])
+
+	name, n, err := decodeDNSName(payload[DNSHeaderLength:])
+	if err != nil {
+		return 0, err
+	}
+	e.Name = name
+
+	cursor := DNSHeaderLength + n
+	if len(payload) < cursor+4 {
+		return 0, ErrNotEnoughData
+	}
+	e.Type = binary.BigEndian.Uint16(payload[cursor : cursor+2])
+	e.Class = binary.BigEndian.Uint16(payload[cursor+2 : cursor+4])
+
+	return read, nil
+}
+
+// decodeDNSName decodes a domain name encoded as a sequence of length prefixed labels. Compressed names
+// aren't supported as they're not used in questions.
+func decodeDNSName(data []byte) (string, int, error) {
+	var labels []string
+
+	cursor := 0
+	for {
+		if cursor >= len(data) {
+			return "", 0, ErrDNSNameMalformatted
+		}
+
+		length := int(data[cursor])
+		cursor++
+
+		if length == 0 {
+			break
+		}
+
+		// label lengths are limited to 63, higher values are compression pointers
+		if length > 63 || cursor+length > len(data) {
+			return "", 0, ErrDNSNameMalformatted
+		}
+
+		label := data[cursor : cursor+length]
+		for _, c := range label {
+			if c < 0x21 || c > 0x7e {
+				return "", 0, ErrNonPrintable
+			}
+		}
+		labels = append(labels, string(label))
+		cursor += length
+	}
+
+	return strings.Join(labels, "."), cursor, nil
+}
+
+// UnmarshalBinary unmarshalls a binary representation of itself
+func (e *PTraceEvent) UnmarshalBinary(data []byte) (int, error) {
+	read, err := UnmarshalBinary(data, &e.SyscallEvent)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(data)-read < 16 {
+		return 0, ErrNotEnoughData
+	}
+
+	e.Request = ByteOrder.Uint32(data[read : read+4])
+	e.PID = ByteOrder.Uint32(data[read+4 : read+8])
+	e.Address = ByteOrder.Uint64(data[read+8 : read+16])
+	return read + 16, nil
+}
+
+// UnmarshalBinary unmarshalls a binary representation of itself
+func (e *MMapEvent) UnmarshalBinary(data []byte) (int, error) {
+	read, err := UnmarshalBinary(data, &e.SyscallEvent, &e.File)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(data)-read < 32 {
+		return 0, ErrNotEnoughData
+	}
+
+	e.Addr = ByteOrder.Uint64(data[read : read+8])
+	e.Offset = ByteOrder.Uint64(data[read+8 : read+16])
+	e.Len = ByteOrder.Uint32(data[read+16 : read+20])
+	e.Protection = int(ByteOrder.Uint32(data[read+20 : read+24]))
+	e.Flags = int(ByteOrder.Uint32(data[read+24 : read+28]))
+
+	// +4 for padding
+
+	return read + 32, nil
+}
+
+// UnmarshalBinary unmarshalls a binary representation of itself
+func (e *MProtectEvent) UnmarshalBinary(data []byte) (int, error) {
+	read, err := UnmarshalBinary(data, &e.SyscallEvent)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(data)-read < 24 {
+		return 0, ErrNotEnoughData
+	}
+
+	e.VMStart = ByteOrder.Uint64(data[read : read+8])
+	e.VMEnd = ByteOrder.Uint64(data[read+8 : read+16])
+	e.VMProtection = int(ByteOrder.Uint32(data[read+16 : read+20]))
+	e.ReqProtection = int(ByteOrder.Uint32(data[read+20 : read+24]))
+	return read + 24, nil
+}
+
+// UnmarshalBinary unmarshalls a binary representation of itself
+func (e *LoadModuleEvent) UnmarshalBinary(data []byte) (int, error) {
+	read, err := UnmarshalBinary(data, &e.SyscallEvent, &e.File)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(data)-read < ModuleNameLength+8 {
+		return 0, ErrNotEnoughData
+	}
+
+	e.Name, err = UnmarshalString(data[read:read+ModuleNameLength], ModuleNameLength)
+	if err != nil {
+		return 0, err
+	}
+	read += ModuleNameLength
+
+	e.LoadedFromMemory = ByteOrder.Uint32(data[read:read+4]) == uint32(1)
+
+	// +4 for padding
+
+	return read + 8, nil
+}
+
+// UnmarshalBinary unmarshalls a binary representation of itself
+func (e *UnloadModuleEvent) UnmarshalBinary(data []byte) (int, error) {
+	read, err := UnmarshalBinary(data, &e.SyscallEvent)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(data)-read < ModuleNameLength {
+		return 0, ErrNotEnoughData
+	}
+
+	e.Name, err = UnmarshalString(data[read:read+ModuleNameLength], ModuleNameLength)
+	if err != nil {
+		return 0, err
+	}
+
+	return read + ModuleNameLength, nil
+}
+
+// UnmarshalBinary unmarshalls a binary representation of itself
+func (e *SignalEvent) UnmarshalBinary(data []byte) (int, error) {
+	read, err := UnmarshalBinary(data, &e.SyscallEvent)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(data)-read < 8 {
+		return 0, ErrNotEnoughData
+	}
+
+	e.PID = ByteOrder.Uint32(data[read : read+4])
+	e.Type = ByteOrder.Uint32(data[read+4 : read+8])
+	return read + 8, nil
+}
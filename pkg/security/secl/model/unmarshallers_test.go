@@ -0,0 +1,89 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// +build linux
+
+package model
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDNSEventUnmarshalBinary(t *testing.T) {
+	question := []byte{
+		0x12, 0x34, // ID
+		0x01, 0x00, // flags
+		0x00, 0x01, // questions
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		4, 'p', 'o', 'o', 'l', 7, 'e', 'x', 'a', 'm', 'p', 'l', 'e', 3, 'c', 'o', 'm', 0,
+		0x00, 0x1c, // AAAA
+		0x00, 0x01, // IN
+	}
+
+	data := make([]byte, 8+int(DNSMaxLength))
+	ByteOrder.PutUint32(data[0:4], uint32(len(question)))
+	copy(data[8:], question)
+
+	var event DNSEvent
+	read, err := event.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, len(data), read)
+	assert.Equal(t, uint16(0x1234), event.ID)
+	assert.Equal(t, uint16(1), event.Count)
+	assert.Equal(t, "pool.example.com", event.Name)
+	assert.Equal(t, "AAAA", QType(event.Type).String())
+	assert.Equal(t, "CLASS_INET", QClass(event.Class).String())
+	assert.Equal(t, uint16(len(question)), event.Size)
+
+	// compression pointers aren't expected in questions
+	copy(data[8+12:], []byte{0xc0, 0x0c})
+	_, err = event.UnmarshalBinary(data)
+	assert.Equal(t, ErrDNSNameMalformatted, err)
+}
+
+func TestConnectEventUnmarshalBinary(t *testing.T) {
+	data := make([]byte, 8+24)
+	copy(data[8:], []byte{10, 0, 0, 1})
+	ByteOrder.PutUint16(data[8+16:], AddressFamilyConstants["AF_INET"])
+	binary.BigEndian.PutUint16(data[8+18:], 443)
+
+	var event ConnectEvent
+	if _, err := event.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "10.0.0.1/32", event.Addr.IPNet.String())
+	assert.Equal(t, uint16(443), event.Addr.Port)
+	assert.Equal(t, "AF_INET", AddressFamily(event.AddrFamily).String())
+}
+
+func TestLoadModuleEventUnmarshalBinary(t *testing.T) {
+	data := make([]byte, 8+72+ModuleNameLength+8)
+	ByteOrder.PutUint64(data[0:8], 0)
+	ByteOrder.PutUint64(data[8:16], 42) // inode
+	copy(data[80:], "rootkit")
+	ByteOrder.PutUint32(data[80+ModuleNameLength:], 1)
+
+	var event LoadModuleEvent
+	read, err := event.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, len(data), read)
+	assert.Equal(t, uint64(42), event.File.Inode)
+	assert.Equal(t, "rootkit", event.Name)
+	assert.True(t, event.LoadedFromMemory)
+
+	if _, err := event.UnmarshalBinary(data[:len(data)-1]); err != ErrNotEnoughData {
+		t.Errorf("expected an error, got %v", err)
+	}
+}
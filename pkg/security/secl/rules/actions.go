@@ -0,0 +1,297 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
+)
+
+// Scope describes the scope of a variable set by a rule action
+type Scope string
+
+const (
+	// ScopeProcess variables are bound to a process and released when it exits
+	ScopeProcess Scope = "process"
+	// ScopeContainer variables are bound to a container and released when its last process exits
+	ScopeContainer Scope = "container"
+)
+
+// VariableScope returns the key of the scope of a variable for the evaluated event, an empty
+// key meaning that the event doesn't belong to the scope
+type VariableScope func(ctx *eval.Context) string
+
+var variableNamePattern = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
+
+// ActionDefinition holds the definition of an action executed when a rule matches. Exactly
+// one of its fields is set.
+type ActionDefinition struct {
+	Set       *SetDefinition       `yaml:"set"`
+	Kill      *KillDefinition      `yaml:"kill"`
+	RateLimit *RateLimitDefinition `yaml:"rate_limit"`
+}
+
+// SetDefinition describes an action setting a scoped variable, readable by the other
+// rules as `${<scope>.<name>}`. The value defaults to 1.
+type SetDefinition struct {
+	Name  string      `yaml:"name"`
+	Value interface{} `yaml:"value"`
+	Scope Scope       `yaml:"scope"`
+}
+
+// VariableName returns the name of the variable in rule expressions
+func (sd *SetDefinition) VariableName() string {
+	return string(sd.Scope) + "." + sd.Name
+}
+
+// KillDefinition describes an action sending a signal to the process of the event. The
+// signal defaults to SIGKILL.
+type KillDefinition struct {
+	Signal string `yaml:"signal"`
+}
+
+// RateLimitDefinition describes the maximum number of events sent for a rule per period.
+// The period defaults to one second and the burst to the limit.
+type RateLimitDefinition struct {
+	Limit  int           `yaml:"limit"`
+	Period time.Duration `yaml:"period"`
+	Burst  int           `yaml:"burst"`
+}
+
+// Check validates the action definition and sets its default values
+func (ad *ActionDefinition) Check() error {
+	count := 0
+	if ad.Set != nil {
+		count++
+	}
+	if ad.Kill != nil {
+		count++
+	}
+	if ad.RateLimit != nil {
+		count++
+	}
+	if count != 1 {
+		return errors.New("an action must define exactly one of `set`, `kill` or `rate_limit`")
+	}
+
+	switch {
+	case ad.Set != nil:
+		if !variableNamePattern.MatchString(ad.Set.Name) {
+			return fmt.Errorf("invalid variable name `%s`", ad.Set.Name)
+		}
+
+		if ad.Set.Scope != ScopeProcess && ad.Set.Scope != ScopeContainer {
+			return fmt.Errorf("invalid scope `%s` for variable `%s`", ad.Set.Scope, ad.Set.Name)
+		}
+
+		switch ad.Set.Value.(type) {
+		case nil:
+			ad.Set.Value = 1
+		case int, string:
+		default:
+			return fmt.Errorf("invalid value `%v` for variable `%s`, only integers and strings are supported", ad.Set.Value, ad.Set.Name)
+		}
+	case ad.Kill != nil:
+		if ad.Kill.Signal == "" {
+			ad.Kill.Signal = "SIGKILL"
+		}
+	case ad.RateLimit != nil:
+		if ad.RateLimit.Limit <= 0 {
+			return errors.New("the rate limit must be positive")
+		}
+
+		if ad.RateLimit.Period < 0 {
+			return errors.New("the rate limit period must be positive")
+		}
+
+		if ad.RateLimit.Period == 0 {
+			ad.RateLimit.Period = time.Second
+		}
+
+		if ad.RateLimit.Burst <= 0 {
+			ad.RateLimit.Burst = ad.RateLimit.Limit
+		}
+	}
+
+	return nil
+}
+
+// GetRateLimit returns the rate limit of the rule, if any
+func (rd *RuleDefinition) GetRateLimit() *RateLimitDefinition {
+	for _, action := range rd.Actions {
+		if action.RateLimit != nil {
+			return action.RateLimit
+		}
+	}
+	return nil
+}
+
+// VariableStore holds the values of the variables set by rule actions, by scope
+type VariableStore struct {
+	sync.RWMutex
+	values map[Scope]map[string]map[string]interface{}
+}
+
+// NewVariableStore returns a new, empty, variable store
+func NewVariableStore() *VariableStore {
+	return &VariableStore{
+		values: make(map[Scope]map[string]map[string]interface{}),
+	}
+}
+
+// Set sets the value of a variable for the given scope key
+func (vs *VariableStore) Set(scope Scope, key string, name string, value interface{}) {
+	vs.Lock()
+	defer vs.Unlock()
+
+	keys, exists := vs.values[scope]
+	if !exists {
+		keys = make(map[string]map[string]interface{})
+		vs.values[scope] = keys
+	}
+
+	variables, exists := keys[key]
+	if !exists {
+		variables = make(map[string]interface{})
+		keys[key] = variables
+	}
+
+	variables[name] = value
+}
+
+// Get returns the value of a variable for the given scope key
+func (vs *VariableStore) Get(scope Scope, key string, name string) (interface{}, bool) {
+	vs.RLock()
+	defer vs.RUnlock()
+
+	value, exists := vs.values[scope][key][name]
+	return value, exists
+}
+
+// HasScope returns whether variables are set for the given scope key
+func (vs *VariableStore) HasScope(scope Scope, key string) bool {
+	vs.RLock()
+	defer vs.RUnlock()
+
+	_, exists := vs.values[scope][key]
+	return exists
+}
+
+// ReleaseScope removes the variables of the given scope key, when a process exits for example
+func (vs *VariableStore) ReleaseScope(scope Scope, key string) {
+	vs.Lock()
+	defer vs.Unlock()
+
+	delete(vs.values[scope], key)
+}
+
+// checkActions validates the actions of a rule definition
+func (rs *RuleSet) checkActions(ruleDef *RuleDefinition) error {
+	rateLimits := 0
+	for _, action := range ruleDef.Actions {
+		if err := action.Check(); err != nil {
+			return err
+		}
+
+		if action.Kill != nil {
+			if _, ok := rs.opts.Signals[action.Kill.Signal]; !ok {
+				return fmt.Errorf("unknown signal `%s`", action.Kill.Signal)
+			}
+		}
+
+		if action.RateLimit != nil {
+			if rateLimits++; rateLimits > 1 {
+				return errors.New("multiple rate limits defined")
+			}
+		}
+	}
+
+	return nil
+}
+
+// addActionVariables registers the variables set by the actions of the rules, so that
+// they can be read by any rule of the ruleset
+func (rs *RuleSet) addActionVariables(ruleDef *RuleDefinition) error {
+	for _, action := range ruleDef.Actions {
+		if action.Set == nil {
+			continue
+		}
+
+		name := action.Set.VariableName()
+		_, isString := action.Set.Value.(string)
+
+		if existing, exists := rs.actionVariables[name]; exists {
+			if existing != isString {
+				return fmt.Errorf("variable `%s` is set with different types", name)
+			}
+			continue
+		}
+
+		if _, exists := rs.opts.Variables[name]; exists {
+			return fmt.Errorf("variable `%s` conflicts with a builtin variable", name)
+		}
+
+		scopeFnc, exists := rs.opts.VariableScopes[action.Set.Scope]
+		if !exists {
+			return fmt.Errorf("scope `%s` of variable `%s` is not supported", action.Set.Scope, name)
+		}
+
+		scope, varName, variables := action.Set.Scope, action.Set.Name, rs.variables
+		if isString {
+			rs.opts.Variables[name] = eval.VariableValue{
+				StringFnc: func(ctx *eval.Context) string {
+					value, _ := variables.Get(scope, scopeFnc(ctx), varName)
+					s, _ := value.(string)
+					return s
+				},
+			}
+		} else {
+			rs.opts.Variables[name] = eval.VariableValue{
+				IntFnc: func(ctx *eval.Context) int {
+					value, _ := variables.Get(scope, scopeFnc(ctx), varName)
+					i, _ := value.(int)
+					return i
+				},
+			}
+		}
+		rs.actionVariables[name] = isString
+	}
+
+	return nil
+}
+
+// applySetActions sets the variables of the set actions of a rule that matched
+func (rs *RuleSet) applySetActions(rule *Rule, ctx *eval.Context) {
+	if rule.Definition == nil {
+		return
+	}
+
+	for _, action := range rule.Definition.Actions {
+		if action.Set == nil {
+			continue
+		}
+
+		scopeFnc, exists := rs.opts.VariableScopes[action.Set.Scope]
+		if !exists {
+			continue
+		}
+
+		if key := scopeFnc(ctx); key != "" {
+			rs.variables.Set(action.Set.Scope, key, action.Set.Name, action.Set.Value)
+		}
+	}
+}
+
+// GetVariableStore returns the store of the variables set by the rule actions
+func (rs *RuleSet) GetVariableStore() *VariableStore {
+	return rs.variables
+}
@@ -0,0 +1,187 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package rules
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
+)
+
+func newActionTestRuleSet() *RuleSet {
+	enabled := map[eval.EventType]bool{"*": true}
+	opts := NewOptsWithParams(testConstants, nil, testSupportedDiscarders, enabled, nil, nil)
+	opts.VariableScopes = map[Scope]VariableScope{
+		ScopeProcess: func(ctx *eval.Context) string {
+			return strconv.Itoa((*testEvent)(ctx.Object).process.uid)
+		},
+	}
+	opts.Signals = map[string]int{"SIGKILL": 9, "SIGTERM": 15}
+
+	return NewRuleSet(&testModel{}, func() eval.Event { return &testEvent{} }, opts)
+}
+
+func TestActionCheck(t *testing.T) {
+	tests := []struct {
+		name   string
+		action *ActionDefinition
+		err    bool
+	}{
+		{name: "empty", action: &ActionDefinition{}, err: true},
+		{name: "set and kill", action: &ActionDefinition{Set: &SetDefinition{Name: "a", Scope: ScopeProcess}, Kill: &KillDefinition{}}, err: true},
+		{name: "set", action: &ActionDefinition{Set: &SetDefinition{Name: "a", Scope: ScopeProcess}}},
+		{name: "set invalid name", action: &ActionDefinition{Set: &SetDefinition{Name: "a.b", Scope: ScopeProcess}}, err: true},
+		{name: "set invalid scope", action: &ActionDefinition{Set: &SetDefinition{Name: "a", Scope: "host"}}, err: true},
+		{name: "set invalid value", action: &ActionDefinition{Set: &SetDefinition{Name: "a", Scope: ScopeProcess, Value: 1.5}}, err: true},
+		{name: "kill", action: &ActionDefinition{Kill: &KillDefinition{}}},
+		{name: "rate limit", action: &ActionDefinition{RateLimit: &RateLimitDefinition{Limit: 2}}},
+		{name: "invalid rate limit", action: &ActionDefinition{RateLimit: &RateLimitDefinition{}}, err: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := test.action.Check(); (err != nil) != test.err {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+
+	set := &ActionDefinition{Set: &SetDefinition{Name: "a", Scope: ScopeProcess}}
+	_ = set.Check()
+	if set.Set.Value != 1 {
+		t.Errorf("expected a default value of 1, got %v", set.Set.Value)
+	}
+
+	kill := &ActionDefinition{Kill: &KillDefinition{}}
+	_ = kill.Check()
+	if kill.Kill.Signal != "SIGKILL" {
+		t.Errorf("expected SIGKILL by default, got %s", kill.Kill.Signal)
+	}
+
+	rateLimit := &ActionDefinition{RateLimit: &RateLimitDefinition{Limit: 2}}
+	_ = rateLimit.Check()
+	if rateLimit.RateLimit.Period != time.Second || rateLimit.RateLimit.Burst != 2 {
+		t.Errorf("unexpected default rate limit: %+v", rateLimit.RateLimit)
+	}
+}
+
+func TestSetActions(t *testing.T) {
+	rs := newActionTestRuleSet()
+
+	ruleDefs := []*RuleDefinition{
+		{
+			ID:         "suspicious_create",
+			Expression: `open.filename =~ "/tmp/*" && open.flags & O_CREAT > 0`,
+			Actions: []*ActionDefinition{
+				{Set: &SetDefinition{Name: "suspicious", Scope: ScopeProcess}},
+				{Set: &SetDefinition{Name: "created", Scope: ScopeProcess, Value: "tmp"}},
+			},
+		},
+		{
+			ID:         "suspicious_mkdir",
+			Expression: `mkdir.filename =~ "/etc/*" && ${process.suspicious} == 1 && "${process.created}" == "tmp"`,
+		},
+	}
+	if err := rs.AddRules(ruleDefs); err.ErrorOrNil() != nil {
+		t.Fatal(err)
+	}
+
+	suite, err := LoadTestSuite(strings.NewReader(`
+tests:
+  - name: mkdir before the creation
+    event: {type: mkdir, fields: {mkdir.filename: /etc/test, process.uid: 1000}}
+  - name: creation
+    event: {type: open, fields: {open.filename: /tmp/test, open.flags: O_CREAT, process.uid: 1000}}
+    expect: [suspicious_create]
+  - name: mkdir by another process
+    event: {type: mkdir, fields: {mkdir.filename: /etc/test, process.uid: 1001}}
+  - name: mkdir after the creation
+    event: {type: mkdir, fields: {mkdir.filename: /etc/test, process.uid: 1000}}
+    expect: [suspicious_mkdir]
+`), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, result := range rs.RunTestSuite(suite, newTestEventOfType) {
+		if !result.Passed() {
+			t.Errorf("%s: %+v", result.Case.Name, result)
+		}
+	}
+
+	if !rs.GetVariableStore().HasScope(ScopeProcess, "1000") {
+		t.Error("the variables of the scope should have been set")
+	}
+	rs.GetVariableStore().ReleaseScope(ScopeProcess, "1000")
+	if rs.GetVariableStore().HasScope(ScopeProcess, "1000") {
+		t.Error("the scope should have been released")
+	}
+	if _, exists := rs.GetVariableStore().Get(ScopeProcess, "1000", "suspicious"); exists {
+		t.Error("the variables of the scope should have been released")
+	}
+}
+
+func TestKillAction(t *testing.T) {
+	rs := newActionTestRuleSet()
+	if _, err := rs.AddRule(&RuleDefinition{
+		ID:         "test",
+		Expression: `open.filename == "/etc/passwd"`,
+		Actions:    []*ActionDefinition{{Kill: &KillDefinition{Signal: "SIGTERM"}}},
+	}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestActionErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		actions []*ActionDefinition
+	}{
+		{
+			name:    "unknown signal",
+			actions: []*ActionDefinition{{Kill: &KillDefinition{Signal: "SIGUNKNOWN"}}},
+		},
+		{
+			name:    "constant not a signal",
+			actions: []*ActionDefinition{{Kill: &KillDefinition{Signal: "O_CREAT"}}},
+		},
+		{
+			name: "multiple rate limits",
+			actions: []*ActionDefinition{
+				{RateLimit: &RateLimitDefinition{Limit: 1}},
+				{RateLimit: &RateLimitDefinition{Limit: 2}},
+			},
+		},
+		{
+			name:    "unsupported scope",
+			actions: []*ActionDefinition{{Set: &SetDefinition{Name: "a", Scope: ScopeContainer}}},
+		},
+		{
+			name: "type conflict",
+			actions: []*ActionDefinition{
+				{Set: &SetDefinition{Name: "a", Scope: ScopeProcess, Value: 1}},
+				{Set: &SetDefinition{Name: "a", Scope: ScopeProcess, Value: "a"}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rs := newActionTestRuleSet()
+			_, err := rs.AddRule(&RuleDefinition{
+				ID:         "test",
+				Expression: `open.filename == "/etc/passwd"`,
+				Actions:    test.actions,
+			})
+			if err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
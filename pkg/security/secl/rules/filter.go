@@ -0,0 +1,221 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package rules
+
+import (
+	"fmt"
+	"reflect"
+	"unsafe"
+
+	"github.com/Masterminds/semver"
+	"github.com/pkg/errors"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
+)
+
+// RuleFilter filters the rules and the macros of the policies before they're added to a ruleset
+type RuleFilter interface {
+	IsRuleAccepted(rule *RuleDefinition) (bool, error)
+	IsMacroAccepted(macro *MacroDefinition) (bool, error)
+}
+
+// AgentVersionFilter accepts the rules and macros whose `agent_version` constraint, if any,
+// is satisfied by the version of the agent
+type AgentVersionFilter struct {
+	version *semver.Version
+}
+
+// NewAgentVersionFilter returns a new agent version filter
+func NewAgentVersionFilter(version string) (*AgentVersionFilter, error) {
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid agent version `%s`", version)
+	}
+
+	// pre-release versions, such as release candidates, are filtered as the release itself
+	if v.Prerelease() != "" {
+		stripped, err := v.SetPrerelease("")
+		if err != nil {
+			return nil, err
+		}
+		v = &stripped
+	}
+
+	return &AgentVersionFilter{version: v}, nil
+}
+
+func (f *AgentVersionFilter) isAccepted(constraint string) (bool, error) {
+	if constraint == "" {
+		return true, nil
+	}
+
+	c, err := semver.NewConstraint(constraint)
+	if err != nil {
+		return false, errors.Wrapf(err, "invalid agent version constraint `%s`", constraint)
+	}
+
+	return c.Check(f.version), nil
+}
+
+// IsRuleAccepted checks whether the rule can be loaded by this version of the agent
+func (f *AgentVersionFilter) IsRuleAccepted(rule *RuleDefinition) (bool, error) {
+	return f.isAccepted(rule.AgentVersionConstraint)
+}
+
+// IsMacroAccepted checks whether the macro can be loaded by this version of the agent
+func (f *AgentVersionFilter) IsMacroAccepted(macro *MacroDefinition) (bool, error) {
+	return f.isAccepted(macro.AgentVersionConstraint)
+}
+
+// SECLRuleFilter accepts the rules and macros whose `filters` are all true. The filters are
+// SECL expressions evaluated against static fields, describing the kernel features of the
+// host for example.
+type SECLRuleFilter struct {
+	model *filterModel
+	opts  *eval.Opts
+}
+
+// NewSECLRuleFilter returns a new SECL filter for the given fields. Only integer, string and
+// boolean values are supported.
+func NewSECLRuleFilter(fields map[eval.Field]interface{}) (*SECLRuleFilter, error) {
+	for field, value := range fields {
+		switch value.(type) {
+		case int, string, bool:
+		default:
+			return nil, fmt.Errorf("unsupported value `%v` for filter field `%s`", value, field)
+		}
+	}
+
+	return &SECLRuleFilter{
+		model: &filterModel{fields: fields},
+		opts: &eval.Opts{
+			Constants: make(map[string]interface{}),
+			Variables: make(map[string]eval.VariableValue),
+			Macros:    make(map[eval.MacroID]*eval.Macro),
+		},
+	}, nil
+}
+
+func (f *SECLRuleFilter) isAccepted(filters []string) (bool, error) {
+	if len(filters) == 0 {
+		return true, nil
+	}
+
+	ctx := eval.NewContext(nil)
+	for _, filter := range filters {
+		rule := &eval.Rule{
+			ID:         filter,
+			Expression: filter,
+		}
+
+		if err := rule.Parse(); err != nil {
+			return false, errors.Wrapf(err, "invalid filter `%s`", filter)
+		}
+
+		if err := rule.GenEvaluator(f.model, f.opts); err != nil {
+			return false, errors.Wrapf(err, "invalid filter `%s`", filter)
+		}
+
+		if !rule.Eval(ctx) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// IsRuleAccepted checks whether the filters of the rule are all true
+func (f *SECLRuleFilter) IsRuleAccepted(rule *RuleDefinition) (bool, error) {
+	return f.isAccepted(rule.Filters)
+}
+
+// IsMacroAccepted checks whether the filters of the macro are all true
+func (f *SECLRuleFilter) IsMacroAccepted(macro *MacroDefinition) (bool, error) {
+	return f.isAccepted(macro.Filters)
+}
+
+// filterModel exposes static fields to the SECL filters
+type filterModel struct {
+	fields map[eval.Field]interface{}
+}
+
+func (m *filterModel) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Evaluator, error) {
+	switch value := m.fields[field].(type) {
+	case int:
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int { return value },
+			Field:   field,
+		}, nil
+	case string:
+		return &eval.StringEvaluator{
+			EvalFnc: func(ctx *eval.Context) string { return value },
+			Field:   field,
+		}, nil
+	case bool:
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool { return value },
+			Field:   field,
+		}, nil
+	}
+
+	return nil, &eval.ErrFieldNotFound{Field: field}
+}
+
+func (m *filterModel) ValidateField(field eval.Field, value eval.FieldValue) error {
+	return nil
+}
+
+func (m *filterModel) GetIterator(field eval.Field) (eval.Iterator, error) {
+	return nil, &eval.ErrIteratorNotSupported{Field: field}
+}
+
+func (m *filterModel) NewEvent() eval.Event {
+	return &filterEvent{model: m}
+}
+
+// filterEvent is the event of the filter model. Its fields don't belong to any event type.
+type filterEvent struct {
+	model *filterModel
+}
+
+func (e *filterEvent) GetType() eval.EventType {
+	return "*"
+}
+
+func (e *filterEvent) GetFieldEventType(field eval.Field) (eval.EventType, error) {
+	if _, exists := e.model.fields[field]; !exists {
+		return "", &eval.ErrFieldNotFound{Field: field}
+	}
+	return "*", nil
+}
+
+func (e *filterEvent) SetFieldValue(field eval.Field, value interface{}) error {
+	return &eval.ErrNotSupported{Field: field}
+}
+
+func (e *filterEvent) GetFieldValue(field eval.Field) (interface{}, error) {
+	value, exists := e.model.fields[field]
+	if !exists {
+		return nil, &eval.ErrFieldNotFound{Field: field}
+	}
+	return value, nil
+}
+
+func (e *filterEvent) GetFieldType(field eval.Field) (reflect.Kind, error) {
+	value, exists := e.model.fields[field]
+	if !exists {
+		return reflect.Invalid, &eval.ErrFieldNotFound{Field: field}
+	}
+	return reflect.TypeOf(value).Kind(), nil
+}
+
+func (e *filterEvent) GetPointer() unsafe.Pointer {
+	return unsafe.Pointer(e)
+}
+
+func (e *filterEvent) GetTags() []string {
+	return nil
+}
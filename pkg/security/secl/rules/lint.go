@@ -0,0 +1,283 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package rules
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/ast"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
+)
+
+// LintKind describes the kind of issue reported by the linter
+type LintKind string
+
+const (
+	// LintUnreachable is reported for rules that can't match any event
+	LintUnreachable LintKind = "unreachable"
+	// LintNoApprover is reported for rules without approver, which disable the in-kernel
+	// filtering of their event type
+	LintNoApprover LintKind = "no_approver"
+	// LintMacroCycle is reported for macros referencing themselves
+	LintMacroCycle LintKind = "macro_cycle"
+)
+
+// LintIssue describes an issue found in a rule or a macro
+type LintIssue struct {
+	Kind    LintKind
+	ID      string
+	Message string
+}
+
+func (li *LintIssue) String() string {
+	return fmt.Sprintf("%s: `%s`: %s", li.Kind, li.ID, li.Message)
+}
+
+// LintRules looks for rules that can't match any event and for rules without approver
+// for the given field capabilities
+func (rs *RuleSet) LintRules(fieldCaps map[eval.EventType]FieldCapabilities) []*LintIssue {
+	var issues []*LintIssue
+
+	ids := rs.ListRuleIDs()
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		rule := rs.rules[id]
+
+		if unreachable, err := rs.isUnreachable(rule); err == nil && unreachable {
+			issues = append(issues, &LintIssue{
+				Kind:    LintUnreachable,
+				ID:      id,
+				Message: "the expression can't be true for any value of its fields",
+			})
+			continue
+		}
+
+		eventType := rule.GetEvaluator().EventTypes[0]
+		caps, exists := fieldCaps[eventType]
+		if !exists {
+			continue
+		}
+
+		bucket := &RuleBucket{}
+		if err := bucket.AddRule(rule); err != nil {
+			continue
+		}
+
+		if _, err := bucket.GetApprovers(rs.eventCtor(), caps); err != nil {
+			issues = append(issues, &LintIssue{
+				Kind:    LintNoApprover,
+				ID:      id,
+				Message: fmt.Sprintf("%s, `%s` events won't be filtered in kernel", err, eventType),
+			})
+		}
+	}
+
+	return issues
+}
+
+// isUnreachable returns whether no entry of the truth table of the rule is true. Rules
+// comparing fields without static value are never reported as the truth table only
+// holds a placeholder value for those fields.
+func (rs *RuleSet) isUnreachable(rule *Rule) (bool, error) {
+	fieldValues := rule.GetEvaluator().FieldValues
+	if len(fieldValues) == 0 {
+		return false, nil
+	}
+
+	for _, values := range fieldValues {
+		if len(values) == 0 {
+			return false, nil
+		}
+	}
+
+	// variables are not part of the truth table
+	if rs.usesVariables(rule.Expression, make(map[MacroID]bool)) {
+		return false, nil
+	}
+
+	truthTable, err := newTruthTable(rule.Rule, rs.eventCtor())
+	if err != nil {
+		return false, err
+	}
+
+	for _, entry := range truthTable.Entries {
+		if entry.Result {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// usesVariables returns whether an expression, or one of the macros it references, reads variables
+func (rs *RuleSet) usesVariables(expression string, visited map[MacroID]bool) bool {
+	if strings.Contains(expression, "${") {
+		return true
+	}
+
+	macro, err := ast.ParseMacro(expression)
+	if err != nil {
+		return false
+	}
+
+	uses := false
+	walkMacroIdents(macro, func(ident string) {
+		if m, exists := rs.opts.Macros[ident]; exists && !visited[ident] {
+			visited[ident] = true
+			uses = uses || rs.usesVariables(m.Expression, visited)
+		}
+	})
+
+	return uses
+}
+
+// LintMacroCycles looks for macros referencing themselves, directly or through other macros.
+// Such macros can't be compiled.
+func LintMacroCycles(macros []*MacroDefinition) []*LintIssue {
+	deps := make(map[MacroID][]MacroID)
+	for _, macroDef := range macros {
+		deps[macroDef.ID] = nil
+	}
+
+	for _, macroDef := range macros {
+		macro, err := ast.ParseMacro(macroDef.Expression)
+		if err != nil {
+			continue
+		}
+
+		var idents []string
+		walkMacroIdents(macro, func(ident string) {
+			idents = append(idents, ident)
+		})
+
+		for _, ident := range idents {
+			if _, isMacro := deps[ident]; isMacro {
+				deps[macroDef.ID] = append(deps[macroDef.ID], ident)
+			}
+		}
+	}
+
+	ids := make([]MacroID, 0, len(deps))
+	for id := range deps {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	var (
+		issues []*LintIssue
+		path   []MacroID
+		visit  func(id MacroID)
+	)
+	states := make(map[MacroID]int)
+
+	visit = func(id MacroID) {
+		states[id] = visiting
+		path = append(path, id)
+
+		for _, dep := range deps[id] {
+			switch states[dep] {
+			case unvisited:
+				visit(dep)
+			case visiting:
+				// the cycle starts at the first occurrence of the dependency in the path
+				var cycle []MacroID
+				for i, p := range path {
+					if p == dep {
+						cycle = append(cycle, path[i:]...)
+						break
+					}
+				}
+				cycle = append(cycle, dep)
+
+				issues = append(issues, &LintIssue{
+					Kind:    LintMacroCycle,
+					ID:      dep,
+					Message: strings.Join(cycle, " -> "),
+				})
+			}
+		}
+
+		path = path[:len(path)-1]
+		states[id] = visited
+	}
+
+	for _, id := range ids {
+		if states[id] == unvisited {
+			visit(id)
+		}
+	}
+
+	return issues
+}
+
+func walkMacroIdents(macro *ast.Macro, fnc func(ident string)) {
+	walkExpressionIdents(macro.Expression, fnc)
+	walkArrayIdents(macro.Array, fnc)
+	walkPrimaryIdents(macro.Primary, fnc)
+}
+
+func walkExpressionIdents(expr *ast.Expression, fnc func(ident string)) {
+	if expr == nil {
+		return
+	}
+
+	walkComparisonIdents(expr.Comparison, fnc)
+	if expr.Next != nil {
+		walkExpressionIdents(expr.Next.Expression, fnc)
+	}
+}
+
+func walkComparisonIdents(comparison *ast.Comparison, fnc func(ident string)) {
+	if comparison == nil {
+		return
+	}
+
+	walkBitOperationIdents(comparison.BitOperation, fnc)
+	if comparison.ScalarComparison != nil {
+		walkComparisonIdents(comparison.ScalarComparison.Next, fnc)
+	}
+	if comparison.ArrayComparison != nil {
+		walkArrayIdents(comparison.ArrayComparison.Array, fnc)
+	}
+}
+
+func walkBitOperationIdents(bitOperation *ast.BitOperation, fnc func(ident string)) {
+	for ; bitOperation != nil; bitOperation = bitOperation.Next {
+		unary := bitOperation.Unary
+		for unary != nil && unary.Unary != nil {
+			unary = unary.Unary
+		}
+		if unary != nil {
+			walkPrimaryIdents(unary.Primary, fnc)
+		}
+	}
+}
+
+func walkPrimaryIdents(primary *ast.Primary, fnc func(ident string)) {
+	if primary == nil {
+		return
+	}
+
+	if primary.Ident != nil {
+		fnc(*primary.Ident)
+	}
+	walkExpressionIdents(primary.SubExpression, fnc)
+}
+
+func walkArrayIdents(array *ast.Array, fnc func(ident string)) {
+	if array != nil && array.Ident != nil {
+		fnc(*array.Ident)
+	}
+}
@@ -0,0 +1,95 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package rules
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
+)
+
+func TestLintRules(t *testing.T) {
+	enabled := map[eval.EventType]bool{"*": true}
+	rs := NewRuleSet(&testModel{}, func() eval.Event { return &testEvent{} }, NewOptsWithParams(testConstants, nil, testSupportedDiscarders, enabled, nil, nil))
+
+	addRuleExpr(t, rs,
+		`open.filename == "/etc/passwd" && process.uid != 0`,
+		`open.filename == "/etc/passwd" && open.filename == "/etc/shadow"`,
+		`process.uid != 0 && open.flags & O_CREAT > 0`,
+		`open.mode == open.flags`,
+		`mkdir.filename == "/etc/passwd"`,
+	)
+
+	caps := map[eval.EventType]FieldCapabilities{
+		"open": {
+			{
+				Field: "open.filename",
+				Types: eval.ScalarValueType,
+			},
+		},
+	}
+
+	var issues []LintIssue
+	for _, issue := range rs.LintRules(caps) {
+		issues = append(issues, LintIssue{Kind: issue.Kind, ID: issue.ID})
+	}
+
+	expected := []LintIssue{
+		{Kind: LintUnreachable, ID: "ID1"},
+		{Kind: LintNoApprover, ID: "ID2"},
+		{Kind: LintNoApprover, ID: "ID3"},
+	}
+	if !reflect.DeepEqual(issues, expected) {
+		t.Errorf("expected %+v, got %+v", expected, issues)
+	}
+}
+
+func TestLintMacroCycles(t *testing.T) {
+	macros := []*MacroDefinition{
+		{ID: "a", Expression: `open.filename == "/etc/passwd" || b`},
+		{ID: "b", Expression: `process.uid == 0 && (c || a)`},
+		{ID: "c", Expression: `process.name in ["bash", "sh"]`},
+		{ID: "d", Expression: `!d`},
+		{ID: "e", Expression: `c && process.uid != 0`},
+	}
+
+	var cycles []string
+	for _, issue := range LintMacroCycles(macros) {
+		if issue.Kind != LintMacroCycle {
+			t.Errorf("unexpected issue: %s", issue)
+		}
+		cycles = append(cycles, issue.Message)
+	}
+
+	expected := []string{"a -> b -> a", "d -> d"}
+	if !reflect.DeepEqual(cycles, expected) {
+		t.Errorf("expected %v, got %v", expected, cycles)
+	}
+}
+
+func TestLintRulesVariables(t *testing.T) {
+	rs := newActionTestRuleSet()
+
+	ruleDefs := []*RuleDefinition{
+		{
+			ID:         "flag",
+			Expression: `open.filename == "/etc/passwd"`,
+			Actions:    []*ActionDefinition{{Set: &SetDefinition{Name: "flagged", Scope: ScopeProcess}}},
+		},
+		{
+			ID:         "flagged",
+			Expression: `mkdir.filename == "/etc/passwd" && ${process.flagged} == 1`,
+		},
+	}
+	if err := rs.AddRules(ruleDefs); err.ErrorOrNil() != nil {
+		t.Fatal(err)
+	}
+
+	if issues := rs.LintRules(nil); len(issues) != 0 {
+		t.Errorf("unexpected issues: %+v", issues)
+	}
+}
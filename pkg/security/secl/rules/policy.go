@@ -27,6 +27,16 @@ type Policy struct {
 	Macros  []*MacroDefinition `yaml:"macros"`
 }
 
+// CombinePolicy describes how a definition is combined with a previous definition of the same ID
+type CombinePolicy string
+
+const (
+	// NoCombine reports an error when a previous definition has the same ID
+	NoCombine CombinePolicy = ""
+	// OverridePolicy replaces the fields of the previous definition with the ones that are set
+	OverridePolicy CombinePolicy = "override"
+)
+
 var ruleIDPattern = `^([a-zA-Z0-9]*_*)*$`
 
 func checkRuleID(ruleID string) bool {
@@ -50,7 +60,13 @@ func (p *Policy) GetValidMacroAndRules() ([]*MacroDefinition, []*RuleDefinition,
 			continue
 		}
 
-		if macroDef.Expression == "" {
+		if macroDef.Combine != NoCombine && macroDef.Combine != OverridePolicy {
+			result = multierror.Append(result, &ErrMacroLoad{Definition: macroDef, Err: fmt.Errorf("invalid combine policy `%s`", macroDef.Combine)})
+			continue
+		}
+
+		// disabling or overriding a previous definition doesn't require an expression
+		if macroDef.Expression == "" && !macroDef.Disabled && macroDef.Combine != OverridePolicy {
 			result = multierror.Append(result, &ErrMacroLoad{Definition: macroDef, Err: errors.New("no expression defined")})
 			continue
 		}
@@ -69,7 +85,13 @@ func (p *Policy) GetValidMacroAndRules() ([]*MacroDefinition, []*RuleDefinition,
 			continue
 		}
 
-		if ruleDef.Expression == "" {
+		if ruleDef.Combine != NoCombine && ruleDef.Combine != OverridePolicy {
+			result = multierror.Append(result, &ErrRuleLoad{Definition: ruleDef, Err: fmt.Errorf("invalid combine policy `%s`", ruleDef.Combine)})
+			continue
+		}
+
+		// disabling or overriding a previous definition doesn't require an expression
+		if ruleDef.Expression == "" && !ruleDef.Disabled && ruleDef.Combine != OverridePolicy {
 			result = multierror.Append(result, &ErrRuleLoad{Definition: ruleDef, Err: errors.New("no expression defined")})
 			continue
 		}
@@ -92,16 +114,16 @@ func LoadPolicy(r io.Reader, name string) (*Policy, error) {
 	return policy, nil
 }
 
-// LoadPolicies loads the policies listed in the configuration and apply them to the given ruleset
-func LoadPolicies(policiesDir string, ruleSet *RuleSet) *multierror.Error {
+// LoadPolicyDir loads and parses the policy files of the given directory, sorted by name
+func LoadPolicyDir(policiesDir string, logger Logger) ([]*Policy, *multierror.Error) {
 	var (
 		result   *multierror.Error
-		allRules []*RuleDefinition
+		policies []*Policy
 	)
 
 	policyFiles, err := ioutil.ReadDir(policiesDir)
 	if err != nil {
-		return multierror.Append(result, ErrPoliciesLoad{Name: policiesDir, Err: err})
+		return nil, multierror.Append(result, ErrPoliciesLoad{Name: policiesDir, Err: err})
 	}
 	sort.Slice(policyFiles, func(i, j int) bool { return policyFiles[i].Name() < policyFiles[j].Name() })
 
@@ -111,7 +133,7 @@ func LoadPolicies(policiesDir string, ruleSet *RuleSet) *multierror.Error {
 
 		// policy path extension check
 		if filepath.Ext(filename) != ".policy" {
-			ruleSet.logger.Debugf("ignoring file `%s` wrong extension `%s`", policyPath.Name(), filepath.Ext(filename))
+			logger.Debugf("ignoring file `%s` wrong extension `%s`", policyPath.Name(), filepath.Ext(filename))
 			continue
 		}
 
@@ -121,38 +143,228 @@ func LoadPolicies(policiesDir string, ruleSet *RuleSet) *multierror.Error {
 			result = multierror.Append(result, &ErrPolicyLoad{Name: filename, Err: err})
 			continue
 		}
-		defer f.Close()
 
 		// Parse policy file
 		policy, err := LoadPolicy(f, filepath.Base(filename))
+		f.Close()
 		if err != nil {
 			result = multierror.Append(result, err)
 			continue
 		}
 
-		// Add policy version for logging purposes
-		ruleSet.AddPolicyVersion(filename, policy.Version)
+		policies = append(policies, policy)
+	}
+
+	return policies, result
+}
+
+// PolicyLayers merges the macros and rules of ordered policies, the definitions of a policy
+// taking precedence over the ones of the previous policies. A definition with the ID of a
+// previous one either removes it, with `disabled: true`, or replaces the fields it sets, with
+// `combine: override`. Definitions rejected by a filter are ignored before being merged, so
+// that a policy can ship variants of a rule for different agent versions or kernels.
+type PolicyLayers struct {
+	filters []RuleFilter
+	logger  Logger
+
+	macros     []*MacroDefinition
+	macroIndex map[MacroID]int
+	rules      []*RuleDefinition
+	ruleIndex  map[RuleID]int
+}
+
+// NewPolicyLayers returns a new, empty, set of policy layers
+func NewPolicyLayers(logger Logger, filters ...RuleFilter) *PolicyLayers {
+	return &PolicyLayers{
+		filters:    filters,
+		logger:     logger,
+		macroIndex: make(map[MacroID]int),
+		ruleIndex:  make(map[RuleID]int),
+	}
+}
+
+// AddPolicy merges the macros and rules of the policy with the ones of the previous policies
+func (pl *PolicyLayers) AddPolicy(policy *Policy) *multierror.Error {
+	macros, rules, result := policy.GetValidMacroAndRules()
+
+	for _, macroDef := range macros {
+		if err := pl.addMacro(macroDef); err != nil {
+			result = multierror.Append(result, &ErrMacroLoad{Definition: macroDef, Err: err})
+		}
+	}
+
+	for _, ruleDef := range rules {
+		if err := pl.addRule(ruleDef); err != nil {
+			result = multierror.Append(result, &ErrRuleLoad{Definition: ruleDef, Err: err})
+		}
+	}
+
+	return result
+}
+
+func (pl *PolicyLayers) isMacroAccepted(macroDef *MacroDefinition) (bool, error) {
+	for _, filter := range pl.filters {
+		if accepted, err := filter.IsMacroAccepted(macroDef); err != nil || !accepted {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func (pl *PolicyLayers) isRuleAccepted(ruleDef *RuleDefinition) (bool, error) {
+	for _, filter := range pl.filters {
+		if accepted, err := filter.IsRuleAccepted(ruleDef); err != nil || !accepted {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func (pl *PolicyLayers) addMacro(macroDef *MacroDefinition) error {
+	accepted, err := pl.isMacroAccepted(macroDef)
+	if err != nil {
+		return err
+	}
+	if !accepted {
+		pl.logger.Debugf("macro `%s` filtered out", macroDef.ID)
+		return nil
+	}
 
-		macros, rules, mErr := policy.GetValidMacroAndRules()
-		if mErr.ErrorOrNil() != nil {
-			result = multierror.Append(result, mErr)
+	index, exists := pl.macroIndex[macroDef.ID]
+	switch {
+	case macroDef.Disabled:
+		if exists {
+			pl.macros[index] = nil
+			delete(pl.macroIndex, macroDef.ID)
 		}
+	case exists && macroDef.Combine == OverridePolicy:
+		merged := *pl.macros[index]
+		if macroDef.Expression != "" {
+			merged.Expression = macroDef.Expression
+		}
+		pl.macros[index] = &merged
+	case exists:
+		return ErrDefinitionIDConflict
+	case macroDef.Expression == "":
+		return errors.New("no definition to override")
+	default:
+		pl.macroIndex[macroDef.ID] = len(pl.macros)
+		pl.macros = append(pl.macros, macroDef)
+	}
+
+	return nil
+}
 
-		if len(macros) > 0 {
-			// Add the macros to the ruleset and generate macros evaluators
-			if mErr := ruleSet.AddMacros(macros); mErr.ErrorOrNil() != nil {
-				result = multierror.Append(result, err)
-			}
+func (pl *PolicyLayers) addRule(ruleDef *RuleDefinition) error {
+	accepted, err := pl.isRuleAccepted(ruleDef)
+	if err != nil {
+		return err
+	}
+	if !accepted {
+		pl.logger.Debugf("rule `%s` filtered out", ruleDef.ID)
+		return nil
+	}
+
+	index, exists := pl.ruleIndex[ruleDef.ID]
+	switch {
+	case ruleDef.Disabled:
+		if exists {
+			pl.rules[index] = nil
+			delete(pl.ruleIndex, ruleDef.ID)
 		}
+	case exists && ruleDef.Combine == OverridePolicy:
+		pl.rules[index] = overrideRule(pl.rules[index], ruleDef)
+	case exists:
+		return ErrDefinitionIDConflict
+	case ruleDef.Expression == "":
+		return errors.New("no definition to override")
+	default:
+		pl.ruleIndex[ruleDef.ID] = len(pl.rules)
+		pl.rules = append(pl.rules, ruleDef)
+	}
+
+	return nil
+}
+
+// overrideRule returns a copy of the rule definition with the fields set by the override.
+// Tags are merged, the tags of the override taking precedence.
+func overrideRule(ruleDef *RuleDefinition, override *RuleDefinition) *RuleDefinition {
+	merged := *ruleDef
+	merged.Policy = override.Policy
+
+	if override.Expression != "" {
+		merged.Expression = override.Expression
+	}
+	if override.Version != "" {
+		merged.Version = override.Version
+	}
+	if override.Description != "" {
+		merged.Description = override.Description
+	}
+	if override.Actions != nil {
+		merged.Actions = override.Actions
+	}
+
+	if len(override.Tags) > 0 {
+		merged.Tags = make(map[string]string, len(ruleDef.Tags)+len(override.Tags))
+		for k, v := range ruleDef.Tags {
+			merged.Tags[k] = v
+		}
+		for k, v := range override.Tags {
+			merged.Tags[k] = v
+		}
+	}
 
-		// aggregates them as we may need to have all the macro before compiling
-		if len(rules) > 0 {
-			allRules = append(allRules, rules...)
+	return &merged
+}
+
+// GetMacros returns the effective macro definitions, in the order of their first definition
+func (pl *PolicyLayers) GetMacros() []*MacroDefinition {
+	var macros []*MacroDefinition
+	for _, macroDef := range pl.macros {
+		if macroDef != nil {
+			macros = append(macros, macroDef)
+		}
+	}
+	return macros
+}
+
+// GetRules returns the effective rule definitions, in the order of their first definition
+func (pl *PolicyLayers) GetRules() []*RuleDefinition {
+	var rules []*RuleDefinition
+	for _, ruleDef := range pl.rules {
+		if ruleDef != nil {
+			rules = append(rules, ruleDef)
+		}
+	}
+	return rules
+}
+
+// LoadPolicies loads the policies of the given directory, merges them in the order of their
+// file names, and applies them to the given ruleset. The macros and rules rejected by one of
+// the filters are not loaded.
+func LoadPolicies(policiesDir string, ruleSet *RuleSet, filters ...RuleFilter) *multierror.Error {
+	policies, result := LoadPolicyDir(policiesDir, ruleSet.logger)
+
+	layers := NewPolicyLayers(ruleSet.logger, filters...)
+	for _, policy := range policies {
+		// Add policy version for logging purposes
+		ruleSet.AddPolicyVersion(policy.Name, policy.Version)
+
+		if err := layers.AddPolicy(policy); err.ErrorOrNil() != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	// Add the macros to the ruleset and generate macros evaluators
+	if macros := layers.GetMacros(); len(macros) > 0 {
+		if err := ruleSet.AddMacros(macros); err.ErrorOrNil() != nil {
+			result = multierror.Append(result, err)
 		}
 	}
 
 	// Add rules to the ruleset and generate rules evaluators
-	if err := ruleSet.AddRules(allRules); err.ErrorOrNil() != nil {
+	if err := ruleSet.AddRules(layers.GetRules()); err.ErrorOrNil() != nil {
 		result = multierror.Append(result, err)
 	}
 
@@ -0,0 +1,201 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package rules
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
+)
+
+func writePolicies(t *testing.T, policies map[string]string) string {
+	dir, err := ioutil.TempDir("", "policies")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	for name, content := range policies {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+func newPolicyTestRuleSet() *RuleSet {
+	enabled := map[eval.EventType]bool{"*": true}
+	return NewRuleSet(&testModel{}, func() eval.Event { return &testEvent{} }, NewOptsWithParams(testConstants, nil, testSupportedDiscarders, enabled, nil, nil))
+}
+
+func TestPolicyLayers(t *testing.T) {
+	dir := writePolicies(t, map[string]string{
+		"00-default.policy": `
+macros:
+  - id: sensitive_files
+    expression: '["/etc/shadow", "/etc/passwd"]'
+rules:
+  - id: sensitive_open
+    expression: open.filename in sensitive_files
+    tags:
+      severity: high
+  - id: etc_mkdir
+    expression: mkdir.filename =~ "/etc/*"
+  - id: root_open
+    expression: open.filename == "/tmp/root" && process.uid == 0
+`,
+		"10-custom.policy": `
+macros:
+  - id: sensitive_files
+    expression: '["/etc/shadow"]'
+    combine: override
+rules:
+  - id: sensitive_open
+    combine: override
+    tags:
+      team: security
+  - id: etc_mkdir
+    disabled: true
+  - id: custom_open
+    expression: open.filename == "/tmp/custom"
+`,
+	})
+
+	rs := newPolicyTestRuleSet()
+	if err := LoadPolicies(dir, rs); err.ErrorOrNil() != nil {
+		t.Fatal(err)
+	}
+
+	ids := rs.ListRuleIDs()
+	sort.Strings(ids)
+	if expected := []RuleID{"custom_open", "root_open", "sensitive_open"}; !reflect.DeepEqual(ids, expected) {
+		t.Fatalf("expected rules %v, got %v", expected, ids)
+	}
+
+	rule := rs.GetRules()["sensitive_open"]
+	if rule.Definition.Expression != "open.filename in sensitive_files" {
+		t.Errorf("unexpected expression: %s", rule.Definition.Expression)
+	}
+	if expected := map[string]string{"severity": "high", "team": "security"}; !reflect.DeepEqual(rule.Definition.Tags, expected) {
+		t.Errorf("expected tags %v, got %v", expected, rule.Definition.Tags)
+	}
+	if rule.Definition.Policy.Name != "10-custom.policy" {
+		t.Errorf("expected the rule to come from the custom policy, got %s", rule.Definition.Policy.Name)
+	}
+
+	event := &testEvent{kind: "open"}
+	event.open.filename = "/etc/passwd"
+	if matched := rs.matchingRules(event); len(matched) != 0 {
+		t.Errorf("the overridden macro shouldn't match /etc/passwd, got %v", matched)
+	}
+
+	event.open.filename = "/etc/shadow"
+	if matched := rs.matchingRules(event); !reflect.DeepEqual(matched, []RuleID{"sensitive_open"}) {
+		t.Errorf("expected sensitive_open to match, got %v", matched)
+	}
+}
+
+func TestPolicyLayersErrors(t *testing.T) {
+	dir := writePolicies(t, map[string]string{
+		"00-default.policy": `
+rules:
+  - id: rule_a
+    expression: open.filename == "/tmp/a"
+`,
+		"10-custom.policy": `
+rules:
+  - id: rule_a
+    expression: open.filename == "/tmp/b"
+  - id: rule_b
+    combine: override
+    tags:
+      team: security
+  - id: rule_c
+    combine: merge
+    expression: open.filename == "/tmp/c"
+`,
+	})
+
+	rs := newPolicyTestRuleSet()
+	err := LoadPolicies(dir, rs)
+	if err.ErrorOrNil() == nil || len(err.Errors) != 3 {
+		t.Fatalf("expected 3 errors, got %v", err)
+	}
+
+	// the first definition is kept on conflict
+	if rule := rs.GetRules()["rule_a"]; rule == nil || rule.Definition.Expression != `open.filename == "/tmp/a"` {
+		t.Errorf("expected the first definition of rule_a to be loaded, got %+v", rule)
+	}
+}
+
+func TestPolicyFilters(t *testing.T) {
+	dir := writePolicies(t, map[string]string{
+		"default.policy": `
+macros:
+  - id: files
+    expression: '["/tmp/old"]'
+    agent_version: "< 7.34"
+  - id: files
+    expression: '["/tmp/new"]'
+    agent_version: ">= 7.34"
+rules:
+  - id: open_files
+    expression: open.filename in files
+  - id: lsm_open
+    expression: open.filename == "/tmp/lsm"
+    filters:
+      - kernel.version.major >= 5 && kernel.has_bpf_lsm
+  - id: recent_open
+    expression: open.filename == "/tmp/recent"
+    filters:
+      - kernel.version.major >= 5
+`,
+	})
+
+	versionFilter, err := NewAgentVersionFilter("7.34.0-rc.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	seclFilter, err := NewSECLRuleFilter(map[eval.Field]interface{}{
+		"kernel.version.major": 5,
+		"kernel.has_bpf_lsm":   false,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rs := newPolicyTestRuleSet()
+	if err := LoadPolicies(dir, rs, versionFilter, seclFilter); err.ErrorOrNil() != nil {
+		t.Fatal(err)
+	}
+
+	ids := rs.ListRuleIDs()
+	sort.Strings(ids)
+	if expected := []RuleID{"open_files", "recent_open"}; !reflect.DeepEqual(ids, expected) {
+		t.Fatalf("expected rules %v, got %v", expected, ids)
+	}
+
+	event := &testEvent{kind: "open"}
+	event.open.filename = "/tmp/new"
+	if matched := rs.matchingRules(event); !reflect.DeepEqual(matched, []RuleID{"open_files"}) {
+		t.Errorf("expected open_files to match, got %v", matched)
+	}
+
+	if _, err := seclFilter.IsRuleAccepted(&RuleDefinition{Filters: []string{"kernel.unknown == 1"}}); err == nil {
+		t.Error("expected an error for an unknown filter field")
+	}
+
+	if _, err := versionFilter.IsRuleAccepted(&RuleDefinition{AgentVersionConstraint: "not a constraint"}); err == nil {
+		t.Error("expected an error for an invalid agent version constraint")
+	}
+}
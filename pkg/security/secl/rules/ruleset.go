@@ -20,8 +20,12 @@ type MacroID = string
 
 // MacroDefinition holds the definition of a macro
 type MacroDefinition struct {
-	ID         MacroID `yaml:"id"`
-	Expression string  `yaml:"expression"`
+	ID                     MacroID       `yaml:"id"`
+	Expression             string        `yaml:"expression"`
+	AgentVersionConstraint string        `yaml:"agent_version"`
+	Filters                []string      `yaml:"filters"`
+	Combine                CombinePolicy `yaml:"combine"`
+	Disabled               bool          `yaml:"disabled"`
 }
 
 // Macro describes a macro of a ruleset
@@ -35,12 +39,17 @@ type RuleID = string
 
 // RuleDefinition holds the definition of a rule
 type RuleDefinition struct {
-	ID          RuleID            `yaml:"id"`
-	Version     string            `yaml:"version"`
-	Expression  string            `yaml:"expression"`
-	Description string            `yaml:"description"`
-	Tags        map[string]string `yaml:"tags"`
-	Policy      *Policy
+	ID                     RuleID              `yaml:"id"`
+	Version                string              `yaml:"version"`
+	Expression             string              `yaml:"expression"`
+	Description            string              `yaml:"description"`
+	Tags                   map[string]string   `yaml:"tags"`
+	Actions                []*ActionDefinition `yaml:"actions"`
+	AgentVersionConstraint string              `yaml:"agent_version"`
+	Filters                []string            `yaml:"filters"`
+	Combine                CombinePolicy       `yaml:"combine"`
+	Disabled               bool                `yaml:"disabled"`
+	Policy                 *Policy
 }
 
 // GetTags returns the tags associated to a rule
@@ -74,6 +83,10 @@ type Opts struct {
 	ReservedRuleIDs     []RuleID
 	EventTypeEnabled    map[eval.EventType]bool
 	Logger              Logger
+	VariableScopes      map[Scope]VariableScope
+	VariableStore       *VariableStore
+	// Signals holds the signals supported by the kill action
+	Signals map[string]int
 }
 
 // NewOptsWithParams initializes a new Opts instance with Debug and Constants parameters
@@ -81,10 +94,16 @@ func NewOptsWithParams(constants map[string]interface{}, variables map[string]ev
 	if len(logger) == 0 {
 		logger = []Logger{NullLogger{}}
 	}
+
+	// the variables set by the rule actions are added to the builtin ones
+	allVariables := make(map[string]eval.VariableValue, len(variables))
+	for name, value := range variables {
+		allVariables[name] = value
+	}
 	return &Opts{
 		Opts: eval.Opts{
 			Constants:        constants,
-			Variables:        variables,
+			Variables:        allVariables,
 			Macros:           make(map[eval.MacroID]*eval.Macro),
 			LegacyAttributes: legacyAttributes,
 		},
@@ -110,6 +129,9 @@ type RuleSet struct {
 	fields []string
 	logger Logger
 	pool   *eval.ContextPool
+	// variables holds the values of the variables set by the rule actions
+	variables       *VariableStore
+	actionVariables map[string]bool
 }
 
 // ListRuleIDs returns the list of RuleIDs from the ruleset
@@ -180,6 +202,13 @@ func (rs *RuleSet) AddMacro(macroDef *MacroDefinition) (*eval.Macro, error) {
 func (rs *RuleSet) AddRules(rules []*RuleDefinition) *multierror.Error {
 	var result *multierror.Error
 
+	// register the variables first so that rules can read the ones set by other rules
+	for _, ruleDef := range rules {
+		if rs.checkActions(ruleDef) == nil {
+			_ = rs.addActionVariables(ruleDef)
+		}
+	}
+
 	for _, ruleDef := range rules {
 		if _, err := rs.AddRule(ruleDef); err != nil {
 			result = multierror.Append(result, err)
@@ -205,6 +234,14 @@ func (rs *RuleSet) AddRule(ruleDef *RuleDefinition) (*eval.Rule, error) {
 		return nil, &ErrRuleLoad{Definition: ruleDef, Err: ErrDefinitionIDConflict}
 	}
 
+	if err := rs.checkActions(ruleDef); err != nil {
+		return nil, &ErrRuleLoad{Definition: ruleDef, Err: errors.Wrap(err, "invalid action")}
+	}
+
+	if err := rs.addActionVariables(ruleDef); err != nil {
+		return nil, &ErrRuleLoad{Definition: ruleDef, Err: errors.Wrap(err, "invalid action")}
+	}
+
 	var tags []string
 	for k, v := range ruleDef.Tags {
 		tags = append(tags, k+":"+v)
@@ -387,6 +424,7 @@ func (rs *RuleSet) Evaluate(event eval.Event) bool {
 		if rule.GetEvaluator().Eval(ctx) {
 			rs.logger.Tracef("Rule `%s` matches with event `%s`\n", rule.ID, event)
 
+			rs.applySetActions(rule, ctx)
 			rs.NotifyRuleMatch(rule, event)
 			result = true
 		}
@@ -463,6 +501,11 @@ func (rs *RuleSet) AddPolicyVersion(filename string, version string) {
 
 // NewRuleSet returns a new ruleset for the specified data model
 func NewRuleSet(model eval.Model, eventCtor func() eval.Event, opts *Opts) *RuleSet {
+	variables := opts.VariableStore
+	if variables == nil {
+		variables = NewVariableStore()
+	}
+
 	return &RuleSet{
 		model:            model,
 		eventCtor:        eventCtor,
@@ -473,5 +516,7 @@ func NewRuleSet(model eval.Model, eventCtor func() eval.Event, opts *Opts) *Rule
 		loadedPolicies:   make(map[string]string),
 		logger:           opts.Logger,
 		pool:             eval.NewContextPool(),
+		variables:        variables,
+		actionVariables:  make(map[string]bool),
 	}
 }
@@ -0,0 +1,215 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package rules
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
+)
+
+// TestSuite holds a list of synthetic events along with the rules expected to match them.
+// As JSON is a subset of YAML, test suites can be written in either format.
+type TestSuite struct {
+	Name  string
+	Cases []*TestCase `yaml:"tests"`
+}
+
+// TestCase describes a synthetic event and the IDs of the rules that should match it.
+// An event that is not expected to match any rule has an empty list of rule IDs.
+type TestCase struct {
+	Name   string    `yaml:"name"`
+	Event  TestEvent `yaml:"event"`
+	Expect []RuleID  `yaml:"expect"`
+}
+
+// TestEvent describes a synthetic event by its type and the values of its fields
+type TestEvent struct {
+	Type   eval.EventType             `yaml:"type"`
+	Fields map[eval.Field]interface{} `yaml:"fields"`
+}
+
+// TestResult holds the outcome of a test case
+type TestResult struct {
+	Case       *TestCase
+	Matched    []RuleID
+	Missing    []RuleID
+	Unexpected []RuleID
+	Err        error
+}
+
+// Passed returns whether the rules matching the event were the expected ones
+func (tr *TestResult) Passed() bool {
+	return tr.Err == nil && len(tr.Missing) == 0 && len(tr.Unexpected) == 0
+}
+
+// LoadTestSuite loads a YAML or JSON file and returns a new test suite
+func LoadTestSuite(r io.Reader, name string) (*TestSuite, error) {
+	suite := &TestSuite{Name: name}
+
+	decoder := yaml.NewDecoder(r)
+	if err := decoder.Decode(suite); err != nil {
+		return nil, errors.Wrapf(err, "failed to load test suite `%s`", name)
+	}
+
+	return suite, nil
+}
+
+// RunTestSuite evaluates the events of the test suite against the ruleset. newEvent returns
+// an empty event of the given type. The listeners of the ruleset are not notified but the
+// variables set by the rule actions are kept from an event to the next, so that multi-step
+// detections can be tested.
+func (rs *RuleSet) RunTestSuite(suite *TestSuite, newEvent func(eventType eval.EventType) (eval.Event, error)) []*TestResult {
+	var results []*TestResult
+
+	for _, testCase := range suite.Cases {
+		result := &TestResult{Case: testCase}
+		results = append(results, result)
+
+		for _, id := range testCase.Expect {
+			if _, exists := rs.rules[id]; !exists {
+				result.Err = fmt.Errorf("unknown rule `%s`", id)
+				break
+			}
+		}
+		if result.Err != nil {
+			continue
+		}
+
+		event, err := newEvent(testCase.Event.Type)
+		if err != nil {
+			result.Err = err
+			continue
+		}
+
+		if err := rs.setTestEventFields(event, testCase.Event.Fields); err != nil {
+			result.Err = err
+			continue
+		}
+
+		result.Matched = rs.matchingRules(event)
+
+		expected := make(map[RuleID]bool, len(testCase.Expect))
+		for _, id := range testCase.Expect {
+			expected[id] = true
+		}
+
+		matched := make(map[RuleID]bool, len(result.Matched))
+		for _, id := range result.Matched {
+			matched[id] = true
+			if !expected[id] {
+				result.Unexpected = append(result.Unexpected, id)
+			}
+		}
+
+		for _, id := range testCase.Expect {
+			if !matched[id] {
+				result.Missing = append(result.Missing, id)
+			}
+		}
+	}
+
+	return results
+}
+
+// matchingRules returns the sorted IDs of the rules matching the event
+func (rs *RuleSet) matchingRules(event eval.Event) []RuleID {
+	bucket, exists := rs.eventRuleBuckets[event.GetType()]
+	if !exists {
+		return nil
+	}
+
+	ctx := rs.pool.Get(event.GetPointer())
+	defer rs.pool.Put(ctx)
+
+	var ids []RuleID
+	for _, rule := range bucket.rules {
+		if rule.GetEvaluator().Eval(ctx) {
+			rs.applySetActions(rule, ctx)
+			ids = append(ids, rule.ID)
+		}
+	}
+	sort.Strings(ids)
+
+	return ids
+}
+
+// setTestEventFields sets the fields of a synthetic event. Integer fields accept the name of
+// constants, and lists of values which are or'ed, so that bitmasks can be written as lists of
+// flags. Array fields accept lists of values.
+func (rs *RuleSet) setTestEventFields(event eval.Event, fields map[eval.Field]interface{}) error {
+	// sort the fields so that errors are reported consistently
+	names := make([]eval.Field, 0, len(fields))
+	for field := range fields {
+		names = append(names, field)
+	}
+	sort.Strings(names)
+
+	for _, field := range names {
+		kind, err := event.GetFieldType(field)
+		if err != nil {
+			return errors.Wrapf(err, "invalid field `%s`", field)
+		}
+
+		values, isList := fields[field].([]interface{})
+		if !isList {
+			values = []interface{}{fields[field]}
+		}
+
+		if kind == reflect.Int {
+			mask := 0
+			for _, value := range values {
+				i, err := rs.testIntValue(field, value)
+				if err != nil {
+					return err
+				}
+				mask |= i
+			}
+			values = []interface{}{mask}
+		}
+
+		// network fields are set from their string representation
+		if kind == reflect.Struct {
+			for i, value := range values {
+				if str, ok := value.(string); ok {
+					ipNet, err := eval.ParseCIDR(str)
+					if err != nil {
+						return errors.Wrapf(err, "invalid value `%v` for field `%s`", value, field)
+					}
+					values[i] = *ipNet
+				}
+			}
+		}
+
+		for _, value := range values {
+			if err := event.SetFieldValue(field, value); err != nil {
+				return errors.Wrapf(err, "invalid value `%v` for field `%s`", value, field)
+			}
+		}
+	}
+
+	return nil
+}
+
+// testIntValue returns the integer value of a field, resolving constants
+func (rs *RuleSet) testIntValue(field eval.Field, value interface{}) (int, error) {
+	switch v := value.(type) {
+	case int:
+		return v, nil
+	case string:
+		if constant, ok := rs.opts.Constants[v].(*eval.IntEvaluator); ok {
+			return constant.Value, nil
+		}
+		return 0, fmt.Errorf("unknown constant `%s` for field `%s`", v, field)
+	}
+	return 0, fmt.Errorf("invalid value `%v` for field `%s`", value, field)
+}
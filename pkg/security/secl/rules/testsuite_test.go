@@ -0,0 +1,132 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package rules
+
+import (
+	"reflect"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
+)
+
+func newTestEventOfType(eventType eval.EventType) (eval.Event, error) {
+	return &testEvent{kind: eventType}, nil
+}
+
+func TestRunTestSuite(t *testing.T) {
+	enabled := map[eval.EventType]bool{"*": true}
+	rs := NewRuleSet(&testModel{}, func() eval.Event { return &testEvent{} }, NewOptsWithParams(testConstants, nil, testSupportedDiscarders, enabled, nil, nil))
+
+	addRuleExpr(t, rs,
+		`open.filename == "/etc/shadow" && process.uid != 0`,
+		`open.filename =~ "/etc/*" && open.flags & O_CREAT > 0`,
+		`mkdir.filename =~ "/etc/*"`,
+	)
+
+	suite, err := LoadTestSuite(strings.NewReader(`
+tests:
+  - name: shadow read by a user
+    event:
+      type: open
+      fields:
+        open.filename: /etc/shadow
+        process.uid: 1000
+    expect: [ID0]
+  - name: file created in etc
+    event:
+      type: open
+      fields:
+        open.filename: /etc/shadow
+        open.flags: [O_CREAT, O_RDWR]
+    expect: [ID1]
+  - name: file created elsewhere
+    event:
+      type: open
+      fields:
+        open.filename: /tmp/test
+        open.flags: O_CREAT
+  - name: wrong expectation
+    event:
+      type: mkdir
+      fields:
+        mkdir.filename: /etc/test
+    expect: [ID0]
+  - name: unknown constant
+    event:
+      type: open
+      fields:
+        open.flags: O_UNKNOWN
+  - name: unknown rule
+    event:
+      type: open
+    expect: [ID9]
+`), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results := rs.RunTestSuite(suite, newTestEventOfType)
+	if len(results) != 6 {
+		t.Fatalf("expected 6 results, got %d", len(results))
+	}
+
+	for i, expected := range []struct {
+		passed     bool
+		matched    []RuleID
+		missing    []RuleID
+		unexpected []RuleID
+		err        bool
+	}{
+		{passed: true, matched: []RuleID{"ID0"}},
+		{passed: true, matched: []RuleID{"ID1"}},
+		{passed: true},
+		{matched: []RuleID{"ID2"}, missing: []RuleID{"ID0"}, unexpected: []RuleID{"ID2"}},
+		{err: true},
+		{err: true},
+	} {
+		result := results[i]
+		if result.Passed() != expected.passed {
+			t.Errorf("%s: expected passed to be %v, got %+v", result.Case.Name, expected.passed, result)
+		}
+		if (result.Err != nil) != expected.err {
+			t.Errorf("%s: unexpected error: %v", result.Case.Name, result.Err)
+		}
+		if !reflect.DeepEqual(result.Matched, expected.matched) {
+			t.Errorf("%s: expected %v to match, got %v", result.Case.Name, expected.matched, result.Matched)
+		}
+		if !reflect.DeepEqual(result.Missing, expected.missing) {
+			t.Errorf("%s: expected %v to be missing, got %v", result.Case.Name, expected.missing, result.Missing)
+		}
+		if !reflect.DeepEqual(result.Unexpected, expected.unexpected) {
+			t.Errorf("%s: expected %v to be unexpected, got %v", result.Case.Name, expected.unexpected, result.Unexpected)
+		}
+	}
+}
+
+func TestSetTestEventFields(t *testing.T) {
+	enabled := map[eval.EventType]bool{"*": true}
+	rs := NewRuleSet(&testModel{}, func() eval.Event { return &testEvent{} }, NewOptsWithParams(testConstants, nil, testSupportedDiscarders, enabled, nil, nil))
+
+	event := &testEvent{}
+	err := rs.setTestEventFields(event, map[eval.Field]interface{}{
+		"open.filename":   "/etc/passwd",
+		"open.flags":      []interface{}{"O_CREAT", syscall.O_RDWR},
+		"process.is_root": true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if event.open.filename != "/etc/passwd" || event.open.flags != syscall.O_CREAT|syscall.O_RDWR || !event.process.isRoot {
+		t.Errorf("unexpected event: %+v", event)
+	}
+
+	if err := rs.setTestEventFields(event, map[eval.Field]interface{}{"open.unknown": 1}); err == nil {
+		t.Error("expected an error for an unknown field")
+	}
+}
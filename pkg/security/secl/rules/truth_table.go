@@ -6,6 +6,7 @@
 package rules
 
 import (
+	"net"
 	"reflect"
 
 	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
@@ -101,6 +102,8 @@ func genFilterValues(rule *eval.Rule, event eval.Event) ([]FilterValues, error)
 				value = 0
 			case reflect.Bool:
 				value = false
+			case reflect.Struct:
+				value = net.IPNet{IP: net.IPv4zero.To4(), Mask: net.CIDRMask(32, 32)}
 			default:
 				return nil, &ErrFieldTypeUnknown{Field: field}
 			}
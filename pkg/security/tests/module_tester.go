@@ -82,6 +82,8 @@ runtime_security_config:
 {{end}}
   erpc_dentry_resolution_enabled: {{ .ErpcDentryResolutionEnabled }}
   map_dentry_resolution_enabled: {{ .MapDentryResolutionEnabled }}
+  network:
+    enabled: {{ .NetworkEnabled }}
 
   policies:
     dir: {{.TestPoliciesDir}}
@@ -128,6 +130,7 @@ type testOpts struct {
 	reuseProbeHandler           bool
 	disableERPCDentryResolution bool
 	disableMapDentryResolution  bool
+	enableNetwork               bool
 }
 
 func (s *stringSlice) String() string {
@@ -147,7 +150,8 @@ func (to testOpts) Equal(opts testOpts) bool {
 		to.eventsCountThreshold == opts.eventsCountThreshold &&
 		to.reuseProbeHandler == opts.reuseProbeHandler &&
 		to.disableERPCDentryResolution == opts.disableERPCDentryResolution &&
-		to.disableMapDentryResolution == opts.disableMapDentryResolution
+		to.disableMapDentryResolution == opts.disableMapDentryResolution &&
+		to.enableNetwork == opts.enableNetwork
 }
 
 type testModule struct {
@@ -371,6 +375,7 @@ func setTestConfig(dir string, opts testOpts) (string, error) {
 		"EventsCountThreshold":        opts.eventsCountThreshold,
 		"ErpcDentryResolutionEnabled": erpcDentryResolutionEnabled,
 		"MapDentryResolutionEnabled":  mapDentryResolutionEnabled,
+		"NetworkEnabled":              opts.enableNetwork,
 		"LogPatterns":                 logPatterns,
 	}); err != nil {
 		return "", err
@@ -491,6 +496,7 @@ func newTestModule(t testing.TB, macroDefs []*rules.MacroDefinition, ruleDefs []
 	config.SelfTestEnabled = false
 	config.ERPCDentryResolutionEnabled = !opts.disableERPCDentryResolution
 	config.MapDentryResolutionEnabled = !opts.disableMapDentryResolution
+	config.NetworkEnabled = opts.enableNetwork
 
 	t.Log("Instantiating a new security module")
 
@@ -0,0 +1,46 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// +build functionaltests
+
+package tests
+
+import (
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sprobe "github.com/DataDog/datadog-agent/pkg/security/probe"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/rules"
+)
+
+func TestMProtectEvent(t *testing.T) {
+	ruleDefs := []*rules.RuleDefinition{
+		{
+			ID:         "test_mprotect",
+			Expression: `mprotect.vm_protection & PROT_EXEC == 0 && mprotect.req_protection & PROT_EXEC > 0 && process.file.name == "testsuite"`,
+		},
+	}
+
+	test, err := newTestModule(t, nil, ruleDefs, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	data, err := syscall.Mmap(-1, 0, syscall.Getpagesize(), syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_PRIVATE|syscall.MAP_ANON)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer syscall.Munmap(data)
+
+	test.WaitSignal(t, func() error {
+		return syscall.Mprotect(data, syscall.PROT_READ|syscall.PROT_EXEC)
+	}, func(event *sprobe.Event, r *rules.Rule) {
+		assert.Equal(t, "mprotect", event.GetType(), "wrong event type")
+		assert.Equal(t, syscall.PROT_READ|syscall.PROT_EXEC, event.MProtect.ReqProtection, "wrong protection")
+	})
+}
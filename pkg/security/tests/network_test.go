@@ -0,0 +1,138 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// +build functionaltests
+
+package tests
+
+import (
+	"net"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sprobe "github.com/DataDog/datadog-agent/pkg/security/probe"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/rules"
+)
+
+func TestNetworkEvents(t *testing.T) {
+	ruleDefs := []*rules.RuleDefinition{
+		{
+			ID:         "test_bind",
+			Expression: `bind.addr.ip == 127.0.0.1 && bind.addr.port == 4242 && process.file.name == "testsuite"`,
+		},
+		{
+			ID:         "test_connect",
+			Expression: `connect.addr.ip in [127.0.0.0/8] && connect.addr.port == 4242 && process.file.name == "testsuite"`,
+		},
+	}
+
+	test, err := newTestModule(t, nil, ruleDefs, testOpts{enableNetwork: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	var listener net.Listener
+
+	t.Run("bind", func(t *testing.T) {
+		test.WaitSignal(t, func() error {
+			listener, err = net.Listen("tcp4", "127.0.0.1:4242")
+			return err
+		}, func(event *sprobe.Event, r *rules.Rule) {
+			assert.Equal(t, "bind", event.GetType(), "wrong event type")
+			assert.Equal(t, uint16(4242), event.Bind.Addr.Port, "wrong port")
+
+			if !validateNetworkSchema(t, event) {
+				t.Error(event.String())
+			}
+		})
+	})
+
+	if listener == nil {
+		t.Fatal("failed to bind the test listener")
+	}
+	defer listener.Close()
+
+	t.Run("connect", func(t *testing.T) {
+		test.WaitSignal(t, func() error {
+			conn, err := net.Dial("tcp4", "127.0.0.1:4242")
+			if err != nil {
+				return err
+			}
+			return conn.Close()
+		}, func(event *sprobe.Event, r *rules.Rule) {
+			assert.Equal(t, "connect", event.GetType(), "wrong event type")
+			assert.Equal(t, "127.0.0.1", event.Connect.Addr.IPNet.IP.String(), "wrong IP")
+
+			if !validateNetworkSchema(t, event) {
+				t.Error(event.String())
+			}
+		})
+	})
+}
+
+// testDNSQuery is a DNS request for the A record of example.com
+var testDNSQuery = []byte{
+	0x12, 0x34, // id
+	0x01, 0x00, // flags: recursion desired
+	0x00, 0x01, // questions
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, // answers, authority and additional records
+	7, 'e', 'x', 'a', 'm', 'p', 'l', 'e', 3, 'c', 'o', 'm', 0,
+	0x00, 0x01, // type A
+	0x00, 0x01, // class IN
+}
+
+func TestDNSEvent(t *testing.T) {
+	ruleDefs := []*rules.RuleDefinition{
+		{
+			ID:         "test_dns",
+			Expression: `dns.question.name == "example.com" && dns.question.type == 1 && process.file.name == "testsuite"`,
+		},
+	}
+
+	test, err := newTestModule(t, nil, ruleDefs, testOpts{enableNetwork: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	validateDNSEvent := func(t *testing.T, event *sprobe.Event) {
+		assert.Equal(t, "dns", event.GetType(), "wrong event type")
+		assert.Equal(t, uint16(0x1234), event.DNS.ID, "wrong request ID")
+		assert.Equal(t, "example.com", event.DNS.Name, "wrong name")
+		assert.Equal(t, uint16(len(testDNSQuery)), event.DNS.Size, "wrong size")
+	}
+
+	t.Run("sendto", func(t *testing.T) {
+		test.WaitSignal(t, func() error {
+			fd, err := syscall.Socket(syscall.AF_INET, syscall.SOCK_DGRAM, 0)
+			if err != nil {
+				return err
+			}
+			defer syscall.Close(fd)
+
+			return syscall.Sendto(fd, testDNSQuery, 0, &syscall.SockaddrInet4{Port: 53, Addr: [4]byte{127, 0, 0, 1}})
+		}, func(event *sprobe.Event, r *rules.Rule) {
+			validateDNSEvent(t, event)
+		})
+	})
+
+	t.Run("write", func(t *testing.T) {
+		test.WaitSignal(t, func() error {
+			conn, err := net.Dial("udp4", "127.0.0.1:53")
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			_, err = conn.Write(testDNSQuery)
+			return err
+		}, func(event *sprobe.Event, r *rules.Rule) {
+			validateDNSEvent(t, event)
+		})
+	})
+}
@@ -98,3 +98,7 @@ func validateSpanSchema(t *testing.T, event *sprobe.Event) bool {
 func validateBPFSchema(t *testing.T, event *sprobe.Event) bool {
 	return validateSchema(t, event, "file:///schemas/bpf.schema.json")
 }
+
+func validateNetworkSchema(t *testing.T, event *sprobe.Event) bool {
+	return validateSchema(t, event, "file:///schemas/network.schema.json")
+}
@@ -0,0 +1,51 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// +build functionaltests
+
+package tests
+
+import (
+	"os/exec"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sprobe "github.com/DataDog/datadog-agent/pkg/security/probe"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/rules"
+)
+
+func TestSignalEvent(t *testing.T) {
+	ruleDefs := []*rules.RuleDefinition{
+		{
+			ID:         "test_signal",
+			Expression: `signal.type == SIGUSR1 && signal.target.file.name == "sleep" && process.file.name == "testsuite"`,
+		},
+	}
+
+	test, err := newTestModule(t, nil, ruleDefs, testOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer test.Close()
+
+	cmd := exec.Command("sleep", "10")
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+	}()
+
+	test.WaitSignal(t, func() error {
+		return cmd.Process.Signal(syscall.SIGUSR1)
+	}, func(event *sprobe.Event, r *rules.Rule) {
+		assert.Equal(t, "signal", event.GetType(), "wrong event type")
+		assert.Equal(t, uint32(cmd.Process.Pid), event.Signal.PID, "wrong target pid")
+		assert.Equal(t, uint32(cmd.Process.Pid), event.Signal.Target.Pid, "wrong target process context")
+	})
+}
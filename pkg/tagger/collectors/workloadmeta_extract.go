@@ -117,6 +117,8 @@ func (c *WorkloadMetaCollector) processEvents(evBundle workloadmeta.EventBundle)
 				tagInfos = append(tagInfos, c.handleKubePod(ev)...)
 			case workloadmeta.KindECSTask:
 				tagInfos = append(tagInfos, c.handleECSTask(ev)...)
+			case workloadmeta.KindProcess:
+				tagInfos = append(tagInfos, c.handleProcess(ev)...)
 			default:
 				log.Errorf("cannot handle event for entity %q with kind %q", entityID.ID, entityID.Kind)
 			}
@@ -195,7 +197,7 @@ func (c *WorkloadMetaCollector) handleContainer(ev workloadmeta.Event) []*TagInf
 	}
 
 	low, orch, high, standard := tags.Compute()
-	return []*TagInfo{
+	tagInfos := []*TagInfo{
 		{
 			Source:               containerSource,
 			Entity:               buildTaggerEntityID(container.EntityID),
@@ -205,6 +207,8 @@ func (c *WorkloadMetaCollector) handleContainer(ev workloadmeta.Event) []*TagInf
 			StandardTags:         standard,
 		},
 	}
+
+	return append(tagInfos, c.extractDerivedTags(container, buildTaggerEntityID(container.EntityID))...)
 }
 
 func (c *WorkloadMetaCollector) handleKubePod(ev workloadmeta.Event) []*TagInfo {
@@ -259,6 +263,7 @@ func (c *WorkloadMetaCollector) handleKubePod(ev workloadmeta.Event) []*TagInfo
 		},
 	}
 
+	taggerEntityIDs := []string{buildTaggerEntityID(pod.EntityID)}
 	for _, podContainer := range pod.Containers {
 		cTagInfo, err := c.extractTagsFromPodContainer(pod, podContainer, tags.Copy())
 		if err != nil {
@@ -267,15 +272,17 @@ func (c *WorkloadMetaCollector) handleKubePod(ev workloadmeta.Event) []*TagInfo
 		}
 
 		tagInfos = append(tagInfos, cTagInfo)
+		taggerEntityIDs = append(taggerEntityIDs, cTagInfo.Entity)
 	}
 
-	return tagInfos
+	return append(tagInfos, c.extractDerivedTags(pod, taggerEntityIDs...)...)
 }
 
 func (c *WorkloadMetaCollector) handleECSTask(ev workloadmeta.Event) []*TagInfo {
 	task := ev.Entity.(*workloadmeta.ECSTask)
 
 	tagInfos := make([]*TagInfo, 0, len(task.Containers))
+	taggerEntityIDs := make([]string, 0, len(task.Containers))
 	for _, taskContainer := range task.Containers {
 		container, err := c.store.GetContainer(taskContainer.ID)
 		if err != nil {
@@ -284,6 +291,7 @@ func (c *WorkloadMetaCollector) handleECSTask(ev workloadmeta.Event) []*TagInfo
 		}
 
 		c.registerChild(task.EntityID, container.EntityID)
+		taggerEntityIDs = append(taggerEntityIDs, buildTaggerEntityID(container.EntityID))
 
 		tags := utils.NewTagList()
 
@@ -341,6 +349,53 @@ func (c *WorkloadMetaCollector) handleECSTask(ev workloadmeta.Event) []*TagInfo
 		})
 	}
 
+	return append(tagInfos, c.extractDerivedTags(task, taggerEntityIDs...)...)
+}
+
+func (c *WorkloadMetaCollector) handleProcess(ev workloadmeta.Event) []*TagInfo {
+	process := ev.Entity.(*workloadmeta.Process)
+
+	tags := utils.NewTagList()
+	tags.AddLow("process_name", process.Name)
+	tags.AddLow("systemd_unit", process.SystemdUnit)
+
+	low, orch, high, standard := tags.Compute()
+	tagInfos := []*TagInfo{
+		{
+			Source:               processSource,
+			Entity:               buildTaggerEntityID(process.EntityID),
+			HighCardTags:         high,
+			OrchestratorCardTags: orch,
+			LowCardTags:          low,
+			StandardTags:         standard,
+		},
+	}
+
+	return append(tagInfos, c.extractDerivedTags(process, buildTaggerEntityID(process.EntityID))...)
+}
+
+// extractDerivedTags applies the tag derivation rules to an entity, the
+// derived tags are set on the given tagger entities, i.e. the entity itself
+// and its containers
+func (c *WorkloadMetaCollector) extractDerivedTags(entity workloadmeta.Entity, taggerEntityIDs ...string) []*TagInfo {
+	tags := c.deriveTags(entity)
+	if tags == nil {
+		return nil
+	}
+
+	low, orch, high, standard := tags.Compute()
+	tagInfos := make([]*TagInfo, 0, len(taggerEntityIDs))
+	for _, taggerEntityID := range taggerEntityIDs {
+		tagInfos = append(tagInfos, &TagInfo{
+			Source:               rulesSource(entity.GetID().Kind),
+			Entity:               taggerEntityID,
+			HighCardTags:         high,
+			OrchestratorCardTags: orch,
+			LowCardTags:          low,
+			StandardTags:         standard,
+		})
+	}
+
 	return tagInfos
 }
 
@@ -481,21 +536,27 @@ func (c *WorkloadMetaCollector) handleDelete(ev workloadmeta.Event) []*TagInfo {
 
 	children := c.children[taggerEntityID]
 
-	source := fmt.Sprintf("%s-%s", workloadmetaCollectorName, string(entityID.Kind))
-	tagInfos := make([]*TagInfo, 0, len(children)+1)
-	tagInfos = append(tagInfos, &TagInfo{
-		Source:       source,
-		Entity:       taggerEntityID,
-		DeleteEntity: true,
-	})
+	sources := []string{fmt.Sprintf("%s-%s", workloadmetaCollectorName, string(entityID.Kind))}
+	if len(c.tagRules[entityID.Kind]) > 0 {
+		sources = append(sources, rulesSource(entityID.Kind))
+	}
 
-	for childEntityID := range children {
-		t := TagInfo{
+	tagInfos := make([]*TagInfo, 0, (len(children)+1)*len(sources))
+	for _, source := range sources {
+		tagInfos = append(tagInfos, &TagInfo{
 			Source:       source,
-			Entity:       childEntityID,
+			Entity:       taggerEntityID,
 			DeleteEntity: true,
+		})
+
+		for childEntityID := range children {
+			t := TagInfo{
+				Source:       source,
+				Entity:       childEntityID,
+				DeleteEntity: true,
+			}
+			tagInfos = append(tagInfos, &t)
 		}
-		tagInfos = append(tagInfos, &t)
 	}
 
 	return tagInfos
@@ -537,6 +598,8 @@ func buildTaggerEntityID(entityID workloadmeta.EntityID) string {
 		return kubelet.PodUIDToTaggerEntityName(entityID.ID)
 	case workloadmeta.KindECSTask:
 		return fmt.Sprintf("ecs_task://%s", entityID.ID)
+	case workloadmeta.KindProcess:
+		return containers.BuildEntityName(string(entityID.Kind), entityID.ID)
 	default:
 		log.Errorf("can't recognize entity %q with kind %q, but building a a tagger ID anyway", entityID.ID, entityID.Kind)
 		return containers.BuildEntityName(string(entityID.Kind), entityID.ID)
@@ -25,6 +25,7 @@ const (
 	podSource       = workloadmetaCollectorName + "-" + string(workloadmeta.KindKubernetesPod)
 	taskSource      = workloadmetaCollectorName + "-" + string(workloadmeta.KindECSTask)
 	containerSource = workloadmetaCollectorName + "-" + string(workloadmeta.KindContainer)
+	processSource   = workloadmetaCollectorName + "-" + string(workloadmeta.KindProcess)
 )
 
 // WorkloadMetaCollector collects tags from the metadata in the workloadmeta
@@ -48,6 +49,8 @@ type WorkloadMetaCollector struct {
 	globContainerLabels    map[string]glob.Glob
 	globContainerEnvLabels map[string]glob.Glob
 
+	tagRules map[workloadmeta.Kind][]tagRule
+
 	collectEC2ResourceTags bool
 }
 
@@ -75,6 +78,12 @@ func (c *WorkloadMetaCollector) Detect(ctx context.Context, out chan<- []*TagInf
 
 	c.staticTags = fargateStaticTags(ctx)
 
+	var tagRules map[string][]config.TagDerivationRule
+	if err := config.Datadog.UnmarshalKey("tag_derivation_rules", &tagRules); err != nil {
+		log.Errorf("cannot parse tag_derivation_rules: %s", err)
+	}
+	c.tagRules = compileTagRules(tagRules)
+
 	return StreamCollection, nil
 }
 
@@ -199,4 +208,9 @@ func init() {
 	CollectorPriorities[podSource] = NodeOrchestrator
 	CollectorPriorities[taskSource] = NodeOrchestrator
 	CollectorPriorities[containerSource] = NodeRuntime
+	CollectorPriorities[processSource] = NodeRuntime
+	CollectorPriorities[rulesSource(workloadmeta.KindKubernetesPod)] = NodeOrchestrator
+	CollectorPriorities[rulesSource(workloadmeta.KindECSTask)] = NodeOrchestrator
+	CollectorPriorities[rulesSource(workloadmeta.KindContainer)] = NodeRuntime
+	CollectorPriorities[rulesSource(workloadmeta.KindProcess)] = NodeRuntime
 }
@@ -0,0 +1,219 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package collectors
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/DataDog/datadog-agent/pkg/config"
+	"github.com/DataDog/datadog-agent/pkg/tagger/utils"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+	"github.com/DataDog/datadog-agent/pkg/workloadmeta"
+)
+
+// wholeValueRegex is used by the rules without regex, matching the whole value of the field
+var wholeValueRegex = regexp.MustCompile(`^(?s:.*)$`)
+
+// tagRule derives a tag from a field of workloadmeta entities.
+//
+// The field is a dot-separated path in the entity, e.g. `image.name` or
+// `namespace_labels.team`: struct fields are matched case-insensitively, ignoring
+// underscores, the rest of the path is used as the key of maps, and slices yield a
+// value per element. Each value is matched against the regex, and the tag value is
+// built by expanding the value template with the captures of the regex, e.g. `$1`
+// or `${team}`. The default is used when no tag value could be derived.
+type tagRule struct {
+	tag          string
+	field        []string
+	regex        *regexp.Regexp
+	value        string
+	defaultValue string
+	cardinality  string
+}
+
+// rulesSource returns the source of the tags derived by the rules of an entity kind
+func rulesSource(kind workloadmeta.Kind) string {
+	return workloadmetaCollectorName + "-" + string(kind) + "-rules"
+}
+
+// compileTagRules validates the rules of each entity kind, skipping the invalid ones
+func compileTagRules(rulesConfig map[string][]config.TagDerivationRule) map[workloadmeta.Kind][]tagRule {
+	rules := make(map[workloadmeta.Kind][]tagRule)
+	for kind, kindRules := range rulesConfig {
+		for _, r := range kindRules {
+			rule, err := compileTagRule(r)
+			if err != nil {
+				log.Errorf("invalid tag derivation rule for tag %q of %s entities: %s", r.Tag, kind, err)
+				continue
+			}
+			rules[workloadmeta.Kind(kind)] = append(rules[workloadmeta.Kind(kind)], rule)
+		}
+	}
+	return rules
+}
+
+func compileTagRule(r config.TagDerivationRule) (tagRule, error) {
+	rule := tagRule{
+		tag:          r.Tag,
+		regex:        wholeValueRegex,
+		value:        r.Value,
+		defaultValue: r.Default,
+		cardinality:  strings.ToLower(r.Cardinality),
+	}
+
+	if rule.tag == "" {
+		return rule, fmt.Errorf("tag is missing")
+	}
+	if r.Field == "" {
+		return rule, fmt.Errorf("field is missing")
+	}
+	rule.field = strings.Split(r.Field, ".")
+
+	switch rule.cardinality {
+	case "":
+		rule.cardinality = "low"
+	case "low", "orchestrator", "high":
+	default:
+		return rule, fmt.Errorf("unknown cardinality %q", r.Cardinality)
+	}
+
+	if r.Regex != "" {
+		re, err := regexp.Compile(r.Regex)
+		if err != nil {
+			return rule, fmt.Errorf("invalid regex: %s", err)
+		}
+		rule.regex = re
+	}
+
+	// without template, use the first capture group if any, the whole match otherwise
+	if rule.value == "" {
+		rule.value = "$0"
+		if rule.regex.NumSubexp() > 0 {
+			rule.value = "$1"
+		}
+	}
+
+	return rule, nil
+}
+
+// deriveTags applies the rules to an entity, it returns nil if there is no rule for its kind
+func (c *WorkloadMetaCollector) deriveTags(entity workloadmeta.Entity) *utils.TagList {
+	rules := c.tagRules[entity.GetID().Kind]
+	if len(rules) == 0 {
+		return nil
+	}
+
+	tags := utils.NewTagList()
+	for _, rule := range rules {
+		rule.apply(entity, tags)
+	}
+	return tags
+}
+
+func (r *tagRule) apply(entity workloadmeta.Entity, tags *utils.TagList) {
+	add := tags.AddLow
+	switch r.cardinality {
+	case "orchestrator":
+		add = tags.AddOrchestrator
+	case "high":
+		add = tags.AddHigh
+	}
+
+	derived := false
+	for _, value := range lookupField(reflect.ValueOf(entity), r.field) {
+		match := r.regex.FindStringSubmatchIndex(value)
+		if match == nil {
+			continue
+		}
+		tagValue := string(r.regex.ExpandString(nil, r.value, value, match))
+		if tagValue == "" {
+			continue
+		}
+		add(r.tag, tagValue)
+		derived = true
+	}
+
+	if !derived && r.defaultValue != "" {
+		add(r.tag, r.defaultValue)
+	}
+}
+
+// lookupField returns the values of the field at the given path in v
+func lookupField(v reflect.Value, path []string) []string {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		if len(path) == 0 {
+			return nil
+		}
+		name := normalizeFieldName(path[0])
+		field := v.FieldByNameFunc(func(fieldName string) bool {
+			return normalizeFieldName(fieldName) == name
+		})
+		if !field.IsValid() {
+			return nil
+		}
+		return lookupField(field, path[1:])
+
+	case reflect.Map:
+		if len(path) == 0 || v.Type().Key().Kind() != reflect.String {
+			return nil
+		}
+		// map keys can contain dots, e.g. labels
+		value := v.MapIndex(reflect.ValueOf(strings.Join(path, ".")).Convert(v.Type().Key()))
+		if !value.IsValid() {
+			return nil
+		}
+		return lookupField(value, nil)
+
+	case reflect.Slice, reflect.Array:
+		var values []string
+		for i := 0; i < v.Len(); i++ {
+			values = append(values, lookupField(v.Index(i), path)...)
+		}
+		return values
+
+	case reflect.String:
+		if len(path) > 0 || v.String() == "" {
+			return nil
+		}
+		return []string{v.String()}
+
+	case reflect.Bool:
+		if len(path) > 0 {
+			return nil
+		}
+		return []string{strconv.FormatBool(v.Bool())}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if len(path) > 0 {
+			return nil
+		}
+		return []string{strconv.FormatInt(v.Int(), 10)}
+
+	case reflect.Uint, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if len(path) > 0 {
+			return nil
+		}
+		return []string{strconv.FormatUint(v.Uint(), 10)}
+	}
+
+	return nil
+}
+
+func normalizeFieldName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
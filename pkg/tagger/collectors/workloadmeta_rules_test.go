@@ -0,0 +1,230 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package collectors
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DataDog/datadog-agent/pkg/config"
+	"github.com/DataDog/datadog-agent/pkg/workloadmeta"
+	workloadmetatesting "github.com/DataDog/datadog-agent/pkg/workloadmeta/testing"
+)
+
+func TestLookupField(t *testing.T) {
+	pod := &workloadmeta.KubernetesPod{
+		EntityID: workloadmeta.EntityID{
+			Kind: workloadmeta.KindKubernetesPod,
+			ID:   "foobar",
+		},
+		EntityMeta: workloadmeta.EntityMeta{
+			Name:        "datadog-agent-foobar",
+			Namespace:   "default",
+			Labels:      map[string]string{"app.kubernetes.io/name": "datadog-agent"},
+			Annotations: map[string]string{"cost-center": "cc-42"},
+		},
+		Owners: []workloadmeta.KubernetesPodOwner{
+			{Kind: "ReplicaSet", Name: "datadog-agent-6d8f5b"},
+			{Kind: "Job", Name: "datadog-job"},
+		},
+		Ready:           true,
+		NamespaceLabels: map[string]string{"team": "containers"},
+	}
+
+	tests := []struct {
+		field    string
+		expected []string
+	}{
+		{field: "name", expected: []string{"datadog-agent-foobar"}},
+		{field: "Namespace", expected: []string{"default"}},
+		{field: "id", expected: []string{"foobar"}},
+		{field: "labels.app.kubernetes.io/name", expected: []string{"datadog-agent"}},
+		{field: "annotations.cost-center", expected: []string{"cc-42"}},
+		{field: "namespace_labels.team", expected: []string{"containers"}},
+		{field: "owners.name", expected: []string{"datadog-agent-6d8f5b", "datadog-job"}},
+		{field: "ready", expected: []string{"true"}},
+		{field: "labels.unknown", expected: nil},
+		{field: "unknown", expected: nil},
+		{field: "name.unknown", expected: nil},
+		{field: "labels", expected: nil},
+		{field: "owners", expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			assert.Equal(t, tt.expected, lookupField(reflect.ValueOf(pod), strings.Split(tt.field, ".")))
+		})
+	}
+}
+
+func TestCompileTagRules(t *testing.T) {
+	rules := compileTagRules(map[string][]config.TagDerivationRule{
+		"container": {
+			{Tag: "team", Field: "image.name"},
+			{Tag: "team", Field: "image.name", Regex: `^registry/([^/]+)/`},
+			{Tag: "team", Field: "image.name", Regex: `^registry/(?P<team>[^/]+)/`, Value: "team-${team}", Cardinality: "High"},
+			{Field: "image.name"},
+			{Tag: "team"},
+			{Tag: "team", Field: "image.name", Regex: `(`},
+			{Tag: "team", Field: "image.name", Cardinality: "unknown"},
+		},
+	})
+
+	require.Len(t, rules[workloadmeta.KindContainer], 3)
+	r := rules[workloadmeta.KindContainer]
+
+	assert.Equal(t, []string{"image", "name"}, r[0].field)
+	assert.Equal(t, "$0", r[0].value)
+	assert.Equal(t, "low", r[0].cardinality)
+	assert.Equal(t, "$1", r[1].value)
+	assert.Equal(t, "team-${team}", r[2].value)
+	assert.Equal(t, "high", r[2].cardinality)
+}
+
+func TestDeriveTags(t *testing.T) {
+	collector := &WorkloadMetaCollector{
+		tagRules: compileTagRules(map[string][]config.TagDerivationRule{
+			"container": {
+				{Tag: "team", Field: "image.name", Regex: `^registry\.example\.com/([^/]+)/`},
+				{Tag: "cost_center", Field: "labels.cost-center", Default: "unknown"},
+				{Tag: "image_repo", Field: "image.name", Regex: `^(?P<registry>[^/]+)/(?P<repo>.+)$`, Value: "${repo}@${registry}", Cardinality: "orchestrator"},
+				{Tag: "runtime", Field: "runtime", Regex: `^containerd$`, Value: "cri"},
+			},
+		}),
+	}
+
+	container := &workloadmeta.Container{
+		EntityID: workloadmeta.EntityID{
+			Kind: workloadmeta.KindContainer,
+			ID:   "foobarquux",
+		},
+		Image: workloadmeta.ContainerImage{
+			Name: "registry.example.com/containers/agent",
+		},
+		Runtime: workloadmeta.ContainerRuntimeDocker,
+	}
+
+	low, orch, high, standard := collector.deriveTags(container).Compute()
+	assert.ElementsMatch(t, []string{"team:containers", "cost_center:unknown"}, low)
+	assert.Equal(t, []string{"image_repo:containers/agent@registry.example.com"}, orch)
+	assert.Empty(t, high)
+	assert.Empty(t, standard)
+
+	// no rule for pods
+	assert.Nil(t, collector.deriveTags(&workloadmeta.KubernetesPod{
+		EntityID: workloadmeta.EntityID{
+			Kind: workloadmeta.KindKubernetesPod,
+			ID:   "foobar",
+		},
+	}))
+}
+
+func TestHandleKubePodTagRules(t *testing.T) {
+	const containerID = "foobarquux"
+
+	podEntityID := workloadmeta.EntityID{
+		Kind: workloadmeta.KindKubernetesPod,
+		ID:   "foobar",
+	}
+	pod := &workloadmeta.KubernetesPod{
+		EntityID: podEntityID,
+		EntityMeta: workloadmeta.EntityMeta{
+			Name:        "datadog-agent-foobar",
+			Namespace:   "default",
+			Annotations: map[string]string{"cost-center": "cc-42"},
+		},
+		Containers: []workloadmeta.OrchestratorContainer{
+			{
+				ID:   containerID,
+				Name: "agent",
+			},
+		},
+	}
+
+	podTaggerEntityID := fmt.Sprintf("kubernetes_pod_uid://%s", podEntityID.ID)
+	containerTaggerEntityID := fmt.Sprintf("container_id://%s", containerID)
+
+	store := workloadmetatesting.NewStore()
+	store.Set(&workloadmeta.Container{
+		EntityID: workloadmeta.EntityID{
+			Kind: workloadmeta.KindContainer,
+			ID:   containerID,
+		},
+	})
+
+	collector := &WorkloadMetaCollector{
+		store:    store,
+		children: make(map[string]map[string]struct{}),
+		tagRules: compileTagRules(map[string][]config.TagDerivationRule{
+			"kubernetes_pod": {
+				{Tag: "cost_center", Field: "annotations.cost-center"},
+			},
+		}),
+	}
+
+	tagInfos := collector.handleKubePod(workloadmeta.Event{
+		Type:   workloadmeta.EventTypeSet,
+		Entity: pod,
+	})
+
+	source := rulesSource(workloadmeta.KindKubernetesPod)
+	var rulesTagInfos []*TagInfo
+	for _, tagInfo := range tagInfos {
+		if tagInfo.Source == source {
+			rulesTagInfos = append(rulesTagInfos, tagInfo)
+		}
+	}
+
+	assertTagInfoListEqual(t, []*TagInfo{
+		{
+			Source:               source,
+			Entity:               podTaggerEntityID,
+			HighCardTags:         []string{},
+			OrchestratorCardTags: []string{},
+			LowCardTags:          []string{"cost_center:cc-42"},
+			StandardTags:         []string{},
+		},
+		{
+			Source:               source,
+			Entity:               containerTaggerEntityID,
+			HighCardTags:         []string{},
+			OrchestratorCardTags: []string{},
+			LowCardTags:          []string{"cost_center:cc-42"},
+			StandardTags:         []string{},
+		},
+	}, rulesTagInfos)
+
+	assertTagInfoListEqual(t, []*TagInfo{
+		{
+			Source:       podSource,
+			Entity:       podTaggerEntityID,
+			DeleteEntity: true,
+		},
+		{
+			Source:       podSource,
+			Entity:       containerTaggerEntityID,
+			DeleteEntity: true,
+		},
+		{
+			Source:       source,
+			Entity:       podTaggerEntityID,
+			DeleteEntity: true,
+		},
+		{
+			Source:       source,
+			Entity:       containerTaggerEntityID,
+			DeleteEntity: true,
+		},
+	}, collector.handleDelete(workloadmeta.Event{
+		Type:   workloadmeta.EventTypeUnset,
+		Entity: pod,
+	}))
+}
@@ -861,6 +861,42 @@ func TestHandleContainer(t *testing.T) {
 	}
 }
 
+func TestHandleProcess(t *testing.T) {
+	process := &workloadmeta.Process{
+		EntityID: workloadmeta.EntityID{
+			Kind: workloadmeta.KindProcess,
+			ID:   "1234",
+		},
+		EntityMeta: workloadmeta.EntityMeta{
+			Name: "postgres",
+		},
+		PID:         1234,
+		Comm:        "postgres",
+		SystemdUnit: "postgresql@14-main.service",
+	}
+
+	collector := &WorkloadMetaCollector{}
+
+	actual := collector.handleProcess(workloadmeta.Event{
+		Type:   workloadmeta.EventTypeSet,
+		Entity: process,
+	})
+
+	assertTagInfoListEqual(t, []*TagInfo{
+		{
+			Source:               processSource,
+			Entity:               "process://1234",
+			HighCardTags:         []string{},
+			OrchestratorCardTags: []string{},
+			LowCardTags: []string{
+				"process_name:postgres",
+				"systemd_unit:postgresql@14-main.service",
+			},
+			StandardTags: []string{},
+		},
+	}, actual)
+}
+
 func TestHandleDelete(t *testing.T) {
 	const (
 		podName       = "datadog-agent-foobar"
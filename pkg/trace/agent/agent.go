@@ -50,6 +50,10 @@ type Agent struct {
 	TraceWriter           *writer.TraceWriter
 	StatsWriter           *writer.StatsWriter
 
+	// OTLPExporter exports sampled traces to an OpenTelemetry collector.
+	// It is nil when OTLP export is disabled.
+	OTLPExporter *writer.OTLPTraceWriter
+
 	// obfuscator is used to obfuscate sensitive data from various span
 	// tags based on their type.
 	obfuscator *obfuscate.Obfuscator
@@ -88,6 +92,9 @@ func NewAgent(ctx context.Context, conf *config.AgentConfig) *Agent {
 		conf:                  conf,
 		ctx:                   ctx,
 	}
+	if conf.OTLPExporter != nil {
+		agnt.OTLPExporter = writer.NewOTLPTraceWriter(conf)
+	}
 	agnt.Receiver = api.NewHTTPReceiver(conf, dynConf, in, agnt)
 	agnt.OTLPReceiver = api.NewOTLPReceiver(in, conf.OTLPReceiver)
 	return agnt
@@ -110,6 +117,9 @@ func (a *Agent) Run() {
 
 	go a.TraceWriter.Run()
 	go a.StatsWriter.Run()
+	if a.OTLPExporter != nil {
+		go a.OTLPExporter.Run()
+	}
 
 	for i := 0; i < runtime.NumCPU(); i++ {
 		go a.work()
@@ -172,6 +182,9 @@ func (a *Agent) loop() {
 			} {
 				stopper.Stop()
 			}
+			if a.OTLPExporter != nil {
+				a.OTLPExporter.Stop()
+			}
 			return
 		}
 	}
@@ -305,13 +318,13 @@ func (a *Agent) Process(p *api.Payload) {
 			// payload size is getting big; split and flush what we have so far
 			ss.TracerPayload = p.TracerPayload.Cut(i)
 			i = 0
-			a.TraceWriter.In <- ss
+			a.writeChunks(ss)
 			ss = new(writer.SampledChunks)
 		}
 	}
 	ss.TracerPayload = p.TracerPayload
 	if ss.Size > 0 {
-		a.TraceWriter.In <- ss
+		a.writeChunks(ss)
 	}
 	if len(envtraces) > 0 {
 		in := stats.Input{Traces: envtraces}
@@ -324,6 +337,15 @@ func (a *Agent) Process(p *api.Payload) {
 	}
 }
 
+// writeChunks sends the sampled chunks ss to the trace writer and, when enabled,
+// to the OTLP exporter.
+func (a *Agent) writeChunks(ss *writer.SampledChunks) {
+	a.TraceWriter.In <- ss
+	if a.OTLPExporter != nil {
+		a.OTLPExporter.In <- ss
+	}
+}
+
 var _ api.StatsProcessor = (*Agent)(nil)
 
 func (a *Agent) processStats(in pb.ClientStatsPayload, lang, tracerVersion string) pb.ClientStatsPayload {
@@ -140,9 +140,53 @@ func normalize(ts *info.TagStats, s *pb.Span) error {
 			delete(s.Meta, "http.status_code")
 		}
 	}
+	normalizeSpanLinks(ts, s)
+	normalizeSpanEvents(s)
 	return nil
 }
 
+// normalizeSpanLinks drops the links of s which do not point to a valid span.
+func normalizeSpanLinks(ts *info.TagStats, s *pb.Span) {
+	if len(s.SpanLinks) == 0 {
+		return
+	}
+	links := s.SpanLinks[:0]
+	for _, l := range s.SpanLinks {
+		if l == nil || l.SpanID == 0 || (l.TraceID == 0 && l.TraceIDHigh == 0) {
+			atomic.AddInt64(&ts.SpansMalformed.InvalidSpanLink, 1)
+			log.Debugf("Fixing malformed trace. Span link is invalid (reason:invalid_span_link), dropping link %v: %s", l, s)
+			continue
+		}
+		links = append(links, l)
+	}
+	if len(links) == 0 {
+		links = nil
+	}
+	s.SpanLinks = links
+}
+
+// normalizeSpanEvents drops the nil events of s and makes sure that all events
+// have a timestamp, defaulting to the span's start.
+func normalizeSpanEvents(s *pb.Span) {
+	if len(s.SpanEvents) == 0 {
+		return
+	}
+	events := s.SpanEvents[:0]
+	for _, e := range s.SpanEvents {
+		if e == nil {
+			continue
+		}
+		if e.TimeUnixNano == 0 {
+			e.TimeUnixNano = uint64(s.Start)
+		}
+		events = append(events, e)
+	}
+	if len(events) == 0 {
+		events = nil
+	}
+	s.SpanEvents = events
+}
+
 // normalizeChunk takes a trace chunk and
 // * populates Origin field if it wasn't populated
 // * populates Priority field if it wasn't populated
@@ -360,6 +360,33 @@ func TestNormalizeTypeTooLong(t *testing.T) {
 	assert.Equal(t, tsMalformed(&info.SpansMalformed{TypeTruncate: 1}), ts)
 }
 
+func TestNormalizeSpanLinks(t *testing.T) {
+	ts := newTagStats()
+	s := newTestSpan()
+	valid := &pb.SpanLink{TraceID: 1, SpanID: 2}
+	high := &pb.SpanLink{TraceIDHigh: 1, SpanID: 2}
+	s.SpanLinks = []*pb.SpanLink{valid, nil, {TraceID: 1}, high, {SpanID: 2}}
+	assert.NoError(t, normalize(ts, s))
+	assert.Equal(t, []*pb.SpanLink{valid, high}, s.SpanLinks)
+	assert.Equal(t, tsMalformed(&info.SpansMalformed{InvalidSpanLink: 3}), ts)
+
+	s.SpanLinks = []*pb.SpanLink{{TraceID: 1}}
+	assert.NoError(t, normalize(newTagStats(), s))
+	assert.Nil(t, s.SpanLinks)
+}
+
+func TestNormalizeSpanEvents(t *testing.T) {
+	ts := newTagStats()
+	s := newTestSpan()
+	s.SpanEvents = []*pb.SpanEvent{{Name: "no-time"}, nil, {TimeUnixNano: 5, Name: "timed"}}
+	assert.NoError(t, normalize(ts, s))
+	assert.Equal(t, []*pb.SpanEvent{
+		{TimeUnixNano: uint64(s.Start), Name: "no-time"},
+		{TimeUnixNano: 5, Name: "timed"},
+	}, s.SpanEvents)
+	assert.Equal(t, newTagStats(), ts)
+}
+
 func TestNormalizeServiceTag(t *testing.T) {
 	ts := newTagStats()
 	s := newTestSpan()
@@ -9,9 +9,12 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"net"
+	"net/http"
 	"os"
 	"runtime"
 	"runtime/pprof"
+	"strconv"
 	"time"
 
 	"github.com/DataDog/datadog-agent/cmd/manager"
@@ -27,6 +30,7 @@ import (
 	"github.com/DataDog/datadog-agent/pkg/trace/metrics"
 	"github.com/DataDog/datadog-agent/pkg/trace/metrics/timing"
 	"github.com/DataDog/datadog-agent/pkg/trace/osutil"
+	"github.com/DataDog/datadog-agent/pkg/trace/replay"
 	"github.com/DataDog/datadog-agent/pkg/trace/watchdog"
 	"github.com/DataDog/datadog-agent/pkg/util"
 	"github.com/DataDog/datadog-agent/pkg/util/log"
@@ -77,6 +81,13 @@ func Run(ctx context.Context) {
 		return
 	}
 
+	if flags.Replay != "" {
+		if err := runReplay(ctx, cfg); err != nil {
+			osutil.Exitf("Failed to replay %s: %v", flags.Replay, err)
+		}
+		return
+	}
+
 	if err := coreconfig.SetupLogger(
 		coreconfig.LoggerName("TRACE"),
 		cfg.LogLevel,
@@ -199,3 +210,30 @@ func Run(ctx context.Context) {
 		f.Close()
 	}
 }
+
+// runReplay replays the capture file specified by the -replay flag against the receiver
+// specified by -replay-target, or the configured receiver, and prints the results.
+func runReplay(ctx context.Context, cfg *config.AgentConfig) error {
+	f, err := os.Open(flags.Replay)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	r, err := replay.NewReader(f)
+	if err != nil {
+		return err
+	}
+	target := flags.ReplayTarget
+	if target == "" {
+		target = fmt.Sprintf("http://%s", net.JoinHostPort(cfg.ReceiverHost, strconv.Itoa(cfg.ReceiverPort)))
+	}
+	fmt.Printf("Replaying %s against %s (speed: %v)...\n", flags.Replay, target, flags.ReplaySpeed)
+	rp := &replay.Replayer{
+		Target: target,
+		Speed:  flags.ReplaySpeed,
+		Client: &http.Client{Timeout: 10 * time.Second},
+	}
+	stats, err := rp.Replay(ctx, r)
+	fmt.Println(stats)
+	return err
+}
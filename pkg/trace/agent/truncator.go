@@ -11,8 +11,8 @@ import (
 	"github.com/DataDog/datadog-agent/pkg/util/log"
 )
 
-// Truncate checks that the span resource, meta and metrics are within the max length
-// and modifies them if they are not
+// Truncate checks that the span resource, meta, metrics, links and events are within the
+// max length and modifies them if they are not
 func Truncate(s *pb.Span) {
 	r, ok := traceutil.TruncateResource(s.Resource)
 	if !ok {
@@ -51,4 +51,26 @@ func Truncate(s *pb.Span) {
 			s.Metrics[k] = v
 		}
 	}
+	if len(s.SpanLinks) > traceutil.MaxSpanLinks {
+		log.Debugf("span.truncate: dropping %d span links (max %d)", len(s.SpanLinks)-traceutil.MaxSpanLinks, traceutil.MaxSpanLinks)
+		s.SpanLinks = s.SpanLinks[:traceutil.MaxSpanLinks]
+	}
+	for _, l := range s.SpanLinks {
+		if traceutil.TruncateAttributes(l.Attributes) {
+			log.Debugf("span.truncate: truncated span link attributes (max %d/%d chars)", traceutil.MaxMetaKeyLen, traceutil.MaxMetaValLen)
+		}
+	}
+	if len(s.SpanEvents) > traceutil.MaxSpanEvents {
+		log.Debugf("span.truncate: dropping %d span events (max %d)", len(s.SpanEvents)-traceutil.MaxSpanEvents, traceutil.MaxSpanEvents)
+		s.SpanEvents = s.SpanEvents[:traceutil.MaxSpanEvents]
+	}
+	for _, e := range s.SpanEvents {
+		if len(e.Name) > traceutil.MaxSpanEventNameLen {
+			log.Debugf("span.truncate: truncating span event name (max %d chars): %s", traceutil.MaxSpanEventNameLen, e.Name)
+			e.Name = traceutil.TruncateUTF8(e.Name, traceutil.MaxSpanEventNameLen)
+		}
+		if traceutil.TruncateAttributes(e.Attributes) {
+			log.Debugf("span.truncate: truncated span event attributes (max %d/%d chars)", traceutil.MaxMetaKeyLen, traceutil.MaxMetaValLen)
+		}
+	}
 }
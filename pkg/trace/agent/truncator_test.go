@@ -93,3 +93,35 @@ func TestTruncateMetaValueTooLong(t *testing.T) {
 		assert.True(t, len(v) < traceutil.MaxMetaValLen+4)
 	}
 }
+
+func TestTruncateSpanLinks(t *testing.T) {
+	s := testSpan()
+	for i := 0; i < traceutil.MaxSpanLinks+10; i++ {
+		s.SpanLinks = append(s.SpanLinks, &pb.SpanLink{TraceID: 1, SpanID: uint64(i + 1)})
+	}
+	s.SpanLinks[0].Attributes = map[string]string{
+		"foo":                           strings.Repeat("TOOLONG", 25000),
+		strings.Repeat("TOOLONG", 1000): "bar",
+	}
+	Truncate(s)
+	assert.Len(t, s.SpanLinks, traceutil.MaxSpanLinks)
+	assert.Len(t, s.SpanLinks[0].Attributes, 2)
+	for k, v := range s.SpanLinks[0].Attributes {
+		assert.True(t, len(k) < traceutil.MaxMetaKeyLen+4)
+		assert.True(t, len(v) < traceutil.MaxMetaValLen+4)
+	}
+}
+
+func TestTruncateSpanEvents(t *testing.T) {
+	s := testSpan()
+	for i := 0; i < traceutil.MaxSpanEvents+10; i++ {
+		s.SpanEvents = append(s.SpanEvents, &pb.SpanEvent{TimeUnixNano: uint64(i), Name: "event"})
+	}
+	s.SpanEvents[0].Name = strings.Repeat("TOOLONG", 100)
+	s.SpanEvents[0].Attributes = map[string]string{"foo": strings.Repeat("TOOLONG", 25000)}
+	Truncate(s)
+	assert.Len(t, s.SpanEvents, traceutil.MaxSpanEvents)
+	assert.Len(t, s.SpanEvents[0].Name, traceutil.MaxSpanEventNameLen)
+	assert.True(t, len(s.SpanEvents[0].Attributes["foo"]) < traceutil.MaxMetaValLen+4)
+	assert.Equal(t, "event", s.SpanEvents[1].Name)
+}
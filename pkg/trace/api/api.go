@@ -44,7 +44,9 @@ import (
 	"github.com/DataDog/datadog-agent/pkg/trace/metrics/timing"
 	"github.com/DataDog/datadog-agent/pkg/trace/osutil"
 	"github.com/DataDog/datadog-agent/pkg/trace/pb"
+	"github.com/DataDog/datadog-agent/pkg/trace/replay"
 	"github.com/DataDog/datadog-agent/pkg/trace/sampler"
+	"github.com/DataDog/datadog-agent/pkg/trace/traceutil"
 	"github.com/DataDog/datadog-agent/pkg/trace/watchdog"
 	"github.com/DataDog/datadog-agent/pkg/util/log"
 )
@@ -78,6 +80,7 @@ type HTTPReceiver struct {
 	statsProcessor   StatsProcessor
 	appsecHandler    http.Handler
 	configSubscriber *config.Subscriber
+	recorder         *replay.Recorder // nil when recording is disabled
 
 	debug               bool
 	rateLimiterResponse int // HTTP status code when refusing
@@ -96,9 +99,18 @@ func NewHTTPReceiver(conf *config.AgentConfig, dynConf *sampler.DynamicConfig, o
 	if err != nil {
 		log.Errorf("Could not instantiate AppSec: %v", err)
 	}
+	var recorder *replay.Recorder
+	if conf.CaptureFile != "" {
+		recorder, err = replay.NewRecorder(conf.CaptureFile, conf.CaptureMaxBytes)
+		if err != nil {
+			log.Errorf("Could not start recording trace payloads: %v", err)
+		}
+	}
+	rateLimiter := newRateLimiter()
+	rateLimiter.weights = conf.ServiceWeights
 	return &HTTPReceiver{
 		Stats:       info.NewReceiverStats(),
-		RateLimiter: newRateLimiter(),
+		RateLimiter: rateLimiter,
 
 		out:              out,
 		statsProcessor:   statsProcessor,
@@ -106,6 +118,7 @@ func NewHTTPReceiver(conf *config.AgentConfig, dynConf *sampler.DynamicConfig, o
 		conf:             conf,
 		dynConf:          dynConf,
 		appsecHandler:    appsecHandler,
+		recorder:         recorder,
 
 		debug:               strings.ToLower(conf.LogLevel) == "debug",
 		rateLimiterResponse: rateLimiterResponse,
@@ -120,10 +133,14 @@ func (r *HTTPReceiver) buildMux() *http.ServeMux {
 	hash, infoHandler := r.makeInfoHandler()
 	r.attachDebugHandlers(mux)
 	for _, e := range endpoints {
-		if e.IsEnabled != nil && !e.IsEnabled() {
+		if e.IsEnabled != nil && !e.IsEnabled(r.conf) {
 			continue
 		}
-		mux.Handle(e.Pattern, replyWithVersion(hash, e.Handler(r)))
+		h := e.Handler(r)
+		if e.Recorded && r.recorder != nil {
+			h = r.recorder.Wrap(h, r.conf.MaxRequestBytes)
+		}
+		mux.Handle(e.Pattern, replyWithVersion(hash, h))
 	}
 	mux.HandleFunc("/info", infoHandler)
 
@@ -293,6 +310,11 @@ func (r *HTTPReceiver) Stop() error {
 	}
 	r.wg.Wait()
 	close(r.out)
+	if r.recorder != nil {
+		if err := r.recorder.Stop(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -456,7 +478,8 @@ func (r *HTTPReceiver) replyOK(v Version, w http.ResponseWriter) (n uint64, ok b
 	}
 }
 
-// rateLimited reports whether n number of traces should be rejected by the API.
+// rateLimited reports whether n number of traces should be rejected by the API. When fair-share
+// rate limiting is enabled, payloads are never rejected as a whole; see rateLimitChunks.
 func (r *HTTPReceiver) rateLimited(n int64) bool {
 	if n == 0 {
 		return false
@@ -465,9 +488,54 @@ func (r *HTTPReceiver) rateLimited(n int64) bool {
 		// rate limiting is off
 		return false
 	}
+	if r.conf.FairShareRateLimiting {
+		return false
+	}
 	return !r.RateLimiter.Permits(n)
 }
 
+// rateLimitChunks removes from tp the chunks rejected by the per-service (fair-share) rate
+// limiter and counts them in ts. Chunks are attributed to the env and service of their root span.
+func (r *HTTPReceiver) rateLimitChunks(tp *pb.TracerPayload, ts *info.TagStats) {
+	if len(tp.Chunks) == 0 || !r.conf.FairShareRateLimiting {
+		return
+	}
+	if r.conf.MaxMemory == 0 && r.conf.MaxCPU == 0 {
+		// rate limiting is off
+		return
+	}
+	keys := make([]serviceKey, len(tp.Chunks))
+	counts := make(map[serviceKey]int64)
+	for i, chunk := range tp.Chunks {
+		k := serviceKey{env: tp.Env}
+		if root := traceutil.GetRoot(chunk.Spans); root != nil {
+			k.service = root.Service
+			if env, ok := root.Meta["env"]; ok {
+				k.env = env
+			}
+		}
+		if k.env == "" {
+			k.env = r.conf.DefaultEnv
+		}
+		keys[i] = k
+		counts[k]++
+	}
+	keep := r.RateLimiter.PermitsServices(counts)
+	n := 0
+	for i, chunk := range tp.Chunks {
+		if keep[keys[i]] {
+			tp.Chunks[n] = chunk
+			n++
+		}
+	}
+	if dropped := len(tp.Chunks) - n; dropped > 0 {
+		log.Debugf("Fair-share rate limiting dropped %d traces", dropped)
+		atomic.AddInt64(&ts.TracesDropped.RateLimited, int64(dropped))
+		metrics.Count("datadog.trace_agent.receiver.ratelimit.traces_dropped", int64(dropped), nil, 1)
+	}
+	tp.Chunks = tp.Chunks[:n]
+}
+
 // StatsProcessor implementations are able to process incoming client stats.
 type StatsProcessor interface {
 	// ProcessStats takes a stats payload and consumes it. It is considered to be originating
@@ -609,7 +677,13 @@ func (r *HTTPReceiver) handleTraces(v Version, w http.ResponseWriter, req *http.
 		ClientComputedStats:    req.Header.Get(headerComputedStats) != "",
 		ClientDroppedP0s:       droppedTracesFromHeader(req.Header, ts),
 	}
+	r.submit(payload)
+}
 
+// submit sends the given payload down the out channel, after applying per-service rate limiting.
+// If the channel is blocked, the payload is sent asynchronously to ensure that it is never dropped.
+func (r *HTTPReceiver) submit(payload *Payload) {
+	r.rateLimitChunks(payload.TracerPayload, payload.Source)
 	select {
 	case r.out <- payload:
 		// ok
@@ -764,6 +838,12 @@ func (r *HTTPReceiver) watchdog(now time.Time) {
 	metrics.Gauge("datadog.trace_agent.heap_alloc", float64(wi.Mem.Alloc), nil, 1)
 	metrics.Gauge("datadog.trace_agent.cpu_percent", wi.CPU.UserAvg*100, nil, 1)
 	metrics.Gauge("datadog.trace_agent.receiver.ratelimit", stats.TargetRate, nil, 1)
+	for _, ss := range stats.Services {
+		if ss.TargetRate < 1 {
+			tags := []string{"env:" + ss.Env, "service:" + ss.Service}
+			metrics.Gauge("datadog.trace_agent.receiver.ratelimit.service", ss.TargetRate, tags, 1)
+		}
+	}
 }
 
 // Languages returns the list of the languages used in the traces the agent receives.
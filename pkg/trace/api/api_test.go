@@ -14,6 +14,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"reflect"
 	"strconv"
 	"strings"
@@ -25,6 +26,7 @@ import (
 	"github.com/DataDog/datadog-agent/pkg/trace/config/features"
 	"github.com/DataDog/datadog-agent/pkg/trace/info"
 	"github.com/DataDog/datadog-agent/pkg/trace/pb"
+	"github.com/DataDog/datadog-agent/pkg/trace/replay"
 	"github.com/DataDog/datadog-agent/pkg/trace/sampler"
 	"github.com/DataDog/datadog-agent/pkg/trace/test/testutil"
 
@@ -1191,3 +1193,103 @@ func msgpTraces(t *testing.T, traces pb.Traces) []byte {
 	}
 	return bts
 }
+
+func TestReceiverCapture(t *testing.T) {
+	assert := assert.New(t)
+	conf := newTestReceiverConfig()
+	conf.CaptureFile = filepath.Join(t.TempDir(), "capture")
+	rcv := newTestReceiverFromConfig(conf)
+	server := httptest.NewServer(rcv.buildMux())
+	defer server.Close()
+
+	body, err := testutil.GetTestTraces(1, 1, false).MarshalMsg(nil)
+	assert.NoError(err)
+	req, err := http.NewRequest("PUT", server.URL+"/v0.4/traces", bytes.NewReader(body))
+	assert.NoError(err)
+	req.Header.Set("Content-Type", "application/msgpack")
+	req.Header.Set(headerLang, "go")
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(err)
+	resp.Body.Close()
+	assert.Equal(http.StatusOK, resp.StatusCode)
+	select {
+	case p := <-rcv.out:
+		assert.Len(p.Chunks(), 1)
+	case <-time.After(time.Second):
+		t.Fatal("no output")
+	}
+	// proxied endpoints are not recorded
+	resp, err = http.Post(server.URL+"/profiling/v1/input", "text/plain", strings.NewReader("profile"))
+	assert.NoError(err)
+	resp.Body.Close()
+	assert.NoError(rcv.recorder.Stop())
+
+	f, err := os.Open(conf.CaptureFile)
+	assert.NoError(err)
+	defer f.Close()
+	r, err := replay.NewReader(f)
+	assert.NoError(err)
+	rec, err := r.Next()
+	assert.NoError(err)
+	assert.Equal("/v0.4/traces", rec.Path)
+	assert.Equal("go", rec.Header.Get(headerLang))
+	assert.Equal(body, rec.Body)
+	_, err = r.Next()
+	assert.Equal(io.EOF, err)
+}
+
+func TestReceiverFairShareRateLimiting(t *testing.T) {
+	assert := assert.New(t)
+	conf := newTestReceiverConfig()
+	conf.DefaultEnv = "none"
+	conf.FairShareRateLimiting = true
+	rcv := newTestReceiverFromConfig(conf)
+
+	chunk := func(service, env string) *pb.TraceChunk {
+		span := &pb.Span{Service: service, TraceID: 1, SpanID: 1}
+		if env != "" {
+			span.Meta = map[string]string{"env": env}
+		}
+		return &pb.TraceChunk{Spans: []*pb.Span{span}}
+	}
+	// the chatty service is well above its share
+	rcv.RateLimiter.PermitsServices(map[serviceKey]int64{
+		{env: "prod", service: "chatty"}: 1000,
+		{env: "none", service: "quiet"}:  10,
+	})
+	rcv.RateLimiter.SetTargetRate(0.5)
+
+	tp := &pb.TracerPayload{Chunks: []*pb.TraceChunk{
+		chunk("chatty", "prod"),
+		chunk("quiet", ""),
+		chunk("chatty", "prod"),
+		chunk("chatty", "staging"),
+	}}
+	ts := info.NewReceiverStats().GetTagStats(info.Tags{})
+	rcv.rateLimitChunks(tp, ts)
+	assert.Len(tp.Chunks, 2)
+	assert.EqualValues(2, ts.TracesDropped.RateLimited)
+	assert.Equal("quiet", tp.Chunks[0].Spans[0].Service)
+	assert.Equal("staging", tp.Chunks[1].Spans[0].Meta["env"], "services are tracked by env")
+
+	t.Run("disabled", func(t *testing.T) {
+		conf.MaxCPU = 0
+		conf.MaxMemory = 0
+		tp := &pb.TracerPayload{Chunks: []*pb.TraceChunk{chunk("chatty", "prod")}}
+		rcv.rateLimitChunks(tp, ts)
+		assert.Len(tp.Chunks, 1)
+	})
+}
+
+func TestReceiverFairShareRateLimited(t *testing.T) {
+	conf := newTestReceiverConfig()
+	assert.False(t, conf.FairShareRateLimiting, "disabled by default")
+
+	rcv := newTestReceiverFromConfig(conf)
+	rcv.RateLimiter.SetTargetRate(0.000001)
+	rcv.rateLimited(10) // the first payload is always accepted
+	assert.True(t, rcv.rateLimited(10), "whole payloads are rejected")
+
+	conf.FairShareRateLimiting = true
+	assert.False(t, rcv.rateLimited(10), "chunks are rejected per service")
+}
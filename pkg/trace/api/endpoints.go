@@ -8,6 +8,7 @@ package api
 import (
 	"net/http"
 
+	"github.com/DataDog/datadog-agent/pkg/trace/config"
 	"github.com/DataDog/datadog-agent/pkg/trace/config/features"
 )
 
@@ -23,74 +24,91 @@ type endpoint struct {
 	// discovery endpoint.
 	Hidden bool
 
+	// Recorded reports whether requests to this endpoint are written to the capture
+	// file, when recording is enabled.
+	Recorded bool
+
 	// IsEnabled specifies a function which reports whether this endpoint should be enabled
 	// based on the given config conf.
-	IsEnabled func() bool
+	IsEnabled func(conf *config.AgentConfig) bool
 }
 
 // endpoints specifies the list of endpoints registered for the trace-agent API.
 var endpoints = []endpoint{
 	{
-		Pattern: "/spans",
-		Handler: func(r *HTTPReceiver) http.Handler { return r.handleWithVersion(v01, r.handleTraces) },
-		Hidden:  true,
+		Pattern:  "/spans",
+		Handler:  func(r *HTTPReceiver) http.Handler { return r.handleWithVersion(v01, r.handleTraces) },
+		Recorded: true,
+		Hidden:   true,
 	},
 	{
-		Pattern: "/services",
-		Handler: func(r *HTTPReceiver) http.Handler { return r.handleWithVersion(v01, r.handleServices) },
-		Hidden:  true,
+		Pattern:  "/services",
+		Handler:  func(r *HTTPReceiver) http.Handler { return r.handleWithVersion(v01, r.handleServices) },
+		Recorded: true,
+		Hidden:   true,
 	},
 	{
-		Pattern: "/v0.1/spans",
-		Handler: func(r *HTTPReceiver) http.Handler { return r.handleWithVersion(v01, r.handleTraces) },
-		Hidden:  true,
+		Pattern:  "/v0.1/spans",
+		Handler:  func(r *HTTPReceiver) http.Handler { return r.handleWithVersion(v01, r.handleTraces) },
+		Recorded: true,
+		Hidden:   true,
 	},
 	{
-		Pattern: "/v0.1/services",
-		Handler: func(r *HTTPReceiver) http.Handler { return r.handleWithVersion(v01, r.handleServices) },
-		Hidden:  true,
+		Pattern:  "/v0.1/services",
+		Handler:  func(r *HTTPReceiver) http.Handler { return r.handleWithVersion(v01, r.handleServices) },
+		Recorded: true,
+		Hidden:   true,
 	},
 	{
-		Pattern: "/v0.2/traces",
-		Handler: func(r *HTTPReceiver) http.Handler { return r.handleWithVersion(v02, r.handleTraces) },
-		Hidden:  true,
+		Pattern:  "/v0.2/traces",
+		Handler:  func(r *HTTPReceiver) http.Handler { return r.handleWithVersion(v02, r.handleTraces) },
+		Recorded: true,
+		Hidden:   true,
 	},
 	{
-		Pattern: "/v0.2/services",
-		Handler: func(r *HTTPReceiver) http.Handler { return r.handleWithVersion(v02, r.handleServices) },
-		Hidden:  true,
+		Pattern:  "/v0.2/services",
+		Handler:  func(r *HTTPReceiver) http.Handler { return r.handleWithVersion(v02, r.handleServices) },
+		Recorded: true,
+		Hidden:   true,
 	},
 	{
-		Pattern: "/v0.3/traces",
-		Handler: func(r *HTTPReceiver) http.Handler { return r.handleWithVersion(v03, r.handleTraces) },
+		Pattern:  "/v0.3/traces",
+		Handler:  func(r *HTTPReceiver) http.Handler { return r.handleWithVersion(v03, r.handleTraces) },
+		Recorded: true,
 	},
 	{
-		Pattern: "/v0.3/services",
-		Handler: func(r *HTTPReceiver) http.Handler { return r.handleWithVersion(v03, r.handleServices) },
+		Pattern:  "/v0.3/services",
+		Handler:  func(r *HTTPReceiver) http.Handler { return r.handleWithVersion(v03, r.handleServices) },
+		Recorded: true,
 	},
 	{
-		Pattern: "/v0.4/traces",
-		Handler: func(r *HTTPReceiver) http.Handler { return r.handleWithVersion(v04, r.handleTraces) },
+		Pattern:  "/v0.4/traces",
+		Handler:  func(r *HTTPReceiver) http.Handler { return r.handleWithVersion(v04, r.handleTraces) },
+		Recorded: true,
 	},
 	{
-		Pattern: "/v0.4/services",
-		Handler: func(r *HTTPReceiver) http.Handler { return r.handleWithVersion(v04, r.handleServices) },
+		Pattern:  "/v0.4/services",
+		Handler:  func(r *HTTPReceiver) http.Handler { return r.handleWithVersion(v04, r.handleServices) },
+		Recorded: true,
 	},
 	{
-		Pattern: "/v0.5/traces",
-		Handler: func(r *HTTPReceiver) http.Handler { return r.handleWithVersion(v05, r.handleTraces) },
+		Pattern:  "/v0.5/traces",
+		Handler:  func(r *HTTPReceiver) http.Handler { return r.handleWithVersion(v05, r.handleTraces) },
+		Recorded: true,
 	},
 	{
-		Pattern: "/v0.6/traces",
-		Handler: func(r *HTTPReceiver) http.Handler { return r.handleWithVersion(v06, r.handleTraces) },
+		Pattern:  "/v0.6/traces",
+		Handler:  func(r *HTTPReceiver) http.Handler { return r.handleWithVersion(v06, r.handleTraces) },
+		Recorded: true,
 	},
 	{
 		Pattern: "/profiling/v1/input",
 		Handler: func(r *HTTPReceiver) http.Handler { return r.profileProxyHandler() },
 	},
 	{
-		Pattern: "/v0.6/stats",
-		Handler: func(r *HTTPReceiver) http.Handler { return http.HandlerFunc(r.handleStats) },
+		Pattern:  "/v0.6/stats",
+		Handler:  func(r *HTTPReceiver) http.Handler { return http.HandlerFunc(r.handleStats) },
+		Recorded: true,
 	},
 	{
 		Pattern: "/appsec/proxy/",
@@ -103,6 +121,18 @@ var endpoints = []endpoint{
 	{
 		Pattern:   "/v0.6/config",
 		Handler:   func(r *HTTPReceiver) http.Handler { return http.HandlerFunc(r.handleConfig) },
-		IsEnabled: func() bool { return features.Has("config_endpoint") },
+		IsEnabled: func(_ *config.AgentConfig) bool { return features.Has("config_endpoint") },
+	},
+	{
+		Pattern:   "/api/v2/spans",
+		Handler:   func(r *HTTPReceiver) http.Handler { return r.handleSpans(zipkinV2, decodeZipkinPayload) },
+		Recorded:  true,
+		IsEnabled: func(conf *config.AgentConfig) bool { return conf.ZipkinReceiverEnabled },
+	},
+	{
+		Pattern:   "/api/traces",
+		Handler:   func(r *HTTPReceiver) http.Handler { return r.handleSpans(jaegerThrift, decodeJaegerPayload) },
+		Recorded:  true,
+		IsEnabled: func(conf *config.AgentConfig) bool { return conf.JaegerReceiverEnabled },
 	},
 }
@@ -0,0 +1,532 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package api
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/DataDog/datadog-agent/pkg/trace/pb"
+)
+
+// The types below mirror the Jaeger Thrift IDL:
+// https://github.com/jaegertracing/jaeger-idl/blob/master/thrift/jaeger.thrift
+
+// jaegerTagType is the type of the value held by a jaegerTag.
+type jaegerTagType int32
+
+const (
+	jaegerTagString jaegerTagType = iota
+	jaegerTagDouble
+	jaegerTagBool
+	jaegerTagLong
+	jaegerTagBinary
+)
+
+// jaegerTag is a basic strongly typed key/value pair.
+type jaegerTag struct {
+	Key     string
+	VType   jaegerTagType
+	VStr    string
+	VDouble float64
+	VBool   bool
+	VLong   int64
+	VBinary []byte
+}
+
+// jaegerLog is a timed event with an arbitrary set of tags.
+type jaegerLog struct {
+	Timestamp int64 // microseconds
+	Fields    []jaegerTag
+}
+
+const (
+	// jaegerSpanRefChildOf is the jaegerSpanRef.RefType of a parent/child relationship.
+	jaegerSpanRefChildOf = 0
+	// jaegerSpanRefFollowsFrom is the jaegerSpanRef.RefType of a causal relationship
+	// where the parent does not depend on the result of the child.
+	jaegerSpanRefFollowsFrom = 1
+)
+
+// jaegerSpanRef describes a causal relationship of the current span to another span.
+type jaegerSpanRef struct {
+	RefType     int32
+	TraceIDLow  int64
+	TraceIDHigh int64
+	SpanID      int64
+}
+
+// jaegerSpan is a named unit of work performed by a service.
+type jaegerSpan struct {
+	TraceIDLow    int64
+	TraceIDHigh   int64
+	SpanID        int64
+	ParentSpanID  int64
+	OperationName string
+	References    []jaegerSpanRef
+	Flags         int32
+	StartTime     int64 // microseconds
+	Duration      int64 // microseconds
+	Tags          []jaegerTag
+	Logs          []jaegerLog
+}
+
+// jaegerProcess describes the traced process/service that emits spans.
+type jaegerProcess struct {
+	ServiceName string
+	Tags        []jaegerTag
+}
+
+// jaegerBatch is a collection of spans reported out of process.
+type jaegerBatch struct {
+	Process jaegerProcess
+	Spans   []*jaegerSpan
+}
+
+// jaegerFlagDebug is set on spans that were forcibly sampled by the client.
+const jaegerFlagDebug = 2
+
+// decodeJaegerPayload decodes a Jaeger Batch serialized using the Thrift binary protocol,
+// as sent by Jaeger clients to the collector's HTTP endpoint, into a TracerPayload.
+func decodeJaegerPayload(body []byte, req *http.Request) (*pb.TracerPayload, error) {
+	r := &thriftReader{b: body}
+	batch := r.readBatch()
+	if r.err != nil {
+		return nil, r.err
+	}
+	return jaegerToTracerPayload(batch), nil
+}
+
+// jaegerToTracerPayload converts the given Jaeger batch into a TracerPayload.
+func jaegerToTracerPayload(batch *jaegerBatch) *pb.TracerPayload {
+	ptags := make(map[string]string, len(batch.Process.Tags))
+	for _, tag := range batch.Process.Tags {
+		ptags[tag.Key] = tag.stringValue()
+	}
+	spans := make([]*pb.Span, 0, len(batch.Spans))
+	debug := make(map[uint64]bool)
+	for _, js := range batch.Spans {
+		span := convertJaegerSpan(batch.Process.ServiceName, ptags, js)
+		if js.Flags&jaegerFlagDebug != 0 {
+			debug[span.SpanID] = true
+		}
+		spans = append(spans, span)
+	}
+	tp := &pb.TracerPayload{
+		Chunks:   traceChunksFromForeignSpans(spans, debug),
+		Hostname: ptags["hostname"],
+	}
+	if v := ptags["jaeger.version"]; v != "" {
+		// e.g. "Go-2.30.0"
+		tp.TracerVersion = "jaeger-" + v
+		if i := strings.IndexByte(v, '-'); i > 0 {
+			tp.LanguageName = strings.ToLower(v[:i])
+		}
+	}
+	return tp
+}
+
+// convertJaegerSpan converts the Jaeger span in to a Datadog span, using the service name
+// and tags of the process which emitted it.
+func convertJaegerSpan(service string, ptags map[string]string, in *jaegerSpan) *pb.Span {
+	span := &pb.Span{
+		TraceID:  uint64(in.TraceIDLow),
+		SpanID:   uint64(in.SpanID),
+		ParentID: uint64(in.ParentSpanID),
+		Start:    in.StartTime * 1000,
+		Duration: in.Duration * 1000,
+		Service:  service,
+		Resource: in.OperationName,
+		Meta:     make(map[string]string, len(ptags)+len(in.Tags)+1),
+		Metrics:  map[string]float64{},
+	}
+	for _, ref := range in.References {
+		if span.ParentID == 0 && ref.RefType == jaegerSpanRefChildOf && ref.TraceIDLow == in.TraceIDLow {
+			span.ParentID = uint64(ref.SpanID)
+			continue
+		}
+		if uint64(ref.SpanID) == span.ParentID && ref.TraceIDLow == in.TraceIDLow {
+			// already represented by the parent ID
+			continue
+		}
+		// any other reference, such as a "follows from" relationship to a span from
+		// another trace, is kept as a span link.
+		refType := "child_of"
+		if ref.RefType == jaegerSpanRefFollowsFrom {
+			refType = "follows_from"
+		}
+		span.SpanLinks = append(span.SpanLinks, &pb.SpanLink{
+			TraceID:     uint64(ref.TraceIDLow),
+			TraceIDHigh: uint64(ref.TraceIDHigh),
+			SpanID:      uint64(ref.SpanID),
+			Attributes:  map[string]string{"jaeger.ref_type": refType},
+		})
+	}
+	for k, v := range ptags {
+		span.Meta[k] = v
+	}
+	span.Meta["jaeger.trace_id"] = fmt.Sprintf("%016x%016x", uint64(in.TraceIDHigh), uint64(in.TraceIDLow))
+	for _, tag := range in.Tags {
+		switch tag.VType {
+		case jaegerTagDouble:
+			span.Metrics[tag.Key] = tag.VDouble
+		case jaegerTagLong:
+			span.Metrics[tag.Key] = float64(tag.VLong)
+		default:
+			span.Meta[tag.Key] = tag.stringValue()
+		}
+	}
+	kind := strings.ToLower(span.Meta["span.kind"])
+	if kind == "" {
+		kind = "unspecified"
+	}
+	span.Name = "jaeger." + kind
+	if v := span.Meta["error"]; v == "true" {
+		span.Error = 1
+	}
+	if len(in.Logs) > 0 {
+		span.SpanEvents = make([]*pb.SpanEvent, 0, len(in.Logs))
+		for _, l := range in.Logs {
+			e := &pb.SpanEvent{
+				TimeUnixNano: uint64(l.Timestamp) * 1000,
+				Attributes:   make(map[string]string, len(l.Fields)),
+			}
+			for _, f := range l.Fields {
+				if f.Key == "event" {
+					e.Name = f.stringValue()
+					continue
+				}
+				e.Attributes[f.Key] = f.stringValue()
+			}
+			if span.Error == 1 && e.Name == "error" {
+				// OpenTracing error log conventions
+				if v := e.Attributes["message"]; v != "" {
+					span.Meta["error.msg"] = v
+				} else if v := e.Attributes["error.object"]; v != "" {
+					span.Meta["error.msg"] = v
+				}
+				if v := e.Attributes["error.kind"]; v != "" {
+					span.Meta["error.type"] = v
+				}
+				if v := e.Attributes["stack"]; v != "" {
+					span.Meta["error.stack"] = v
+				}
+			}
+			span.SpanEvents = append(span.SpanEvents, e)
+		}
+		setForeignSpanEvents(span)
+	}
+	setForeignSpanDefaults(span)
+	span.Type = foreignSpanKindType(kind, span.Meta)
+	return span
+}
+
+// stringValue returns the string representation of the tag's value.
+func (t *jaegerTag) stringValue() string {
+	switch t.VType {
+	case jaegerTagDouble:
+		return strconv.FormatFloat(t.VDouble, 'f', -1, 64)
+	case jaegerTagBool:
+		return strconv.FormatBool(t.VBool)
+	case jaegerTagLong:
+		return strconv.FormatInt(t.VLong, 10)
+	case jaegerTagBinary:
+		return base64.StdEncoding.EncodeToString(t.VBinary)
+	default:
+		return t.VStr
+	}
+}
+
+// Thrift field types, as used by the binary protocol.
+const (
+	thriftStop   byte = 0
+	thriftBool   byte = 2
+	thriftByte   byte = 3
+	thriftDouble byte = 4
+	thriftI16    byte = 6
+	thriftI32    byte = 8
+	thriftI64    byte = 10
+	thriftString byte = 11
+	thriftStruct byte = 12
+	thriftMap    byte = 13
+	thriftSet    byte = 14
+	thriftList   byte = 15
+)
+
+// thriftMaxDepth is the maximum nesting depth of skipped values.
+const thriftMaxDepth = 64
+
+// errThriftTruncated is returned when a Thrift payload ends unexpectedly.
+var errThriftTruncated = errors.New("thrift: truncated payload")
+
+// thriftReader reads values encoded using the Thrift binary protocol. The first error
+// encountered is stored in err, after which all reads return zero values.
+type thriftReader struct {
+	b   []byte // unread bytes
+	err error
+}
+
+func (r *thriftReader) next(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if n < 0 || len(r.b) < n {
+		r.err = errThriftTruncated
+		return nil
+	}
+	v := r.b[:n]
+	r.b = r.b[n:]
+	return v
+}
+
+func (r *thriftReader) readByte() byte {
+	if b := r.next(1); b != nil {
+		return b[0]
+	}
+	return 0
+}
+
+func (r *thriftReader) readI16() int16 {
+	if b := r.next(2); b != nil {
+		return int16(binary.BigEndian.Uint16(b))
+	}
+	return 0
+}
+
+func (r *thriftReader) readI32() int32 {
+	if b := r.next(4); b != nil {
+		return int32(binary.BigEndian.Uint32(b))
+	}
+	return 0
+}
+
+func (r *thriftReader) readI64() int64 {
+	if b := r.next(8); b != nil {
+		return int64(binary.BigEndian.Uint64(b))
+	}
+	return 0
+}
+
+func (r *thriftReader) readDouble() float64 {
+	return math.Float64frombits(uint64(r.readI64()))
+}
+
+func (r *thriftReader) readBool() bool {
+	return r.readByte() != 0
+}
+
+func (r *thriftReader) readBinary() []byte {
+	return r.next(int(r.readI32()))
+}
+
+func (r *thriftReader) readString() string {
+	return string(r.readBinary())
+}
+
+// readStruct reads a struct, calling fn for each of its fields. fn must consume the field's
+// value, either by reading it or by calling skip.
+func (r *thriftReader) readStruct(fn func(id int16, typ byte)) {
+	for r.err == nil {
+		typ := r.readByte()
+		if typ == thriftStop {
+			return
+		}
+		id := r.readI16()
+		fn(id, typ)
+	}
+}
+
+// readList reads a list header, calling fn once for each of its elements, which must be of
+// type elem. Lists of a different type are skipped.
+func (r *thriftReader) readList(elem byte, fn func()) {
+	typ := r.readByte()
+	n := int(r.readI32())
+	if n < 0 || n > len(r.b) {
+		// each element takes at least one byte
+		if r.err == nil {
+			r.err = errThriftTruncated
+		}
+		return
+	}
+	for i := 0; i < n && r.err == nil; i++ {
+		if typ == elem {
+			fn()
+		} else {
+			r.skip(typ, 0)
+		}
+	}
+}
+
+// skip consumes a value of the given type.
+func (r *thriftReader) skip(typ byte, depth int) {
+	if depth > thriftMaxDepth {
+		r.err = errors.New("thrift: maximum nesting depth exceeded")
+		return
+	}
+	switch typ {
+	case thriftBool, thriftByte:
+		r.next(1)
+	case thriftI16:
+		r.next(2)
+	case thriftI32:
+		r.next(4)
+	case thriftDouble, thriftI64:
+		r.next(8)
+	case thriftString:
+		r.readBinary()
+	case thriftStruct:
+		r.readStruct(func(_ int16, typ byte) { r.skip(typ, depth+1) })
+	case thriftMap:
+		ktyp, vtyp := r.readByte(), r.readByte()
+		n := int(r.readI32())
+		for i := 0; i < n && r.err == nil; i++ {
+			r.skip(ktyp, depth+1)
+			r.skip(vtyp, depth+1)
+		}
+	case thriftSet, thriftList:
+		etyp := r.readByte()
+		n := int(r.readI32())
+		for i := 0; i < n && r.err == nil; i++ {
+			r.skip(etyp, depth+1)
+		}
+	default:
+		if r.err == nil {
+			r.err = fmt.Errorf("thrift: unknown field type %d", typ)
+		}
+	}
+}
+
+func (r *thriftReader) readBatch() *jaegerBatch {
+	var b jaegerBatch
+	r.readStruct(func(id int16, typ byte) {
+		switch {
+		case id == 1 && typ == thriftStruct:
+			r.readProcess(&b.Process)
+		case id == 2 && typ == thriftList:
+			r.readList(thriftStruct, func() { b.Spans = append(b.Spans, r.readSpan()) })
+		default:
+			r.skip(typ, 0)
+		}
+	})
+	return &b
+}
+
+func (r *thriftReader) readProcess(p *jaegerProcess) {
+	r.readStruct(func(id int16, typ byte) {
+		switch {
+		case id == 1 && typ == thriftString:
+			p.ServiceName = r.readString()
+		case id == 2 && typ == thriftList:
+			p.Tags = r.readTags()
+		default:
+			r.skip(typ, 0)
+		}
+	})
+}
+
+func (r *thriftReader) readSpan() *jaegerSpan {
+	var s jaegerSpan
+	r.readStruct(func(id int16, typ byte) {
+		switch {
+		case id == 1 && typ == thriftI64:
+			s.TraceIDLow = r.readI64()
+		case id == 2 && typ == thriftI64:
+			s.TraceIDHigh = r.readI64()
+		case id == 3 && typ == thriftI64:
+			s.SpanID = r.readI64()
+		case id == 4 && typ == thriftI64:
+			s.ParentSpanID = r.readI64()
+		case id == 5 && typ == thriftString:
+			s.OperationName = r.readString()
+		case id == 6 && typ == thriftList:
+			r.readList(thriftStruct, func() { s.References = append(s.References, r.readSpanRef()) })
+		case id == 7 && typ == thriftI32:
+			s.Flags = r.readI32()
+		case id == 8 && typ == thriftI64:
+			s.StartTime = r.readI64()
+		case id == 9 && typ == thriftI64:
+			s.Duration = r.readI64()
+		case id == 10 && typ == thriftList:
+			s.Tags = r.readTags()
+		case id == 11 && typ == thriftList:
+			r.readList(thriftStruct, func() { s.Logs = append(s.Logs, r.readLog()) })
+		default:
+			r.skip(typ, 0)
+		}
+	})
+	return &s
+}
+
+func (r *thriftReader) readSpanRef() jaegerSpanRef {
+	var ref jaegerSpanRef
+	r.readStruct(func(id int16, typ byte) {
+		switch {
+		case id == 1 && typ == thriftI32:
+			ref.RefType = r.readI32()
+		case id == 2 && typ == thriftI64:
+			ref.TraceIDLow = r.readI64()
+		case id == 3 && typ == thriftI64:
+			ref.TraceIDHigh = r.readI64()
+		case id == 4 && typ == thriftI64:
+			ref.SpanID = r.readI64()
+		default:
+			r.skip(typ, 0)
+		}
+	})
+	return ref
+}
+
+func (r *thriftReader) readLog() jaegerLog {
+	var l jaegerLog
+	r.readStruct(func(id int16, typ byte) {
+		switch {
+		case id == 1 && typ == thriftI64:
+			l.Timestamp = r.readI64()
+		case id == 2 && typ == thriftList:
+			l.Fields = r.readTags()
+		default:
+			r.skip(typ, 0)
+		}
+	})
+	return l
+}
+
+func (r *thriftReader) readTags() []jaegerTag {
+	var tags []jaegerTag
+	r.readList(thriftStruct, func() { tags = append(tags, r.readTag()) })
+	return tags
+}
+
+func (r *thriftReader) readTag() jaegerTag {
+	var t jaegerTag
+	r.readStruct(func(id int16, typ byte) {
+		switch {
+		case id == 1 && typ == thriftString:
+			t.Key = r.readString()
+		case id == 2 && typ == thriftI32:
+			t.VType = jaegerTagType(r.readI32())
+		case id == 3 && typ == thriftString:
+			t.VStr = r.readString()
+		case id == 4 && typ == thriftDouble:
+			t.VDouble = r.readDouble()
+		case id == 5 && typ == thriftBool:
+			t.VBool = r.readBool()
+		case id == 6 && typ == thriftI64:
+			t.VLong = r.readI64()
+		case id == 7 && typ == thriftString:
+			t.VBinary = r.readBinary()
+		default:
+			r.skip(typ, 0)
+		}
+	})
+	return t
+}
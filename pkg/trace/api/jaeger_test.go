@@ -0,0 +1,260 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package api
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DataDog/datadog-agent/pkg/trace/pb"
+	"github.com/DataDog/datadog-agent/pkg/trace/sampler"
+	"github.com/stretchr/testify/assert"
+)
+
+// thriftTestWriter encodes values using the Thrift binary protocol for testing purposes.
+type thriftTestWriter struct {
+	bytes.Buffer
+}
+
+func (w *thriftTestWriter) field(typ byte, id int16) {
+	w.WriteByte(typ)
+	binary.Write(w, binary.BigEndian, id)
+}
+
+func (w *thriftTestWriter) stop() { w.WriteByte(thriftStop) }
+
+func (w *thriftTestWriter) i32(id int16, v int32) {
+	w.field(thriftI32, id)
+	binary.Write(w, binary.BigEndian, v)
+}
+
+func (w *thriftTestWriter) i64(id int16, v int64) {
+	w.field(thriftI64, id)
+	binary.Write(w, binary.BigEndian, v)
+}
+
+func (w *thriftTestWriter) double(id int16, v float64) {
+	w.field(thriftDouble, id)
+	binary.Write(w, binary.BigEndian, math.Float64bits(v))
+}
+
+func (w *thriftTestWriter) bool(id int16, v bool) {
+	w.field(thriftBool, id)
+	if v {
+		w.WriteByte(1)
+	} else {
+		w.WriteByte(0)
+	}
+}
+
+func (w *thriftTestWriter) str(id int16, v string) {
+	w.field(thriftString, id)
+	binary.Write(w, binary.BigEndian, int32(len(v)))
+	w.WriteString(v)
+}
+
+func (w *thriftTestWriter) list(id int16, elem byte, n int) {
+	w.field(thriftList, id)
+	w.WriteByte(elem)
+	binary.Write(w, binary.BigEndian, int32(n))
+}
+
+func (w *thriftTestWriter) tags(id int16, tags []jaegerTag) {
+	w.list(id, thriftStruct, len(tags))
+	for _, t := range tags {
+		w.str(1, t.Key)
+		w.i32(2, int32(t.VType))
+		switch t.VType {
+		case jaegerTagString:
+			w.str(3, t.VStr)
+		case jaegerTagDouble:
+			w.double(4, t.VDouble)
+		case jaegerTagBool:
+			w.bool(5, t.VBool)
+		case jaegerTagLong:
+			w.i64(6, t.VLong)
+		}
+		w.stop()
+	}
+}
+
+func (w *thriftTestWriter) batch(b *jaegerBatch) []byte {
+	w.field(thriftStruct, 1)
+	w.str(1, b.Process.ServiceName)
+	w.tags(2, b.Process.Tags)
+	w.stop()
+	w.list(2, thriftStruct, len(b.Spans))
+	for _, s := range b.Spans {
+		w.i64(1, s.TraceIDLow)
+		w.i64(2, s.TraceIDHigh)
+		w.i64(3, s.SpanID)
+		w.i64(4, s.ParentSpanID)
+		w.str(5, s.OperationName)
+		w.list(6, thriftStruct, len(s.References))
+		for _, ref := range s.References {
+			w.i32(1, ref.RefType)
+			w.i64(2, ref.TraceIDLow)
+			w.i64(3, ref.TraceIDHigh)
+			w.i64(4, ref.SpanID)
+			w.stop()
+		}
+		w.i32(7, s.Flags)
+		w.i64(8, s.StartTime)
+		w.i64(9, s.Duration)
+		w.tags(10, s.Tags)
+		w.list(11, thriftStruct, len(s.Logs))
+		for _, l := range s.Logs {
+			w.i64(1, l.Timestamp)
+			w.tags(2, l.Fields)
+			w.stop()
+		}
+		w.str(42, "unknown field")
+		w.stop()
+	}
+	w.i64(3, 1) // seqNo
+	w.stop()
+	return w.Bytes()
+}
+
+var jaegerTestBatch = &jaegerBatch{
+	Process: jaegerProcess{
+		ServiceName: "frontend",
+		Tags: []jaegerTag{
+			{Key: "jaeger.version", VStr: "Go-2.30.0"},
+			{Key: "hostname", VStr: "host1"},
+		},
+	},
+	Spans: []*jaegerSpan{
+		{
+			TraceIDLow:    0x1234,
+			TraceIDHigh:   0x1,
+			SpanID:        0x10,
+			OperationName: "HTTP GET /dispatch",
+			Flags:         1,
+			StartTime:     1556604172355737,
+			Duration:      1431,
+			Tags: []jaegerTag{
+				{Key: "span.kind", VStr: "server"},
+				{Key: "http.method", VStr: "GET"},
+				{Key: "http.status_code", VType: jaegerTagLong, VLong: 500},
+				{Key: "error", VType: jaegerTagBool, VBool: true},
+			},
+			Logs: []jaegerLog{
+				{
+					Timestamp: 1556604172355800,
+					Fields: []jaegerTag{
+						{Key: "event", VStr: "error"},
+						{Key: "error.kind", VStr: "timeout"},
+						{Key: "message", VStr: "deadline exceeded"},
+					},
+				},
+			},
+		},
+		{
+			TraceIDLow:    0x1234,
+			TraceIDHigh:   0x1,
+			SpanID:        0x11,
+			OperationName: "GET",
+			References: []jaegerSpanRef{
+				{RefType: jaegerSpanRefChildOf, TraceIDLow: 0x1234, TraceIDHigh: 0x1, SpanID: 0x10},
+				{RefType: jaegerSpanRefFollowsFrom, TraceIDLow: 0x5678, SpanID: 0x20},
+			},
+			Flags: jaegerFlagDebug | 1,
+			Tags: []jaegerTag{
+				{Key: "span.kind", VStr: "client"},
+				{Key: "db.type", VStr: "redis"},
+				{Key: "retries", VType: jaegerTagDouble, VDouble: 2.5},
+			},
+		},
+	},
+}
+
+func TestDecodeJaeger(t *testing.T) {
+	assert := assert.New(t)
+
+	var w thriftTestWriter
+	body := w.batch(jaegerTestBatch)
+	r := &thriftReader{b: body}
+	assert.Equal(jaegerTestBatch, r.readBatch())
+	assert.NoError(r.err)
+	assert.Empty(r.b)
+
+	for i := 0; i < len(body); i++ {
+		_, err := decodeJaegerPayload(body[:i], nil)
+		assert.Error(err, "truncated at %d", i)
+	}
+}
+
+func TestConvertJaeger(t *testing.T) {
+	assert := assert.New(t)
+
+	tp := jaegerToTracerPayload(jaegerTestBatch)
+	assert.Equal("jaeger-Go-2.30.0", tp.TracerVersion)
+	assert.Equal("go", tp.LanguageName)
+	assert.Equal("host1", tp.Hostname)
+	assert.Len(tp.Chunks, 1)
+	assert.EqualValues(sampler.PriorityUserKeep, tp.Chunks[0].Priority)
+
+	server, client := tp.Chunks[0].Spans[0], tp.Chunks[0].Spans[1]
+	assert.Equal(uint64(0x1234), server.TraceID)
+	assert.Equal(uint64(0x10), server.SpanID)
+	assert.Equal("frontend", server.Service)
+	assert.Equal("jaeger.server", server.Name)
+	assert.Equal("GET", server.Resource)
+	assert.Equal("web", server.Type)
+	assert.Equal(int64(1556604172355737000), server.Start)
+	assert.Equal(int64(1431000), server.Duration)
+	assert.Equal(int32(1), server.Error)
+	assert.Equal("deadline exceeded", server.Meta["error.msg"])
+	assert.Equal("timeout", server.Meta["error.type"])
+	assert.Equal("00000000000000010000000000001234", server.Meta["jaeger.trace_id"])
+	assert.Equal(float64(500), server.Metrics["http.status_code"])
+	assert.Equal(`[{"time_unix_nano":1556604172355800000,"name":"error","attributes":{"error.kind":"timeout","message":"deadline exceeded"}}]`, server.Meta["events"])
+	assert.Equal([]*pb.SpanEvent{{
+		TimeUnixNano: 1556604172355800000,
+		Name:         "error",
+		Attributes:   map[string]string{"error.kind": "timeout", "message": "deadline exceeded"},
+	}}, server.SpanEvents)
+	assert.Nil(server.SpanLinks)
+
+	assert.Equal(server.SpanID, client.ParentID)
+	assert.Equal("jaeger.client", client.Name)
+	assert.Equal("GET", client.Resource)
+	assert.Equal("cache", client.Type)
+	assert.Equal(int32(0), client.Error)
+	assert.Equal(2.5, client.Metrics["retries"])
+	assert.Equal([]*pb.SpanLink{{
+		TraceID:    0x5678,
+		SpanID:     0x20,
+		Attributes: map[string]string{"jaeger.ref_type": "follows_from"},
+	}}, client.SpanLinks)
+}
+
+func TestJaegerEndpoint(t *testing.T) {
+	assert := assert.New(t)
+	conf := newTestReceiverConfig()
+	conf.JaegerReceiverEnabled = true
+	rcv := newTestReceiverFromConfig(conf)
+	server := httptest.NewServer(rcv.buildMux())
+	defer server.Close()
+
+	var w thriftTestWriter
+	resp, err := http.Post(server.URL+"/api/traces", "application/x-thrift", bytes.NewReader(w.batch(jaegerTestBatch)))
+	assert.NoError(err)
+	assert.Equal(http.StatusAccepted, resp.StatusCode)
+	select {
+	case p := <-rcv.out:
+		assert.Len(p.Chunks(), 1)
+		assert.Equal("frontend", p.Chunk(0).Spans[0].Service)
+	case <-time.After(time.Second):
+		t.Fatal("no output")
+	}
+}
@@ -317,6 +317,63 @@ func marshalEvents(events []*otlppb.Span_Event) string {
 	return str.String()
 }
 
+// convertSpanEvents converts the OTLP span events into Datadog span events.
+func convertSpanEvents(events []*otlppb.Span_Event) []*pb.SpanEvent {
+	if len(events) == 0 {
+		return nil
+	}
+	out := make([]*pb.SpanEvent, 0, len(events))
+	for _, e := range events {
+		if e == nil {
+			continue
+		}
+		out = append(out, &pb.SpanEvent{
+			TimeUnixNano: e.TimeUnixNano,
+			Name:         e.Name,
+			Attributes:   attributesMap(e.Attributes),
+		})
+	}
+	return out
+}
+
+// convertSpanLinks converts the OTLP span links into Datadog span links, keeping the
+// upper 64 bits of 128-bit trace IDs.
+func convertSpanLinks(links []*otlppb.Span_Link) []*pb.SpanLink {
+	if len(links) == 0 {
+		return nil
+	}
+	out := make([]*pb.SpanLink, 0, len(links))
+	for _, l := range links {
+		if l == nil {
+			continue
+		}
+		link := &pb.SpanLink{
+			TraceID:                byteArrayToUint64(l.TraceId),
+			SpanID:                 byteArrayToUint64(l.SpanId),
+			Attributes:             attributesMap(l.Attributes),
+			Tracestate:             l.TraceState,
+			DroppedAttributesCount: l.DroppedAttributesCount,
+		}
+		if len(l.TraceId) == 16 {
+			link.TraceIDHigh = binary.BigEndian.Uint64(l.TraceId[:8])
+		}
+		out = append(out, link)
+	}
+	return out
+}
+
+// attributesMap converts the given list of key-values into a map of their string representations.
+func attributesMap(kvs []*otlppb.KeyValue) map[string]string {
+	if len(kvs) == 0 {
+		return nil
+	}
+	m := make(map[string]string, len(kvs))
+	for _, kv := range kvs {
+		m[kv.Key] = anyValueString(kv.Value)
+	}
+	return m
+}
+
 // convertSpan converts the span in to a Datadog span, and uses the rattr resource tags and the lib instrumentation
 // library attributes to further augment it.
 func convertSpan(rattr map[string]string, lib *otlppb.InstrumentationLibrary, in *otlppb.Span) *pb.Span {
@@ -347,6 +404,8 @@ func convertSpan(rattr map[string]string, lib *otlppb.InstrumentationLibrary, in
 	if len(in.Events) > 0 {
 		span.Meta["events"] = marshalEvents(in.Events)
 	}
+	span.SpanEvents = convertSpanEvents(in.Events)
+	span.SpanLinks = convertSpanLinks(in.Links)
 	for _, kv := range in.Attributes {
 		switch v := kv.Value.Value.(type) {
 		case *otlppb.AnyValue_DoubleValue:
@@ -492,6 +492,18 @@ func TestOTLPConvertSpan(t *testing.T) {
 					"count": 2,
 				},
 				Type: "web",
+				SpanEvents: []*pb.SpanEvent{
+					{
+						TimeUnixNano: 123,
+						Name:         "boom",
+						Attributes:   map[string]string{"message": "Out of memory", "accuracy": "2.40"},
+					},
+					{
+						TimeUnixNano: 456,
+						Name:         "exception",
+						Attributes:   map[string]string{"exception.message": "Out of memory", "exception.type": "mem", "exception.stacktrace": "1/2/3"},
+					},
+				},
 			},
 		}, {
 			rattr: map[string]string{
@@ -582,6 +594,18 @@ func TestOTLPConvertSpan(t *testing.T) {
 					"count": 2,
 				},
 				Type: "web",
+				SpanEvents: []*pb.SpanEvent{
+					{
+						TimeUnixNano: 123,
+						Name:         "boom",
+						Attributes:   map[string]string{"message": "Out of memory", "accuracy": "2.40"},
+					},
+					{
+						TimeUnixNano: 456,
+						Name:         "exception",
+						Attributes:   map[string]string{"exception.message": "Out of memory", "exception.type": "mem", "exception.stacktrace": "1/2/3"},
+					},
+				},
 			},
 		}, {
 			rattr: map[string]string{
@@ -632,8 +656,18 @@ func TestOTLPConvertSpan(t *testing.T) {
 					},
 				},
 				DroppedEventsCount: 0,
-				Links:              nil,
-				DroppedLinksCount:  0,
+				Links: []*otlppb.Span_Link{
+					{
+						TraceId:    otlpTestID128,
+						SpanId:     []byte{0, 0, 0, 0, 0, 0, 0, 42},
+						TraceState: "dd=s:1",
+						Attributes: []*otlppb.KeyValue{
+							{Key: "messaging.operation", Value: &otlppb.AnyValue{Value: &otlppb.AnyValue_StringValue{StringValue: "publish"}}},
+						},
+						DroppedAttributesCount: 3,
+					},
+				},
+				DroppedLinksCount: 0,
 				Status: &otlppb.Status{
 					Message: "Error",
 					Code:    otlppb.Status_STATUS_CODE_ERROR,
@@ -671,6 +705,28 @@ func TestOTLPConvertSpan(t *testing.T) {
 					"count": 2,
 				},
 				Type: "web",
+				SpanEvents: []*pb.SpanEvent{
+					{
+						TimeUnixNano: 123,
+						Name:         "boom",
+						Attributes:   map[string]string{"message": "Out of memory", "accuracy": "2.40"},
+					},
+					{
+						TimeUnixNano: 456,
+						Name:         "exception",
+						Attributes:   map[string]string{"exception.message": "Out of memory", "exception.type": "mem", "exception.stacktrace": "1/2/3"},
+					},
+				},
+				SpanLinks: []*pb.SpanLink{
+					{
+						TraceID:                2594128270069917171,
+						TraceIDHigh:            8277424847105943461,
+						SpanID:                 42,
+						Attributes:             map[string]string{"messaging.operation": "publish"},
+						Tracestate:             "dd=s:1",
+						DroppedAttributesCount: 3,
+					},
+				},
 			},
 		},
 	} {
@@ -870,6 +926,18 @@ func trimSpaces(str string) string {
 	return out.String()
 }
 
+func TestConvertSpanLinks(t *testing.T) {
+	assert.Nil(t, convertSpanLinks(nil))
+	assert.Equal(t, []*pb.SpanLink{
+		{TraceID: 1, SpanID: 2},
+		{TraceID: 0, SpanID: 2},
+	}, convertSpanLinks([]*otlppb.Span_Link{
+		{TraceId: []byte{0, 0, 0, 0, 0, 0, 0, 1}, SpanId: []byte{0, 0, 0, 0, 0, 0, 0, 2}},
+		nil,
+		{TraceId: []byte{1}, SpanId: []byte{0, 0, 0, 0, 0, 0, 0, 2}},
+	}))
+}
+
 func BenchmarkProcessRequest(b *testing.B) {
 	metadata := http.Header(map[string][]string{
 		headerLang:        {"go"},
@@ -6,6 +6,7 @@
 package api
 
 import (
+	"sort"
 	"sync"
 	"time"
 
@@ -20,10 +21,19 @@ import (
 //
 // The rateLimiter also uses a decay mechanism to ensure that older entries have
 // lesser impact on the rate computation.
+//
+// Additionally, the rateLimiter keeps track of the traces received from each service
+// (see PermitsServices). The target rate is then distributed between services using
+// weighted fair-share, so that a single chatty service can not starve the others.
 type rateLimiter struct {
 	mu sync.RWMutex
 	// stats keeps track of all the internal counters used by the rate limiter.
 	stats info.RateLimiterStats
+	// services keeps track of the per-service counters and rates.
+	services map[serviceKey]*serviceLimiter
+	// weights specifies the fair-share weight of services, by service name. Services
+	// which are not found here have a weight of 1.
+	weights map[string]float64
 	// decayPeriod specifies the interval at which the counters should be decayed.
 	decayPeriod time.Duration
 	// decayFactor specifies the factor using which the counters are decayed. See
@@ -40,6 +50,7 @@ func newRateLimiter() *rateLimiter {
 		stats: info.RateLimiterStats{
 			TargetRate: 1,
 		},
+		services:    make(map[serviceKey]*serviceLimiter),
 		decayPeriod: 5 * time.Second,
 		decayFactor: decayFactor,
 		exit:        make(chan struct{}),
@@ -68,6 +79,15 @@ func (ps *rateLimiter) decayScore() {
 	ps.stats.RecentPayloadsSeen /= ps.decayFactor
 	ps.stats.RecentTracesSeen /= ps.decayFactor
 	ps.stats.RecentTracesDropped /= ps.decayFactor
+	for k, s := range ps.services {
+		s.seen /= ps.decayFactor
+		s.dropped /= ps.decayFactor
+		if s.seen < 1 {
+			// this service hasn't sent anything in a while
+			delete(ps.services, k)
+		}
+	}
+	ps.computeServiceRatesLocked()
 	ps.mu.Unlock()
 }
 
@@ -79,6 +99,7 @@ func (ps *rateLimiter) Stop() { close(ps.exit) }
 func (ps *rateLimiter) SetTargetRate(rate float64) {
 	ps.mu.Lock()
 	ps.stats.TargetRate = rate
+	ps.computeServiceRatesLocked()
 	ps.mu.Unlock()
 }
 
@@ -116,11 +137,34 @@ func (ps *rateLimiter) Active() bool {
 	return active
 }
 
-// Stats returns a copy of the currrent rate limiter's stats.
+// Stats returns a copy of the currrent rate limiter's stats, including per-service stats.
 func (ps *rateLimiter) Stats() *info.RateLimiterStats {
 	ps.mu.RLock()
 	stats := ps.stats
+	if len(ps.services) > 0 {
+		stats.Services = make([]info.ServiceRateLimiterStats, 0, len(ps.services))
+		for k, s := range ps.services {
+			ss := info.ServiceRateLimiterStats{
+				Env:                 k.env,
+				Service:             k.service,
+				Weight:              s.weight,
+				TargetRate:          s.targetRate,
+				RecentTracesSeen:    s.seen,
+				RecentTracesDropped: s.dropped,
+			}
+			if s.seen > 0 {
+				ss.DropRate = s.dropped / s.seen
+			}
+			stats.Services = append(stats.Services, ss)
+		}
+	}
 	ps.mu.RUnlock()
+	sort.Slice(stats.Services, func(i, j int) bool {
+		if stats.Services[i].Env != stats.Services[j].Env {
+			return stats.Services[i].Env < stats.Services[j].Env
+		}
+		return stats.Services[i].Service < stats.Services[j].Service
+	})
 	return &stats
 }
 
@@ -155,6 +199,127 @@ func (ps *rateLimiter) Permits(n int64) bool {
 	return keep
 }
 
+// maxRateLimitedServices specifies the maximum number of services tracked by the rate limiter.
+// Traces from additional services are accounted under overflowServiceKey.
+const maxRateLimitedServices = 1000
+
+// serviceKey identifies a service for the purpose of per-service rate limiting.
+type serviceKey struct {
+	env, service string
+}
+
+// overflowServiceKey is used for all the services seen after reaching maxRateLimitedServices.
+var overflowServiceKey = serviceKey{service: "_overflow"}
+
+// serviceLimiter holds the rate limiting state of a single service.
+type serviceLimiter struct {
+	// weight specifies the fair-share weight of the service.
+	weight float64
+	// targetRate is the rate limiting rate that we are aiming for, for this service.
+	targetRate float64
+	// seen and dropped are the (decayed) number of traces seen and dropped.
+	seen, dropped float64
+}
+
+func (s *serviceLimiter) realRate() float64 {
+	if s.seen <= 0 {
+		// avoid division by zero
+		return s.targetRate
+	}
+	return 1 - (s.dropped / s.seen)
+}
+
+// PermitsServices reports, for each service found in counts, whether the rate limiter should
+// allow its traces to enter the pipeline. The counts map holds the number of traces received
+// from each service, in a single payload. Like Permits, it alters the internal statistics and
+// should only be called once per payload.
+func (ps *rateLimiter) PermitsServices(counts map[serviceKey]int64) map[serviceKey]bool {
+	keep := make(map[serviceKey]bool, len(counts))
+	ps.mu.Lock()
+	ps.stats.RecentPayloadsSeen++
+	for k, n := range counts {
+		keep[k] = true
+		if n <= 0 {
+			continue
+		}
+		s := ps.serviceLocked(k)
+		if s.realRate() > s.targetRate {
+			// this service is keeping more than its target rate, drop
+			keep[k] = false
+			s.dropped += float64(n)
+			ps.stats.RecentTracesDropped += float64(n)
+		}
+		// this should be done *after* testing the real rate against the target rate,
+		// otherwise we could end up systematically dropping the first payload.
+		s.seen += float64(n)
+		ps.stats.RecentTracesSeen += float64(n)
+	}
+	ps.mu.Unlock()
+	return keep
+}
+
+// serviceLocked returns the limiter for the service k, creating it if needed.
+func (ps *rateLimiter) serviceLocked(k serviceKey) *serviceLimiter {
+	if s, ok := ps.services[k]; ok {
+		return s
+	}
+	if len(ps.services) >= maxRateLimitedServices {
+		k = overflowServiceKey
+		if s, ok := ps.services[k]; ok {
+			return s
+		}
+	}
+	// new services are accepted until the next time the rates get computed
+	s := &serviceLimiter{weight: ps.weightLocked(k.service), targetRate: 1}
+	ps.services[k] = s
+	return s
+}
+
+func (ps *rateLimiter) weightLocked(service string) float64 {
+	if w, ok := ps.weights[service]; ok && w > 0 {
+		return w
+	}
+	return 1
+}
+
+// computeServiceRatesLocked distributes the target rate between services using weighted max-min
+// fairness: the total number of traces which can be kept (the target rate applied to the recently
+// seen traces) is shared between services proportionally to their weight. Services sending less
+// than their share are not limited, and the remainder is redistributed between the others.
+func (ps *rateLimiter) computeServiceRatesLocked() {
+	if ps.stats.TargetRate >= 1 {
+		for _, s := range ps.services {
+			s.targetRate = 1
+		}
+		return
+	}
+	var (
+		budget float64 // number of traces which can be kept
+		weight float64 // total weight of the remaining services
+		all    = make([]*serviceLimiter, 0, len(ps.services))
+	)
+	for _, s := range ps.services {
+		budget += s.seen
+		weight += s.weight
+		all = append(all, s)
+	}
+	budget *= ps.stats.TargetRate
+	// go through services in increasing order of their demand relative to their weight
+	sort.Slice(all, func(i, j int) bool { return all[i].seen/all[i].weight < all[j].seen/all[j].weight })
+	for _, s := range all {
+		share := budget * s.weight / weight
+		switch {
+		case s.seen <= share:
+			s.targetRate = 1
+			budget -= s.seen
+		default:
+			s.targetRate = share / s.seen
+			budget -= share
+		}
+		weight -= s.weight
+	}
+}
+
 // computeRateLimitingRate gives us the new rate at which requests need to be rate limited. It is computed
 // based on how much the [current] value surpasses the [max], and then combined with [rate]. The [current] and
 // [max] values may be any values which have an impact on the allowed traffic, for example: a maximum amount
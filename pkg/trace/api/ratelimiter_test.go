@@ -6,6 +6,7 @@
 package api
 
 import (
+	"strconv"
 	"sync"
 	"testing"
 	"time"
@@ -169,3 +170,71 @@ func TestRateLimiterPermits(t *testing.T) {
 		RecentTracesDropped: 89116.55620097058,
 	}, ps.stats)
 }
+
+func TestRateLimiterFairShare(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		assert := assert.New(t)
+		ps := newRateLimiter()
+		chatty, quiet := serviceKey{"prod", "chatty"}, serviceKey{"prod", "quiet"}
+		keep := ps.PermitsServices(map[serviceKey]int64{chatty: 900, quiet: 100})
+		assert.Equal(map[serviceKey]bool{chatty: true, quiet: true}, keep, "new services are accepted")
+
+		ps.SetTargetRate(0.5)
+		// 500 traces can be kept: the quiet service is below its share (250) and is not
+		// limited, the remainder (400) goes to the chatty service.
+		assert.Equal(1.0, ps.services[quiet].targetRate)
+		assert.InDelta(400.0/900.0, ps.services[chatty].targetRate, 1e-9)
+
+		keep = ps.PermitsServices(map[serviceKey]int64{chatty: 10, quiet: 10})
+		assert.Equal(map[serviceKey]bool{chatty: false, quiet: true}, keep)
+
+		stats := ps.Stats()
+		assert.Len(stats.Services, 2)
+		assert.Equal("chatty", stats.Services[0].Service)
+		assert.Equal(910.0, stats.Services[0].RecentTracesSeen)
+		assert.Equal(10.0, stats.Services[0].RecentTracesDropped)
+		assert.InDelta(10.0/910.0, stats.Services[0].DropRate, 1e-9)
+		assert.Equal("quiet", stats.Services[1].Service)
+		assert.Equal(0.0, stats.Services[1].DropRate)
+		assert.Equal(1020.0, stats.RecentTracesSeen)
+		assert.Equal(10.0, stats.RecentTracesDropped)
+
+		ps.SetTargetRate(1)
+		assert.Equal(1.0, ps.services[chatty].targetRate)
+	})
+
+	t.Run("weighted", func(t *testing.T) {
+		assert := assert.New(t)
+		ps := newRateLimiter()
+		ps.weights = map[string]float64{"a": 3}
+		a, b, c := serviceKey{service: "a"}, serviceKey{service: "b"}, serviceKey{service: "c"}
+		ps.PermitsServices(map[serviceKey]int64{a: 600, b: 300, c: 100})
+		ps.SetTargetRate(0.5)
+		// 500 traces can be kept, for a total weight of 5: c keeps its 100 traces, and
+		// the remaining 400 are split 3:1 between a and b.
+		assert.Equal(1.0, ps.services[c].targetRate)
+		assert.Equal(0.5, ps.services[a].targetRate)
+		assert.InDelta(100.0/300.0, ps.services[b].targetRate, 1e-9)
+	})
+
+	t.Run("decay", func(t *testing.T) {
+		ps := newRateLimiter()
+		ps.PermitsServices(map[serviceKey]int64{{service: "a"}: 2})
+		ps.decayScore()
+		assert.Len(t, ps.services, 1)
+		for i := 0; i < 10; i++ {
+			ps.decayScore()
+		}
+		assert.Len(t, ps.services, 0, "inactive services are forgotten")
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+		ps := newRateLimiter()
+		for i := 0; i < maxRateLimitedServices+10; i++ {
+			keep := ps.PermitsServices(map[serviceKey]int64{{service: strconv.Itoa(i)}: 1})
+			assert.Len(t, keep, 1)
+		}
+		assert.Len(t, ps.services, maxRateLimitedServices+1)
+		assert.Equal(t, 10.0, ps.services[overflowServiceKey].seen)
+	})
+}
@@ -0,0 +1,206 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package api
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/DataDog/datadog-agent/pkg/trace/api/apiutil"
+	"github.com/DataDog/datadog-agent/pkg/trace/metrics"
+	"github.com/DataDog/datadog-agent/pkg/trace/metrics/timing"
+	"github.com/DataDog/datadog-agent/pkg/trace/pb"
+	"github.com/DataDog/datadog-agent/pkg/trace/sampler"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+
+	semconv "go.opentelemetry.io/collector/model/semconv/v1.5.0"
+)
+
+// spansDecoder decodes the body of a request received on a third-party tracing endpoint
+// (such as Zipkin or Jaeger) into a TracerPayload.
+type spansDecoder func(body []byte, req *http.Request) (*pb.TracerPayload, error)
+
+// handleSpans returns an http.Handler serving a third-party tracing endpoint. The body of incoming
+// requests is decoded using decode and the resulting payload is sent down the same pipeline as
+// payloads received on the Datadog endpoints (normalization, sampling, stats).
+func (r *HTTPReceiver) handleSpans(v Version, decode spansDecoder) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		defer timing.Since("datadog.trace_agent.receiver.serve_spans_ms", time.Now())
+		ts := r.tagStats(v, req.Header)
+		tags := []string{"handler:spans", fmt.Sprintf("v:%s", v)}
+
+		body := io.Reader(req.Body)
+		if req.Header.Get("Content-Encoding") == "gzip" {
+			gzipr, err := gzip.NewReader(body)
+			if err != nil {
+				httpDecodingError(err, tags, w)
+				atomic.AddInt64(&ts.TracesDropped.DecodingError, 1)
+				return
+			}
+			defer gzipr.Close()
+			body = gzipr
+		}
+		rd := apiutil.NewLimitedReader(io.NopCloser(body), r.conf.MaxRequestBytes)
+		buf := getBuffer()
+		defer putBuffer(buf)
+		if _, err := io.Copy(buf, rd); err != nil {
+			httpDecodingError(err, tags, w)
+			if err == apiutil.ErrLimitedReaderLimitReached {
+				atomic.AddInt64(&ts.TracesDropped.PayloadTooLarge, 1)
+			} else {
+				atomic.AddInt64(&ts.TracesDropped.EOF, 1)
+			}
+			return
+		}
+		tp, err := decode(buf.Bytes(), req)
+		if err != nil {
+			httpDecodingError(err, tags, w)
+			atomic.AddInt64(&ts.TracesDropped.DecodingError, 1)
+			log.Errorf("Cannot decode %s traces payload: %v", v, err)
+			return
+		}
+		if r.rateLimited(int64(len(tp.Chunks))) {
+			// this payload can not be accepted
+			w.WriteHeader(r.rateLimiterResponse)
+			atomic.AddInt64(&ts.PayloadRefused, 1)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+
+		atomic.AddInt64(&ts.TracesReceived, int64(len(tp.Chunks)))
+		atomic.AddInt64(&ts.TracesBytes, rd.Count)
+		atomic.AddInt64(&ts.PayloadAccepted, 1)
+		metrics.Count("datadog.trace_agent.receiver.spans_payload", 1, ts.AsTags(), 1)
+
+		if tp.LanguageName == "" {
+			tp.LanguageName = ts.Lang
+		}
+		if tp.LanguageVersion == "" {
+			tp.LanguageVersion = ts.LangVersion
+		}
+		if tp.TracerVersion == "" {
+			tp.TracerVersion = ts.TracerVersion
+		}
+		if tp.ContainerID == "" {
+			tp.ContainerID = req.Header.Get(headerContainerID)
+		}
+		if ctags := getContainerTags(tp.ContainerID); ctags != "" {
+			if tp.Tags == nil {
+				tp.Tags = make(map[string]string)
+			}
+			tp.Tags[tagContainersTags] = ctags
+		}
+		r.submit(&Payload{
+			Source:        ts,
+			TracerPayload: tp,
+		})
+	})
+}
+
+// traceChunksFromForeignSpans groups the given spans into trace chunks by trace ID. All the chunks
+// are marked as auto-kept, because the spans were already chosen as keepers by the client; chunks
+// containing a span whose ID is found in debug are marked as user-kept.
+func traceChunksFromForeignSpans(spans []*pb.Span, debug map[uint64]bool) []*pb.TraceChunk {
+	byID := make(map[uint64][]*pb.Span)
+	var order []uint64
+	for _, s := range spans {
+		if _, ok := byID[s.TraceID]; !ok {
+			order = append(order, s.TraceID)
+		}
+		byID[s.TraceID] = append(byID[s.TraceID], s)
+	}
+	chunks := make([]*pb.TraceChunk, 0, len(byID))
+	for _, id := range order {
+		chunk := &pb.TraceChunk{
+			// auto-keep all incoming traces; they were already chosen as keepers
+			// on the client side.
+			Priority: int32(sampler.PriorityAutoKeep),
+			Spans:    byID[id],
+		}
+		for _, s := range chunk.Spans {
+			if debug[s.SpanID] {
+				chunk.Priority = int32(sampler.PriorityUserKeep)
+				break
+			}
+		}
+		chunks = append(chunks, chunk)
+	}
+	return chunks
+}
+
+// foreignSpanKindType returns a Datadog span type based on the given span kind (one of "server",
+// "client", "producer", "consumer", case insensitive) and the span's meta.
+func foreignSpanKindType(kind string, meta map[string]string) string {
+	switch kind {
+	case "server", "SERVER":
+		return "web"
+	case "client", "CLIENT":
+		db, ok := meta[string(semconv.AttributeDBSystem)]
+		if !ok {
+			db, ok = meta["db.type"]
+		}
+		if !ok {
+			return "http"
+		}
+		switch db {
+		case "redis", "memcached":
+			return "cache"
+		default:
+			return "db"
+		}
+	default:
+		return "custom"
+	}
+}
+
+// foreignSpanEvent is the JSON representation of a span event in the "events" tag.
+type foreignSpanEvent struct {
+	TimeUnixNano uint64            `json:"time_unix_nano,omitempty"`
+	Name         string            `json:"name,omitempty"`
+	Attributes   map[string]string `json:"attributes,omitempty"`
+}
+
+// setForeignSpanEvents sorts the events of span, such as Zipkin annotations or Jaeger logs, by
+// time and also marshals them into JSON in the "events" tag, using the same format as marshalEvents.
+func setForeignSpanEvents(span *pb.Span) {
+	sort.SliceStable(span.SpanEvents, func(i, j int) bool {
+		return span.SpanEvents[i].TimeUnixNano < span.SpanEvents[j].TimeUnixNano
+	})
+	events := make([]foreignSpanEvent, len(span.SpanEvents))
+	for i, e := range span.SpanEvents {
+		events[i] = foreignSpanEvent{TimeUnixNano: e.TimeUnixNano, Name: e.Name, Attributes: e.Attributes}
+	}
+	out, err := json.Marshal(events)
+	if err != nil {
+		// should never happen
+		return
+	}
+	span.Meta["events"] = string(out)
+}
+
+// setForeignSpanDefaults fills in the env and version of span from well-known
+// OpenTelemetry semantic convention tags, if not already present.
+func setForeignSpanDefaults(span *pb.Span) {
+	if _, ok := span.Meta["env"]; !ok {
+		if env := span.Meta[string(semconv.AttributeDeploymentEnvironment)]; env != "" {
+			span.Meta["env"] = env
+		}
+	}
+	if _, ok := span.Meta["version"]; !ok {
+		if ver := span.Meta[string(semconv.AttributeServiceVersion)]; ver != "" {
+			span.Meta["version"] = ver
+		}
+	}
+	if r := resourceFromTags(span.Meta); r != "" {
+		span.Resource = r
+	}
+}
@@ -76,4 +76,20 @@ const (
 	// Response: Service sampling rates.
 	//
 	v06 Version = "v0.6"
+
+	// zipkinV2
+	//
+	// Content-Type: application/json or application/x-protobuf
+	// Payload: Zipkin v2 list of spans (https://zipkin.io/zipkin-api/#/default/post_spans)
+	// Response: 202 Accepted.
+	//
+	zipkinV2 Version = "zipkin_v2"
+
+	// jaegerThrift
+	//
+	// Content-Type: application/x-thrift
+	// Payload: Jaeger Batch, serialized using the Thrift binary protocol (jaeger-idl/thrift/jaeger.thrift)
+	// Response: 202 Accepted.
+	//
+	jaegerThrift Version = "jaeger_thrift"
 )
@@ -0,0 +1,498 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package api
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/DataDog/datadog-agent/pkg/trace/pb"
+)
+
+// zipkinSpan is a Zipkin v2 span, as described by the Zipkin API:
+// https://github.com/openzipkin/zipkin-api/blob/master/zipkin2-api.yaml
+type zipkinSpan struct {
+	TraceID        string             `json:"traceId"`
+	ParentID       string             `json:"parentId,omitempty"`
+	ID             string             `json:"id"`
+	Kind           string             `json:"kind,omitempty"`
+	Name           string             `json:"name,omitempty"`
+	Timestamp      uint64             `json:"timestamp,omitempty"` // microseconds
+	Duration       uint64             `json:"duration,omitempty"`  // microseconds
+	LocalEndpoint  *zipkinEndpoint    `json:"localEndpoint,omitempty"`
+	RemoteEndpoint *zipkinEndpoint    `json:"remoteEndpoint,omitempty"`
+	Annotations    []zipkinAnnotation `json:"annotations,omitempty"`
+	Tags           map[string]string  `json:"tags,omitempty"`
+	Debug          bool               `json:"debug,omitempty"`
+	Shared         bool               `json:"shared,omitempty"`
+}
+
+// zipkinEndpoint is the network context of a node in the service graph.
+type zipkinEndpoint struct {
+	ServiceName string `json:"serviceName,omitempty"`
+	IPv4        string `json:"ipv4,omitempty"`
+	IPv6        string `json:"ipv6,omitempty"`
+	Port        int32  `json:"port,omitempty"`
+}
+
+// zipkinAnnotation associates an event that explains latency with a timestamp.
+type zipkinAnnotation struct {
+	Timestamp uint64 `json:"timestamp"` // microseconds
+	Value     string `json:"value"`
+}
+
+// errInvalidZipkinID is returned when a Zipkin trace or span ID is not a valid hex string.
+var errInvalidZipkinID = errors.New("invalid zipkin ID")
+
+// decodeZipkinPayload decodes a Zipkin v2 payload, either JSON or protobuf encoded based on the
+// request's Content-Type, into a TracerPayload.
+func decodeZipkinPayload(body []byte, req *http.Request) (*pb.TracerPayload, error) {
+	var (
+		spans []*zipkinSpan
+		err   error
+	)
+	switch getMediaType(req) {
+	case "application/x-protobuf", "application/protobuf":
+		spans, err = unmarshalZipkinProto(body)
+	default:
+		err = json.Unmarshal(body, &spans)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return zipkinToTracerPayload(spans)
+}
+
+// zipkinToTracerPayload converts the given Zipkin spans into a TracerPayload.
+func zipkinToTracerPayload(in []*zipkinSpan) (*pb.TracerPayload, error) {
+	spans := make([]*pb.Span, 0, len(in))
+	debug := make(map[uint64]bool)
+	// shared maps the IDs of the server side of shared spans to their new IDs.
+	shared := make(map[uint64]uint64)
+	for _, zs := range in {
+		if zs == nil {
+			continue
+		}
+		span, err := convertZipkinSpan(zs)
+		if err != nil {
+			return nil, err
+		}
+		if zs.Shared && strings.EqualFold(zs.Kind, "server") {
+			// The server side of a shared span (B3 single-host spans) has the same ID as its
+			// client side; give it a new ID and make it the child of the client span.
+			id := sharedSpanID(span.SpanID)
+			shared[span.SpanID] = id
+			span.ParentID = span.SpanID
+			span.SpanID = id
+		}
+		if zs.Debug {
+			debug[span.SpanID] = true
+		}
+		spans = append(spans, span)
+	}
+	if len(shared) > 0 {
+		for _, span := range spans {
+			if id, ok := shared[span.ParentID]; ok && id != span.SpanID {
+				// children of the server side of a shared span
+				span.ParentID = id
+			}
+		}
+	}
+	return &pb.TracerPayload{
+		Chunks:        traceChunksFromForeignSpans(spans, debug),
+		TracerVersion: "zipkin",
+	}, nil
+}
+
+// sharedSpanID returns a new span ID for the server side of a shared span having the given ID.
+func sharedSpanID(id uint64) uint64 {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%d:server", id)
+	return h.Sum64()
+}
+
+// convertZipkinSpan converts the Zipkin span in to a Datadog span.
+func convertZipkinSpan(in *zipkinSpan) (*pb.Span, error) {
+	traceID, err := parseZipkinID(in.TraceID)
+	if err != nil {
+		return nil, fmt.Errorf("traceId %q: %v", in.TraceID, err)
+	}
+	spanID, err := parseZipkinID(in.ID)
+	if err != nil {
+		return nil, fmt.Errorf("id %q: %v", in.ID, err)
+	}
+	var parentID uint64
+	if in.ParentID != "" {
+		if parentID, err = parseZipkinID(in.ParentID); err != nil {
+			return nil, fmt.Errorf("parentId %q: %v", in.ParentID, err)
+		}
+	}
+	kind := strings.ToLower(in.Kind)
+	if kind == "" {
+		kind = "unspecified"
+	}
+	span := &pb.Span{
+		Name:     "zipkin." + kind,
+		TraceID:  traceID,
+		SpanID:   spanID,
+		ParentID: parentID,
+		Start:    int64(in.Timestamp) * 1000,
+		Duration: int64(in.Duration) * 1000,
+		Resource: in.Name,
+		Meta:     make(map[string]string, len(in.Tags)+2),
+		Metrics:  map[string]float64{},
+	}
+	for k, v := range in.Tags {
+		span.Meta[k] = v
+	}
+	span.Meta["zipkin.trace_id"] = strings.ToLower(in.TraceID)
+	if ep := in.LocalEndpoint; ep != nil {
+		span.Service = ep.ServiceName
+		if ip := ep.ipString(); ip != "" {
+			span.Meta["net.host.ip"] = ip
+		}
+	}
+	if ep := in.RemoteEndpoint; ep != nil {
+		if ep.ServiceName != "" {
+			span.Meta["peer.service"] = ep.ServiceName
+		}
+		if ip := ep.ipString(); ip != "" {
+			span.Meta["net.peer.ip"] = ip
+		}
+		if ep.Port != 0 {
+			span.Meta["net.peer.port"] = strconv.Itoa(int(ep.Port))
+		}
+	}
+	if len(in.Annotations) > 0 {
+		span.SpanEvents = make([]*pb.SpanEvent, 0, len(in.Annotations))
+		for _, a := range in.Annotations {
+			span.SpanEvents = append(span.SpanEvents, &pb.SpanEvent{TimeUnixNano: a.Timestamp * 1000, Name: a.Value})
+		}
+		setForeignSpanEvents(span)
+	}
+	if msg, ok := in.Tags["error"]; ok {
+		// by convention, the presence of the "error" tag marks the span as failed,
+		// and its value holds the error message, if any.
+		span.Error = 1
+		if msg != "" && msg != "true" {
+			span.Meta["error.msg"] = msg
+		}
+	}
+	setForeignSpanDefaults(span)
+	span.Type = foreignSpanKindType(kind, span.Meta)
+	return span, nil
+}
+
+// ipString returns the IPv4 address of the endpoint, or its IPv6 address if the former is unset.
+func (e *zipkinEndpoint) ipString() string {
+	if e.IPv4 != "" {
+		return e.IPv4
+	}
+	return e.IPv6
+}
+
+// parseZipkinID parses the given 64-bit or 128-bit lower-hex encoded ID, returning its lower
+// 64 bits.
+func parseZipkinID(id string) (uint64, error) {
+	if len(id) == 0 || len(id) > 32 {
+		return 0, errInvalidZipkinID
+	}
+	if len(id) > 16 {
+		id = id[len(id)-16:]
+	}
+	n, err := strconv.ParseUint(id, 16, 64)
+	if err != nil {
+		return 0, errInvalidZipkinID
+	}
+	return n, nil
+}
+
+// Protobuf wire types, as used by the Zipkin proto3 encoding.
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+// unmarshalZipkinProto decodes a protobuf encoded zipkin.proto3.ListOfSpans:
+// https://github.com/openzipkin/zipkin-api/blob/master/zipkin.proto
+func unmarshalZipkinProto(b []byte) ([]*zipkinSpan, error) {
+	var spans []*zipkinSpan
+	err := eachProtoField(b, func(field uint64, wire uint64, buf *protoReader) error {
+		if field != 1 || wire != wireBytes {
+			return buf.skip(wire)
+		}
+		raw, err := buf.bytes()
+		if err != nil {
+			return err
+		}
+		span, err := unmarshalZipkinProtoSpan(raw)
+		if err != nil {
+			return err
+		}
+		spans = append(spans, span)
+		return nil
+	})
+	return spans, err
+}
+
+// unmarshalZipkinProtoSpan decodes a protobuf encoded zipkin.proto3.Span.
+func unmarshalZipkinProtoSpan(b []byte) (*zipkinSpan, error) {
+	var span zipkinSpan
+	err := eachProtoField(b, func(field uint64, wire uint64, buf *protoReader) error {
+		switch {
+		case field == 1 && wire == wireBytes:
+			raw, err := buf.bytes()
+			span.TraceID = hex.EncodeToString(raw)
+			return err
+		case field == 2 && wire == wireBytes:
+			raw, err := buf.bytes()
+			span.ParentID = hex.EncodeToString(raw)
+			return err
+		case field == 3 && wire == wireBytes:
+			raw, err := buf.bytes()
+			span.ID = hex.EncodeToString(raw)
+			return err
+		case field == 4 && wire == wireVarint:
+			v, err := buf.varint()
+			span.Kind = zipkinProtoKinds[v]
+			return err
+		case field == 5 && wire == wireBytes:
+			s, err := buf.string()
+			span.Name = s
+			return err
+		case field == 6 && wire == wireFixed64:
+			v, err := buf.fixed64()
+			span.Timestamp = v
+			return err
+		case field == 7 && wire == wireVarint:
+			v, err := buf.varint()
+			span.Duration = v
+			return err
+		case (field == 8 || field == 9) && wire == wireBytes:
+			raw, err := buf.bytes()
+			if err != nil {
+				return err
+			}
+			ep, err := unmarshalZipkinProtoEndpoint(raw)
+			if field == 8 {
+				span.LocalEndpoint = ep
+			} else {
+				span.RemoteEndpoint = ep
+			}
+			return err
+		case field == 10 && wire == wireBytes:
+			raw, err := buf.bytes()
+			if err != nil {
+				return err
+			}
+			a, err := unmarshalZipkinProtoAnnotation(raw)
+			span.Annotations = append(span.Annotations, a)
+			return err
+		case field == 11 && wire == wireBytes:
+			raw, err := buf.bytes()
+			if err != nil {
+				return err
+			}
+			k, v, err := unmarshalProtoMapEntry(raw)
+			if span.Tags == nil {
+				span.Tags = make(map[string]string)
+			}
+			span.Tags[k] = v
+			return err
+		case (field == 12 || field == 13) && wire == wireVarint:
+			v, err := buf.varint()
+			if field == 12 {
+				span.Debug = v != 0
+			} else {
+				span.Shared = v != 0
+			}
+			return err
+		default:
+			return buf.skip(wire)
+		}
+	})
+	return &span, err
+}
+
+// zipkinProtoKinds maps zipkin.proto3.Span.Kind values to their JSON names.
+var zipkinProtoKinds = map[uint64]string{
+	1: "CLIENT",
+	2: "SERVER",
+	3: "PRODUCER",
+	4: "CONSUMER",
+}
+
+// unmarshalZipkinProtoEndpoint decodes a protobuf encoded zipkin.proto3.Endpoint.
+func unmarshalZipkinProtoEndpoint(b []byte) (*zipkinEndpoint, error) {
+	var ep zipkinEndpoint
+	err := eachProtoField(b, func(field uint64, wire uint64, buf *protoReader) error {
+		switch {
+		case field == 1 && wire == wireBytes:
+			s, err := buf.string()
+			ep.ServiceName = s
+			return err
+		case (field == 2 || field == 3) && wire == wireBytes:
+			raw, err := buf.bytes()
+			if err != nil || len(raw) == 0 {
+				return err
+			}
+			if field == 2 {
+				ep.IPv4 = net.IP(raw).String()
+			} else {
+				ep.IPv6 = net.IP(raw).String()
+			}
+			return nil
+		case field == 4 && wire == wireVarint:
+			v, err := buf.varint()
+			ep.Port = int32(v)
+			return err
+		default:
+			return buf.skip(wire)
+		}
+	})
+	return &ep, err
+}
+
+// unmarshalZipkinProtoAnnotation decodes a protobuf encoded zipkin.proto3.Annotation.
+func unmarshalZipkinProtoAnnotation(b []byte) (zipkinAnnotation, error) {
+	var a zipkinAnnotation
+	err := eachProtoField(b, func(field uint64, wire uint64, buf *protoReader) error {
+		switch {
+		case field == 1 && wire == wireFixed64:
+			v, err := buf.fixed64()
+			a.Timestamp = v
+			return err
+		case field == 2 && wire == wireBytes:
+			s, err := buf.string()
+			a.Value = s
+			return err
+		default:
+			return buf.skip(wire)
+		}
+	})
+	return a, err
+}
+
+// unmarshalProtoMapEntry decodes a protobuf encoded map<string, string> entry.
+func unmarshalProtoMapEntry(b []byte) (key, value string, err error) {
+	err = eachProtoField(b, func(field uint64, wire uint64, buf *protoReader) error {
+		switch {
+		case field == 1 && wire == wireBytes:
+			s, err := buf.string()
+			key = s
+			return err
+		case field == 2 && wire == wireBytes:
+			s, err := buf.string()
+			value = s
+			return err
+		default:
+			return buf.skip(wire)
+		}
+	})
+	return key, value, err
+}
+
+// eachProtoField calls fn for each field found in the protobuf encoded message b. fn must
+// consume the field's value from buf.
+func eachProtoField(b []byte, fn func(field uint64, wire uint64, buf *protoReader) error) error {
+	buf := &protoReader{b: b}
+	for len(buf.b) > 0 {
+		key, err := buf.varint()
+		if err != nil {
+			return err
+		}
+		if err := fn(key>>3, key&7, buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// errProtoTruncated is returned when a protobuf message ends unexpectedly.
+var errProtoTruncated = errors.New("protobuf: truncated message")
+
+// protoReader reads values encoded using the protobuf wire format.
+type protoReader struct {
+	b []byte // unread bytes
+}
+
+// varint reads a base 128 varint.
+func (r *protoReader) varint() (uint64, error) {
+	v, n := binary.Uvarint(r.b)
+	if n <= 0 {
+		return 0, errProtoTruncated
+	}
+	r.b = r.b[n:]
+	return v, nil
+}
+
+// fixed64 reads a little-endian 64-bit value.
+func (r *protoReader) fixed64() (uint64, error) {
+	if len(r.b) < 8 {
+		return 0, errProtoTruncated
+	}
+	v := binary.LittleEndian.Uint64(r.b)
+	r.b = r.b[8:]
+	return v, nil
+}
+
+// fixed32 reads a little-endian 32-bit value.
+func (r *protoReader) fixed32() (uint32, error) {
+	if len(r.b) < 4 {
+		return 0, errProtoTruncated
+	}
+	v := binary.LittleEndian.Uint32(r.b)
+	r.b = r.b[4:]
+	return v, nil
+}
+
+// bytes reads a length-delimited value. The returned slice aliases the underlying buffer.
+func (r *protoReader) bytes() ([]byte, error) {
+	n, err := r.varint()
+	if err != nil {
+		return nil, err
+	}
+	if n > uint64(len(r.b)) {
+		return nil, errProtoTruncated
+	}
+	v := r.b[:n]
+	r.b = r.b[n:]
+	return v, nil
+}
+
+// string reads a length-delimited string.
+func (r *protoReader) string() (string, error) {
+	v, err := r.bytes()
+	return string(v), err
+}
+
+// skip consumes a field value of the given wire type.
+func (r *protoReader) skip(wire uint64) error {
+	var err error
+	switch wire {
+	case wireVarint:
+		_, err = r.varint()
+	case wireFixed64:
+		_, err = r.fixed64()
+	case wireBytes:
+		_, err = r.bytes()
+	case wireFixed32:
+		_, err = r.fixed32()
+	default:
+		err = fmt.Errorf("protobuf: unsupported wire type %d", wire)
+	}
+	return err
+}
@@ -0,0 +1,229 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package api
+
+import (
+	"encoding/binary"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DataDog/datadog-agent/pkg/trace/info"
+	"github.com/DataDog/datadog-agent/pkg/trace/pb"
+	"github.com/DataDog/datadog-agent/pkg/trace/sampler"
+	"github.com/stretchr/testify/assert"
+)
+
+const zipkinTestPayload = `[
+  {
+    "traceId": "5af7183fb1d4cf5f5af7183fb1d4cf5f",
+    "id": "352bff9a74ca9ad2",
+    "kind": "SERVER",
+    "name": "get /api",
+    "timestamp": 1556604172355737,
+    "duration": 1431,
+    "localEndpoint": {"serviceName": "backend", "ipv4": "192.168.99.1", "port": 3306},
+    "remoteEndpoint": {"ipv4": "172.19.0.2", "port": 58648},
+    "annotations": [{"timestamp": 1556604172355800, "value": "wr"}],
+    "tags": {"http.method": "GET", "http.route": "/api", "error": "connection refused", "deployment.environment": "prod"}
+  },
+  {
+    "traceId": "5af7183fb1d4cf5f5af7183fb1d4cf5f",
+    "parentId": "352bff9a74ca9ad2",
+    "id": "6b221d5bc9e6496c",
+    "kind": "CLIENT",
+    "name": "select",
+    "timestamp": 1556604172355800,
+    "duration": 200,
+    "localEndpoint": {"serviceName": "backend"},
+    "remoteEndpoint": {"serviceName": "mysql"},
+    "tags": {"db.system": "mysql"},
+    "debug": true
+  }
+]`
+
+func TestConvertZipkin(t *testing.T) {
+	assert := assert.New(t)
+
+	req, _ := http.NewRequest("POST", "/api/v2/spans", nil)
+	req.Header.Set("Content-Type", "application/json")
+	tp, err := decodeZipkinPayload([]byte(zipkinTestPayload), req)
+	assert.NoError(err)
+	assert.Equal("zipkin", tp.TracerVersion)
+	assert.Len(tp.Chunks, 1)
+
+	chunk := tp.Chunks[0]
+	assert.EqualValues(sampler.PriorityUserKeep, chunk.Priority)
+	assert.Len(chunk.Spans, 2)
+
+	server, client := chunk.Spans[0], chunk.Spans[1]
+	assert.Equal(uint64(0x5af7183fb1d4cf5f), server.TraceID)
+	assert.Equal(uint64(0x352bff9a74ca9ad2), server.SpanID)
+	assert.Equal(uint64(0), server.ParentID)
+	assert.Equal("zipkin.server", server.Name)
+	assert.Equal("GET /api", server.Resource)
+	assert.Equal("backend", server.Service)
+	assert.Equal("web", server.Type)
+	assert.Equal(int64(1556604172355737000), server.Start)
+	assert.Equal(int64(1431000), server.Duration)
+	assert.Equal(int32(1), server.Error)
+	assert.Equal("connection refused", server.Meta["error.msg"])
+	assert.Equal("prod", server.Meta["env"])
+	assert.Equal("192.168.99.1", server.Meta["net.host.ip"])
+	assert.Equal("172.19.0.2", server.Meta["net.peer.ip"])
+	assert.Equal("58648", server.Meta["net.peer.port"])
+	assert.Equal("5af7183fb1d4cf5f5af7183fb1d4cf5f", server.Meta["zipkin.trace_id"])
+	assert.Equal(`[{"time_unix_nano":1556604172355800000,"name":"wr"}]`, server.Meta["events"])
+	assert.Equal([]*pb.SpanEvent{{TimeUnixNano: 1556604172355800000, Name: "wr"}}, server.SpanEvents)
+
+	assert.Equal(server.SpanID, client.ParentID)
+	assert.Equal("zipkin.client", client.Name)
+	assert.Equal("select", client.Resource)
+	assert.Equal("db", client.Type)
+	assert.Equal("mysql", client.Meta["peer.service"])
+	assert.Equal(int32(0), client.Error)
+}
+
+func TestConvertZipkinShared(t *testing.T) {
+	assert := assert.New(t)
+
+	tp, err := zipkinToTracerPayload([]*zipkinSpan{
+		{TraceID: "1", ID: "2", ParentID: "1", Kind: "CLIENT"},
+		{TraceID: "1", ID: "2", ParentID: "1", Kind: "SERVER", Shared: true},
+		{TraceID: "1", ID: "3", ParentID: "2", Kind: "CLIENT"},
+	})
+	assert.NoError(err)
+	spans := tp.Chunks[0].Spans
+	assert.EqualValues(sampler.PriorityAutoKeep, tp.Chunks[0].Priority)
+	assert.Equal(uint64(2), spans[0].SpanID)
+	assert.Equal(uint64(1), spans[0].ParentID)
+	assert.NotEqual(uint64(2), spans[1].SpanID)
+	assert.Equal(uint64(2), spans[1].ParentID)
+	assert.Equal(spans[1].SpanID, spans[2].ParentID)
+}
+
+func TestConvertZipkinInvalidID(t *testing.T) {
+	for _, id := range []string{"", "xyz", strings.Repeat("a", 33)} {
+		_, err := zipkinToTracerPayload([]*zipkinSpan{{TraceID: id, ID: "1"}})
+		assert.Error(t, err, id)
+	}
+}
+
+func TestUnmarshalZipkinProto(t *testing.T) {
+	assert := assert.New(t)
+
+	var ep, ann, tag, span, list protoTestBuffer
+	ep.bytes(1, []byte("frontend"))
+	ep.bytes(2, []byte{10, 0, 0, 1})
+	ep.varint(4, 8080)
+	ann.fixed64(1, 1556604172355800)
+	ann.bytes(2, []byte("ws"))
+	tag.bytes(1, []byte("http.method"))
+	tag.bytes(2, []byte("POST"))
+	span.bytes(1, []byte{0x5a, 0xf7, 0x18, 0x3f, 0xb1, 0xd4, 0xcf, 0x5f})
+	span.bytes(3, []byte{0x35, 0x2b, 0xff, 0x9a, 0x74, 0xca, 0x9a, 0xd2})
+	span.varint(4, 2) // SERVER
+	span.bytes(5, []byte("post"))
+	span.fixed64(6, 1556604172355737)
+	span.varint(7, 1431)
+	span.bytes(8, ep.b)
+	span.bytes(10, ann.b)
+	span.bytes(11, tag.b)
+	span.varint(99, 1) // unknown field
+	list.bytes(1, span.b)
+
+	spans, err := unmarshalZipkinProto(list.b)
+	assert.NoError(err)
+	assert.Len(spans, 1)
+	assert.Equal(&zipkinSpan{
+		TraceID:       "5af7183fb1d4cf5f",
+		ID:            "352bff9a74ca9ad2",
+		Kind:          "SERVER",
+		Name:          "post",
+		Timestamp:     1556604172355737,
+		Duration:      1431,
+		LocalEndpoint: &zipkinEndpoint{ServiceName: "frontend", IPv4: "10.0.0.1", Port: 8080},
+		Annotations:   []zipkinAnnotation{{Timestamp: 1556604172355800, Value: "ws"}},
+		Tags:          map[string]string{"http.method": "POST"},
+	}, spans[0])
+
+	_, err = unmarshalZipkinProto(list.b[:len(list.b)-1])
+	assert.Equal(errProtoTruncated, err)
+}
+
+func TestZipkinEndpoint(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		rcv := newTestReceiverFromConfig(newTestReceiverConfig())
+		server := httptest.NewServer(rcv.buildMux())
+		defer server.Close()
+
+		resp, err := http.Post(server.URL+"/api/v2/spans", "application/json", strings.NewReader(zipkinTestPayload))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		assert := assert.New(t)
+		conf := newTestReceiverConfig()
+		conf.ZipkinReceiverEnabled = true
+		rcv := newTestReceiverFromConfig(conf)
+		server := httptest.NewServer(rcv.buildMux())
+		defer server.Close()
+
+		resp, err := http.Post(server.URL+"/api/v2/spans", "application/json", strings.NewReader(zipkinTestPayload))
+		assert.NoError(err)
+		assert.Equal(http.StatusAccepted, resp.StatusCode)
+		select {
+		case p := <-rcv.out:
+			assert.Len(p.Chunks(), 1)
+			assert.Equal("zipkin", p.TracerPayload.TracerVersion)
+		case <-time.After(time.Second):
+			t.Fatal("no output")
+		}
+		ts := rcv.Stats.Stats[info.Tags{EndpointVersion: string(zipkinV2)}]
+		assert.EqualValues(1, ts.TracesReceived)
+		assert.EqualValues(1, ts.PayloadAccepted)
+
+		resp, err = http.Post(server.URL+"/api/v2/spans", "application/json", strings.NewReader("[{"))
+		assert.NoError(err)
+		assert.Equal(http.StatusBadRequest, resp.StatusCode)
+	})
+}
+
+// protoTestBuffer encodes protobuf messages for testing purposes.
+type protoTestBuffer struct {
+	b []byte
+}
+
+func (p *protoTestBuffer) key(field, wire uint64) {
+	p.b = appendUvarint(p.b, field<<3|wire)
+}
+
+func (p *protoTestBuffer) varint(field, v uint64) {
+	p.key(field, wireVarint)
+	p.b = appendUvarint(p.b, v)
+}
+
+func (p *protoTestBuffer) fixed64(field, v uint64) {
+	p.key(field, wireFixed64)
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], v)
+	p.b = append(p.b, buf[:]...)
+}
+
+func (p *protoTestBuffer) bytes(field uint64, v []byte) {
+	p.key(field, wireBytes)
+	p.b = appendUvarint(p.b, uint64(len(v)))
+	p.b = append(p.b, v...)
+}
+
+func appendUvarint(b []byte, v uint64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(tmp[:], v)
+	return append(b, tmp[:n]...)
+}
@@ -44,6 +44,18 @@ type OTLP struct {
 	MaxRequestBytes int64 `mapstructure:"-"`
 }
 
+// OTLPExporter holds the configuration for exporting sampled traces to an OpenTelemetry
+// collector, alongside the Datadog intake.
+type OTLPExporter struct {
+	// Endpoint specifies the URL of the collector's OTLP/HTTP traces endpoint,
+	// e.g. "http://localhost:4318/v1/traces".
+	Endpoint string `mapstructure:"endpoint"`
+
+	// Headers specifies additional HTTP headers to send with each request, such as
+	// authentication headers required by the collector.
+	Headers map[string]string `mapstructure:"headers"`
+}
+
 // ObfuscationConfig holds the configuration for obfuscating sensitive data
 // for various span types.
 type ObfuscationConfig struct {
@@ -273,6 +285,12 @@ func (c *AgentConfig) applyDatadogConfig() error {
 	if k := "apm_config.max_payload_size"; config.Datadog.IsSet(k) {
 		c.MaxRequestBytes = config.Datadog.GetInt64(k)
 	}
+	if k := "apm_config.capture.file"; config.Datadog.IsSet(k) {
+		c.CaptureFile = config.Datadog.GetString(k)
+	}
+	if k := "apm_config.capture.max_bytes"; config.Datadog.IsSet(k) {
+		c.CaptureMaxBytes = config.Datadog.GetInt64(k)
+	}
 	if k := "apm_config.replace_tags"; config.Datadog.IsSet(k) {
 		rt := make([]*ReplaceRule, 0)
 		if err := config.Datadog.UnmarshalKey(k, &rt); err != nil {
@@ -312,6 +330,24 @@ func (c *AgentConfig) applyDatadogConfig() error {
 		MaxRequestBytes: c.MaxRequestBytes,
 	}
 
+	if k := "apm_config.otlp_exporter.endpoint"; config.Datadog.IsSet(k) {
+		endpoint := config.Datadog.GetString(k)
+		if u, err := url.Parse(endpoint); err != nil || u.Host == "" {
+			log.Errorf("Invalid %s %q, OTLP export is disabled.", k, endpoint)
+		} else {
+			c.OTLPExporter = &OTLPExporter{
+				Endpoint: endpoint,
+				Headers:  config.Datadog.GetStringMapString("apm_config.otlp_exporter.headers"),
+			}
+		}
+	}
+	if config.Datadog.IsSet("apm_config.zipkin.enabled") {
+		c.ZipkinReceiverEnabled = config.Datadog.GetBool("apm_config.zipkin.enabled")
+	}
+	if config.Datadog.IsSet("apm_config.jaeger.enabled") {
+		c.JaegerReceiverEnabled = config.Datadog.GetBool("apm_config.jaeger.enabled")
+	}
+
 	c.Obfuscation = new(ObfuscationConfig)
 	if config.Datadog.IsSet("apm_config.obfuscation") {
 		var o ObfuscationConfig
@@ -355,6 +391,20 @@ func (c *AgentConfig) applyDatadogConfig() error {
 	if config.Datadog.IsSet("apm_config.max_memory") {
 		c.MaxMemory = config.Datadog.GetFloat64("apm_config.max_memory")
 	}
+	if k := "apm_config.fair_share_rate_limiting.enabled"; config.Datadog.IsSet(k) {
+		c.FairShareRateLimiting = config.Datadog.GetBool(k)
+	}
+	if k := "apm_config.fair_share_rate_limiting.service_weights"; config.Datadog.IsSet(k) {
+		c.ServiceWeights = make(map[string]float64)
+		for service, w := range config.Datadog.GetStringMap(k) {
+			weight, err := toFloat64(w)
+			if err != nil || weight <= 0 {
+				log.Errorf("Invalid weight for service %q in %s: %v", service, k, w)
+				continue
+			}
+			c.ServiceWeights[service] = weight
+		}
+	}
 
 	// undocumented writers
 	for key, cfg := range map[string]*WriterConfig{
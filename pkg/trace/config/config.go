@@ -80,7 +80,9 @@ type AgentConfig struct {
 	ReceiverSocket  string // if not empty, UDS will be enabled on unix://<receiver_socket>
 	ConnectionLimit int    // for rate-limiting, how many unique connections to allow in a lease period (30s)
 	ReceiverTimeout int
-	MaxRequestBytes int64 // specifies the maximum allowed request size for incoming trace payloads
+	MaxRequestBytes int64  // specifies the maximum allowed request size for incoming trace payloads
+	CaptureFile     string // if not empty, incoming trace payloads are recorded into this file
+	CaptureMaxBytes int64  // maximum size of the capture file; 0 means no limit
 
 	// Writers
 	SynchronousFlushing     bool // Mode where traces are only submitted when FlushAsync is called, used for Serverless Extension
@@ -102,6 +104,12 @@ type AgentConfig struct {
 	MaxCPU           float64       // MaxCPU is the max UserAvg CPU the program should consume
 	WatchdogInterval time.Duration // WatchdogInterval is the delay between 2 watchdog checks
 
+	// FairShareRateLimiting reports whether the receiver distributes the rate limiting rate between
+	// services using weighted fair-share, instead of applying it equally to all incoming payloads.
+	FairShareRateLimiting bool
+	// ServiceWeights specifies the fair-share weight of services, by service name. The default weight is 1.
+	ServiceWeights map[string]float64
+
 	// http/s proxying
 	ProxyURL          *url.URL
 	SkipSSLValidation bool
@@ -135,6 +143,18 @@ type AgentConfig struct {
 	// OTLPReceiver holds the configuration for OpenTelemetry receiver.
 	OTLPReceiver *OTLP
 
+	// OTLPExporter holds the configuration for exporting sampled traces to an OpenTelemetry
+	// collector. It is nil when the export is disabled.
+	OTLPExporter *OTLPExporter
+
+	// ZipkinReceiverEnabled reports whether the receiver accepts Zipkin v2 spans (JSON or protobuf)
+	// on the /api/v2/spans endpoint.
+	ZipkinReceiverEnabled bool
+
+	// JaegerReceiverEnabled reports whether the receiver accepts Jaeger batches (Thrift binary) on
+	// the /api/traces endpoint.
+	JaegerReceiverEnabled bool
+
 	// Profiling settings, or nil if profiling is disabled
 	ProfilingSettings *profiling.Settings
 }
@@ -167,7 +187,8 @@ func New() *AgentConfig {
 
 		ReceiverHost:    "localhost",
 		ReceiverPort:    8126,
-		MaxRequestBytes: 50 * 1024 * 1024, // 50MB
+		MaxRequestBytes: 50 * 1024 * 1024,   // 50MB
+		CaptureMaxBytes: 1024 * 1024 * 1024, // 1GB
 
 		StatsWriter:             new(WriterConfig),
 		TraceWriter:             new(WriterConfig),
@@ -23,6 +23,17 @@ var (
 	// Info will display information about a running agent.
 	Info bool
 
+	// Replay specifies the path to a capture file to replay against a running trace-agent.
+	Replay string
+
+	// ReplaySpeed specifies the replay speed, relative to the recorded timings.
+	// A value of 0 replays as fast as possible.
+	ReplaySpeed float64
+
+	// ReplayTarget specifies the base URL of the receiver to replay against. When empty,
+	// the receiver found in the configuration is used.
+	ReplayTarget string
+
 	// CPUProfile specifies the path to output CPU profiling information to.
 	// When empty, CPU profiling is disabled.
 	CPUProfile string
@@ -47,6 +58,11 @@ func init() {
 	flag.BoolVar(&Version, "version", false, "Show version information and exit")
 	flag.BoolVar(&Info, "info", false, "Show info about running trace agent process and exit")
 
+	// replay
+	flag.StringVar(&Replay, "replay", "", "Replay the trace payloads recorded in the given capture `file` and exit")
+	flag.Float64Var(&ReplaySpeed, "replay-speed", 1, "Replay speed relative to the recorded timings (0 means as fast as possible)")
+	flag.StringVar(&ReplayTarget, "replay-target", "", "Base URL of the trace-agent receiver to replay against (defaults to the configured receiver)")
+
 	// profiling
 	flag.StringVar(&CPUProfile, "cpuprofile", "", "Write cpu profile to file")
 	flag.StringVar(&MemProfile, "memprofile", "", "Write memory profile to `file`")
@@ -77,6 +77,9 @@ const (
   {{if lt .Status.RateLimiter.TargetRate 1.0}}
   WARNING: Rate-limiter keep percentage: {{percent .Status.RateLimiter.TargetRate}} %
   {{end}}
+  {{ range $s := .Status.RateLimiter.Services }}{{ if gt $s.DropRate 0.0 }}
+  WARNING: Rate-limiter dropping {{percent $s.DropRate}} % of traces from service '{{ $s.Service }}' (env: {{ $s.Env }})
+  {{ end }}{{ end }}
 
   --- Writer stats (1 min) ---
 
@@ -173,6 +176,25 @@ type RateLimiterStats struct {
 	RecentTracesSeen float64
 	// RecentTracesDropped is the number of traces that were dropped.
 	RecentTracesDropped float64
+	// Services holds the per-service rate limiting data, sorted by env and service.
+	Services []ServiceRateLimiterStats `json:",omitempty"`
+}
+
+// ServiceRateLimiterStats contains the rate limiting data of a single service.
+type ServiceRateLimiterStats struct {
+	// Env and Service identify the service.
+	Env     string
+	Service string
+	// Weight is the fair-share weight of the service.
+	Weight float64
+	// TargetRate is the rate limiting rate that we are aiming for, for this service.
+	TargetRate float64
+	// RecentTracesSeen is the number of traces that passed by.
+	RecentTracesSeen float64
+	// RecentTracesDropped is the number of traces that were dropped.
+	RecentTracesDropped float64
+	// DropRate is the ratio of recent traces which were dropped.
+	DropRate float64
 }
 
 // UpdateRateLimiter updates internal stats about the rate limiting.
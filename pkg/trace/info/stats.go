@@ -216,6 +216,8 @@ type TracesDropped struct {
 	// EOF is when an unexpected EOF is encountered, this can happen because the client has aborted
 	// or because a bad payload (i.e. shorter than claimed in Content-Length) was sent.
 	EOF int64
+	// RateLimited is when a trace is dropped by the per-service (fair-share) rate limiter.
+	RateLimited int64
 }
 
 // tagValues converts TracesDropped into a map representation with keys matching standardized names for all reasons
@@ -229,6 +231,7 @@ func (s *TracesDropped) tagValues() map[string]int64 {
 		"foreign_span":      atomic.LoadInt64(&s.ForeignSpan),
 		"timeout":           atomic.LoadInt64(&s.Timeout),
 		"unexpected_eof":    atomic.LoadInt64(&s.EOF),
+		"rate_limited":      atomic.LoadInt64(&s.RateLimited),
 	}
 }
 
@@ -262,6 +265,8 @@ type SpansMalformed struct {
 	InvalidDuration int64
 	// InvalidHTTPStatusCode is when a span's metadata contains an invalid http status code
 	InvalidHTTPStatusCode int64
+	// InvalidSpanLink is when a span contains a link which does not point to a valid span
+	InvalidSpanLink int64
 }
 
 // tagValues converts SpansMalformed into a map representation with keys matching standardized names for all reasons
@@ -279,6 +284,7 @@ func (s *SpansMalformed) tagValues() map[string]int64 {
 		"invalid_start_date":       atomic.LoadInt64(&s.InvalidStartDate),
 		"invalid_duration":         atomic.LoadInt64(&s.InvalidDuration),
 		"invalid_http_status_code": atomic.LoadInt64(&s.InvalidHTTPStatusCode),
+		"invalid_span_link":        atomic.LoadInt64(&s.InvalidSpanLink),
 	}
 }
 
@@ -374,6 +380,7 @@ func (s *Stats) update(recent *Stats) {
 	atomic.AddInt64(&s.TracesDropped.TraceIDZero, atomic.LoadInt64(&recent.TracesDropped.TraceIDZero))
 	atomic.AddInt64(&s.TracesDropped.SpanIDZero, atomic.LoadInt64(&recent.TracesDropped.SpanIDZero))
 	atomic.AddInt64(&s.TracesDropped.ForeignSpan, atomic.LoadInt64(&recent.TracesDropped.ForeignSpan))
+	atomic.AddInt64(&s.TracesDropped.RateLimited, atomic.LoadInt64(&recent.TracesDropped.RateLimited))
 	atomic.AddInt64(&s.SpansMalformed.DuplicateSpanID, atomic.LoadInt64(&recent.SpansMalformed.DuplicateSpanID))
 	atomic.AddInt64(&s.SpansMalformed.ServiceEmpty, atomic.LoadInt64(&recent.SpansMalformed.ServiceEmpty))
 	atomic.AddInt64(&s.SpansMalformed.ServiceTruncate, atomic.LoadInt64(&recent.SpansMalformed.ServiceTruncate))
@@ -386,6 +393,7 @@ func (s *Stats) update(recent *Stats) {
 	atomic.AddInt64(&s.SpansMalformed.InvalidStartDate, atomic.LoadInt64(&recent.SpansMalformed.InvalidStartDate))
 	atomic.AddInt64(&s.SpansMalformed.InvalidDuration, atomic.LoadInt64(&recent.SpansMalformed.InvalidDuration))
 	atomic.AddInt64(&s.SpansMalformed.InvalidHTTPStatusCode, atomic.LoadInt64(&recent.SpansMalformed.InvalidHTTPStatusCode))
+	atomic.AddInt64(&s.SpansMalformed.InvalidSpanLink, atomic.LoadInt64(&recent.SpansMalformed.InvalidSpanLink))
 	atomic.AddInt64(&s.TracesFiltered, atomic.LoadInt64(&recent.TracesFiltered))
 	atomic.AddInt64(&s.ClientDroppedP0Traces, atomic.LoadInt64(&recent.ClientDroppedP0Traces))
 	atomic.AddInt64(&s.ClientDroppedP0Spans, atomic.LoadInt64(&recent.ClientDroppedP0Spans))
@@ -410,6 +418,7 @@ func (s *Stats) reset() {
 	atomic.StoreInt64(&s.TracesDropped.ForeignSpan, 0)
 	atomic.StoreInt64(&s.TracesDropped.Timeout, 0)
 	atomic.StoreInt64(&s.TracesDropped.EOF, 0)
+	atomic.StoreInt64(&s.TracesDropped.RateLimited, 0)
 	atomic.StoreInt64(&s.SpansMalformed.DuplicateSpanID, 0)
 	atomic.StoreInt64(&s.SpansMalformed.ServiceEmpty, 0)
 	atomic.StoreInt64(&s.SpansMalformed.ServiceTruncate, 0)
@@ -422,6 +431,7 @@ func (s *Stats) reset() {
 	atomic.StoreInt64(&s.SpansMalformed.InvalidStartDate, 0)
 	atomic.StoreInt64(&s.SpansMalformed.InvalidDuration, 0)
 	atomic.StoreInt64(&s.SpansMalformed.InvalidHTTPStatusCode, 0)
+	atomic.StoreInt64(&s.SpansMalformed.InvalidSpanLink, 0)
 	atomic.StoreInt64(&s.TracesFiltered, 0)
 	atomic.StoreInt64(&s.TracesPriorityNone, 0)
 	atomic.StoreInt64(&s.ClientDroppedP0Traces, 0)
@@ -31,6 +31,7 @@ func TestTracesDropped(t *testing.T) {
 			"span_id_zero":      1,
 			"timeout":           0,
 			"unexpected_eof":    0,
+			"rate_limited":      0,
 		}, s.tagValues())
 	})
 
@@ -55,6 +56,7 @@ func TestSpansMalformed(t *testing.T) {
 			"service_truncate":         0,
 			"invalid_start_date":       0,
 			"invalid_http_status_code": 0,
+			"invalid_span_link":        0,
 			"invalid_duration":         0,
 			"duplicate_span_id":        0,
 			"service_empty":            1,
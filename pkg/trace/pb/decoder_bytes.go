@@ -66,6 +66,40 @@ func parseStringBytes(bts []byte) (string, []byte, error) {
 	return repairUTF8(msgp.UnsafeString(i)), bts, nil
 }
 
+// parseStringMapBytes reads a map of strings from the msgpack payload, reusing m
+// when it is not nil. A nil value in the payload results in a nil map.
+func parseStringMapBytes(bts []byte, m map[string]string) (map[string]string, []byte, error) {
+	if msgp.IsNil(bts) {
+		bts, err := msgp.ReadNilBytes(bts)
+		return nil, bts, err
+	}
+	sz, bts, err := msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		return m, bts, err
+	}
+	if m == nil && sz > 0 {
+		m = make(map[string]string, sz)
+	} else if len(m) > 0 {
+		for key := range m {
+			delete(m, key)
+		}
+	}
+	for sz > 0 {
+		sz--
+		var k, v string
+		k, bts, err = parseStringBytes(bts)
+		if err != nil {
+			return m, bts, err
+		}
+		v, bts, err = parseStringBytes(bts)
+		if err != nil {
+			return m, bts, msgp.WrapError(err, k)
+		}
+		m[k] = v
+	}
+	return m, bts, nil
+}
+
 // parseFloat64Bytes parses a float64 even if the sent value is an int64 or an uint64;
 // this is required because the encoding library could remove bytes from the encoded
 // payload to reduce the size, if they're not needed.
@@ -16,9 +16,21 @@ import (
 // MarshalMsg implements msgp.Marshaler
 func (z *Span) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 12
+	// omitempty: check for empty values
+	zb0001Len := uint32(14)
+	var zb0001Mask uint16 /* 14 bits */
+	if z.SpanLinks == nil {
+		zb0001Len--
+		zb0001Mask |= 0x1000
+	}
+	if z.SpanEvents == nil {
+		zb0001Len--
+		zb0001Mask |= 0x2000
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
 	// string "service"
-	o = append(o, 0x8c, 0xa7, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65)
+	o = append(o, 0xa7, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65)
 	o = msgp.AppendString(o, z.Service)
 	// string "name"
 	o = append(o, 0xa4, 0x6e, 0x61, 0x6d, 0x65)
@@ -61,6 +73,38 @@ func (z *Span) MarshalMsg(b []byte) (o []byte, err error) {
 	// string "type"
 	o = append(o, 0xa4, 0x74, 0x79, 0x70, 0x65)
 	o = msgp.AppendString(o, z.Type)
+	if (zb0001Mask & 0x1000) == 0 { // if not empty
+		// string "span_links"
+		o = append(o, 0xaa, 0x73, 0x70, 0x61, 0x6e, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x73)
+		o = msgp.AppendArrayHeader(o, uint32(len(z.SpanLinks)))
+		for za0005 := range z.SpanLinks {
+			if z.SpanLinks[za0005] == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o, err = z.SpanLinks[za0005].MarshalMsg(o)
+				if err != nil {
+					err = msgp.WrapError(err, "SpanLinks", za0005)
+					return
+				}
+			}
+		}
+	}
+	if (zb0001Mask & 0x2000) == 0 { // if not empty
+		// string "span_events"
+		o = append(o, 0xab, 0x73, 0x70, 0x61, 0x6e, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73)
+		o = msgp.AppendArrayHeader(o, uint32(len(z.SpanEvents)))
+		for za0006 := range z.SpanEvents {
+			if z.SpanEvents[za0006] == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o, err = z.SpanEvents[za0006].MarshalMsg(o)
+				if err != nil {
+					err = msgp.WrapError(err, "SpanEvents", za0006)
+					return
+				}
+			}
+		}
+	}
 	return
 }
 
@@ -217,6 +261,76 @@ func (z *Span) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				err = msgp.WrapError(err, "Type")
 				return
 			}
+		case "span_links":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				z.SpanLinks = nil
+				break
+			}
+			var zb0004 uint32
+			zb0004, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "SpanLinks")
+				return
+			}
+			if cap(z.SpanLinks) >= int(zb0004) {
+				z.SpanLinks = (z.SpanLinks)[:zb0004]
+			} else {
+				z.SpanLinks = make([]*SpanLink, zb0004)
+			}
+			for za0005 := range z.SpanLinks {
+				if msgp.IsNil(bts) {
+					bts, err = msgp.ReadNilBytes(bts)
+					if err != nil {
+						return
+					}
+					z.SpanLinks[za0005] = nil
+				} else {
+					if z.SpanLinks[za0005] == nil {
+						z.SpanLinks[za0005] = new(SpanLink)
+					}
+					bts, err = z.SpanLinks[za0005].UnmarshalMsg(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "SpanLinks", za0005)
+						return
+					}
+				}
+			}
+		case "span_events":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				z.SpanEvents = nil
+				break
+			}
+			var zb0005 uint32
+			zb0005, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "SpanEvents")
+				return
+			}
+			if cap(z.SpanEvents) >= int(zb0005) {
+				z.SpanEvents = (z.SpanEvents)[:zb0005]
+			} else {
+				z.SpanEvents = make([]*SpanEvent, zb0005)
+			}
+			for za0006 := range z.SpanEvents {
+				if msgp.IsNil(bts) {
+					bts, err = msgp.ReadNilBytes(bts)
+					if err != nil {
+						return
+					}
+					z.SpanEvents[za0006] = nil
+				} else {
+					if z.SpanEvents[za0006] == nil {
+						z.SpanEvents[za0006] = new(SpanEvent)
+					}
+					bts, err = z.SpanEvents[za0006].UnmarshalMsg(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "SpanEvents", za0006)
+						return
+					}
+				}
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -245,6 +359,266 @@ func (z *Span) Msgsize() (s int) {
 			s += msgp.StringPrefixSize + len(za0003) + msgp.Float64Size
 		}
 	}
-	s += 5 + msgp.StringPrefixSize + len(z.Type)
+	s += 5 + msgp.StringPrefixSize + len(z.Type) + 11 + msgp.ArrayHeaderSize
+	for za0005 := range z.SpanLinks {
+		if z.SpanLinks[za0005] == nil {
+			s += msgp.NilSize
+		} else {
+			s += z.SpanLinks[za0005].Msgsize()
+		}
+	}
+	s += 12 + msgp.ArrayHeaderSize
+	for za0006 := range z.SpanEvents {
+		if z.SpanEvents[za0006] == nil {
+			s += msgp.NilSize
+		} else {
+			s += z.SpanEvents[za0006].Msgsize()
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *SpanLink) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// omitempty: check for empty values
+	zb0001Len := uint32(7)
+	var zb0001Mask uint8 /* 7 bits */
+	if z.TraceIDHigh == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	if z.Attributes == nil {
+		zb0001Len--
+		zb0001Mask |= 0x8
+	}
+	if z.Tracestate == "" {
+		zb0001Len--
+		zb0001Mask |= 0x10
+	}
+	if z.Flags == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x20
+	}
+	if z.DroppedAttributesCount == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x40
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+	// string "trace_id"
+	o = append(o, 0xa8, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64)
+	o = msgp.AppendUint64(o, z.TraceID)
+	if (zb0001Mask & 0x2) == 0 { // if not empty
+		// string "trace_id_high"
+		o = append(o, 0xad, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x5f, 0x68, 0x69, 0x67, 0x68)
+		o = msgp.AppendUint64(o, z.TraceIDHigh)
+	}
+	// string "span_id"
+	o = append(o, 0xa7, 0x73, 0x70, 0x61, 0x6e, 0x5f, 0x69, 0x64)
+	o = msgp.AppendUint64(o, z.SpanID)
+	if (zb0001Mask & 0x8) == 0 { // if not empty
+		// string "attributes"
+		o = append(o, 0xaa, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73)
+		o = msgp.AppendMapHeader(o, uint32(len(z.Attributes)))
+		for za0001, za0002 := range z.Attributes {
+			o = msgp.AppendString(o, za0001)
+			o = msgp.AppendString(o, za0002)
+		}
+	}
+	if (zb0001Mask & 0x10) == 0 { // if not empty
+		// string "tracestate"
+		o = append(o, 0xaa, 0x74, 0x72, 0x61, 0x63, 0x65, 0x73, 0x74, 0x61, 0x74, 0x65)
+		o = msgp.AppendString(o, z.Tracestate)
+	}
+	if (zb0001Mask & 0x20) == 0 { // if not empty
+		// string "flags"
+		o = append(o, 0xa5, 0x66, 0x6c, 0x61, 0x67, 0x73)
+		o = msgp.AppendUint32(o, z.Flags)
+	}
+	if (zb0001Mask & 0x40) == 0 { // if not empty
+		// string "dropped_attributes_count"
+		o = append(o, 0xb8, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74)
+		o = msgp.AppendUint32(o, z.DroppedAttributesCount)
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *SpanLink) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "trace_id":
+			z.TraceID, bts, err = parseUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "TraceID")
+				return
+			}
+		case "trace_id_high":
+			z.TraceIDHigh, bts, err = parseUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "TraceIDHigh")
+				return
+			}
+		case "span_id":
+			z.SpanID, bts, err = parseUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "SpanID")
+				return
+			}
+		case "attributes":
+			z.Attributes, bts, err = parseStringMapBytes(bts, z.Attributes)
+			if err != nil {
+				err = msgp.WrapError(err, "Attributes")
+				return
+			}
+		case "tracestate":
+			z.Tracestate, bts, err = parseStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Tracestate")
+				return
+			}
+		case "flags":
+			var flags uint64
+			flags, bts, err = parseUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Flags")
+				return
+			}
+			z.Flags = uint32(flags)
+		case "dropped_attributes_count":
+			var count uint64
+			count, bts, err = parseUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "DroppedAttributesCount")
+				return
+			}
+			z.DroppedAttributesCount = uint32(count)
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *SpanLink) Msgsize() (s int) {
+	s = 1 + 9 + msgp.Uint64Size + 14 + msgp.Uint64Size + 8 + msgp.Uint64Size + 11 + msgp.MapHeaderSize
+	if z.Attributes != nil {
+		for za0001, za0002 := range z.Attributes {
+			_ = za0002
+			s += msgp.StringPrefixSize + len(za0001) + msgp.StringPrefixSize + len(za0002)
+		}
+	}
+	s += 11 + msgp.StringPrefixSize + len(z.Tracestate) + 6 + msgp.Uint32Size + 25 + msgp.Uint32Size
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *SpanEvent) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// omitempty: check for empty values
+	zb0001Len := uint32(3)
+	var zb0001Mask uint8 /* 3 bits */
+	if z.Attributes == nil {
+		zb0001Len--
+		zb0001Mask |= 0x4
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+	// string "time_unix_nano"
+	o = append(o, 0xae, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x6e, 0x61, 0x6e, 0x6f)
+	o = msgp.AppendUint64(o, z.TimeUnixNano)
+	// string "name"
+	o = append(o, 0xa4, 0x6e, 0x61, 0x6d, 0x65)
+	o = msgp.AppendString(o, z.Name)
+	if (zb0001Mask & 0x4) == 0 { // if not empty
+		// string "attributes"
+		o = append(o, 0xaa, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73)
+		o = msgp.AppendMapHeader(o, uint32(len(z.Attributes)))
+		for za0001, za0002 := range z.Attributes {
+			o = msgp.AppendString(o, za0001)
+			o = msgp.AppendString(o, za0002)
+		}
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *SpanEvent) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		err = msgp.WrapError(err)
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "time_unix_nano":
+			z.TimeUnixNano, bts, err = parseUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "TimeUnixNano")
+				return
+			}
+		case "name":
+			z.Name, bts, err = parseStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "Name")
+				return
+			}
+		case "attributes":
+			z.Attributes, bts, err = parseStringMapBytes(bts, z.Attributes)
+			if err != nil {
+				err = msgp.WrapError(err, "Attributes")
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *SpanEvent) Msgsize() (s int) {
+	s = 1 + 15 + msgp.Uint64Size + 5 + msgp.StringPrefixSize + len(z.Name) + 11 + msgp.MapHeaderSize
+	if z.Attributes != nil {
+		for za0001, za0002 := range z.Attributes {
+			_ = za0002
+			s += msgp.StringPrefixSize + len(za0001) + msgp.StringPrefixSize + len(za0002)
+		}
+	}
 	return
 }
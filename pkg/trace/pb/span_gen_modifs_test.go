@@ -410,3 +410,78 @@ func TestDeserialization(t *testing.T) {
 		})
 	})
 }
+
+func TestSpanLinksAndEvents(t *testing.T) {
+	span := &Span{
+		Service: "producer",
+		TraceID: 1,
+		SpanID:  2,
+		SpanLinks: []*SpanLink{
+			{TraceID: 3, TraceIDHigh: 4, SpanID: 5, Attributes: map[string]string{"link.kind": "follows_from"}, Tracestate: "dd=s:1", Flags: 1, DroppedAttributesCount: 2},
+			{TraceID: 6, SpanID: 7},
+		},
+		SpanEvents: []*SpanEvent{
+			{TimeUnixNano: 1234, Name: "exception", Attributes: map[string]string{"exception.message": "boom"}},
+			{Name: "empty"},
+		},
+	}
+
+	t.Run("msgpack", func(t *testing.T) {
+		assert := assert.New(t)
+		bts, err := span.MarshalMsg(nil)
+		assert.NoError(err)
+		assert.True(len(bts) <= span.Msgsize())
+		got, err := decodeBytes(bts)
+		assert.NoError(err)
+		assert.Equal(span, got)
+	})
+
+	t.Run("msgpack-omitted", func(t *testing.T) {
+		assert := assert.New(t)
+		bts, err := (&Span{Service: "svc"}).MarshalMsg(nil)
+		assert.NoError(err)
+		sz, _, err := msgp.ReadMapHeaderBytes(bts)
+		assert.NoError(err)
+		assert.EqualValues(12, sz)
+		got, err := decodeBytes(bts)
+		assert.NoError(err)
+		assert.Nil(got.SpanLinks)
+		assert.Nil(got.SpanEvents)
+	})
+
+	t.Run("msgpack-lenient", func(t *testing.T) {
+		assert := assert.New(t)
+		// tracers may encode IDs as signed integers and strings as binary
+		b := msgp.AppendMapHeader(nil, 1)
+		b = msgp.AppendString(b, "span_links")
+		b = msgp.AppendArrayHeader(b, 2)
+		b = msgp.AppendMapHeader(b, 4)
+		b = msgp.AppendString(b, "trace_id")
+		b = msgp.AppendInt64(b, 3)
+		b = msgp.AppendString(b, "span_id")
+		b = msgp.AppendInt32(b, 5)
+		b = msgp.AppendString(b, "attributes")
+		b = msgp.AppendMapHeader(b, 1)
+		b = msgp.AppendBytes(b, []byte("key"))
+		b = msgp.AppendBytes(b, []byte("value"))
+		b = msgp.AppendString(b, "unknown")
+		b = msgp.AppendString(b, "skipped")
+		b = msgp.AppendNil(b)
+		got, err := decodeBytes(b)
+		assert.NoError(err)
+		assert.Equal([]*SpanLink{
+			{TraceID: 3, SpanID: 5, Attributes: map[string]string{"key": "value"}},
+			nil,
+		}, got.SpanLinks)
+	})
+
+	t.Run("protobuf", func(t *testing.T) {
+		assert := assert.New(t)
+		bts, err := span.Marshal()
+		assert.NoError(err)
+		assert.Len(bts, span.Size())
+		var got Span
+		assert.NoError(got.Unmarshal(bts))
+		assert.Equal(span, &got)
+	})
+}
@@ -0,0 +1,219 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package replay implements recording of the raw payloads received by the trace-agent into
+// capture files, and replaying these captures against a running trace-agent. It is meant to
+// help reproducing issues seen on real traffic and to benchmark the agent.
+package replay
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// fileHeader is written at the beginning of each (uncompressed) capture file stream, followed
+// by the file format version.
+var fileHeader = []byte("DDTRACECAP")
+
+// fileVersion specifies the version of the capture file format.
+const fileVersion uint8 = 1
+
+// maxFieldSize is the maximum allowed size of a single field (header, path or body) when
+// reading a capture file. It protects against corrupt files.
+const maxFieldSize = 256 * 1024 * 1024
+
+// Record holds a single HTTP request received by the trace-agent.
+type Record struct {
+	// Time specifies the time at which the request was received.
+	Time time.Time
+	// Method specifies the HTTP method of the request.
+	Method string
+	// Path specifies the request URI (path and query).
+	Path string
+	// Header holds the recorded headers of the request. See IsRecordedHeader.
+	Header http.Header
+	// Body holds the raw body of the request, as it was received.
+	Body []byte
+}
+
+// IsRecordedHeader reports whether the HTTP header key is stored in capture files. Only the
+// headers which affect payload decoding and tagging are kept (content type and encoding, and
+// the Datadog headers set by tracers); credentials are never recorded.
+func IsRecordedHeader(key string) bool {
+	key = http.CanonicalHeaderKey(key)
+	switch key {
+	case "Content-Type", "Content-Encoding", "User-Agent":
+		return true
+	}
+	return strings.HasPrefix(key, "Datadog-") || strings.HasPrefix(key, "X-Datadog-")
+}
+
+// Writer writes records into a capture file.
+type Writer struct {
+	gz  *gzip.Writer
+	buf *bytes.Buffer
+	n   int64 // number of uncompressed bytes written
+}
+
+// NewWriter returns a new Writer writing a capture file into w. The file header is written
+// immediately. Close must be called to flush all the data.
+func NewWriter(w io.Writer) (*Writer, error) {
+	cw := &Writer{
+		gz:  gzip.NewWriter(w),
+		buf: new(bytes.Buffer),
+	}
+	cw.buf.Write(fileHeader)
+	cw.buf.WriteByte(fileVersion)
+	if err := cw.flushBuffer(); err != nil {
+		return nil, err
+	}
+	return cw, nil
+}
+
+// Write writes rec into the capture file.
+func (w *Writer) Write(rec *Record) error {
+	w.putUvarint(uint64(rec.Time.UnixNano()))
+	w.putString(rec.Method)
+	w.putString(rec.Path)
+	var n int
+	for _, vs := range rec.Header {
+		n += len(vs)
+	}
+	w.putUvarint(uint64(n))
+	for k, vs := range rec.Header {
+		for _, v := range vs {
+			w.putString(k)
+			w.putString(v)
+		}
+	}
+	w.putUvarint(uint64(len(rec.Body)))
+	w.buf.Write(rec.Body)
+	return w.flushBuffer()
+}
+
+// Size returns the number of uncompressed bytes written so far.
+func (w *Writer) Size() int64 { return w.n }
+
+// Flush flushes any pending compressed data to the underlying writer.
+func (w *Writer) Flush() error { return w.gz.Flush() }
+
+// Close flushes all data and closes the stream. It does not close the underlying writer.
+func (w *Writer) Close() error { return w.gz.Close() }
+
+func (w *Writer) putUvarint(v uint64) {
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(tmp[:], v)
+	w.buf.Write(tmp[:n])
+}
+
+func (w *Writer) putString(s string) {
+	w.putUvarint(uint64(len(s)))
+	w.buf.WriteString(s)
+}
+
+func (w *Writer) flushBuffer() error {
+	n, err := w.gz.Write(w.buf.Bytes())
+	w.n += int64(n)
+	w.buf.Reset()
+	return err
+}
+
+// ErrInvalidFile is returned when reading a file which is not a trace-agent capture file.
+var ErrInvalidFile = errors.New("not a trace-agent capture file")
+
+// Reader reads records from a capture file.
+type Reader struct {
+	r *bufio.Reader
+}
+
+// NewReader returns a new Reader reading the capture file from r. It returns ErrInvalidFile
+// if r does not contain a capture file.
+func NewReader(r io.Reader) (*Reader, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, ErrInvalidFile
+	}
+	br := bufio.NewReader(gz)
+	hdr := make([]byte, len(fileHeader)+1)
+	if _, err := io.ReadFull(br, hdr); err != nil || !bytes.Equal(hdr[:len(fileHeader)], fileHeader) {
+		return nil, ErrInvalidFile
+	}
+	if v := hdr[len(fileHeader)]; v > fileVersion {
+		return nil, fmt.Errorf("unsupported capture file version %d", v)
+	}
+	return &Reader{r: br}, nil
+}
+
+// Next returns the next record in the file. It returns io.EOF when there are no more records.
+// A record which was only partially written (for example, because the agent was killed while
+// recording) is treated as the end of the file.
+func (r *Reader) Next() (*Record, error) {
+	ts, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		return nil, eof(err)
+	}
+	rec := &Record{Time: time.Unix(0, int64(ts)), Header: make(http.Header)}
+	if rec.Method, err = r.string(); err != nil {
+		return nil, eof(err)
+	}
+	if rec.Path, err = r.string(); err != nil {
+		return nil, eof(err)
+	}
+	n, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		return nil, eof(err)
+	}
+	for i := uint64(0); i < n; i++ {
+		k, err := r.string()
+		if err != nil {
+			return nil, eof(err)
+		}
+		v, err := r.string()
+		if err != nil {
+			return nil, eof(err)
+		}
+		rec.Header.Add(k, v)
+	}
+	if rec.Body, err = r.bytes(); err != nil {
+		return nil, eof(err)
+	}
+	return rec, nil
+}
+
+func (r *Reader) bytes() ([]byte, error) {
+	n, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		return nil, err
+	}
+	if n > maxFieldSize {
+		return nil, fmt.Errorf("field too large (%d bytes), capture file may be corrupt", n)
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(r.r, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func (r *Reader) string() (string, error) {
+	b, err := r.bytes()
+	return string(b), err
+}
+
+// eof converts errors caused by a truncated file into io.EOF.
+func eof(err error) error {
+	if err == io.ErrUnexpectedEOF {
+		return io.EOF
+	}
+	return err
+}
@@ -0,0 +1,106 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package replay
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testRecords = []*Record{
+	{
+		Time:   time.Unix(100, 5),
+		Method: "PUT",
+		Path:   "/v0.4/traces",
+		Header: http.Header{
+			"Content-Type":                []string{"application/msgpack"},
+			"Datadog-Meta-Lang":           []string{"go"},
+			"Datadog-Meta-Tracer-Version": []string{"1.2.3"},
+			"X-Datadog-Trace-Count":       []string{"2"},
+		},
+		Body: []byte{0x92, 0x90, 0x90},
+	},
+	{
+		Time:   time.Unix(101, 0),
+		Method: "POST",
+		Path:   "/v0.6/stats?x=1",
+		Header: http.Header{},
+		Body:   []byte{},
+	},
+}
+
+func writeTestFile(t *testing.T, records []*Record) []byte {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf)
+	assert.NoError(t, err)
+	for _, rec := range records {
+		assert.NoError(t, w.Write(rec))
+	}
+	assert.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestFileRoundTrip(t *testing.T) {
+	assert := assert.New(t)
+	r, err := NewReader(bytes.NewReader(writeTestFile(t, testRecords)))
+	assert.NoError(err)
+	for _, want := range testRecords {
+		got, err := r.Next()
+		assert.NoError(err)
+		assert.True(want.Time.Equal(got.Time))
+		assert.Equal(want.Method, got.Method)
+		assert.Equal(want.Path, got.Path)
+		assert.Equal(want.Header, got.Header)
+		assert.Equal(want.Body, got.Body)
+	}
+	_, err = r.Next()
+	assert.Equal(io.EOF, err)
+}
+
+func TestFileTruncated(t *testing.T) {
+	assert := assert.New(t)
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf)
+	assert.NoError(err)
+	assert.NoError(w.Write(testRecords[0]))
+	assert.NoError(w.Flush())
+	n := buf.Len()
+	assert.NoError(w.Write(testRecords[1]))
+	assert.NoError(w.Flush())
+
+	// the second record is only partially written
+	r, err := NewReader(bytes.NewReader(buf.Bytes()[:n+3]))
+	assert.NoError(err)
+	_, err = r.Next()
+	assert.NoError(err)
+	_, err = r.Next()
+	assert.Equal(io.EOF, err)
+}
+
+func TestFileInvalid(t *testing.T) {
+	_, err := NewReader(strings.NewReader("not a capture"))
+	assert.Equal(t, ErrInvalidFile, err)
+}
+
+func TestIsRecordedHeader(t *testing.T) {
+	for key, want := range map[string]bool{
+		"content-type":          true,
+		"Datadog-Meta-Lang":     true,
+		"X-Datadog-Trace-Count": true,
+		"Datadog-Container-Id":  true,
+		"DD-Api-Key":            false,
+		"Authorization":         false,
+		"Cookie":                false,
+	} {
+		assert.Equal(t, want, IsRecordedHeader(key), key)
+	}
+}
@@ -0,0 +1,149 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package replay
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/DataDog/datadog-agent/pkg/trace/metrics"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+)
+
+// Recorder asynchronously records HTTP requests into a capture file. Recording never blocks
+// the caller: records are dropped when the recorder can not keep up, and once the file has
+// reached its maximum size.
+type Recorder struct {
+	in       chan *Record
+	f        *os.File
+	w        *Writer
+	maxBytes int64
+	exit     chan struct{}
+
+	recorded, dropped int64
+}
+
+// NewRecorder creates (or truncates) the capture file at path and returns a Recorder writing
+// to it. No more than maxBytes (uncompressed) are written to the file; a value of zero or less
+// means no limit.
+func NewRecorder(path string, maxBytes int64) (*Recorder, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	w, err := NewWriter(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	r := &Recorder{
+		in:       make(chan *Record, 100),
+		f:        f,
+		w:        w,
+		maxBytes: maxBytes,
+		exit:     make(chan struct{}),
+	}
+	go r.run()
+	log.Infof("Recording incoming trace payloads to %s", path)
+	return r, nil
+}
+
+// Record schedules rec to be written into the capture file.
+func (r *Recorder) Record(rec *Record) {
+	select {
+	case r.in <- rec:
+	default:
+		atomic.AddInt64(&r.dropped, 1)
+	}
+}
+
+// Wrap returns an http.Handler which records all requests before passing them on to h. The
+// body is read into memory up to maxBytes; larger requests are passed on without being recorded.
+func (r *Recorder) Wrap(h http.Handler, maxBytes int64) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, err := ioutil.ReadAll(io.LimitReader(req.Body, maxBytes+1))
+		rest := req.Body
+		req.Body = readCloser{io.MultiReader(bytes.NewReader(body), rest), rest}
+		if err == nil && int64(len(body)) <= maxBytes {
+			rec := &Record{
+				Time:   time.Now(),
+				Method: req.Method,
+				Path:   req.URL.RequestURI(),
+				Header: make(http.Header),
+				Body:   body,
+			}
+			for k, vs := range req.Header {
+				if IsRecordedHeader(k) {
+					rec.Header[k] = vs
+				}
+			}
+			r.Record(rec)
+		}
+		h.ServeHTTP(w, req)
+	})
+}
+
+// Stop stops the recorder, writing all pending records and closing the capture file.
+func (r *Recorder) Stop() error {
+	close(r.in)
+	<-r.exit
+	if err := r.w.Close(); err != nil {
+		r.f.Close()
+		return err
+	}
+	return r.f.Close()
+}
+
+func (r *Recorder) run() {
+	defer close(r.exit)
+	t := time.NewTicker(10 * time.Second)
+	defer t.Stop()
+	full := false
+	for {
+		select {
+		case rec, ok := <-r.in:
+			if !ok {
+				r.report()
+				return
+			}
+			if full {
+				atomic.AddInt64(&r.dropped, 1)
+				continue
+			}
+			if err := r.w.Write(rec); err != nil {
+				log.Errorf("Error writing to capture file, recording stopped: %v", err)
+				full = true
+				continue
+			}
+			atomic.AddInt64(&r.recorded, 1)
+			if r.maxBytes > 0 && r.w.Size() >= r.maxBytes {
+				log.Warnf("Capture file reached its maximum size (%d bytes), recording stopped.", r.maxBytes)
+				full = true
+			}
+		case <-t.C:
+			if err := r.w.Flush(); err != nil {
+				log.Errorf("Error flushing capture file: %v", err)
+			}
+			r.report()
+		}
+	}
+}
+
+func (r *Recorder) report() {
+	metrics.Count("datadog.trace_agent.receiver.capture.recorded", atomic.SwapInt64(&r.recorded, 0), nil, 1)
+	metrics.Count("datadog.trace_agent.receiver.capture.dropped", atomic.SwapInt64(&r.dropped, 0), nil, 1)
+}
+
+// readCloser combines a Reader and a Closer into an io.ReadCloser.
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
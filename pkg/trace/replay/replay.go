@@ -0,0 +1,110 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package replay
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Stats holds statistics about a replay.
+type Stats struct {
+	// Requests specifies the number of requests sent.
+	Requests int
+	// Errors specifies the number of requests which failed or which were answered
+	// with a non-2xx status code.
+	Errors int
+	// Bytes specifies the total size of the request bodies sent.
+	Bytes int64
+	// Duration specifies the time it took to replay the capture.
+	Duration time.Duration
+}
+
+// String implements fmt.Stringer.
+func (s Stats) String() string {
+	return fmt.Sprintf("%d requests (%d errors, %d bytes) replayed in %s", s.Requests, s.Errors, s.Bytes, s.Duration)
+}
+
+// Replayer sends the requests found in a capture file to a trace-agent receiver.
+type Replayer struct {
+	// Target specifies the base URL of the receiver, e.g. "http://localhost:8126".
+	Target string
+	// Speed specifies the replay speed relative to the recorded timings: 1 replays in real
+	// time, 2 twice as fast, etc. A value of zero or less sends requests as fast as possible.
+	Speed float64
+	// Client specifies the HTTP client to use. If nil, http.DefaultClient is used.
+	Client *http.Client
+}
+
+// Replay sends all the records from r, respecting the configured speed. It stops early when ctx
+// is cancelled. Failed requests are counted in the returned stats and do not stop the replay.
+func (rp *Replayer) Replay(ctx context.Context, r *Reader) (stats Stats, err error) {
+	var (
+		first  time.Time
+		start  = time.Now()
+		target = strings.TrimSuffix(rp.Target, "/")
+		client = rp.Client
+	)
+	if client == nil {
+		client = http.DefaultClient
+	}
+	defer func() { stats.Duration = time.Since(start) }()
+	for {
+		rec, err := r.Next()
+		if err == io.EOF {
+			return stats, nil
+		}
+		if err != nil {
+			return stats, err
+		}
+		if first.IsZero() {
+			first = rec.Time
+		}
+		if rp.Speed > 0 {
+			// wait until the record is due, relative to the start of the replay
+			due := start.Add(time.Duration(float64(rec.Time.Sub(first)) / rp.Speed))
+			select {
+			case <-time.After(time.Until(due)):
+			case <-ctx.Done():
+				return stats, ctx.Err()
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			return stats, err
+		}
+		stats.Requests++
+		stats.Bytes += int64(len(rec.Body))
+		if err := rp.send(ctx, client, target, rec); err != nil {
+			stats.Errors++
+		}
+	}
+}
+
+func (rp *Replayer) send(ctx context.Context, client *http.Client, target string, rec *Record) error {
+	req, err := http.NewRequestWithContext(ctx, rec.Method, target+rec.Path, bytes.NewReader(rec.Body))
+	if err != nil {
+		return err
+	}
+	for k, vs := range rec.Header {
+		req.Header[k] = vs
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	io.Copy(ioutil.Discard, resp.Body) //nolint:errcheck
+	resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("%s %s: %s", rec.Method, rec.Path, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package replay
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testTarget records the requests it receives.
+type testTarget struct {
+	mu       sync.Mutex
+	requests []*Record
+}
+
+func (tt *testTarget) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := ioutil.ReadAll(req.Body)
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+	tt.requests = append(tt.requests, &Record{
+		Time:   time.Now(),
+		Method: req.Method,
+		Path:   req.URL.RequestURI(),
+		Header: req.Header,
+		Body:   body,
+	})
+	if req.URL.Path == "/fail" {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	assert := assert.New(t)
+	path := filepath.Join(t.TempDir(), "capture.dog")
+	rec, err := NewRecorder(path, 0)
+	assert.NoError(err)
+
+	var original testTarget
+	srv := httptest.NewServer(rec.Wrap(&original, 4))
+	defer srv.Close()
+	for _, body := range []string{"one", "two", "this body is too large to be recorded"} {
+		req, _ := http.NewRequest("PUT", srv.URL+"/v0.4/traces?a=b", bytes.NewReader([]byte(body)))
+		req.Header.Set("Content-Type", "application/msgpack")
+		req.Header.Set("Datadog-Meta-Lang", "python")
+		req.Header.Set("DD-Api-Key", "secret")
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(err)
+		resp.Body.Close()
+	}
+	resp, err := http.Post(srv.URL+"/fail", "text/plain", bytes.NewReader(nil))
+	assert.NoError(err)
+	resp.Body.Close()
+	assert.NoError(rec.Stop())
+
+	// the wrapped handler always receives the full body
+	assert.Len(original.requests, 4)
+	assert.Equal("this body is too large to be recorded", string(original.requests[2].Body))
+
+	var replayed testTarget
+	dst := httptest.NewServer(&replayed)
+	defer dst.Close()
+	f, err := os.Open(path)
+	assert.NoError(err)
+	defer f.Close()
+	r, err := NewReader(f)
+	assert.NoError(err)
+	rp := &Replayer{Target: dst.URL + "/", Speed: 0}
+	stats, err := rp.Replay(context.Background(), r)
+	assert.NoError(err)
+	assert.Equal(3, stats.Requests)
+	assert.Equal(1, stats.Errors)
+	assert.EqualValues(6, stats.Bytes)
+
+	assert.Len(replayed.requests, 3)
+	first := replayed.requests[0]
+	assert.Equal("PUT", first.Method)
+	assert.Equal("/v0.4/traces?a=b", first.Path)
+	assert.Equal("one", string(first.Body))
+	assert.Equal("python", first.Header.Get("Datadog-Meta-Lang"))
+	assert.Equal("application/msgpack", first.Header.Get("Content-Type"))
+	assert.Empty(first.Header.Get("DD-Api-Key"))
+	assert.Equal("two", string(replayed.requests[1].Body))
+	assert.Equal("/fail", replayed.requests[2].Path)
+}
+
+func TestReplaySpeed(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Now()
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf)
+	assert.NoError(err)
+	for i := 0; i < 3; i++ {
+		assert.NoError(w.Write(&Record{Time: now.Add(time.Duration(i) * 100 * time.Millisecond), Method: "PUT", Path: "/v0.4/traces"}))
+	}
+	assert.NoError(w.Close())
+
+	var replayed testTarget
+	dst := httptest.NewServer(&replayed)
+	defer dst.Close()
+	r, err := NewReader(&buf)
+	assert.NoError(err)
+	rp := &Replayer{Target: dst.URL, Speed: 2}
+	stats, err := rp.Replay(context.Background(), r)
+	assert.NoError(err)
+	assert.Equal(3, stats.Requests)
+	// 200ms of recorded traffic replayed twice as fast
+	assert.True(stats.Duration >= 100*time.Millisecond, stats.Duration)
+	assert.True(stats.Duration < 200*time.Millisecond, stats.Duration)
+}
+
+func TestReplayCancel(t *testing.T) {
+	now := time.Now()
+	var buf bytes.Buffer
+	w, _ := NewWriter(&buf)
+	w.Write(&Record{Time: now, Method: "PUT", Path: "/"})
+	w.Write(&Record{Time: now.Add(time.Hour), Method: "PUT", Path: "/"})
+	w.Close()
+
+	dst := httptest.NewServer(&testTarget{})
+	defer dst.Close()
+	r, _ := NewReader(&buf)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	stats, err := (&Replayer{Target: dst.URL, Speed: 1}).Replay(ctx, r)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Equal(t, 1, stats.Requests)
+}
@@ -25,6 +25,12 @@ const (
 	MaxMetaValLen = 25000
 	// MaxMetricsKeyLen the maximum length of a metric name key
 	MaxMetricsKeyLen = MaxMetaKeyLen
+	// MaxSpanLinks the maximum number of links a span can have
+	MaxSpanLinks = 128
+	// MaxSpanEvents the maximum number of events a span can have
+	MaxSpanEvents = 128
+	// MaxSpanEventNameLen the maximum length of a span event name
+	MaxSpanEventNameLen = MaxNameLen
 )
 
 // TruncateResource truncates a span's resource to the maximum allowed length.
@@ -49,3 +55,27 @@ func TruncateUTF8(s string, limit int) string {
 	}
 	return s
 }
+
+// TruncateAttributes makes sure that the keys and values of the given span link or span
+// event attributes are within the same limits as the span's meta. It returns true if
+// the attributes were modified.
+func TruncateAttributes(attrs map[string]string) bool {
+	var truncated bool
+	for k, v := range attrs {
+		modified := false
+		if len(k) > MaxMetaKeyLen {
+			delete(attrs, k)
+			k = TruncateUTF8(k, MaxMetaKeyLen) + "..."
+			modified = true
+		}
+		if len(v) > MaxMetaValLen {
+			v = TruncateUTF8(v, MaxMetaValLen) + "..."
+			modified = true
+		}
+		if modified {
+			attrs[k] = v
+			truncated = true
+		}
+	}
+	return truncated
+}
@@ -0,0 +1,422 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package writer
+
+import (
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/hex"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/DataDog/datadog-agent/pkg/trace/config"
+	"github.com/DataDog/datadog-agent/pkg/trace/logutil"
+	"github.com/DataDog/datadog-agent/pkg/trace/metrics"
+	"github.com/DataDog/datadog-agent/pkg/trace/metrics/timing"
+	"github.com/DataDog/datadog-agent/pkg/trace/osutil"
+	"github.com/DataDog/datadog-agent/pkg/trace/pb"
+	"github.com/DataDog/datadog-agent/pkg/trace/pb/otlppb"
+	httputils "github.com/DataDog/datadog-agent/pkg/util/http"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+
+	"github.com/gogo/protobuf/proto"
+	semconv "go.opentelemetry.io/collector/model/semconv/v1.5.0"
+)
+
+// otlpLibraryName is the instrumentation library name reported for all exported spans.
+const otlpLibraryName = "datadog"
+
+// OTLPTraceWriter buffers sampled traces and exports them to an OpenTelemetry collector
+// using the OTLP/HTTP protobuf encoding. Traces are exported after normalization, obfuscation
+// and sampling, exactly as they are sent to the Datadog intake by the TraceWriter.
+type OTLPTraceWriter struct {
+	// In receives sampled spans to be exported by the writer.
+	// Channel should only be received from when testing.
+	In chan *SampledChunks
+
+	hostname string
+	env      string
+	sender   *sender
+	headers  map[string]string
+	stop     chan struct{}
+	wg       sync.WaitGroup // waits for gzippers
+	tick     time.Duration  // flush frequency
+
+	tracerPayloads []*pb.TracerPayload // tracer payloads buffered
+	bufferedSize   int                 // estimated buffer size
+
+	// stats
+	traces, spans, payloads, bytes, retries, errors int64
+
+	easylog *logutil.ThrottledLogger
+}
+
+// NewOTLPTraceWriter returns a new OTLPTraceWriter exporting to the endpoint found in the
+// given agent configuration. It will accept incoming spans via the In channel.
+func NewOTLPTraceWriter(cfg *config.AgentConfig) *OTLPTraceWriter {
+	w := &OTLPTraceWriter{
+		In:       make(chan *SampledChunks, 1000),
+		hostname: cfg.Hostname,
+		env:      cfg.DefaultEnv,
+		stop:     make(chan struct{}),
+		tick:     5 * time.Second,
+		headers: map[string]string{
+			"Content-Type":     "application/x-protobuf",
+			"Content-Encoding": "gzip",
+		},
+		easylog: logutil.NewThrottled(5, 10*time.Second), // no more than 5 messages every 10 seconds
+	}
+	for k, v := range cfg.OTLPExporter.Headers {
+		w.headers[k] = v
+	}
+	if s := cfg.TraceWriter.FlushPeriodSeconds; s != 0 {
+		w.tick = time.Duration(s*1000) * time.Millisecond
+	}
+	url, err := url.Parse(cfg.OTLPExporter.Endpoint)
+	if err != nil {
+		osutil.Exitf("Invalid OTLP exporter endpoint: %q", cfg.OTLPExporter.Endpoint)
+	}
+	w.sender = newSender(&senderConfig{
+		client:    httputils.NewResetClient(cfg.ConnectionResetInterval, cfg.NewHTTPClient),
+		maxConns:  20,
+		maxQueued: 100,
+		url:       url,
+		recorder:  w,
+	})
+	log.Infof("Exporting sampled traces to OTLP endpoint %s", url.Redacted())
+	return w
+}
+
+// Stop stops the OTLPTraceWriter and attempts to flush whatever is left in the sender's buffer.
+func (w *OTLPTraceWriter) Stop() {
+	log.Debug("Exiting OTLP trace writer. Trying to flush whatever is left...")
+	w.stop <- struct{}{}
+	<-w.stop
+	w.sender.Stop()
+}
+
+// Run starts the OTLPTraceWriter.
+func (w *OTLPTraceWriter) Run() {
+	t := time.NewTicker(w.tick)
+	defer t.Stop()
+	defer close(w.stop)
+	for {
+		select {
+		case pkg := <-w.In:
+			w.addSpans(pkg)
+		case <-w.stop:
+			w.drainAndFlush()
+			return
+		case <-t.C:
+			w.report()
+			w.flush()
+		}
+	}
+}
+
+func (w *OTLPTraceWriter) addSpans(pkg *SampledChunks) {
+	atomic.AddInt64(&w.traces, int64(len(pkg.TracerPayload.Chunks)))
+	if pkg.Size+w.bufferedSize > MaxPayloadSize {
+		// reached maximum allowed buffered size
+		w.flush()
+	}
+	if len(pkg.TracerPayload.Chunks) > 0 {
+		w.tracerPayloads = append(w.tracerPayloads, pkg.TracerPayload)
+	}
+	w.bufferedSize += pkg.Size
+}
+
+func (w *OTLPTraceWriter) drainAndFlush() {
+outer:
+	for {
+		select {
+		case pkg := <-w.In:
+			w.addSpans(pkg)
+		default:
+			break outer
+		}
+	}
+	w.flush()
+	// Wait for encoding/compression to complete on each payload,
+	// and submission to the sender
+	w.wg.Wait()
+}
+
+func (w *OTLPTraceWriter) flush() {
+	if len(w.tracerPayloads) == 0 {
+		// nothing to do
+		return
+	}
+	defer timing.Since("datadog.trace_agent.otlp_writer.encode_ms", time.Now())
+
+	req := tracerPayloadsToOTLP(w.tracerPayloads, w.hostname, w.env)
+	w.tracerPayloads = w.tracerPayloads[:0]
+	w.bufferedSize = 0
+	for _, rspans := range req.ResourceSpans {
+		for _, lspans := range rspans.InstrumentationLibrarySpans {
+			atomic.AddInt64(&w.spans, int64(len(lspans.Spans)))
+		}
+	}
+	b, err := proto.Marshal(req)
+	if err != nil {
+		log.Errorf("Failed to serialize OTLP payload, data dropped: %v", err)
+		return
+	}
+
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		p := newPayload(w.headers)
+		gzipw, err := gzip.NewWriterLevel(p.body, gzip.BestSpeed)
+		if err != nil {
+			// it will never happen, unless an invalid compression is chosen;
+			// we know gzip.BestSpeed is valid.
+			log.Errorf("gzip.NewWriterLevel: %d", err)
+			return
+		}
+		if _, err := gzipw.Write(b); err != nil {
+			log.Errorf("Error gzipping OTLP trace payload: %v", err)
+		}
+		if err := gzipw.Close(); err != nil {
+			log.Errorf("Error closing gzip stream when writing OTLP trace payload: %v", err)
+		}
+		w.sender.Push(p)
+	}()
+}
+
+func (w *OTLPTraceWriter) report() {
+	metrics.Count("datadog.trace_agent.otlp_writer.traces", atomic.SwapInt64(&w.traces, 0), nil, 1)
+	metrics.Count("datadog.trace_agent.otlp_writer.spans", atomic.SwapInt64(&w.spans, 0), nil, 1)
+	metrics.Count("datadog.trace_agent.otlp_writer.payloads", atomic.SwapInt64(&w.payloads, 0), nil, 1)
+	metrics.Count("datadog.trace_agent.otlp_writer.bytes", atomic.SwapInt64(&w.bytes, 0), nil, 1)
+	metrics.Count("datadog.trace_agent.otlp_writer.retries", atomic.SwapInt64(&w.retries, 0), nil, 1)
+	metrics.Count("datadog.trace_agent.otlp_writer.errors", atomic.SwapInt64(&w.errors, 0), nil, 1)
+}
+
+var _ eventRecorder = (*OTLPTraceWriter)(nil)
+
+// recordEvent implements eventRecorder.
+func (w *OTLPTraceWriter) recordEvent(t eventType, data *eventData) {
+	switch t {
+	case eventTypeRetry:
+		log.Debugf("Retrying to export OTLP trace payload; error: %s", data.err)
+		atomic.AddInt64(&w.retries, 1)
+
+	case eventTypeSent:
+		log.Debugf("Exported traces to the OTLP endpoint; time: %s, bytes: %d", data.duration, data.bytes)
+		atomic.AddInt64(&w.bytes, int64(data.bytes))
+		atomic.AddInt64(&w.payloads, 1)
+
+	case eventTypeRejected:
+		w.easylog.Warn("OTLP trace payload rejected by collector: %v", data.err)
+		atomic.AddInt64(&w.errors, 1)
+
+	case eventTypeDropped:
+		w.easylog.Warn("OTLP trace writer queue full. Payload dropped (%.2fKB).", float64(data.bytes)/1024)
+		metrics.Count("datadog.trace_agent.otlp_writer.dropped", 1, nil, 1)
+	}
+}
+
+// traceIDTags lists the span tags which may hold the original 128-bit trace ID of spans
+// received from third-party tracers, as lower-hex strings.
+var traceIDTags = []string{"zipkin.trace_id", "jaeger.trace_id"}
+
+// tracerPayloadsToOTLP converts the given tracer payloads into an OTLP export request. Spans are
+// grouped into one ResourceSpans per tracer payload and service. Chunks which were only kept for
+// their analyzed spans (DroppedTrace) are skipped. The hostname and env are used as defaults when
+// not specified by the payloads.
+func tracerPayloadsToOTLP(payloads []*pb.TracerPayload, hostname, env string) *otlppb.ExportTraceServiceRequest {
+	req := &otlppb.ExportTraceServiceRequest{}
+	for _, tp := range payloads {
+		byService := make(map[string][]*otlppb.Span)
+		envs := make(map[string]string)
+		for _, chunk := range tp.Chunks {
+			if chunk.DroppedTrace {
+				continue
+			}
+			for _, span := range chunk.Spans {
+				byService[span.Service] = append(byService[span.Service], spanToOTLP(span, chunk))
+				if v := span.Meta["env"]; v != "" {
+					envs[span.Service] = v
+				}
+			}
+		}
+		services := make([]string, 0, len(byService))
+		for svc := range byService {
+			services = append(services, svc)
+		}
+		sort.Strings(services)
+		for _, svc := range services {
+			rattr := map[string]string{
+				string(semconv.AttributeServiceName):           svc,
+				string(semconv.AttributeDeploymentEnvironment): firstNonEmpty(envs[svc], tp.Env, env),
+				string(semconv.AttributeHostName):              firstNonEmpty(tp.Hostname, hostname),
+				string(semconv.AttributeTelemetrySDKLanguage):  tp.LanguageName,
+				string(semconv.AttributeServiceVersion):        tp.AppVersion,
+				string(semconv.AttributeContainerID):           tp.ContainerID,
+			}
+			req.ResourceSpans = append(req.ResourceSpans, &otlppb.ResourceSpans{
+				Resource: &otlppb.Resource{Attributes: stringAttributes(rattr)},
+				InstrumentationLibrarySpans: []*otlppb.InstrumentationLibrarySpans{{
+					InstrumentationLibrary: &otlppb.InstrumentationLibrary{
+						Name:    otlpLibraryName,
+						Version: tp.TracerVersion,
+					},
+					Spans: byService[svc],
+				}},
+			})
+		}
+	}
+	return req
+}
+
+// spanToOTLP converts the Datadog span s, part of chunk, into an OTLP span.
+func spanToOTLP(s *pb.Span, chunk *pb.TraceChunk) *otlppb.Span {
+	out := &otlppb.Span{
+		TraceId:           otlpTraceID(s),
+		SpanId:            otlpSpanID(s.SpanID),
+		Name:              s.Resource,
+		Kind:              otlpSpanKind(s),
+		StartTimeUnixNano: uint64(s.Start),
+		EndTimeUnixNano:   uint64(s.Start + s.Duration),
+		Attributes:        make([]*otlppb.KeyValue, 0, len(s.Meta)+len(s.Metrics)+4),
+		Status:            &otlppb.Status{},
+	}
+	if out.Name == "" {
+		out.Name = s.Name
+	}
+	if s.ParentID != 0 {
+		out.ParentSpanId = otlpSpanID(s.ParentID)
+	}
+	if s.Error != 0 {
+		out.Status.Code = otlppb.Status_STATUS_CODE_ERROR
+		out.Status.Message = s.Meta["error.msg"]
+	}
+	meta := map[string]string{
+		"operation.name": s.Name,
+		"resource.name":  s.Resource,
+		"span.type":      s.Type,
+	}
+	if chunk.Origin != "" {
+		meta["_dd.origin"] = chunk.Origin
+	}
+	for k, v := range s.Meta {
+		meta[k] = v
+	}
+	out.Attributes = append(out.Attributes, stringAttributes(meta)...)
+	keys := make([]string, 0, len(s.Metrics))
+	for k := range s.Metrics {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		out.Attributes = append(out.Attributes, &otlppb.KeyValue{
+			Key:   k,
+			Value: &otlppb.AnyValue{Value: &otlppb.AnyValue_DoubleValue{DoubleValue: s.Metrics[k]}},
+		})
+	}
+	out.Attributes = append(out.Attributes, &otlppb.KeyValue{
+		Key:   "sampling.priority",
+		Value: &otlppb.AnyValue{Value: &otlppb.AnyValue_IntValue{IntValue: int64(chunk.Priority)}},
+	})
+	for _, e := range s.SpanEvents {
+		out.Events = append(out.Events, &otlppb.Span_Event{
+			TimeUnixNano: e.TimeUnixNano,
+			Name:         e.Name,
+			Attributes:   stringAttributes(e.Attributes),
+		})
+	}
+	for _, l := range s.SpanLinks {
+		traceID := make([]byte, 16)
+		binary.BigEndian.PutUint64(traceID[:8], l.TraceIDHigh)
+		binary.BigEndian.PutUint64(traceID[8:], l.TraceID)
+		out.Links = append(out.Links, &otlppb.Span_Link{
+			TraceId:                traceID,
+			SpanId:                 otlpSpanID(l.SpanID),
+			TraceState:             l.Tracestate,
+			Attributes:             stringAttributes(l.Attributes),
+			DroppedAttributesCount: l.DroppedAttributesCount,
+		})
+	}
+	return out
+}
+
+// otlpTraceID returns the 128-bit trace ID of s. The original ID is restored for spans received
+// from third-party tracers; otherwise the upper 64 bits are zero.
+func otlpTraceID(s *pb.Span) []byte {
+	for _, k := range traceIDTags {
+		if v, ok := s.Meta[k]; ok && len(v) == 32 {
+			if id, err := hex.DecodeString(v); err == nil && binary.BigEndian.Uint64(id[8:]) == s.TraceID {
+				return id
+			}
+		}
+	}
+	id := make([]byte, 16)
+	binary.BigEndian.PutUint64(id[8:], s.TraceID)
+	return id
+}
+
+func otlpSpanID(id uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, id)
+	return b
+}
+
+// otlpSpanKind returns the OTLP span kind of s, based on its "span.kind" tag or its type.
+func otlpSpanKind(s *pb.Span) otlppb.Span_SpanKind {
+	switch strings.ToLower(s.Meta["span.kind"]) {
+	case "server":
+		return otlppb.Span_SPAN_KIND_SERVER
+	case "client":
+		return otlppb.Span_SPAN_KIND_CLIENT
+	case "producer":
+		return otlppb.Span_SPAN_KIND_PRODUCER
+	case "consumer":
+		return otlppb.Span_SPAN_KIND_CONSUMER
+	case "internal":
+		return otlppb.Span_SPAN_KIND_INTERNAL
+	}
+	switch s.Type {
+	case "web":
+		return otlppb.Span_SPAN_KIND_SERVER
+	case "http", "db", "sql", "cache", "redis", "memcached", "cassandra", "elasticsearch", "mongodb":
+		return otlppb.Span_SPAN_KIND_CLIENT
+	}
+	return otlppb.Span_SPAN_KIND_INTERNAL
+}
+
+// stringAttributes converts m into a list of OTLP string attributes, sorted by key. Empty
+// values are omitted.
+func stringAttributes(m map[string]string) []*otlppb.KeyValue {
+	keys := make([]string, 0, len(m))
+	for k, v := range m {
+		if v != "" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	attrs := make([]*otlppb.KeyValue, 0, len(keys))
+	for _, k := range keys {
+		attrs = append(attrs, &otlppb.KeyValue{
+			Key:   k,
+			Value: &otlppb.AnyValue{Value: &otlppb.AnyValue_StringValue{StringValue: m[k]}},
+		})
+	}
+	return attrs
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
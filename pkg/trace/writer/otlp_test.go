@@ -0,0 +1,174 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package writer
+
+import (
+	"compress/gzip"
+	"encoding/hex"
+	"io/ioutil"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/trace/config"
+	"github.com/DataDog/datadog-agent/pkg/trace/pb"
+	"github.com/DataDog/datadog-agent/pkg/trace/pb/otlppb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOTLPTraceWriter(t *testing.T) {
+	assert := assert.New(t)
+	srv := newTestServer()
+	cfg := &config.AgentConfig{
+		Hostname:   testHostname,
+		DefaultEnv: testEnv,
+		Endpoints: []*config.Endpoint{{
+			APIKey: "123",
+			Host:   srv.URL,
+		}},
+		TraceWriter: &config.WriterConfig{ConnectionLimit: 200, QueueSize: 40},
+		OTLPExporter: &config.OTLPExporter{
+			Endpoint: srv.URL + "/v1/traces",
+			Headers:  map[string]string{"Authorization": "Bearer abc"},
+		},
+	}
+	testSpans := []*SampledChunks{
+		randomSampledSpans(20, 0),
+		randomSampledSpans(10, 0),
+	}
+	w := NewOTLPTraceWriter(cfg)
+	go w.Run()
+	for _, ss := range testSpans {
+		w.In <- ss
+	}
+	w.Stop()
+
+	assert.Equal(1, srv.Accepted())
+	p := srv.Payloads()[0]
+	assert.Equal("Bearer abc", p.headers["Authorization"])
+	assert.Equal("application/x-protobuf", p.headers["Content-Type"])
+	assert.NotContains(p.headers, headerAPIKey)
+
+	gzipr, err := gzip.NewReader(p.body)
+	assert.NoError(err)
+	slurp, err := ioutil.ReadAll(gzipr)
+	assert.NoError(err)
+	var req otlppb.ExportTraceServiceRequest
+	assert.NoError(proto.Unmarshal(slurp, &req))
+	var n int
+	for _, rspans := range req.ResourceSpans {
+		for _, lspans := range rspans.InstrumentationLibrarySpans {
+			n += len(lspans.Spans)
+		}
+	}
+	assert.Equal(30, n)
+}
+
+func TestTracerPayloadsToOTLP(t *testing.T) {
+	assert := assert.New(t)
+	req := tracerPayloadsToOTLP([]*pb.TracerPayload{{
+		LanguageName:  "go",
+		TracerVersion: "1.2.3",
+		ContainerID:   "cid",
+		Chunks: []*pb.TraceChunk{
+			{
+				Priority: 2,
+				Spans: []*pb.Span{
+					{
+						Service:  "web",
+						Name:     "http.request",
+						Resource: "GET /users",
+						TraceID:  0x1234,
+						SpanID:   0x10,
+						Start:    100,
+						Duration: 50,
+						Type:     "web",
+						Error:    1,
+						Meta: map[string]string{
+							"env":             "prod",
+							"error.msg":       "boom",
+							"zipkin.trace_id": "00000000000000010000000000001234",
+						},
+						Metrics: map[string]float64{"http.status_code": 500},
+					},
+					{
+						Service:  "db",
+						Name:     "postgres.query",
+						TraceID:  0x1234,
+						SpanID:   0x11,
+						ParentID: 0x10,
+						Type:     "sql",
+						SpanLinks: []*pb.SpanLink{
+							{TraceID: 0x42, TraceIDHigh: 0x1, SpanID: 0x43, Tracestate: "dd=s:1", Attributes: map[string]string{"link.kind": "follows_from"}, DroppedAttributesCount: 1},
+						},
+						SpanEvents: []*pb.SpanEvent{
+							{TimeUnixNano: 120, Name: "retry", Attributes: map[string]string{"attempt": "2"}},
+						},
+					},
+				},
+			},
+			{
+				DroppedTrace: true,
+				Spans:        []*pb.Span{{Service: "web", TraceID: 0x99, SpanID: 0x99}},
+			},
+		},
+	}}, "host1", "staging")
+
+	assert.Len(req.ResourceSpans, 2)
+	db, web := req.ResourceSpans[0], req.ResourceSpans[1]
+	assert.Equal(stringAttributes(map[string]string{
+		"service.name":           "db",
+		"deployment.environment": "staging",
+		"host.name":              "host1",
+		"telemetry.sdk.language": "go",
+		"container.id":           "cid",
+	}), db.Resource.Attributes)
+	assert.Equal("prod", attributeValue(web.Resource.Attributes, "deployment.environment").GetStringValue())
+	assert.Equal("1.2.3", web.InstrumentationLibrarySpans[0].InstrumentationLibrary.Version)
+
+	assert.Len(web.InstrumentationLibrarySpans[0].Spans, 1)
+	span := web.InstrumentationLibrarySpans[0].Spans[0]
+	id, _ := hex.DecodeString("00000000000000010000000000001234")
+	assert.Equal(id, span.TraceId)
+	assert.Equal([]byte{0, 0, 0, 0, 0, 0, 0, 0x10}, span.SpanId)
+	assert.Nil(span.ParentSpanId)
+	assert.Equal("GET /users", span.Name)
+	assert.Equal(otlppb.Span_SPAN_KIND_SERVER, span.Kind)
+	assert.Equal(uint64(100), span.StartTimeUnixNano)
+	assert.Equal(uint64(150), span.EndTimeUnixNano)
+	assert.Equal(otlppb.Status_STATUS_CODE_ERROR, span.Status.Code)
+	assert.Equal("boom", span.Status.Message)
+	assert.Equal("http.request", attributeValue(span.Attributes, "operation.name").GetStringValue())
+	assert.Equal(float64(500), attributeValue(span.Attributes, "http.status_code").GetDoubleValue())
+	assert.Equal(int64(2), attributeValue(span.Attributes, "sampling.priority").GetIntValue())
+
+	span = db.InstrumentationLibrarySpans[0].Spans[0]
+	assert.Equal([]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x12, 0x34}, span.TraceId)
+	assert.Equal([]byte{0, 0, 0, 0, 0, 0, 0, 0x10}, span.ParentSpanId)
+	assert.Equal("postgres.query", span.Name)
+	assert.Equal(otlppb.Span_SPAN_KIND_CLIENT, span.Kind)
+	assert.Equal(otlppb.Status_STATUS_CODE_UNSET, span.Status.Code)
+	assert.Equal([]*otlppb.Span_Link{{
+		TraceId:                []byte{0, 0, 0, 0, 0, 0, 0, 0x1, 0, 0, 0, 0, 0, 0, 0, 0x42},
+		SpanId:                 []byte{0, 0, 0, 0, 0, 0, 0, 0x43},
+		TraceState:             "dd=s:1",
+		Attributes:             stringAttributes(map[string]string{"link.kind": "follows_from"}),
+		DroppedAttributesCount: 1,
+	}}, span.Links)
+	assert.Equal([]*otlppb.Span_Event{{
+		TimeUnixNano: 120,
+		Name:         "retry",
+		Attributes:   stringAttributes(map[string]string{"attempt": "2"}),
+	}}, span.Events)
+}
+
+func attributeValue(attrs []*otlppb.KeyValue, key string) *otlppb.AnyValue {
+	for _, kv := range attrs {
+		if kv.Key == key {
+			return kv.Value
+		}
+	}
+	return nil
+}
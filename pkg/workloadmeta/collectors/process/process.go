@@ -0,0 +1,292 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// +build linux
+
+package process
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DataDog/datadog-agent/pkg/config"
+	"github.com/DataDog/datadog-agent/pkg/errors"
+	"github.com/DataDog/datadog-agent/pkg/process/procutil"
+	"github.com/DataDog/datadog-agent/pkg/process/util"
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+	"github.com/DataDog/datadog-agent/pkg/workloadmeta"
+)
+
+const (
+	collectorID   = "process"
+	componentName = "workloadmeta-process"
+)
+
+// selector is a compiled config.ProcessSelector
+type selector struct {
+	name        string
+	processName string
+	cmdline     *regexp.Regexp
+	ports       map[int]struct{}
+	systemdUnit string
+}
+
+type collector struct {
+	store         workloadmeta.Store
+	procRoot      string
+	selectors     []selector
+	listProcesses func() (map[int32]*procutil.Process, error)
+	seen          map[workloadmeta.EntityID]struct{}
+
+	// sockets holds the listening sockets by inode, read once per pull
+	sockets map[string]socket
+}
+
+func init() {
+	workloadmeta.RegisterCollector(collectorID, func() workloadmeta.Collector {
+		return &collector{
+			seen: make(map[workloadmeta.EntityID]struct{}),
+		}
+	})
+}
+
+func (c *collector) Start(_ context.Context, store workloadmeta.Store) error {
+	if !config.Datadog.GetBool("workloadmeta_process_collector.enabled") {
+		return errors.NewDisabled(componentName, "process collection is disabled")
+	}
+
+	var selectorsConfig []config.ProcessSelector
+	if err := config.Datadog.UnmarshalKey("workloadmeta_process_collector.selectors", &selectorsConfig); err != nil {
+		return fmt.Errorf("cannot parse workloadmeta_process_collector.selectors: %s", err)
+	}
+
+	c.selectors = compileSelectors(selectorsConfig)
+	if len(c.selectors) == 0 {
+		return errors.NewDisabled(componentName, "no valid process selector is configured")
+	}
+
+	probe := procutil.NewProcessProbe()
+
+	c.store = store
+	c.procRoot = util.HostProc()
+	c.listProcesses = func() (map[int32]*procutil.Process, error) {
+		return probe.ProcessesByPID(time.Now(), false)
+	}
+
+	return nil
+}
+
+func (c *collector) Pull(_ context.Context) error {
+	procs, err := c.listProcesses()
+	if err != nil {
+		return err
+	}
+
+	processes := c.matchProcesses(procs)
+
+	events := make([]workloadmeta.CollectorEvent, 0, len(processes))
+	seen := make(map[workloadmeta.EntityID]struct{}, len(processes))
+	for _, process := range processes {
+		seen[process.EntityID] = struct{}{}
+		events = append(events, workloadmeta.CollectorEvent{
+			Type:   workloadmeta.EventTypeSet,
+			Source: workloadmeta.SourceProcess,
+			Entity: process,
+		})
+	}
+
+	for id := range c.seen {
+		if _, found := seen[id]; found {
+			continue
+		}
+
+		events = append(events, workloadmeta.CollectorEvent{
+			Type:   workloadmeta.EventTypeUnset,
+			Source: workloadmeta.SourceProcess,
+			Entity: id,
+		})
+	}
+
+	c.seen = seen
+	c.store.Notify(events)
+
+	return nil
+}
+
+// matchProcesses returns the processes matching a selector. Processes whose
+// parent matched the same selector, e.g. the workers of a server, are skipped,
+// as well as the processes running in containers.
+func (c *collector) matchProcesses(procs map[int32]*procutil.Process) []*workloadmeta.Process {
+	matched := make(map[int32]int, len(procs))
+	candidates := make(map[int32]*workloadmeta.Process)
+	c.sockets = nil
+
+	for pid, proc := range procs {
+		process := &workloadmeta.Process{
+			EntityID: workloadmeta.EntityID{
+				Kind: workloadmeta.KindProcess,
+				ID:   strconv.Itoa(int(pid)),
+			},
+			PID:        int(pid),
+			PPID:       int(proc.Ppid),
+			Comm:       proc.Name,
+			Executable: proc.Exe,
+			Cmdline:    proc.Cmdline,
+		}
+		if proc.Stats != nil && proc.Stats.CreateTime > 0 {
+			process.CreationTime = time.Unix(0, proc.Stats.CreateTime*int64(time.Millisecond))
+		}
+
+		// the cgroup and the ports are only read for the processes
+		// matching the cheaper criteria
+		var (
+			cgroupRead  bool
+			inContainer bool
+			portsRead   bool
+		)
+		for i, s := range c.selectors {
+			if !s.matchNameAndCmdline(process) {
+				continue
+			}
+
+			if !cgroupRead {
+				process.SystemdUnit, inContainer = readCgroup(c.procRoot, int(pid))
+				cgroupRead = true
+			}
+			if inContainer {
+				break
+			}
+			if s.systemdUnit != "" && s.systemdUnit != process.SystemdUnit {
+				continue
+			}
+
+			if !portsRead {
+				process.Ports = c.listeningPorts(int(pid))
+				portsRead = true
+			}
+			if !s.matchPorts(process) {
+				continue
+			}
+
+			process.Name = s.name
+			if process.Name == "" {
+				process.Name = process.Comm
+			}
+			matched[pid] = i
+			candidates[pid] = process
+			break
+		}
+	}
+
+	processes := make([]*workloadmeta.Process, 0, len(candidates))
+	for pid, process := range candidates {
+		if parentSelector, found := matched[int32(process.PPID)]; found && parentSelector == matched[pid] {
+			continue
+		}
+		processes = append(processes, process)
+	}
+
+	return processes
+}
+
+// listeningPorts returns the ports the sockets of a process are listening on
+func (c *collector) listeningPorts(pid int) []workloadmeta.ProcessPort {
+	inodes, err := socketInodes(c.procRoot, pid)
+	if err != nil {
+		log.Debugf("cannot list the sockets of process %d: %s", pid, err)
+		return nil
+	}
+	if len(inodes) == 0 {
+		return nil
+	}
+
+	if c.sockets == nil {
+		c.sockets = listeningSockets(c.procRoot)
+	}
+
+	return filterPorts(c.sockets, inodes)
+}
+
+func compileSelectors(selectorsConfig []config.ProcessSelector) []selector {
+	selectors := make([]selector, 0, len(selectorsConfig))
+	for _, sc := range selectorsConfig {
+		s, err := compileSelector(sc)
+		if err != nil {
+			log.Errorf("invalid process selector %q: %s", sc.Name, err)
+			continue
+		}
+		selectors = append(selectors, s)
+	}
+	return selectors
+}
+
+func compileSelector(sc config.ProcessSelector) (selector, error) {
+	s := selector{
+		name:        sc.Name,
+		processName: sc.ProcessName,
+		systemdUnit: sc.SystemdUnit,
+	}
+
+	if s.name == "" && s.processName == "" {
+		return s, fmt.Errorf("either name or process_name must be set")
+	}
+
+	if sc.Cmdline != "" {
+		re, err := regexp.Compile(sc.Cmdline)
+		if err != nil {
+			return s, fmt.Errorf("invalid cmdline pattern: %s", err)
+		}
+		s.cmdline = re
+	}
+
+	if len(sc.Ports) > 0 {
+		s.ports = make(map[int]struct{}, len(sc.Ports))
+		for _, port := range sc.Ports {
+			s.ports[port] = struct{}{}
+		}
+	}
+
+	// units are services unless told otherwise, e.g. `postgresql`
+	if s.systemdUnit != "" && !strings.Contains(s.systemdUnit, ".") {
+		s.systemdUnit += ".service"
+	}
+
+	// a selector with a name only matches the processes of the same name
+	if s.processName == "" && s.cmdline == nil && s.ports == nil && s.systemdUnit == "" {
+		s.processName = s.name
+	}
+
+	return s, nil
+}
+
+func (s *selector) matchNameAndCmdline(process *workloadmeta.Process) bool {
+	if s.processName != "" && s.processName != process.Comm {
+		return false
+	}
+
+	if s.cmdline != nil && !s.cmdline.MatchString(strings.Join(process.Cmdline, " ")) {
+		return false
+	}
+
+	return true
+}
+
+func (s *selector) matchPorts(process *workloadmeta.Process) bool {
+	if s.ports == nil {
+		return true
+	}
+
+	for _, port := range process.Ports {
+		if _, found := s.ports[port.Port]; found {
+			return true
+		}
+	}
+
+	return false
+}
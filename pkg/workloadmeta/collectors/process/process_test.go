@@ -0,0 +1,268 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// +build linux
+
+package process
+
+import (
+	"bufio"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DataDog/datadog-agent/pkg/config"
+	"github.com/DataDog/datadog-agent/pkg/process/procutil"
+	"github.com/DataDog/datadog-agent/pkg/workloadmeta"
+)
+
+const (
+	testTCP = `  sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode
+   0: 00000000:1538 00000000:0000 0A 00000000:00000000 00:00000000 00000000   113        0 1001 1 0000000000000000 100 0 0 10 0
+   1: 0100007F:0050 00000000:0000 0A 00000000:00000000 00:00000000 00000000     0        0 2001 1 0000000000000000 100 0 0 10 0
+   2: 0100007F:1538 0100007F:D2F0 01 00000000:00000000 00:00000000 00000000   113        0 1002 1 0000000000000000 20 4 30 10 -1
+`
+	testTCP6 = `  sl  local_address                         remote_address                        st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode
+   0: 00000000000000000000000000000000:1538 00000000000000000000000000000000:0000 0A 00000000:00000000 00:00000000 00000000   113        0 1003 1 0000000000000000 100 0 0 10 0
+   1: 00000000000000000000000001000000:0051 00000000000000000000000000000000:0000 0A 00000000:00000000 00:00000000 00000000     0        0 2002 1 0000000000000000 100 0 0 10 0
+`
+	testUDP = `   sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode ref pointer drops
+  1: 00000000:14E9 00000000:0000 07 00000000:00000000 00:00000000 00000000   113        0 1004 2 0000000000000000 0
+  2: 0100007F:9C40 0100007F:0035 01 00000000:00000000 00:00000000 00000000   113        0 1005 2 0000000000000000 0
+`
+)
+
+type fakeProc struct {
+	pid     int32
+	ppid    int32
+	name    string
+	cmdline []string
+	cgroup  string
+	sockets []string
+}
+
+// fakeStore records the events the collector notifies
+type fakeStore struct {
+	workloadmeta.Store
+	events []workloadmeta.CollectorEvent
+}
+
+func (s *fakeStore) Notify(events []workloadmeta.CollectorEvent) {
+	s.events = append(s.events, events...)
+}
+
+// setupProcRoot writes a proc tree with the given processes and the test sockets
+func setupProcRoot(t *testing.T, procs []fakeProc) (string, map[int32]*procutil.Process) {
+	procRoot, err := ioutil.TempDir("", "workloadmeta-process")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(procRoot) })
+
+	netPath := filepath.Join(procRoot, "1", "net")
+	require.NoError(t, os.MkdirAll(netPath, 0755))
+	for name, content := range map[string]string{"tcp": testTCP, "tcp6": testTCP6, "udp": testUDP} {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(netPath, name), []byte(content), 0644))
+	}
+
+	processes := make(map[int32]*procutil.Process, len(procs))
+	for _, p := range procs {
+		pidPath := filepath.Join(procRoot, strconv.Itoa(int(p.pid)))
+		require.NoError(t, os.MkdirAll(filepath.Join(pidPath, "fd"), 0755))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(pidPath, "cgroup"), []byte(p.cgroup), 0644))
+		for i, inode := range p.sockets {
+			require.NoError(t, os.Symlink("socket:["+inode+"]", filepath.Join(pidPath, "fd", strconv.Itoa(i+3))))
+		}
+		require.NoError(t, os.Symlink("/dev/null", filepath.Join(pidPath, "fd", "0")))
+
+		processes[p.pid] = &procutil.Process{
+			Pid:     p.pid,
+			Ppid:    p.ppid,
+			Name:    p.name,
+			Cmdline: p.cmdline,
+		}
+	}
+
+	return procRoot, processes
+}
+
+func TestParseHexAddress(t *testing.T) {
+	tests := []struct {
+		address string
+		ip      string
+		port    int
+		ok      bool
+	}{
+		{address: "0100007F:1538", ip: "127.0.0.1", port: 5432, ok: true},
+		{address: "00000000:0050", ip: "0.0.0.0", port: 80, ok: true},
+		{address: "00000000000000000000000001000000:0051", ip: "::1", port: 81, ok: true},
+		{address: "B80D01200000000000000000F0DEBC9A:01BB", ip: "2001:db8::9abc:def0", port: 443, ok: true},
+		{address: "0100007F", ok: false},
+		{address: "0100007F:ZZZZ", ok: false},
+		{address: "01007F:0050", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			ip, port, ok := parseHexAddress(tt.address)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.ip, ip)
+			assert.Equal(t, tt.port, port)
+		})
+	}
+}
+
+func TestParseSockets(t *testing.T) {
+	sockets := make(map[string]socket)
+	parseSockets(bufio.NewScanner(strings.NewReader(testTCP)), "tcp", sockets)
+	parseSockets(bufio.NewScanner(strings.NewReader(testUDP)), "udp", sockets)
+
+	assert.Equal(t, map[string]socket{
+		"1001": {address: "0.0.0.0", port: 5432, protocol: "tcp"},
+		"2001": {address: "127.0.0.1", port: 80, protocol: "tcp"},
+		"1004": {address: "0.0.0.0", port: 5353, protocol: "udp"},
+	}, sockets)
+}
+
+func TestReadCgroup(t *testing.T) {
+	procRoot, _ := setupProcRoot(t, []fakeProc{
+		{pid: 10, cgroup: "0::/system.slice/postgresql@14-main.service\n"},
+		{pid: 11, cgroup: "12:memory:/system.slice/nginx.service\n1:name=systemd:/system.slice/nginx.service\n"},
+		{pid: 12, cgroup: "0::/system.slice/docker-a5901276aed16ae9ea11660a41fecd674da47e8f5d8d5bce0080a611feed2be9.scope\n"},
+		{pid: 13, cgroup: "0::/user.slice/user-1000.slice/session-2.scope\n"},
+	})
+
+	unit, inContainer := readCgroup(procRoot, 10)
+	assert.Equal(t, "postgresql@14-main.service", unit)
+	assert.False(t, inContainer)
+
+	unit, inContainer = readCgroup(procRoot, 11)
+	assert.Equal(t, "nginx.service", unit)
+	assert.False(t, inContainer)
+
+	unit, inContainer = readCgroup(procRoot, 12)
+	assert.Empty(t, unit)
+	assert.True(t, inContainer)
+
+	unit, inContainer = readCgroup(procRoot, 13)
+	assert.Empty(t, unit)
+	assert.False(t, inContainer)
+
+	unit, inContainer = readCgroup(procRoot, 14)
+	assert.Empty(t, unit)
+	assert.False(t, inContainer)
+}
+
+func TestCompileSelectors(t *testing.T) {
+	selectors := compileSelectors([]config.ProcessSelector{
+		{Name: "postgres"},
+		{Name: "pg", ProcessName: "postgres", SystemdUnit: "postgresql"},
+		{ProcessName: "redis-server", SystemdUnit: "redis.scope"},
+		{Name: "java-app", Cmdline: `-jar /opt/app\.jar`, Ports: []int{8080}},
+		{Cmdline: "postgres"},
+		{Name: "broken", Cmdline: "("},
+	})
+
+	require.Len(t, selectors, 4)
+	assert.Equal(t, "postgres", selectors[0].processName)
+	assert.Equal(t, "postgresql.service", selectors[1].systemdUnit)
+	assert.Equal(t, "redis.scope", selectors[2].systemdUnit)
+	assert.Empty(t, selectors[3].processName)
+	assert.NotNil(t, selectors[3].cmdline)
+	assert.Equal(t, map[int]struct{}{8080: {}}, selectors[3].ports)
+}
+
+func TestPull(t *testing.T) {
+	procRoot, processes := setupProcRoot(t, []fakeProc{
+		// a postgres server and its worker, sharing the listening socket
+		{pid: 100, ppid: 1, name: "postgres", cmdline: []string{"/usr/lib/postgresql/14/bin/postgres", "-D", "/var/lib/postgresql/14/main"}, cgroup: "0::/system.slice/postgresql@14-main.service\n", sockets: []string{"1001", "1003", "1002"}},
+		{pid: 101, ppid: 100, name: "postgres", cmdline: []string{"postgres: 14/main: checkpointer"}, cgroup: "0::/system.slice/postgresql@14-main.service\n", sockets: []string{"1001"}},
+		// a postgres server running in a container
+		{pid: 200, ppid: 1, name: "postgres", cmdline: []string{"postgres"}, cgroup: "0::/system.slice/docker-a5901276aed16ae9ea11660a41fecd674da47e8f5d8d5bce0080a611feed2be9.scope\n"},
+		// a java application matched by its cmdline and port
+		{pid: 300, ppid: 1, name: "java", cmdline: []string{"java", "-jar", "/opt/app.jar"}, cgroup: "0::/system.slice/app.service\n", sockets: []string{"2001", "2002"}},
+		{pid: 301, ppid: 1, name: "java", cmdline: []string{"java", "-jar", "/opt/app.jar"}, cgroup: "0::/system.slice/app.service\n", sockets: []string{"1004"}},
+		{pid: 400, ppid: 1, name: "bash", cmdline: []string{"bash"}},
+	})
+
+	store := &fakeStore{}
+	c := &collector{
+		store:    store,
+		procRoot: procRoot,
+		selectors: compileSelectors([]config.ProcessSelector{
+			{Name: "postgres"},
+			{Name: "app", Cmdline: `-jar /opt/app\.jar`, Ports: []int{80}},
+		}),
+		listProcesses: func() (map[int32]*procutil.Process, error) {
+			return processes, nil
+		},
+		seen: make(map[workloadmeta.EntityID]struct{}),
+	}
+
+	require.NoError(t, c.Pull(nil))
+
+	var collected []*workloadmeta.Process
+	for _, ev := range store.events {
+		assert.Equal(t, workloadmeta.EventTypeSet, ev.Type)
+		assert.Equal(t, workloadmeta.SourceProcess, ev.Source)
+		collected = append(collected, ev.Entity.(*workloadmeta.Process))
+	}
+	sort.Slice(collected, func(i, j int) bool { return collected[i].PID < collected[j].PID })
+
+	assert.Equal(t, []*workloadmeta.Process{
+		{
+			EntityID: workloadmeta.EntityID{
+				Kind: workloadmeta.KindProcess,
+				ID:   "100",
+			},
+			EntityMeta: workloadmeta.EntityMeta{
+				Name: "postgres",
+			},
+			PID:         100,
+			PPID:        1,
+			Comm:        "postgres",
+			Cmdline:     []string{"/usr/lib/postgresql/14/bin/postgres", "-D", "/var/lib/postgresql/14/main"},
+			Ports:       []workloadmeta.ProcessPort{{Address: "0.0.0.0", Port: 5432, Protocol: "tcp"}},
+			SystemdUnit: "postgresql@14-main.service",
+		},
+		{
+			EntityID: workloadmeta.EntityID{
+				Kind: workloadmeta.KindProcess,
+				ID:   "300",
+			},
+			EntityMeta: workloadmeta.EntityMeta{
+				Name: "app",
+			},
+			PID:     300,
+			PPID:    1,
+			Comm:    "java",
+			Cmdline: []string{"java", "-jar", "/opt/app.jar"},
+			Ports: []workloadmeta.ProcessPort{
+				{Address: "127.0.0.1", Port: 80, Protocol: "tcp"},
+				{Address: "::1", Port: 81, Protocol: "tcp"},
+			},
+			SystemdUnit: "app.service",
+		},
+	}, collected)
+
+	// the processes that disappeared are unset
+	delete(processes, 300)
+	store.events = nil
+	require.NoError(t, c.Pull(nil))
+
+	require.Len(t, store.events, 2)
+	for _, ev := range store.events {
+		if ev.Type == workloadmeta.EventTypeUnset {
+			assert.Equal(t, workloadmeta.EntityID{Kind: workloadmeta.KindProcess, ID: "300"}, ev.Entity)
+		} else {
+			assert.Equal(t, "100", ev.Entity.GetID().ID)
+		}
+	}
+}
@@ -0,0 +1,243 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// +build linux
+
+package process
+
+import (
+	"bufio"
+	"encoding/hex"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/DataDog/datadog-agent/pkg/util/log"
+	"github.com/DataDog/datadog-agent/pkg/workloadmeta"
+)
+
+const (
+	// tcpListen is the state of listening TCP sockets in /proc/net/tcp
+	tcpListen = "0A"
+	// udpClose is the state of unconnected UDP sockets in /proc/net/udp
+	udpClose = "07"
+)
+
+// containerCgroupRe matches the container IDs in cgroup paths
+var containerCgroupRe = regexp.MustCompile("[0-9a-f]{64}|[0-9a-f]{8}(-[0-9a-f]{4}){4}")
+
+// socket is a listening socket of /proc/net
+type socket struct {
+	address  string
+	port     int
+	protocol string
+}
+
+// readCgroup returns the systemd unit of a process from /proc/<pid>/cgroup,
+// and whether it runs in a container.
+//
+// The file format will be something like:
+//
+// 0::/system.slice/postgresql@14-main.service
+// 1:name=systemd:/system.slice/nginx.service
+func readCgroup(procRoot string, pid int) (string, bool) {
+	f, err := os.Open(filepath.Join(procRoot, strconv.Itoa(pid), "cgroup"))
+	if err != nil {
+		log.Debugf("cannot read the cgroup of process %d: %s", pid, err)
+		return "", false
+	}
+	defer f.Close()
+
+	var unit string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		parts := strings.SplitN(scanner.Text(), ":", 3)
+		if len(parts) < 3 {
+			continue
+		}
+
+		path := parts[2]
+		if containerCgroupRe.MatchString(path) {
+			return "", true
+		}
+
+		// the unified hierarchy or the systemd one hold the unit
+		if unit != "" || (parts[1] != "" && parts[1] != "name=systemd") {
+			continue
+		}
+		for _, element := range strings.Split(path, "/") {
+			if strings.HasSuffix(element, ".service") {
+				unit = element
+			}
+		}
+	}
+
+	return unit, false
+}
+
+// socketInodes returns the inodes of the sockets opened by a process
+func socketInodes(procRoot string, pid int) (map[string]struct{}, error) {
+	fdPath := filepath.Join(procRoot, strconv.Itoa(pid), "fd")
+	fds, err := ioutil.ReadDir(fdPath)
+	if err != nil {
+		return nil, err
+	}
+
+	inodes := make(map[string]struct{})
+	for _, fd := range fds {
+		link, err := os.Readlink(filepath.Join(fdPath, fd.Name()))
+		if err != nil {
+			continue
+		}
+
+		// socket links look like socket:[12345]
+		if strings.HasPrefix(link, "socket:[") && strings.HasSuffix(link, "]") {
+			inodes[link[len("socket:["):len(link)-1]] = struct{}{}
+		}
+	}
+
+	return inodes, nil
+}
+
+// listeningSockets returns the listening sockets of the host network
+// namespace, by inode
+func listeningSockets(procRoot string) map[string]socket {
+	sockets := make(map[string]socket)
+	for _, protocol := range []string{"tcp", "tcp6", "udp", "udp6"} {
+		f, err := os.Open(filepath.Join(procRoot, "1", "net", protocol))
+		if err != nil {
+			log.Debugf("cannot read the %s sockets: %s", protocol, err)
+			continue
+		}
+
+		parseSockets(bufio.NewScanner(f), strings.TrimSuffix(protocol, "6"), sockets)
+		f.Close()
+	}
+
+	return sockets
+}
+
+// parseSockets parses the sockets listed in /proc/net/{tcp,udp}[6]. The file
+// format will be something like:
+//
+// sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode
+// 0: 0100007F:1538 00000000:0000 0A 00000000:00000000 00:00000000 00000000   999        0 23456
+func parseSockets(scanner *bufio.Scanner, protocol string, sockets map[string]socket) {
+	listenState := tcpListen
+	if protocol == "udp" {
+		listenState = udpClose
+	}
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 10 || fields[3] != listenState {
+			continue
+		}
+
+		address, port, ok := parseHexAddress(fields[1])
+		if !ok {
+			continue
+		}
+
+		// connected UDP sockets have a remote port
+		if protocol == "udp" && !strings.HasSuffix(fields[2], ":0000") {
+			continue
+		}
+
+		sockets[fields[9]] = socket{
+			address:  address,
+			port:     port,
+			protocol: protocol,
+		}
+	}
+}
+
+// parseHexAddress parses the addresses of /proc/net, written as the hex
+// representation of the address in host byte order (little endian), by
+// 32 bit words, and of the port
+func parseHexAddress(s string) (string, int, bool) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 2 {
+		return "", 0, false
+	}
+
+	port, err := strconv.ParseUint(parts[1], 16, 16)
+	if err != nil {
+		return "", 0, false
+	}
+
+	b, err := hex.DecodeString(parts[0])
+	if err != nil || (len(b) != net.IPv4len && len(b) != net.IPv6len) {
+		return "", 0, false
+	}
+
+	ip := make(net.IP, len(b))
+	for i := 0; i < len(b); i += 4 {
+		ip[i], ip[i+1], ip[i+2], ip[i+3] = b[i+3], b[i+2], b[i+1], b[i]
+	}
+
+	return ip.String(), int(port), true
+}
+
+// filterPorts returns the ports of the sockets with the given inodes, sorted
+// by port. A port listened on both IPv4 and IPv6 is only returned once, with
+// the unspecified address if any, the lowest one otherwise.
+func filterPorts(sockets map[string]socket, inodes map[string]struct{}) []workloadmeta.ProcessPort {
+	type key struct {
+		port     int
+		protocol string
+	}
+
+	byPort := make(map[key]workloadmeta.ProcessPort)
+	for inode := range inodes {
+		s, found := sockets[inode]
+		if !found {
+			continue
+		}
+
+		k := key{port: s.port, protocol: s.protocol}
+		if existing, found := byPort[k]; found && !preferAddress(s.address, existing.Address) {
+			continue
+		}
+
+		byPort[k] = workloadmeta.ProcessPort{
+			Address:  s.address,
+			Port:     s.port,
+			Protocol: s.protocol,
+		}
+	}
+
+	ports := make([]workloadmeta.ProcessPort, 0, len(byPort))
+	for _, port := range byPort {
+		ports = append(ports, port)
+	}
+
+	sort.Slice(ports, func(i, j int) bool {
+		if ports[i].Port != ports[j].Port {
+			return ports[i].Port < ports[j].Port
+		}
+		return ports[i].Protocol < ports[j].Protocol
+	})
+
+	return ports
+}
+
+// preferAddress returns whether address a is preferred over address b
+func preferAddress(a, b string) bool {
+	if isUnspecified(a) != isUnspecified(b) {
+		return isUnspecified(a)
+	}
+	return a < b
+}
+
+func isUnspecified(address string) bool {
+	ip := net.ParseIP(address)
+	return ip != nil && ip.IsUnspecified()
+}
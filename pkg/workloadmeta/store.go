@@ -323,6 +323,16 @@ func (s *store) GetECSTask(id string) (*ECSTask, error) {
 	return entity.(*ECSTask), nil
 }
 
+// GetProcess returns metadata about a process.
+func (s *store) GetProcess(id string) (*Process, error) {
+	entity, err := s.getEntityByKind(KindProcess, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.(*Process), nil
+}
+
 // Notify notifies the store with a slice of events.
 func (s *store) Notify(events []CollectorEvent) {
 	if len(events) > 0 {
@@ -30,6 +30,7 @@ type Store interface {
 	GetKubernetesPod(id string) (*KubernetesPod, error)
 	GetKubernetesPodForContainer(containerID string) (*KubernetesPod, error)
 	GetECSTask(id string) (*ECSTask, error)
+	GetProcess(id string) (*Process, error)
 	Notify(events []CollectorEvent)
 	Dump(verbose bool) WorkloadDumpResponse
 }
@@ -54,6 +55,7 @@ const (
 	KindContainer     Kind = "container"
 	KindKubernetesPod Kind = "kubernetes_pod"
 	KindECSTask       Kind = "ecs_task"
+	KindProcess       Kind = "process"
 
 	SourceDocker       Source = "docker"
 	SourceContainerd   Source = "containerd"
@@ -61,6 +63,7 @@ const (
 	SourceECSFargate   Source = "ecs_fargate"
 	SourceKubelet      Source = "kubelet"
 	SourceKubeMetadata Source = "kube_metadata"
+	SourceProcess      Source = "process"
 
 	ContainerRuntimeDocker     ContainerRuntime = "docker"
 	ContainerRuntimeContainerd ContainerRuntime = "containerd"
@@ -471,6 +474,96 @@ func (t ECSTask) String(verbose bool) string {
 
 var _ Entity = &ECSTask{}
 
+// ProcessPort is a port a process is listening on.
+type ProcessPort struct {
+	Address  string
+	Port     int
+	Protocol string
+}
+
+// String returns a string representation of ProcessPort.
+func (p ProcessPort) String(verbose bool) string {
+	var sb strings.Builder
+	_, _ = fmt.Fprintln(&sb, "Port:", p.Port)
+
+	if verbose {
+		_, _ = fmt.Fprintln(&sb, "Address:", p.Address)
+		_, _ = fmt.Fprintln(&sb, "Protocol:", p.Protocol)
+	}
+
+	return sb.String()
+}
+
+// Process is a long-running process of the host, running outside of
+// containers. Its name is the name of the process selector it matched.
+type Process struct {
+	EntityID
+	EntityMeta
+	PID          int
+	PPID         int
+	Comm         string
+	Executable   string
+	Cmdline      []string
+	Ports        []ProcessPort
+	SystemdUnit  string
+	CreationTime time.Time
+}
+
+// GetID returns the Process's EntityID.
+func (p Process) GetID() EntityID {
+	return p.EntityID
+}
+
+// Merge merges a Process with another. Returns an error if trying to merge
+// with another kind.
+func (p *Process) Merge(e Entity) error {
+	pp, ok := e.(*Process)
+	if !ok {
+		return fmt.Errorf("cannot merge Process with different kind %T", e)
+	}
+
+	return mergo.Merge(p, pp)
+}
+
+// DeepCopy returns a deep copy of the process.
+func (p Process) DeepCopy() Entity {
+	cp := deepcopy.Copy(p).(Process)
+	return &cp
+}
+
+// String returns a string representation of Process.
+func (p Process) String(verbose bool) string {
+	var sb strings.Builder
+	_, _ = fmt.Fprintln(&sb, "----------- Entity ID -----------")
+	_, _ = fmt.Fprint(&sb, p.EntityID.String(verbose))
+
+	_, _ = fmt.Fprintln(&sb, "----------- Entity Meta -----------")
+	_, _ = fmt.Fprint(&sb, p.EntityMeta.String(verbose))
+
+	_, _ = fmt.Fprintln(&sb, "----------- Process Info -----------")
+	_, _ = fmt.Fprintln(&sb, "PID:", p.PID)
+	_, _ = fmt.Fprintln(&sb, "Comm:", p.Comm)
+	_, _ = fmt.Fprintln(&sb, "Systemd Unit:", p.SystemdUnit)
+
+	if verbose {
+		_, _ = fmt.Fprintln(&sb, "PPID:", p.PPID)
+		_, _ = fmt.Fprintln(&sb, "Executable:", p.Executable)
+		_, _ = fmt.Fprintln(&sb, "Cmdline:", sliceToString(p.Cmdline))
+		_, _ = fmt.Fprintln(&sb, "Creation Time:", p.CreationTime)
+	}
+
+	if len(p.Ports) > 0 {
+		_, _ = fmt.Fprintln(&sb, "----------- Ports -----------")
+		for _, port := range p.Ports {
+			_, _ = fmt.Fprint(&sb, port.String(verbose))
+		}
+	}
+
+	return sb.String()
+}
+
+var _ Entity = &Process{}
+
 // CollectorEvent is an event generated by a metadata collector, to be handled
 // by the metadata store.
 type CollectorEvent struct {
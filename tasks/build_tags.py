@@ -32,6 +32,7 @@ ALL_TAGS = set(
         "python",
         "secrets",
         "systemd",
+        "wasmchecks",  # The WebAssembly check loader, which requires Go 1.18
         "zk",
         "zlib",
     ]